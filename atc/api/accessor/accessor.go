@@ -2,6 +2,8 @@ package accessor
 
 import (
 	"fmt"
+	"path"
+	"sort"
 	"strings"
 
 	"github.com/concourse/concourse/atc"
@@ -14,11 +16,14 @@ type Access interface {
 	HasToken() bool
 	IsAuthenticated() bool
 	IsAuthorized(string) bool
+	IsAuthorizedForPipeline(string, string) bool
 	IsAdmin() bool
 	IsSystem() bool
 	TeamNames() []string
+	PipelineTeamNames() []string
 	TeamRoles() map[string][]string
 	Claims() Claims
+	AuthSubject() atc.AuthSubject
 }
 
 type Claims struct {
@@ -28,6 +33,7 @@ type Claims struct {
 	UserName  string
 	Email     string
 	Connector string
+	SessionID int
 }
 
 type Verification struct {
@@ -36,9 +42,18 @@ type Verification struct {
 	RawClaims    map[string]interface{}
 }
 
+// Action is the API action being accessed. Pipeline is set when the action
+// targets a single pipeline, so that custom team roles can grant it for only
+// some of a team's pipelines.
+type Action struct {
+	Name     string
+	Pipeline string
+}
+
 type access struct {
 	verification      Verification
 	requiredRole      string
+	action            Action
 	systemClaimKey    string
 	systemClaimValues []string
 	teams             []db.Team
@@ -47,6 +62,7 @@ type access struct {
 func NewAccessor(
 	verification Verification,
 	requiredRole string,
+	action Action,
 	systemClaimKey string,
 	systemClaimValues []string,
 	teams []db.Team,
@@ -54,6 +70,7 @@ func NewAccessor(
 	return &access{
 		verification:      verification,
 		requiredRole:      requiredRole,
+		action:            action,
 		systemClaimKey:    systemClaimKey,
 		systemClaimValues: systemClaimValues,
 		teams:             teams,
@@ -83,6 +100,24 @@ func (a *access) IsAuthorized(teamName string) bool {
 	return false
 }
 
+// IsAuthorizedForPipeline returns whether the user is authorized on the given
+// pipeline of the team, either through a role on the whole team or through a
+// role granted only on some of its pipelines.
+func (a *access) IsAuthorizedForPipeline(teamName string, pipelineName string) bool {
+
+	if a.IsAdmin() {
+		return true
+	}
+
+	for _, team := range a.teams {
+		if team.Name() == teamName {
+			return a.hasRequiredRoleOnPipeline(team, pipelineName)
+		}
+	}
+
+	return false
+}
+
 func (a *access) TeamNames() []string {
 
 	teamNames := []string{}
@@ -90,7 +125,7 @@ func (a *access) TeamNames() []string {
 	isAdmin := a.IsAdmin()
 
 	for _, team := range a.teams {
-		if isAdmin || a.hasRequiredRole(team.Auth()) {
+		if isAdmin || a.hasRequiredRole(team) {
 			teamNames = append(teamNames, team.Name())
 		}
 	}
@@ -98,9 +133,40 @@ func (a *access) TeamNames() []string {
 	return teamNames
 }
 
-func (a *access) hasRequiredRole(auth atc.TeamAuth) bool {
-	for _, teamRole := range a.rolesForTeam(auth) {
-		if a.hasPermission(teamRole) {
+// PipelineTeamNames returns the teams on which the user is not authorized as a
+// whole, but is granted the required role on some of their pipelines. Results
+// from these teams must be checked with IsAuthorizedForPipeline.
+func (a *access) PipelineTeamNames() []string {
+
+	teamNames := []string{}
+
+	if a.IsAdmin() {
+		return teamNames
+	}
+
+	for _, team := range a.teams {
+		if a.hasRequiredRole(team) {
+			continue
+		}
+
+		for _, teamRole := range a.grantedRoles(team, anyPipeline) {
+			if a.hasPermission(team, teamRole, a.action.Pipeline) {
+				teamNames = append(teamNames, team.Name())
+				break
+			}
+		}
+	}
+
+	return teamNames
+}
+
+func (a *access) hasRequiredRole(team db.Team) bool {
+	return a.hasRequiredRoleOnPipeline(team, a.action.Pipeline)
+}
+
+func (a *access) hasRequiredRoleOnPipeline(team db.Team, pipelineName string) bool {
+	for _, teamRole := range a.rolesForPipeline(team, pipelineName) {
+		if a.hasPermission(team, teamRole, pipelineName) {
 			return true
 		}
 	}
@@ -112,7 +178,7 @@ func (a *access) teamRoles() map[string][]string {
 	teamRoles := map[string][]string{}
 
 	for _, team := range a.teams {
-		if roles := a.rolesForTeam(team.Auth()); len(roles) > 0 {
+		if roles := a.rolesForTeam(team); len(roles) > 0 {
 			teamRoles[team.Name()] = roles
 		}
 	}
@@ -120,57 +186,201 @@ func (a *access) teamRoles() map[string][]string {
 	return teamRoles
 }
 
-func (a *access) rolesForTeam(auth atc.TeamAuth) []string {
+func (a *access) rolesForTeam(team db.Team) []string {
+	return a.rolesForPipeline(team, a.action.Pipeline)
+}
+
+// rolesForPipeline returns the roles granted to the user on the team, leaving
+// out the ones that are restricted to pipelines other than the given one.
+func (a *access) rolesForPipeline(team db.Team, pipelineName string) []string {
+	return a.grantedRoles(team, func(patterns []string) bool {
+		if pipelineName == "" {
+			return false
+		}
+
+		for _, pattern := range patterns {
+			if matched, _ := path.Match(pattern, pipelineName); matched {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+func anyPipeline([]string) bool { return true }
+
+// grantedRoles returns the roles granted to the user on the team. Grants that
+// are restricted to pipelines are only included when allowPipelines accepts
+// their pipeline patterns.
+func (a *access) grantedRoles(team db.Team, allowPipelines func([]string) bool) []string {
+
+	// api tokens are granted at most one role on exactly one team
+	if token, ok := a.apiToken(); ok {
+		if token.team != team.Name() {
+			return nil
+		}
+
+		if token.creator == nil {
+			return []string{token.role}
+		}
+
+		if role := a.creatorRole(team, *token.creator, token.role); role != "" {
+			return []string{role}
+		}
+
+		return nil
+	}
 
 	roleSet := map[string]bool{}
 
-	groups := a.groups()
-	connectorID := a.connectorID()
-	userID := a.userID()
-	userName := a.UserName()
+	for _, rule := range MatchTeamAuth(team.Auth(), a.AuthSubject()) {
+		if len(rule.Pipelines) > 0 && !allowPipelines(rule.Pipelines) {
+			continue
+		}
 
-	for role, auth := range auth {
-		userAuth := auth["users"]
-		groupAuth := auth["groups"]
+		roleSet[rule.Role] = true
+	}
 
-		// backwards compatibility for allow-all-users
+	var roles []string
+	for role := range roleSet {
+		roles = append(roles, role)
+	}
+	return roles
+}
+
+// AuthSubject returns the user as seen by the team auth rules.
+func (a *access) AuthSubject() atc.AuthSubject {
+	return atc.AuthSubject{
+		Connector: a.connectorID(),
+		UserID:    a.userID(),
+		UserName:  a.UserName(),
+		Groups:    a.groups(),
+	}
+}
+
+// MatchTeamAuth returns the rules of the team auth config that grant a role
+// to the subject, ordered by role.
+func MatchTeamAuth(auth atc.TeamAuth, subject atc.AuthSubject) []atc.TeamAuthRule {
+	var roles []string
+	for role := range auth {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	var rules []atc.TeamAuthRule
+
+	for _, role := range roles {
+		userAuth := auth[role]["users"]
+		groupAuth := auth[role]["groups"]
+		pipelines := auth[role]["pipelines"]
+
+		// backwards compatibility for allow-all-users, which only applies to
+		// entries that set nothing else. an entry restricted to pipelines
+		// grants nothing unless it names users or groups
 		if len(userAuth) == 0 && len(groupAuth) == 0 {
-			roleSet[role] = true
+			if isAllowAllUsers(auth[role]) {
+				rules = append(rules, atc.TeamAuthRule{
+					Role: role,
+					Type: atc.TeamAuthRuleAll,
+				})
+			}
+			continue
 		}
 
 		for _, user := range userAuth {
-			if userID != "" {
-				if strings.EqualFold(user, fmt.Sprintf("%v:%v", connectorID, userID)) {
-					roleSet[role] = true
-				}
-			}
-			if userName != "" {
-				if strings.EqualFold(user, fmt.Sprintf("%v:%v", connectorID, userName)) {
-					roleSet[role] = true
-				}
+			if (subject.UserID != "" && strings.EqualFold(user, fmt.Sprintf("%v:%v", subject.Connector, subject.UserID))) ||
+				(subject.UserName != "" && strings.EqualFold(user, fmt.Sprintf("%v:%v", subject.Connector, subject.UserName))) {
+				rules = append(rules, atc.TeamAuthRule{
+					Role:      role,
+					Type:      atc.TeamAuthRuleUser,
+					Value:     user,
+					Pipelines: pipelines,
+				})
 			}
 		}
 
 		for _, group := range groupAuth {
-			for _, claimGroup := range groups {
-				if claimGroup != "" {
-					if strings.EqualFold(group, fmt.Sprintf("%v:%v", connectorID, claimGroup)) {
-						roleSet[role] = true
-					}
+			for _, claimGroup := range subject.Groups {
+				if claimGroup != "" && strings.EqualFold(group, fmt.Sprintf("%v:%v", subject.Connector, claimGroup)) {
+					rules = append(rules, atc.TeamAuthRule{
+						Role:      role,
+						Type:      atc.TeamAuthRuleGroup,
+						Value:     group,
+						Pipelines: pipelines,
+					})
+					break
 				}
 			}
 		}
 	}
 
-	var roles []string
-	for role := range roleSet {
-		roles = append(roles, role)
+	return rules
+}
+
+func isAllowAllUsers(config map[string][]string) bool {
+	for key := range config {
+		if key != "users" && key != "groups" {
+			return false
+		}
 	}
-	return roles
+	return true
+}
+
+func (a *access) hasPermission(team db.Team, role string, pipelineName string) bool {
+	if HasPermission(role, a.requiredRole) {
+		return true
+	}
+
+	customRole, found := customRole(team, role)
+	if !found {
+		return false
+	}
+
+	if customRole.Extends != "" && HasPermission(customRole.Extends, a.requiredRole) {
+		return true
+	}
+
+	for _, permission := range customRole.Permissions {
+		if permission.Action != a.action.Name {
+			continue
+		}
+
+		if len(permission.Pipelines) == 0 {
+			return true
+		}
+
+		for _, pipeline := range permission.Pipelines {
+			if pipeline == pipelineName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func customRole(team db.Team, role string) (atc.TeamRole, bool) {
+	for _, customRole := range team.CustomRoles() {
+		if customRole.Name == role {
+			return customRole, true
+		}
+	}
+	return atc.TeamRole{}, false
 }
 
-func (a *access) hasPermission(role string) bool {
-	switch a.requiredRole {
+// BaseRole returns the built-in role that the given role of the team grants,
+// resolving custom roles to the role they extend.
+func BaseRole(team db.Team, role string) string {
+	if customRole, found := customRole(team, role); found {
+		return customRole.Extends
+	}
+	return role
+}
+
+// HasPermission returns whether the given role satisfies the required role.
+func HasPermission(role string, requiredRole string) bool {
+	switch requiredRole {
 	case OwnerRole:
 		return role == OwnerRole
 	case MemberRole:
@@ -209,6 +419,82 @@ func (a *access) federatedClaim(name string) string {
 	return ""
 }
 
+type apiTokenGrant struct {
+	team string
+	role string
+
+	// creator is only set for personal tokens
+	creator *atc.AuthSubject
+}
+
+func (a *access) apiToken() (apiTokenGrant, bool) {
+	raw, ok := a.claims()["api_token"].(map[string]interface{})
+	if !ok {
+		return apiTokenGrant{}, false
+	}
+
+	var grant apiTokenGrant
+	grant.team, _ = raw["team"].(string)
+	grant.role, _ = raw["role"].(string)
+
+	if creator, ok := raw["creator"].(map[string]interface{}); ok {
+		subject := atc.AuthSubject{}
+		subject.Connector, _ = creator["connector"].(string)
+		subject.UserID, _ = creator["user_id"].(string)
+		subject.UserName, _ = creator["user_name"].(string)
+
+		groups, _ := creator["groups"].([]interface{})
+		for _, group := range groups {
+			if group, ok := group.(string); ok {
+				subject.Groups = append(subject.Groups, group)
+			}
+		}
+
+		grant.creator = &subject
+	}
+
+	return grant, true
+}
+
+// creatorRole caps the role of a personal api token by the role its creator
+// currently holds on the team, so that a token stops granting what its
+// creator has since lost. It returns the highest role up to the token's
+// role which the creator still holds, or "" if there is none.
+func (a *access) creatorRole(team db.Team, creator atc.AuthSubject, tokenRole string) string {
+	creatorIsAdmin := false
+	for _, t := range a.teams {
+		if t.Admin() && subjectHasRole(t, creator, OwnerRole) {
+			creatorIsAdmin = true
+			break
+		}
+	}
+
+	for _, role := range []string{OwnerRole, MemberRole, OperatorRole, ViewerRole} {
+		if !HasPermission(tokenRole, role) {
+			continue
+		}
+
+		if creatorIsAdmin || subjectHasRole(team, creator, role) {
+			return role
+		}
+	}
+
+	return ""
+}
+
+// subjectHasRole returns whether the subject is granted the role, or one
+// which includes it, on the whole team. Grants restricted to pipelines are
+// left out, the same as when the token was created.
+func subjectHasRole(team db.Team, subject atc.AuthSubject, role string) bool {
+	for _, rule := range MatchTeamAuth(team.Auth(), subject) {
+		if len(rule.Pipelines) == 0 && HasPermission(BaseRole(team, rule.Role), role) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (a *access) claim(name string) string {
 	if raw, ok := a.claims()[name]; ok {
 		if claim, ok := raw.(string); ok {
@@ -230,6 +516,17 @@ func (a *access) connectorID() string {
 	return a.federatedClaim("connector_id")
 }
 
+func (a *access) sessionID() int {
+	switch id := a.claims()["session_id"].(type) {
+	case int:
+		return id
+	case float64:
+		return int(id)
+	default:
+		return 0
+	}
+}
+
 func (a *access) groups() []string {
 	groups := []string{}
 	if raw, ok := a.claims()["groups"]; ok {
@@ -244,24 +541,21 @@ func (a *access) groups() []string {
 	return groups
 }
 
-func (a *access) adminTeams() []string {
-	var adminTeams []string
+func (a *access) IsAdmin() bool {
 
-	for _, team := range a.teams {
-		if team.Admin() {
-			adminTeams = append(adminTeams, team.Name())
-		}
+	// api tokens are scoped to a single team and never grant admin
+	if _, ok := a.apiToken(); ok {
+		return false
 	}
-	return adminTeams
-}
 
-func (a *access) IsAdmin() bool {
-
-	teamRoles := a.teamRoles()
+	for _, team := range a.teams {
+		if !team.Admin() {
+			continue
+		}
 
-	for _, adminTeam := range a.adminTeams() {
-		for _, role := range teamRoles[adminTeam] {
-			if role == "owner" {
+		// roles granted on some pipelines never make the user an admin
+		for _, role := range a.rolesForPipeline(team, "") {
+			if role == OwnerRole {
 				return true
 			}
 		}
@@ -293,5 +587,6 @@ func (a *access) Claims() Claims {
 		UserID:    a.userID(),
 		UserName:  a.UserName(),
 		Connector: a.connectorID(),
+		SessionID: a.sessionID(),
 	}
 }
@@ -33,7 +33,7 @@ var _ = Describe("AccessorFactory", func() {
 
 		JustBeforeEach(func() {
 			factory := accessor.NewAccessFactory(systemClaimKey, systemClaimValues)
-			access = factory.Create(role, verification, teams)
+			access = factory.Create(role, accessor.Action{}, verification, teams)
 		})
 
 		It("creates an accessor", func() {
@@ -15,6 +15,7 @@ var _ = Describe("Accessor", func() {
 	var (
 		verification accessor.Verification
 		requiredRole string
+		action       accessor.Action
 		teams        []db.Team
 		access       accessor.Access
 
@@ -32,12 +33,13 @@ var _ = Describe("Accessor", func() {
 		fakeTeam3.NameReturns("some-team-3")
 
 		verification = accessor.Verification{}
+		action = accessor.Action{}
 
 		teams = []db.Team{fakeTeam1, fakeTeam2, fakeTeam3}
 	})
 
 	JustBeforeEach(func() {
-		access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+		access = accessor.NewAccessor(verification, requiredRole, action, "sub", []string{"system"}, teams)
 	})
 
 	Describe("HasToken", func() {
@@ -190,7 +192,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+			access = accessor.NewAccessor(verification, requiredRole, action, "sub", []string{"system"}, teams)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -236,7 +238,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+			access = accessor.NewAccessor(verification, requiredRole, action, "sub", []string{"system"}, teams)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -606,6 +608,21 @@ var _ = Describe("Accessor", func() {
 				}))
 			})
 		})
+
+		Context("when the token belongs to a session", func() {
+			BeforeEach(func() {
+				verification.HasToken = true
+				verification.IsTokenValid = true
+				verification.RawClaims = map[string]interface{}{
+					"sub":        "some-sub",
+					"session_id": 42,
+				}
+			})
+
+			It("returns the session id", func() {
+				Expect(result.SessionID).To(Equal(42))
+			})
+		})
 	})
 
 	Describe("TeamRoles", func() {
@@ -771,4 +788,439 @@ var _ = Describe("Accessor", func() {
 			})
 		})
 	})
+
+	Describe("API tokens", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "api-token",
+					"user_id":      "1",
+					"user_name":    "some-user",
+				},
+				"api_token": map[string]interface{}{
+					"team": "some-team-1",
+					"role": "member",
+				},
+			}
+
+			fakeTeam1.AdminReturns(true)
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{
+					"users": []string{"api-token:some-user"},
+				},
+			})
+		})
+
+		It("only grants the token role on the token team", func() {
+			Expect(access.TeamRoles()).To(Equal(map[string][]string{
+				"some-team-1": []string{"member"},
+			}))
+		})
+
+		It("is never admin", func() {
+			Expect(access.IsAdmin()).To(BeFalse())
+		})
+
+		Context("when the action requires a role within the token role", func() {
+			BeforeEach(func() {
+				requiredRole = "viewer"
+			})
+
+			It("is authorized for the token team only", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+				Expect(access.IsAuthorized("some-team-2")).To(BeFalse())
+			})
+		})
+
+		Context("when the action requires a role beyond the token role", func() {
+			BeforeEach(func() {
+				requiredRole = "owner"
+			})
+
+			It("is not authorized", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+			})
+		})
+
+		Context("when the token is a personal token", func() {
+			BeforeEach(func() {
+				verification.RawClaims["api_token"] = map[string]interface{}{
+					"team": "some-team-1",
+					"role": "member",
+					"creator": map[string]interface{}{
+						"connector": "some-connector",
+						"user_id":   "some-user-id",
+						"user_name": "some-user",
+						"groups":    []interface{}{},
+					},
+				}
+
+				fakeTeam1.AdminReturns(false)
+			})
+
+			Context("when the creator still holds the token role", func() {
+				BeforeEach(func() {
+					fakeTeam1.AuthReturns(atc.TeamAuth{
+						"owner": map[string][]string{
+							"users": []string{"some-connector:some-user"},
+						},
+					})
+				})
+
+				It("grants the token role", func() {
+					Expect(access.TeamRoles()).To(Equal(map[string][]string{
+						"some-team-1": []string{"member"},
+					}))
+				})
+			})
+
+			Context("when the creator has been downgraded", func() {
+				BeforeEach(func() {
+					fakeTeam1.AuthReturns(atc.TeamAuth{
+						"viewer": map[string][]string{
+							"users": []string{"some-connector:some-user"},
+						},
+					})
+				})
+
+				It("caps the token role by the role of the creator", func() {
+					Expect(access.TeamRoles()).To(Equal(map[string][]string{
+						"some-team-1": []string{"viewer"},
+					}))
+				})
+			})
+
+			Context("when the creator only holds a pipeline-scoped role", func() {
+				BeforeEach(func() {
+					fakeTeam1.AuthReturns(atc.TeamAuth{
+						"member": map[string][]string{
+							"users":     []string{"some-connector:some-user"},
+							"pipelines": []string{"some-pipeline"},
+						},
+					})
+				})
+
+				It("grants nothing", func() {
+					Expect(access.TeamRoles()).To(BeEmpty())
+				})
+			})
+
+			Context("when the creator has been removed from the team", func() {
+				BeforeEach(func() {
+					fakeTeam1.AuthReturns(atc.TeamAuth{
+						"owner": map[string][]string{
+							"users": []string{"some-connector:some-other-user"},
+						},
+					})
+
+					requiredRole = "viewer"
+				})
+
+				It("grants nothing", func() {
+					Expect(access.TeamRoles()).To(BeEmpty())
+					Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+				})
+			})
+
+			Context("when the creator is an admin", func() {
+				BeforeEach(func() {
+					fakeTeam1.AuthReturns(atc.TeamAuth{})
+
+					fakeTeam2.AdminReturns(true)
+					fakeTeam2.AuthReturns(atc.TeamAuth{
+						"owner": map[string][]string{
+							"users": []string{"some-connector:some-user"},
+						},
+					})
+				})
+
+				It("grants the token role", func() {
+					Expect(access.TeamRoles()).To(HaveKeyWithValue("some-team-1", []string{"member"}))
+				})
+			})
+		})
+	})
+
+	Describe("custom roles", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_name":    "some-user",
+				},
+			}
+
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"release-manager": map[string][]string{
+					"users": []string{"some-connector:some-user"},
+				},
+			})
+			fakeTeam1.CustomRolesReturns([]atc.TeamRole{
+				{
+					Name:    "release-manager",
+					Extends: "pipeline-operator",
+					Permissions: []atc.TeamRolePermission{
+						{Action: atc.SaveConfig, Pipelines: []string{"release"}},
+						{Action: atc.ExposePipeline},
+					},
+				},
+			})
+		})
+
+		It("reports the custom role", func() {
+			Expect(access.TeamRoles()).To(Equal(map[string][]string{
+				"some-team-1": []string{"release-manager"},
+			}))
+		})
+
+		Context("when the action is granted by the extended role", func() {
+			BeforeEach(func() {
+				requiredRole = "pipeline-operator"
+				action = accessor.Action{Name: atc.PausePipeline, Pipeline: "some-pipeline"}
+			})
+
+			It("is authorized", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+			})
+		})
+
+		Context("when the action is beyond the extended role", func() {
+			BeforeEach(func() {
+				requiredRole = "member"
+				action = accessor.Action{Name: atc.OrderPipelines}
+			})
+
+			It("is not authorized", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+			})
+		})
+
+		Context("when the action is granted on any pipeline", func() {
+			BeforeEach(func() {
+				requiredRole = "member"
+				action = accessor.Action{Name: atc.ExposePipeline, Pipeline: "some-pipeline"}
+			})
+
+			It("is authorized", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+			})
+		})
+
+		Context("when the action is granted on specific pipelines", func() {
+			BeforeEach(func() {
+				requiredRole = "member"
+			})
+
+			Context("when accessing one of the pipelines", func() {
+				BeforeEach(func() {
+					action = accessor.Action{Name: atc.SaveConfig, Pipeline: "release"}
+				})
+
+				It("is authorized", func() {
+					Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+				})
+			})
+
+			Context("when accessing another pipeline", func() {
+				BeforeEach(func() {
+					action = accessor.Action{Name: atc.SaveConfig, Pipeline: "some-pipeline"}
+				})
+
+				It("is not authorized", func() {
+					Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when another team defines the role", func() {
+			BeforeEach(func() {
+				fakeTeam2.AuthReturns(atc.TeamAuth{
+					"release-manager": map[string][]string{
+						"users": []string{"some-connector:some-user"},
+					},
+				})
+
+				requiredRole = "pipeline-operator"
+			})
+
+			It("only applies the role on the defining team", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+				Expect(access.IsAuthorized("some-team-2")).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("pipeline-scoped roles", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_name":    "some-user",
+				},
+			}
+
+			fakeTeam1.AdminReturns(true)
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{
+					"users":     []string{"some-connector:some-user"},
+					"pipelines": []string{"deploy-*", "release"},
+				},
+			})
+
+			requiredRole = "pipeline-operator"
+		})
+
+		It("never makes the user an admin", func() {
+			action = accessor.Action{Name: atc.PausePipeline, Pipeline: "release"}
+			access = accessor.NewAccessor(verification, requiredRole, action, "sub", []string{"system"}, teams)
+
+			Expect(access.IsAdmin()).To(BeFalse())
+		})
+
+		Context("when the action targets a granted pipeline", func() {
+			BeforeEach(func() {
+				action = accessor.Action{Name: atc.PausePipeline, Pipeline: "deploy-prod"}
+			})
+
+			It("is authorized", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+				Expect(access.TeamNames()).To(ConsistOf("some-team-1"))
+			})
+		})
+
+		Context("when the action targets another pipeline", func() {
+			BeforeEach(func() {
+				action = accessor.Action{Name: atc.PausePipeline, Pipeline: "some-pipeline"}
+			})
+
+			It("is not authorized", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+			})
+		})
+
+		Context("when the action does not target a pipeline", func() {
+			BeforeEach(func() {
+				requiredRole = "viewer"
+				action = accessor.Action{Name: atc.ListAllPipelines}
+			})
+
+			It("is not authorized on the team", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+				Expect(access.TeamNames()).To(BeEmpty())
+			})
+
+			It("lists the team as partially accessible", func() {
+				Expect(access.PipelineTeamNames()).To(ConsistOf("some-team-1"))
+			})
+
+			It("is authorized on the granted pipelines only", func() {
+				Expect(access.IsAuthorizedForPipeline("some-team-1", "deploy-staging")).To(BeTrue())
+				Expect(access.IsAuthorizedForPipeline("some-team-1", "release")).To(BeTrue())
+				Expect(access.IsAuthorizedForPipeline("some-team-1", "some-pipeline")).To(BeFalse())
+				Expect(access.IsAuthorizedForPipeline("some-team-2", "release")).To(BeFalse())
+			})
+		})
+
+		Context("when the user is also granted a role on the whole team", func() {
+			BeforeEach(func() {
+				fakeTeam1.AuthReturns(atc.TeamAuth{
+					"owner": map[string][]string{
+						"users":     []string{"some-connector:some-user"},
+						"pipelines": []string{"release"},
+					},
+					"viewer": map[string][]string{
+						"users": []string{"some-connector:some-user"},
+					},
+				})
+
+				requiredRole = "viewer"
+				action = accessor.Action{Name: atc.ListAllPipelines}
+			})
+
+			It("is authorized on the team", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+				Expect(access.PipelineTeamNames()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("MatchTeamAuth", func() {
+		var auth atc.TeamAuth
+
+		BeforeEach(func() {
+			auth = atc.TeamAuth{
+				"owner": map[string][]string{
+					"users": {"github:some-user", "local:some-user"},
+				},
+				"member": map[string][]string{
+					"groups": {"github:some-org:some-team"},
+				},
+				"viewer": map[string][]string{
+					"users":     {"github:some-id"},
+					"pipelines": {"some-*"},
+				},
+			}
+		})
+
+		It("matches users by name or id within their connector", func() {
+			rules := accessor.MatchTeamAuth(auth, atc.AuthSubject{
+				Connector: "github",
+				UserID:    "some-id",
+				UserName:  "Some-User",
+			})
+
+			Expect(rules).To(Equal([]atc.TeamAuthRule{
+				{Role: "owner", Type: atc.TeamAuthRuleUser, Value: "github:some-user"},
+				{Role: "viewer", Type: atc.TeamAuthRuleUser, Value: "github:some-id", Pipelines: []string{"some-*"}},
+			}))
+		})
+
+		It("matches groups within the connector", func() {
+			rules := accessor.MatchTeamAuth(auth, atc.AuthSubject{
+				Connector: "github",
+				UserName:  "other-user",
+				Groups:    []string{"some-org", "some-org:some-team"},
+			})
+
+			Expect(rules).To(Equal([]atc.TeamAuthRule{
+				{Role: "member", Type: atc.TeamAuthRuleGroup, Value: "github:some-org:some-team"},
+			}))
+		})
+
+		It("does not match users of other connectors", func() {
+			rules := accessor.MatchTeamAuth(auth, atc.AuthSubject{
+				Connector: "gitlab",
+				UserName:  "some-user",
+				Groups:    []string{"some-org:some-team"},
+			})
+
+			Expect(rules).To(BeEmpty())
+		})
+
+		It("matches everyone on roles without users and groups", func() {
+			rules := accessor.MatchTeamAuth(atc.TeamAuth{
+				"viewer": map[string][]string{},
+			}, atc.AuthSubject{})
+
+			Expect(rules).To(Equal([]atc.TeamAuthRule{
+				{Role: "viewer", Type: atc.TeamAuthRuleAll},
+			}))
+		})
+
+		It("matches no one on roles restricted to pipelines without users and groups", func() {
+			rules := accessor.MatchTeamAuth(atc.TeamAuth{
+				"owner": map[string][]string{
+					"pipelines": {"some-pipeline"},
+				},
+			}, atc.AuthSubject{Connector: "github", UserName: "some-user"})
+
+			Expect(rules).To(BeEmpty())
+		})
+	})
 })
@@ -4,10 +4,21 @@ package accessorfakes
 import (
 	"sync"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 )
 
 type FakeAccess struct {
+	AuthSubjectStub        func() atc.AuthSubject
+	authSubjectMutex       sync.RWMutex
+	authSubjectArgsForCall []struct {
+	}
+	authSubjectReturns struct {
+		result1 atc.AuthSubject
+	}
+	authSubjectReturnsOnCall map[int]struct {
+		result1 atc.AuthSubject
+	}
 	ClaimsStub        func() accessor.Claims
 	claimsMutex       sync.RWMutex
 	claimsArgsForCall []struct {
@@ -59,6 +70,18 @@ type FakeAccess struct {
 	isAuthorizedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsAuthorizedForPipelineStub        func(string, string) bool
+	isAuthorizedForPipelineMutex       sync.RWMutex
+	isAuthorizedForPipelineArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	isAuthorizedForPipelineReturns struct {
+		result1 bool
+	}
+	isAuthorizedForPipelineReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsSystemStub        func() bool
 	isSystemMutex       sync.RWMutex
 	isSystemArgsForCall []struct {
@@ -69,6 +92,16 @@ type FakeAccess struct {
 	isSystemReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PipelineTeamNamesStub        func() []string
+	pipelineTeamNamesMutex       sync.RWMutex
+	pipelineTeamNamesArgsForCall []struct {
+	}
+	pipelineTeamNamesReturns struct {
+		result1 []string
+	}
+	pipelineTeamNamesReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	TeamNamesStub        func() []string
 	teamNamesMutex       sync.RWMutex
 	teamNamesArgsForCall []struct {
@@ -93,6 +126,58 @@ type FakeAccess struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeAccess) AuthSubject() atc.AuthSubject {
+	fake.authSubjectMutex.Lock()
+	ret, specificReturn := fake.authSubjectReturnsOnCall[len(fake.authSubjectArgsForCall)]
+	fake.authSubjectArgsForCall = append(fake.authSubjectArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AuthSubject", []interface{}{})
+	fake.authSubjectMutex.Unlock()
+	if fake.AuthSubjectStub != nil {
+		return fake.AuthSubjectStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authSubjectReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) AuthSubjectCallCount() int {
+	fake.authSubjectMutex.RLock()
+	defer fake.authSubjectMutex.RUnlock()
+	return len(fake.authSubjectArgsForCall)
+}
+
+func (fake *FakeAccess) AuthSubjectCalls(stub func() atc.AuthSubject) {
+	fake.authSubjectMutex.Lock()
+	defer fake.authSubjectMutex.Unlock()
+	fake.AuthSubjectStub = stub
+}
+
+func (fake *FakeAccess) AuthSubjectReturns(result1 atc.AuthSubject) {
+	fake.authSubjectMutex.Lock()
+	defer fake.authSubjectMutex.Unlock()
+	fake.AuthSubjectStub = nil
+	fake.authSubjectReturns = struct {
+		result1 atc.AuthSubject
+	}{result1}
+}
+
+func (fake *FakeAccess) AuthSubjectReturnsOnCall(i int, result1 atc.AuthSubject) {
+	fake.authSubjectMutex.Lock()
+	defer fake.authSubjectMutex.Unlock()
+	fake.AuthSubjectStub = nil
+	if fake.authSubjectReturnsOnCall == nil {
+		fake.authSubjectReturnsOnCall = make(map[int]struct {
+			result1 atc.AuthSubject
+		})
+	}
+	fake.authSubjectReturnsOnCall[i] = struct {
+		result1 atc.AuthSubject
+	}{result1}
+}
+
 func (fake *FakeAccess) Claims() accessor.Claims {
 	fake.claimsMutex.Lock()
 	ret, specificReturn := fake.claimsReturnsOnCall[len(fake.claimsArgsForCall)]
@@ -361,6 +446,67 @@ func (fake *FakeAccess) IsAuthorizedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsAuthorizedForPipeline(arg1 string, arg2 string) bool {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	ret, specificReturn := fake.isAuthorizedForPipelineReturnsOnCall[len(fake.isAuthorizedForPipelineArgsForCall)]
+	fake.isAuthorizedForPipelineArgsForCall = append(fake.isAuthorizedForPipelineArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("IsAuthorizedForPipeline", []interface{}{arg1, arg2})
+	fake.isAuthorizedForPipelineMutex.Unlock()
+	if fake.IsAuthorizedForPipelineStub != nil {
+		return fake.IsAuthorizedForPipelineStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isAuthorizedForPipelineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineCallCount() int {
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
+	return len(fake.isAuthorizedForPipelineArgsForCall)
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineCalls(stub func(string, string) bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = stub
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineArgsForCall(i int) (string, string) {
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
+	argsForCall := fake.isAuthorizedForPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineReturns(result1 bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = nil
+	fake.isAuthorizedForPipelineReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineReturnsOnCall(i int, result1 bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = nil
+	if fake.isAuthorizedForPipelineReturnsOnCall == nil {
+		fake.isAuthorizedForPipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isAuthorizedForPipelineReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsSystem() bool {
 	fake.isSystemMutex.Lock()
 	ret, specificReturn := fake.isSystemReturnsOnCall[len(fake.isSystemArgsForCall)]
@@ -413,6 +559,58 @@ func (fake *FakeAccess) IsSystemReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) PipelineTeamNames() []string {
+	fake.pipelineTeamNamesMutex.Lock()
+	ret, specificReturn := fake.pipelineTeamNamesReturnsOnCall[len(fake.pipelineTeamNamesArgsForCall)]
+	fake.pipelineTeamNamesArgsForCall = append(fake.pipelineTeamNamesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineTeamNames", []interface{}{})
+	fake.pipelineTeamNamesMutex.Unlock()
+	if fake.PipelineTeamNamesStub != nil {
+		return fake.PipelineTeamNamesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineTeamNamesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) PipelineTeamNamesCallCount() int {
+	fake.pipelineTeamNamesMutex.RLock()
+	defer fake.pipelineTeamNamesMutex.RUnlock()
+	return len(fake.pipelineTeamNamesArgsForCall)
+}
+
+func (fake *FakeAccess) PipelineTeamNamesCalls(stub func() []string) {
+	fake.pipelineTeamNamesMutex.Lock()
+	defer fake.pipelineTeamNamesMutex.Unlock()
+	fake.PipelineTeamNamesStub = stub
+}
+
+func (fake *FakeAccess) PipelineTeamNamesReturns(result1 []string) {
+	fake.pipelineTeamNamesMutex.Lock()
+	defer fake.pipelineTeamNamesMutex.Unlock()
+	fake.PipelineTeamNamesStub = nil
+	fake.pipelineTeamNamesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeAccess) PipelineTeamNamesReturnsOnCall(i int, result1 []string) {
+	fake.pipelineTeamNamesMutex.Lock()
+	defer fake.pipelineTeamNamesMutex.Unlock()
+	fake.PipelineTeamNamesStub = nil
+	if fake.pipelineTeamNamesReturnsOnCall == nil {
+		fake.pipelineTeamNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.pipelineTeamNamesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeAccess) TeamNames() []string {
 	fake.teamNamesMutex.Lock()
 	ret, specificReturn := fake.teamNamesReturnsOnCall[len(fake.teamNamesArgsForCall)]
@@ -520,6 +718,8 @@ func (fake *FakeAccess) TeamRolesReturnsOnCall(i int, result1 map[string][]strin
 func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.authSubjectMutex.RLock()
+	defer fake.authSubjectMutex.RUnlock()
 	fake.claimsMutex.RLock()
 	defer fake.claimsMutex.RUnlock()
 	fake.hasTokenMutex.RLock()
@@ -530,8 +730,12 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.isAuthenticatedMutex.RUnlock()
 	fake.isAuthorizedMutex.RLock()
 	defer fake.isAuthorizedMutex.RUnlock()
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
 	fake.isSystemMutex.RLock()
 	defer fake.isSystemMutex.RUnlock()
+	fake.pipelineTeamNamesMutex.RLock()
+	defer fake.pipelineTeamNamesMutex.RUnlock()
 	fake.teamNamesMutex.RLock()
 	defer fake.teamNamesMutex.RUnlock()
 	fake.teamRolesMutex.RLock()
@@ -9,12 +9,13 @@ import (
 )
 
 type FakeAccessFactory struct {
-	CreateStub        func(string, accessor.Verification, []db.Team) accessor.Access
+	CreateStub        func(string, accessor.Action, accessor.Verification, []db.Team) accessor.Access
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
 		arg1 string
-		arg2 accessor.Verification
-		arg3 []db.Team
+		arg2 accessor.Action
+		arg3 accessor.Verification
+		arg4 []db.Team
 	}
 	createReturns struct {
 		result1 accessor.Access
@@ -26,23 +27,24 @@ type FakeAccessFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAccessFactory) Create(arg1 string, arg2 accessor.Verification, arg3 []db.Team) accessor.Access {
-	var arg3Copy []db.Team
-	if arg3 != nil {
-		arg3Copy = make([]db.Team, len(arg3))
-		copy(arg3Copy, arg3)
+func (fake *FakeAccessFactory) Create(arg1 string, arg2 accessor.Action, arg3 accessor.Verification, arg4 []db.Team) accessor.Access {
+	var arg4Copy []db.Team
+	if arg4 != nil {
+		arg4Copy = make([]db.Team, len(arg4))
+		copy(arg4Copy, arg4)
 	}
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
 		arg1 string
-		arg2 accessor.Verification
-		arg3 []db.Team
-	}{arg1, arg2, arg3Copy})
-	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3Copy})
+		arg2 accessor.Action
+		arg3 accessor.Verification
+		arg4 []db.Team
+	}{arg1, arg2, arg3, arg4Copy})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3, arg4Copy})
 	fake.createMutex.Unlock()
 	if fake.CreateStub != nil {
-		return fake.CreateStub(arg1, arg2, arg3)
+		return fake.CreateStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -57,17 +59,17 @@ func (fake *FakeAccessFactory) CreateCallCount() int {
 	return len(fake.createArgsForCall)
 }
 
-func (fake *FakeAccessFactory) CreateCalls(stub func(string, accessor.Verification, []db.Team) accessor.Access) {
+func (fake *FakeAccessFactory) CreateCalls(stub func(string, accessor.Action, accessor.Verification, []db.Team) accessor.Access) {
 	fake.createMutex.Lock()
 	defer fake.createMutex.Unlock()
 	fake.CreateStub = stub
 }
 
-func (fake *FakeAccessFactory) CreateArgsForCall(i int) (string, accessor.Verification, []db.Team) {
+func (fake *FakeAccessFactory) CreateArgsForCall(i int) (string, accessor.Action, accessor.Verification, []db.Team) {
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	argsForCall := fake.createArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeAccessFactory) CreateReturns(result1 accessor.Access) {
@@ -0,0 +1,83 @@
+package accessor
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// APITokenConnector is the connector reported in the claims of requests
+// authenticated with an API token.
+const APITokenConnector = "api-token"
+
+//go:generate counterfeiter . APITokenFinder
+
+type APITokenFinder interface {
+	UseAPIToken(string) (db.APIToken, bool, error)
+}
+
+func NewAPITokenVerifier(verifier TokenVerifier, finder APITokenFinder) TokenVerifier {
+	return &apiTokenVerifier{
+		verifier: verifier,
+		finder:   finder,
+	}
+}
+
+type apiTokenVerifier struct {
+	verifier TokenVerifier
+	finder   APITokenFinder
+}
+
+func (v *apiTokenVerifier) Verify(r *http.Request) (map[string]interface{}, error) {
+	parts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") || !strings.HasPrefix(parts[1], atc.APITokenPrefix) {
+		return v.verifier.Verify(r)
+	}
+
+	token, found, err := v.finder.UseAPIToken(parts[1])
+	if err != nil {
+		return nil, ErrVerificationFailed
+	}
+
+	if !found {
+		return nil, ErrVerificationInvalidToken
+	}
+
+	grant := map[string]interface{}{
+		"team": token.TeamName(),
+		"role": token.Role(),
+	}
+
+	userName := token.CreatedBy()
+	if token.ServiceAccount() {
+		userName = token.Name()
+	} else {
+		creator := token.Creator()
+
+		groups := []interface{}{}
+		for _, group := range creator.Groups {
+			groups = append(groups, group)
+		}
+
+		grant["creator"] = map[string]interface{}{
+			"connector": creator.Connector,
+			"user_id":   creator.UserID,
+			"user_name": creator.UserName,
+			"groups":    groups,
+		}
+	}
+
+	return map[string]interface{}{
+		"sub":  APITokenConnector + ":" + strconv.Itoa(token.ID()),
+		"name": token.Name(),
+		"federated_claims": map[string]interface{}{
+			"connector_id": APITokenConnector,
+			"user_id":      strconv.Itoa(token.ID()),
+			"user_name":    userName,
+		},
+		"api_token": grant,
+	}, nil
+}
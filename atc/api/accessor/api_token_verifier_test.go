@@ -0,0 +1,143 @@
+package accessor_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+)
+
+var _ = Describe("APITokenVerifier", func() {
+	var (
+		fakeVerifier *accessorfakes.FakeTokenVerifier
+		fakeFinder   *accessorfakes.FakeAPITokenFinder
+		fakeToken    *dbfakes.FakeAPIToken
+
+		req      *http.Request
+		verifier accessor.TokenVerifier
+
+		err    error
+		claims map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		fakeVerifier = new(accessorfakes.FakeTokenVerifier)
+		fakeFinder = new(accessorfakes.FakeAPITokenFinder)
+
+		fakeToken = new(dbfakes.FakeAPIToken)
+		fakeToken.IDReturns(42)
+		fakeToken.NameReturns("some-token")
+		fakeToken.TeamNameReturns("some-team")
+		fakeToken.RoleReturns("member")
+		fakeToken.CreatedByReturns("some-user")
+		fakeToken.CreatorReturns(atc.AuthSubject{
+			Connector: "some-connector",
+			UserID:    "some-user-id",
+			UserName:  "some-user",
+			Groups:    []string{"some-group"},
+		})
+
+		req, err = http.NewRequest("GET", "localhost:8080", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier = accessor.NewAPITokenVerifier(fakeVerifier, fakeFinder)
+	})
+
+	JustBeforeEach(func() {
+		claims, err = verifier.Verify(req)
+	})
+
+	Context("when the request has a jwt", func() {
+		BeforeEach(func() {
+			req.Header.Add("Authorization", "Bearer some.jwt.token")
+			fakeVerifier.VerifyReturns(map[string]interface{}{"sub": "some-sub"}, nil)
+		})
+
+		It("delegates to the wrapped verifier", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeVerifier.VerifyCallCount()).To(Equal(1))
+			Expect(fakeFinder.UseAPITokenCallCount()).To(Equal(0))
+			Expect(claims).To(Equal(map[string]interface{}{"sub": "some-sub"}))
+		})
+	})
+
+	Context("when the request has an api token", func() {
+		BeforeEach(func() {
+			req.Header.Add("Authorization", "Bearer cpat_some-secret")
+		})
+
+		Context("when the token is found", func() {
+			BeforeEach(func() {
+				fakeFinder.UseAPITokenReturns(fakeToken, true, nil)
+			})
+
+			It("looks up the token", func() {
+				Expect(fakeVerifier.VerifyCallCount()).To(Equal(0))
+				Expect(fakeFinder.UseAPITokenArgsForCall(0)).To(Equal("cpat_some-secret"))
+			})
+
+			It("returns claims scoped to the token team and role", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claims).To(Equal(map[string]interface{}{
+					"sub":  "api-token:42",
+					"name": "some-token",
+					"federated_claims": map[string]interface{}{
+						"connector_id": "api-token",
+						"user_id":      "42",
+						"user_name":    "some-user",
+					},
+					"api_token": map[string]interface{}{
+						"team": "some-team",
+						"role": "member",
+						"creator": map[string]interface{}{
+							"connector": "some-connector",
+							"user_id":   "some-user-id",
+							"user_name": "some-user",
+							"groups":    []interface{}{"some-group"},
+						},
+					},
+				}))
+			})
+
+			Context("when the token belongs to a service account", func() {
+				BeforeEach(func() {
+					fakeToken.ServiceAccountReturns(true)
+				})
+
+				It("uses the token name as the user name", func() {
+					Expect(claims["federated_claims"]).To(HaveKeyWithValue("user_name", "some-token"))
+				})
+
+				It("does not include a creator", func() {
+					Expect(claims["api_token"]).ToNot(HaveKey("creator"))
+				})
+			})
+		})
+
+		Context("when the token is not found", func() {
+			BeforeEach(func() {
+				fakeFinder.UseAPITokenReturns(nil, false, nil)
+			})
+
+			It("fails verification", func() {
+				Expect(err).To(Equal(accessor.ErrVerificationInvalidToken))
+			})
+		})
+
+		Context("when looking up the token fails", func() {
+			BeforeEach(func() {
+				fakeFinder.UseAPITokenReturns(nil, false, errors.New("nope"))
+			})
+
+			It("fails verification", func() {
+				Expect(err).To(Equal(accessor.ErrVerificationFailed))
+			})
+		})
+	})
+})
@@ -3,8 +3,10 @@ package accessor
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -14,7 +16,7 @@ import (
 //go:generate counterfeiter . AccessFactory
 
 type AccessFactory interface {
-	Create(string, Verification, []db.Team) Access
+	Create(string, Action, Verification, []db.Team) Access
 }
 
 //go:generate counterfeiter . TokenVerifier
@@ -56,9 +58,24 @@ func NewHandler(
 		teamFetcher:   teamFetcher,
 		userTracker:   userTracker,
 		customRoles:   customRoles,
+
+		pipelineScoped: pipelineScopedActions[action],
 	}
 }
 
+// pipelineScopedActions are the actions whose route names a pipeline. Other
+// routes must not take a pipeline from the query string, where a client could
+// name a pipeline they have been granted a role on.
+var pipelineScopedActions = func() map[string]bool {
+	actions := map[string]bool{}
+	for _, route := range atc.Routes {
+		if strings.Contains(route.Path, "/:pipeline_name") {
+			actions[route.Name] = true
+		}
+	}
+	return actions
+}()
+
 type accessorHandler struct {
 	logger        lager.Logger
 	action        string
@@ -69,6 +86,8 @@ type accessorHandler struct {
 	userTracker   UserTracker
 	auditor       auditor.Auditor
 	customRoles   map[string]string
+
+	pipelineScoped bool
 }
 
 func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -85,7 +104,16 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		requiredRole = DefaultRoles[h.action]
 	}
 
-	acc := h.accessFactory.Create(requiredRole, h.verifyToken(r), teams)
+	action := Action{Name: h.action}
+
+	// the router prepends the matched route params to the query, so the first
+	// value is the route's. it is read without parsing the form, which would
+	// be cached on the request before the body is consumed by the handler
+	if h.pipelineScoped {
+		action.Pipeline = r.URL.Query().Get(":pipeline_name")
+	}
+
+	acc := h.accessFactory.Create(requiredRole, action, h.verifyToken(r), teams)
 
 	claims := acc.Claims()
 
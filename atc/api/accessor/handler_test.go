@@ -13,6 +13,8 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 
+	"github.com/tedsuo/rata"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -103,10 +105,50 @@ var _ = Describe("Handler", func() {
 
 			It("creates an accessor with the given teams", func() {
 				Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-				_, _, teams := fakeAccessorFactory.CreateArgsForCall(0)
+				_, _, _, teams := fakeAccessorFactory.CreateArgsForCall(0)
 				Expect(teams).To(Equal(fakeTeams))
 			})
 
+			It("creates an accessor for the action", func() {
+				_, acc, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+				Expect(acc).To(Equal(accessor.Action{Name: "some-action"}))
+			})
+
+			Context("when the route names a pipeline", func() {
+				BeforeEach(func() {
+					action = atc.GetPipeline
+					r = routedRequest(atc.GetPipeline, "/api/v1/teams/some-team/pipelines/some-pipeline")
+				})
+
+				It("creates an accessor for the action on the pipeline", func() {
+					_, acc, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					Expect(acc).To(Equal(accessor.Action{Name: atc.GetPipeline, Pipeline: "some-pipeline"}))
+				})
+
+				Context("when the query also names a pipeline", func() {
+					BeforeEach(func() {
+						r = routedRequest(atc.GetPipeline, "/api/v1/teams/some-team/pipelines/some-pipeline?%3Apipeline_name=other-pipeline")
+					})
+
+					It("uses the pipeline from the route", func() {
+						_, acc, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+						Expect(acc).To(Equal(accessor.Action{Name: atc.GetPipeline, Pipeline: "some-pipeline"}))
+					})
+				})
+			})
+
+			Context("when only the query names a pipeline", func() {
+				BeforeEach(func() {
+					action = atc.ListPipelines
+					r = routedRequest(atc.ListPipelines, "/api/v1/teams/some-team/pipelines?%3Apipeline_name=some-pipeline")
+				})
+
+				It("creates an accessor for the action on no pipeline", func() {
+					_, acc, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					Expect(acc).To(Equal(accessor.Action{Name: atc.ListPipelines}))
+				})
+			})
+
 			Context("when there's a default role for the given action", func() {
 				BeforeEach(func() {
 					action = atc.SaveConfig
@@ -119,7 +161,7 @@ var _ = Describe("Handler", func() {
 
 					It("finds the role", func() {
 						Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-						role, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+						role, _, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
 						Expect(role).To(Equal(accessor.MemberRole))
 					})
 				})
@@ -133,7 +175,7 @@ var _ = Describe("Handler", func() {
 
 					It("finds the role", func() {
 						Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-						role, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+						role, _, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
 						Expect(role).To(Equal(accessor.ViewerRole))
 					})
 				})
@@ -151,7 +193,7 @@ var _ = Describe("Handler", func() {
 
 					It("sends a blank role (admin roles don't have defaults)", func() {
 						Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-						role, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+						role, _, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
 						Expect(role).To(BeEmpty())
 					})
 				})
@@ -164,7 +206,7 @@ var _ = Describe("Handler", func() {
 
 				It("creates an accessor with a verification result that has no token", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(verification.HasToken).To(BeFalse())
 					Expect(verification.IsTokenValid).To(BeFalse())
 				})
@@ -177,7 +219,7 @@ var _ = Describe("Handler", func() {
 
 				It("creates an accessor with a verification result that has an invalid token", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(verification.HasToken).To(BeTrue())
 					Expect(verification.IsTokenValid).To(BeFalse())
 				})
@@ -196,7 +238,7 @@ var _ = Describe("Handler", func() {
 
 				It("creates an accessor with a successful verification", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(verification.HasToken).To(BeTrue())
 					Expect(verification.IsTokenValid).To(BeTrue())
 					Expect(verification.RawClaims).To(Equal(claims))
@@ -279,3 +321,30 @@ var _ = Describe("Handler", func() {
 		})
 	})
 })
+
+// routedRequest returns the request as seen by a handler behind the router,
+// which adds the matched route params to its query.
+func routedRequest(name string, path string) *http.Request {
+	var route rata.Route
+	for _, r := range atc.Routes {
+		if r.Name == name {
+			route = r
+		}
+	}
+
+	var routed *http.Request
+	router, err := rata.NewRouter(rata.Routes{route}, rata.Handlers{
+		name: http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			routed = r
+		}),
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	r, err := http.NewRequest(route.Method, "http://localhost:8080"+path, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	router.ServeHTTP(httptest.NewRecorder(), r)
+	Expect(routed).NotTo(BeNil())
+
+	return routed
+}
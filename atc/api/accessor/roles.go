@@ -1,6 +1,8 @@
 package accessor
 
 import (
+	"fmt"
+
 	"github.com/concourse/concourse/atc"
 )
 
@@ -13,12 +15,14 @@ const (
 
 var DefaultRoles = map[string]string{
 	atc.SaveConfig:                    MemberRole,
+	atc.CheckConfigCreds:              MemberRole,
 	atc.GetConfig:                     ViewerRole,
 	atc.GetCC:                         ViewerRole,
 	atc.GetBuild:                      ViewerRole,
 	atc.GetCheck:                      ViewerRole,
 	atc.GetBuildPlan:                  ViewerRole,
 	atc.CreateBuild:                   MemberRole,
+	atc.CreateJobPlan:                 MemberRole,
 	atc.ListBuilds:                    ViewerRole,
 	atc.BuildEvents:                   ViewerRole,
 	atc.BuildResources:                ViewerRole,
@@ -29,6 +33,7 @@ var DefaultRoles = map[string]string{
 	atc.RerunJobBuild:                 OperatorRole,
 	atc.ListAllJobs:                   ViewerRole,
 	atc.ListJobs:                      ViewerRole,
+	atc.ListJobStats:                  ViewerRole,
 	atc.ListJobBuilds:                 ViewerRole,
 	atc.ListJobInputs:                 ViewerRole,
 	atc.GetJobBuild:                   ViewerRole,
@@ -70,6 +75,8 @@ var DefaultRoles = map[string]string{
 	atc.ListPipelineBuilds:            ViewerRole,
 	atc.CreatePipelineBuild:           MemberRole,
 	atc.PipelineBadge:                 ViewerRole,
+	atc.PipelineEvents:                ViewerRole,
+	atc.ListNotificationDeliveries:    ViewerRole,
 	atc.RegisterWorker:                MemberRole,
 	atc.LandWorker:                    MemberRole,
 	atc.RetireWorker:                  MemberRole,
@@ -85,6 +92,8 @@ var DefaultRoles = map[string]string{
 	atc.ListContainers:                ViewerRole,
 	atc.GetContainer:                  ViewerRole,
 	atc.HijackContainer:               MemberRole,
+	atc.StreamInContainer:             MemberRole,
+	atc.StreamOutContainer:            MemberRole,
 	atc.ListDestroyingContainers:      ViewerRole,
 	atc.ReportWorkerContainers:        MemberRole,
 	atc.ListVolumes:                   ViewerRole,
@@ -93,11 +102,52 @@ var DefaultRoles = map[string]string{
 	atc.ListTeams:                     ViewerRole,
 	atc.GetTeam:                       ViewerRole,
 	atc.SetTeam:                       OwnerRole,
+	atc.ExplainTeamAuth:               OwnerRole,
+	atc.ExportTeam:                    OwnerRole,
+	atc.ImportTeam:                    OwnerRole,
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
+	atc.TeamEvents:                    ViewerRole,
 	atc.CreateArtifact:                MemberRole,
 	atc.GetArtifact:                   MemberRole,
 	atc.ListBuildArtifacts:            ViewerRole,
 	atc.GetWall:                       ViewerRole,
+	atc.ListWallMessages:              ViewerRole,
+	atc.GetMaintenance:                ViewerRole,
+	atc.ListAPITokens:                 ViewerRole,
+	atc.CreateAPIToken:                ViewerRole,
+	atc.DeleteAPIToken:                ViewerRole,
+}
+
+// IsBuiltInRole returns whether the role is one of the roles every team has.
+func IsBuiltInRole(role string) bool {
+	switch role {
+	case OwnerRole, MemberRole, OperatorRole, ViewerRole:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateCustomRoles ensures a team's custom roles do not shadow a built-in
+// role, only extend built-in roles and only grant actions known to the API.
+func ValidateCustomRoles(roles []atc.TeamRole) error {
+	for _, role := range roles {
+		if IsBuiltInRole(role.Name) {
+			return fmt.Errorf("custom role '%s' conflicts with a built-in role", role.Name)
+		}
+
+		if role.Extends != "" && !IsBuiltInRole(role.Extends) {
+			return fmt.Errorf("custom role '%s' extends unknown role '%s'", role.Name, role.Extends)
+		}
+
+		for _, permission := range role.Permissions {
+			if _, found := DefaultRoles[permission.Action]; !found {
+				return fmt.Errorf("custom role '%s' grants unknown action '%s'", role.Name, permission.Action)
+			}
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,217 @@
+package accessor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/patrickmn/go-cache"
+)
+
+//go:generate counterfeiter . SessionTracker
+
+type SessionTracker interface {
+	SaveSession(db.SessionSpec) (db.Session, error)
+	RevokedSessionIDs() ([]int, error)
+}
+
+// NewSessionVerifier records a session for every token accepted by the
+// wrapped verifier and rejects tokens whose session has been revoked.
+//
+// Sessions which have been seen within the expiration are checked against an
+// in-memory deny-list which is reloaded whenever a session is revoked, so
+// revocation does not cost a database round trip per request. The deny-list
+// is also reloaded every expiration, in case a notification is missed or
+// cannot be listened for.
+func NewSessionVerifier(
+	logger lager.Logger,
+	verifier TokenVerifier,
+	sessions SessionTracker,
+	notifications Notifications,
+	expiration time.Duration,
+) TokenVerifier {
+	v := &sessionVerifier{
+		logger:        logger,
+		verifier:      verifier,
+		sessions:      sessions,
+		notifications: notifications,
+		known:         cache.New(expiration, expiration),
+		pollInterval:  expiration,
+		revoked:       map[int]bool{},
+	}
+
+	go v.waitForRevocations()
+
+	return v
+}
+
+type sessionVerifier struct {
+	logger        lager.Logger
+	verifier      TokenVerifier
+	sessions      SessionTracker
+	notifications Notifications
+	known         *cache.Cache
+	pollInterval  time.Duration
+
+	revokedLock sync.RWMutex
+	revoked     map[int]bool
+}
+
+func (v *sessionVerifier) Verify(r *http.Request) (map[string]interface{}, error) {
+	claims, err := v.verifier.Verify(r)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(parts) != 2 {
+		return nil, ErrVerificationInvalidToken
+	}
+
+	key := sessionKey(parts[1])
+
+	var id int
+	if cached, found := v.known.Get(key); found {
+		id = cached.(int)
+	} else {
+		session, err := v.sessions.SaveSession(sessionSpec(parts[1], claims))
+		if err != nil {
+			v.logger.Error("failed-to-save-session", err)
+			return nil, ErrVerificationFailed
+		}
+
+		id = session.ID()
+
+		if session.Revoked() {
+			v.markRevoked(id)
+			return nil, ErrVerificationRevokedToken
+		}
+
+		v.known.Set(key, id, cache.DefaultExpiration)
+	}
+
+	if v.isRevoked(id) {
+		return nil, ErrVerificationRevokedToken
+	}
+
+	claims["session_id"] = id
+
+	return claims, nil
+}
+
+func (v *sessionVerifier) isRevoked(id int) bool {
+	v.revokedLock.RLock()
+	defer v.revokedLock.RUnlock()
+
+	return v.revoked[id]
+}
+
+func (v *sessionVerifier) markRevoked(id int) {
+	v.revokedLock.Lock()
+	defer v.revokedLock.Unlock()
+
+	v.revoked[id] = true
+}
+
+func (v *sessionVerifier) refreshRevoked() {
+	ids, err := v.sessions.RevokedSessionIDs()
+	if err != nil {
+		v.logger.Error("failed-to-fetch-revoked-sessions", err)
+		return
+	}
+
+	revoked := map[int]bool{}
+	for _, id := range ids {
+		revoked[id] = true
+	}
+
+	v.revokedLock.Lock()
+	v.revoked = revoked
+	v.revokedLock.Unlock()
+}
+
+func (v *sessionVerifier) waitForRevocations() {
+	backoff := v.initialBackoff()
+
+	for {
+		notifier, err := v.notifications.Listen(atc.SessionRevocationChannel)
+		if err != nil {
+			v.logger.Error("failed-to-listen-for-session-revocations", err)
+
+			// keep the deny-list fresh by polling until listening succeeds
+			v.refreshRevoked()
+
+			time.Sleep(backoff)
+
+			backoff *= 2
+			if backoff > v.pollInterval {
+				backoff = v.pollInterval
+			}
+
+			continue
+		}
+
+		backoff = v.initialBackoff()
+
+		v.refreshRevoked()
+		v.refreshOnRevocations(notifier)
+
+		v.notifications.Unlisten(atc.SessionRevocationChannel, notifier)
+	}
+}
+
+// refreshOnRevocations reloads the deny-list on every notification and every
+// poll interval, until the notifier is closed.
+func (v *sessionVerifier) refreshOnRevocations(notifier chan bool) {
+	ticker := time.NewTicker(v.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case _, ok := <-notifier:
+			if !ok {
+				return
+			}
+
+		case <-ticker.C:
+		}
+
+		v.refreshRevoked()
+	}
+}
+
+func (v *sessionVerifier) initialBackoff() time.Duration {
+	if v.pollInterval < time.Second {
+		return v.pollInterval
+	}
+
+	return time.Second
+}
+
+func sessionSpec(token string, claims map[string]interface{}) db.SessionSpec {
+	spec := db.SessionSpec{Token: token}
+
+	spec.Sub, _ = claims["sub"].(string)
+
+	if federated, ok := claims["federated_claims"].(map[string]interface{}); ok {
+		spec.UserName, _ = federated["user_name"].(string)
+		spec.Connector, _ = federated["connector_id"].(string)
+	}
+
+	if exp, ok := claims["exp"].(float64); ok {
+		spec.ExpiresAt = time.Unix(int64(exp), 0)
+	}
+
+	return spec
+}
+
+func sessionKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,209 @@
+package accessor_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+)
+
+var _ = Describe("SessionVerifier", func() {
+	var (
+		fakeVerifier      *accessorfakes.FakeTokenVerifier
+		fakeTracker       *accessorfakes.FakeSessionTracker
+		fakeNotifications *accessorfakes.FakeNotifications
+		fakeSession       *dbfakes.FakeSession
+		notifier          chan bool
+
+		req      *http.Request
+		verifier accessor.TokenVerifier
+
+		err    error
+		claims map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		fakeVerifier = new(accessorfakes.FakeTokenVerifier)
+		fakeVerifier.VerifyStub = func(*http.Request) (map[string]interface{}, error) {
+			return map[string]interface{}{
+				"sub": "some-sub",
+				"exp": float64(1600000000),
+				"federated_claims": map[string]interface{}{
+					"user_name":    "some-user",
+					"connector_id": "github",
+				},
+			}, nil
+		}
+
+		fakeSession = new(dbfakes.FakeSession)
+		fakeSession.IDReturns(42)
+
+		fakeTracker = new(accessorfakes.FakeSessionTracker)
+		fakeTracker.SaveSessionReturns(fakeSession, nil)
+
+		notifier = make(chan bool, 1)
+		fakeNotifications = new(accessorfakes.FakeNotifications)
+		fakeNotifications.ListenReturns(notifier, nil)
+
+		req, err = http.NewRequest("GET", "localhost:8080", nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Add("Authorization", "Bearer some.jwt.token")
+
+		verifier = accessor.NewSessionVerifier(
+			lagertest.NewTestLogger("test"),
+			fakeVerifier,
+			fakeTracker,
+			fakeNotifications,
+			time.Minute,
+		)
+
+		Eventually(fakeTracker.RevokedSessionIDsCallCount).Should(Equal(1))
+	})
+
+	JustBeforeEach(func() {
+		claims, err = verifier.Verify(req)
+	})
+
+	It("listens for session revocations", func() {
+		Expect(fakeNotifications.ListenArgsForCall(0)).To(Equal(atc.SessionRevocationChannel))
+	})
+
+	It("records the session", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeTracker.SaveSessionCallCount()).To(Equal(1))
+		Expect(fakeTracker.SaveSessionArgsForCall(0)).To(Equal(db.SessionSpec{
+			Token:     "some.jwt.token",
+			Sub:       "some-sub",
+			UserName:  "some-user",
+			Connector: "github",
+			ExpiresAt: time.Unix(1600000000, 0),
+		}))
+	})
+
+	It("adds the session to the claims", func() {
+		Expect(claims["sub"]).To(Equal("some-sub"))
+		Expect(claims["session_id"]).To(Equal(42))
+	})
+
+	Context("when the token is used again", func() {
+		It("does not record the session again", func() {
+			_, err = verifier.Verify(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeTracker.SaveSessionCallCount()).To(Equal(1))
+		})
+
+		Context("after the session has been revoked", func() {
+			JustBeforeEach(func() {
+				fakeTracker.RevokedSessionIDsReturns([]int{42}, nil)
+				notifier <- true
+
+				Eventually(fakeTracker.RevokedSessionIDsCallCount).Should(Equal(2))
+			})
+
+			It("rejects the token", func() {
+				Eventually(func() error {
+					_, err := verifier.Verify(req)
+					return err
+				}).Should(Equal(accessor.ErrVerificationRevokedToken))
+			})
+		})
+	})
+
+	Context("when the session has already been revoked", func() {
+		BeforeEach(func() {
+			fakeSession.RevokedReturns(true)
+		})
+
+		It("rejects the token", func() {
+			Expect(err).To(Equal(accessor.ErrVerificationRevokedToken))
+		})
+	})
+
+	Context("when recording the session fails", func() {
+		BeforeEach(func() {
+			fakeTracker.SaveSessionReturns(nil, errors.New("nope"))
+		})
+
+		It("fails verification", func() {
+			Expect(err).To(Equal(accessor.ErrVerificationFailed))
+		})
+	})
+
+	Context("when the poll interval elapses", func() {
+		BeforeEach(func() {
+			verifier = accessor.NewSessionVerifier(
+				lagertest.NewTestLogger("test"),
+				fakeVerifier,
+				fakeTracker,
+				fakeNotifications,
+				10*time.Millisecond,
+			)
+		})
+
+		It("reloads the revoked sessions without a notification", func() {
+			Eventually(fakeTracker.RevokedSessionIDsCallCount).Should(BeNumerically(">", 3))
+		})
+	})
+
+	Context("when listening for revocations fails", func() {
+		var pollingNotifications *accessorfakes.FakeNotifications
+
+		BeforeEach(func() {
+			pollingNotifications = new(accessorfakes.FakeNotifications)
+			pollingNotifications.ListenReturnsOnCall(0, nil, errors.New("nope"))
+			pollingNotifications.ListenReturnsOnCall(1, nil, errors.New("nope"))
+			pollingNotifications.ListenReturns(notifier, nil)
+
+			fakeTracker.RevokedSessionIDsReturns([]int{42}, nil)
+
+			verifier = accessor.NewSessionVerifier(
+				lagertest.NewTestLogger("test"),
+				fakeVerifier,
+				fakeTracker,
+				pollingNotifications,
+				10*time.Millisecond,
+			)
+		})
+
+		It("falls back to polling and retries", func() {
+			Eventually(func() error {
+				_, err := verifier.Verify(req)
+				return err
+			}).Should(Equal(accessor.ErrVerificationRevokedToken))
+
+			Eventually(pollingNotifications.ListenCallCount).Should(Equal(3))
+		})
+	})
+
+	Context("when the notifier is closed", func() {
+		BeforeEach(func() {
+			close(notifier)
+		})
+
+		It("listens again", func() {
+			Eventually(fakeNotifications.ListenCallCount).Should(BeNumerically(">", 1))
+			Expect(fakeNotifications.UnlistenCallCount()).To(BeNumerically(">", 0))
+		})
+	})
+
+	Context("when the wrapped verifier fails", func() {
+		BeforeEach(func() {
+			fakeVerifier.VerifyStub = nil
+			fakeVerifier.VerifyReturns(nil, accessor.ErrVerificationTokenExpired)
+		})
+
+		It("returns the error without recording a session", func() {
+			Expect(err).To(Equal(accessor.ErrVerificationTokenExpired))
+			Expect(fakeTracker.SaveSessionCallCount()).To(Equal(0))
+		})
+	})
+})
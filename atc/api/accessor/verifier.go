@@ -22,6 +22,7 @@ var (
 	ErrVerificationTokenExpired     = errors.New("token is expired")
 	ErrVerificationInvalidAudience  = errors.New("token has invalid audience")
 	ErrVerificationFailed           = errors.New("token verification failed")
+	ErrVerificationRevokedToken     = errors.New("token has been revoked")
 )
 
 func NewVerifier(httpClient *http.Client, keySetURL *url.URL, audience []string) *verifier {
@@ -17,6 +17,7 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
+	"github.com/concourse/concourse/atc/api/infoserver/infoserverfakes"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/creds"
@@ -24,6 +25,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/gc/gcfakes"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/atc/wrappa"
 
@@ -54,9 +56,13 @@ var (
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbUserFactory           *dbfakes.FakeUserFactory
+	dbAPITokenFactory       *dbfakes.FakeAPITokenFactory
+	dbSessionFactory        *dbfakes.FakeSessionFactory
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	dbMaintenance           *dbfakes.FakeMaintenance
+	fakeInfoCacher          *infoserverfakes.FakeCacher
 	fakeSecretManager       *credsfakes.FakeSecrets
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
@@ -102,8 +108,12 @@ var _ = BeforeEach(func() {
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 	dbUserFactory = new(dbfakes.FakeUserFactory)
+	dbAPITokenFactory = new(dbfakes.FakeAPITokenFactory)
+	dbSessionFactory = new(dbfakes.FakeSessionFactory)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	dbMaintenance = new(dbfakes.FakeMaintenance)
+	fakeInfoCacher = new(infoserverfakes.FakeCacher)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -159,7 +169,8 @@ var _ = BeforeEach(func() {
 	checkWorkerTeamAccessHandlerFactory := auth.NewCheckWorkerTeamAccessHandlerFactory(dbWorkerFactory)
 
 	fakePolicyChecker = new(policycheckerfakes.FakePolicyChecker)
-	fakePolicyChecker.CheckReturns(true, nil)
+	fakePolicyChecker.CheckReturns(policy.PassedPolicyCheck(), nil)
+	fakePolicyChecker.CheckInputReturns(policy.PassedPolicyCheck(), nil)
 
 	apiWrapper := wrappa.MultiWrappa{
 		wrappa.NewPolicyCheckWrappa(logger, fakePolicyChecker),
@@ -191,6 +202,8 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		dbAPITokenFactory,
+		dbSessionFactory,
 
 		constructedEventHandler.Construct,
 
@@ -208,7 +221,11 @@ var _ = BeforeEach(func() {
 		credsManagers,
 		interceptTimeoutFactory,
 		time.Second,
+		24*time.Hour,
 		dbWall,
+		dbMaintenance,
+		fakeInfoCacher,
+		fakePolicyChecker,
 		fakeClock,
 
 		true, /* enableArchivePipeline */
@@ -0,0 +1,350 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Tokens API", func() {
+	var (
+		response *http.Response
+
+		ownToken     *dbfakes.FakeAPIToken
+		otherToken   *dbfakes.FakeAPIToken
+		serviceToken *dbfakes.FakeAPIToken
+	)
+
+	fakeToken := func(id int, name string, createdBySub string, serviceAccount bool) *dbfakes.FakeAPIToken {
+		token := new(dbfakes.FakeAPIToken)
+		token.IDReturns(id)
+		token.NameReturns(name)
+		token.TeamNameReturns("some-team")
+		token.RoleReturns("member")
+		token.ServiceAccountReturns(serviceAccount)
+		token.CreatedByReturns("some-user")
+		token.CreatedBySubReturns(createdBySub)
+		token.CreatedAtReturns(time.Unix(100, 0))
+		token.ExpiresAtReturns(time.Unix(200, 0))
+		return token
+	}
+
+	BeforeEach(func() {
+		dbTeam.NameReturns("some-team")
+
+		fakeAccess.IsAuthenticatedReturns(true)
+		fakeAccess.IsAuthorizedReturns(true)
+		fakeAccess.ClaimsReturns(accessor.Claims{
+			Sub:       "some-sub",
+			UserName:  "some-user",
+			Connector: "github",
+		})
+		fakeAccess.TeamRolesReturns(map[string][]string{
+			"some-team": []string{"member"},
+		})
+
+		ownToken = fakeToken(1, "own-token", "some-sub", false)
+		otherToken = fakeToken(2, "other-token", "other-sub", false)
+		serviceToken = fakeToken(3, "service-token", "other-sub", true)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/tokens", func() {
+		BeforeEach(func() {
+			dbAPITokenFactory.GetAPITokensReturns([]db.APIToken{ownToken, otherToken, serviceToken}, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/tokens")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		It("lists the tokens of the team", func() {
+			Expect(dbAPITokenFactory.GetAPITokensArgsForCall(0)).To(Equal(734))
+		})
+
+		Context("when the user is not a team owner", func() {
+			It("only returns their own tokens", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 1,
+						"name": "own-token",
+						"team_name": "some-team",
+						"role": "member",
+						"created_by": "some-user",
+						"created_at": 100,
+						"expires_at": 200
+					}
+				]`))
+			})
+		})
+
+		Context("when the user is a team owner", func() {
+			BeforeEach(func() {
+				fakeAccess.TeamRolesReturns(map[string][]string{
+					"some-team": []string{"owner"},
+				})
+			})
+
+			It("returns every token", func() {
+				var tokens []atc.APIToken
+				Expect(json.NewDecoder(response.Body).Decode(&tokens)).To(Succeed())
+				Expect(tokens).To(HaveLen(3))
+			})
+		})
+
+		Context("when listing the tokens fails", func() {
+			BeforeEach(func() {
+				dbAPITokenFactory.GetAPITokensReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/tokens", func() {
+		var tokenRequest atc.APITokenRequest
+
+		BeforeEach(func() {
+			tokenRequest = atc.APITokenRequest{
+				Name:      "some-token",
+				Role:      "member",
+				ExpiresIn: time.Hour,
+			}
+
+			dbAPITokenFactory.CreateAPITokenReturns(ownToken, nil)
+
+			fakeAccess.AuthSubjectReturns(atc.AuthSubject{
+				Connector: "some-connector",
+				UserName:  "some-user",
+			})
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(tokenRequest)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Post(server.URL+"/api/v1/teams/some-team/tokens", "application/json", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates the token and returns it once", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+			Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(1))
+			teamID, spec := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+			Expect(teamID).To(Equal(734))
+			Expect(spec.Name).To(Equal("some-token"))
+			Expect(spec.Role).To(Equal("member"))
+			Expect(spec.CreatedBy).To(Equal("some-user"))
+			Expect(spec.CreatedBySub).To(Equal("some-sub"))
+			Expect(spec.Creator).To(Equal(atc.AuthSubject{
+				Connector: "some-connector",
+				UserName:  "some-user",
+			}))
+			Expect(spec.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+			Expect(spec.Token).To(HavePrefix(atc.APITokenPrefix))
+
+			var token atc.APIToken
+			Expect(json.NewDecoder(response.Body).Decode(&token)).To(Succeed())
+			Expect(token.Name).To(Equal("own-token"))
+			Expect(token.Token).To(Equal(spec.Token))
+		})
+
+		Context("when the request is authenticated with an api token", func() {
+			BeforeEach(func() {
+				fakeAccess.ClaimsReturns(accessor.Claims{Connector: "api-token"})
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token has no expiry", func() {
+			BeforeEach(func() {
+				tokenRequest.ExpiresIn = 0
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				body, _ := ioutil.ReadAll(response.Body)
+				Expect(string(body)).To(Equal("token expiry must be specified"))
+			})
+		})
+
+		Context("when the expiry is longer than the maximum lifetime", func() {
+			BeforeEach(func() {
+				tokenRequest.ExpiresIn = 25 * time.Hour
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				body, _ := ioutil.ReadAll(response.Body)
+				Expect(string(body)).To(Equal("token expiry cannot be longer than 24h0m0s"))
+				Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the role is unknown", func() {
+			BeforeEach(func() {
+				tokenRequest.Role = "superuser"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the role exceeds the role of the user", func() {
+			BeforeEach(func() {
+				tokenRequest.Role = "owner"
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				body, _ := ioutil.ReadAll(response.Body)
+				Expect(string(body)).To(ContainSubstring("beyond your own"))
+			})
+		})
+
+		Context("when creating a service account token", func() {
+			BeforeEach(func() {
+				tokenRequest.ServiceAccount = true
+			})
+
+			Context("when the user is not a team owner", func() {
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the user is a team owner", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{
+						"some-team": []string{"owner"},
+					})
+				})
+
+				It("creates the token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					_, spec := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+					Expect(spec.ServiceAccount).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when a token with the same name exists", func() {
+			BeforeEach(func() {
+				dbAPITokenFactory.CreateAPITokenReturns(nil, db.ErrAPITokenAlreadyExists)
+			})
+
+			It("returns 409", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/tokens/:token_name", func() {
+		var tokenName string
+
+		BeforeEach(func() {
+			tokenName = "own-token"
+			dbAPITokenFactory.FindAPITokenStub = func(teamID int, name string) (db.APIToken, bool, error) {
+				for _, token := range []db.APIToken{ownToken, otherToken, serviceToken} {
+					if token.Name() == name {
+						return token, true, nil
+					}
+				}
+				return nil, false, nil
+			}
+			dbAPITokenFactory.DeleteAPITokenReturns(true, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/tokens/"+tokenName, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("deletes the token", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+			teamID, name := dbAPITokenFactory.DeleteAPITokenArgsForCall(0)
+			Expect(teamID).To(Equal(734))
+			Expect(name).To(Equal("own-token"))
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				tokenName = "bogus-token"
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the token belongs to someone else", func() {
+			BeforeEach(func() {
+				tokenName = "other-token"
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			Context("when the user is a team owner", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{
+						"some-team": []string{"owner"},
+					})
+				})
+
+				It("deletes the token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+			})
+		})
+
+		Context("when the token is a service account", func() {
+			BeforeEach(func() {
+				tokenName = "service-token"
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+})
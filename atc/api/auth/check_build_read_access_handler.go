@@ -78,7 +78,7 @@ func (h checkBuildReadAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 
 	acc := accessor.GetAccessor(r)
 
-	if !acc.IsAuthenticated() || !acc.IsAuthorized(build.TeamName()) {
+	if !acc.IsAuthenticated() || (!acc.IsAuthorized(build.TeamName()) && !acc.IsAuthorizedForPipeline(build.TeamName(), build.PipelineName())) {
 		pipeline, found, err := build.Pipeline()
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
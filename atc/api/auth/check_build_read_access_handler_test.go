@@ -126,6 +126,16 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 			WithExistingBuild(ItReturnsTheBuild)
 		})
 
+		Context("when authenticated and granted the build's pipeline", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+				fakeaccess.IsAuthorizedForPipelineReturns(true)
+			})
+
+			WithExistingBuild(ItReturnsTheBuild)
+		})
+
 		Context("when authenticated but accessing different team's build", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(true)
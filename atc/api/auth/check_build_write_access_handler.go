@@ -67,7 +67,7 @@ func (h checkBuildWriteAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if !acc.IsAuthorized(build.TeamName()) {
+	if !acc.IsAuthorized(build.TeamName()) && !acc.IsAuthorizedForPipeline(build.TeamName(), build.PipelineName()) {
 		h.rejector.Forbidden(w, r)
 		return
 	}
@@ -82,6 +82,24 @@ var _ = Describe("Builds API", func() {
 					fakeAccess.IsAuthorizedReturns(true)
 				})
 
+				Context("when the cluster is in maintenance mode", func() {
+					BeforeEach(func() {
+						dbMaintenance.ModeReturns(atc.Maintenance{Enabled: true, Reason: "upgrading"}, nil)
+					})
+
+					It("returns 503 with the reason", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(ContainSubstring("upgrading"))
+					})
+
+					It("does not create a build", func() {
+						Expect(dbTeam.CreateStartedBuildCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when creating a started build fails", func() {
 					BeforeEach(func() {
 						dbTeam.CreateStartedBuildReturns(nil, errors.New("oh no!"))
@@ -145,6 +163,145 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("POST /api/v1/teams/:team_name/job-plan", func() {
+		var (
+			request  atc.JobPlanRequest
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			request = atc.JobPlanRequest{
+				Config: atc.Config{
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "some-repo",
+							Type:   "git",
+							Source: atc.Source{"uri": "some-uri"},
+						},
+						{
+							Name:   "some-image",
+							Type:   "registry-image",
+							Source: atc.Source{"repository": "some-repository"},
+						},
+					},
+					Jobs: atc.JobConfigs{
+						{
+							Name: "some-job",
+							PlanSequence: []atc.Step{
+								{Config: &atc.GetStep{Name: "some-repo"}},
+								{Config: &atc.GetStep{Name: "some-image"}},
+								{Config: &atc.PutStep{Name: "some-repo"}},
+							},
+						},
+					},
+				},
+				Job:       "some-job",
+				Artifacts: map[string]int{"some-repo": 42},
+				Versions:  map[string]atc.Version{"some-image": {"digest": "some-digest"}},
+			}
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			reqPayload, err := json.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/job-plan", bytes.NewBuffer(reqPayload))
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		planFrom := func() atc.Plan {
+			var plan atc.Plan
+			err := json.NewDecoder(response.Body).Decode(&plan)
+			Expect(err).NotTo(HaveOccurred())
+			return plan
+		}
+
+		It("compiles the job into a plan using the uploaded artifacts", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			plan := planFrom()
+			Expect(plan.Do).NotTo(BeNil())
+			Expect(*plan.Do).To(HaveLen(3))
+
+			steps := *plan.Do
+			Expect(steps[0].ArtifactInput).To(Equal(&atc.ArtifactInputPlan{
+				ArtifactID: 42,
+				Name:       "some-repo",
+			}))
+			Expect(steps[1].Get.Version).To(Equal(&atc.Version{"digest": "some-digest"}))
+			Expect(steps[2].OnSuccess.Step.Put.Resource).To(Equal("some-repo"))
+		})
+
+		It("does not create a build", func() {
+			Expect(dbTeam.CreateStartedBuildCallCount()).To(BeZero())
+		})
+
+		Context("when skipping puts", func() {
+			BeforeEach(func() {
+				request.SkipPuts = true
+			})
+
+			It("leaves out the put step", func() {
+				plan := planFrom()
+				steps := *plan.Do
+				Expect(steps[2].Do).To(Equal(&atc.DoPlan{}))
+			})
+		})
+
+		Context("when a get step has no version or artifact", func() {
+			BeforeEach(func() {
+				request.Versions = nil
+			})
+
+			It("returns 400 with the reason", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("some-image"))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				request.Job = "bogus-job"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("unknown job: bogus-job"))
+			})
+		})
+
+		Context("when the config is invalid", func() {
+			BeforeEach(func() {
+				request.Config.Jobs[0].PlanSequence = append(request.Config.Jobs[0].PlanSequence, atc.Step{
+					Config: &atc.GetStep{Name: "bogus-resource"},
+				})
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("invalid pipeline config"))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds", func() {
 		var response *http.Response
 		var queryParams string
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/maintenanceserver"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -14,6 +15,10 @@ func (s *Server) CreateBuild(team db.Team) http.Handler {
 	hLog := s.logger.Session("create-build")
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if maintenanceserver.RejectNewBuilds(hLog, s.maintenance, w) {
+			return
+		}
+
 		var plan atc.Plan
 		err := json.NewDecoder(r.Body).Decode(&plan)
 		if err != nil {
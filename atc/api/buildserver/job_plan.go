@@ -0,0 +1,95 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/builds"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// CreateJobPlan compiles a job from the given pipeline config into a plan the
+// same way the scheduler would, so that it can be run with CreateBuild. The
+// pipeline does not need to exist.
+func (s *Server) CreateJobPlan(team db.Team) http.Handler {
+	hLog := s.logger.Session("create-job-plan")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req atc.JobPlanRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			hLog.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_, errorMessages := configvalidate.Validate(req.Config)
+		if len(errorMessages) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid pipeline config:\n%s", strings.Join(errorMessages, "\n"))
+			return
+		}
+
+		job, found := req.Config.Jobs.Lookup(req.Job)
+		if !found {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "unknown job: %s", req.Job)
+			return
+		}
+
+		var resources db.SchedulerResources
+		for _, resource := range req.Config.Resources {
+			resources = append(resources, db.SchedulerResource{
+				Name:   resource.Name,
+				Type:   resource.Type,
+				Source: resource.Source,
+			})
+		}
+
+		resourceTypes := req.ResourceTypes
+		if len(resourceTypes) == 0 {
+			for _, resourceType := range req.Config.ResourceTypes {
+				resourceTypes = append(resourceTypes, atc.VersionedResourceType{
+					ResourceType: resourceType,
+				})
+			}
+		}
+
+		var inputs []db.BuildInput
+		for name, version := range req.Versions {
+			inputs = append(inputs, db.BuildInput{
+				Name:    name,
+				Version: version,
+			})
+		}
+
+		plan, err := s.planner.CreateOneOff(
+			job.StepConfig(),
+			resources,
+			resourceTypes,
+			inputs,
+			builds.OneOffOptions{
+				Artifacts: req.Artifacts,
+				SkipPuts:  req.SkipPuts,
+			},
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "failed to plan job %s: %s", req.Job, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(plan)
+		if err != nil {
+			hLog.Error("failed-to-encode-plan", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
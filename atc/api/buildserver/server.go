@@ -2,9 +2,12 @@ package buildserver
 
 import (
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/auth"
+	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -18,6 +21,8 @@ type Server struct {
 	teamFactory         db.TeamFactory
 	buildFactory        db.BuildFactory
 	eventHandlerFactory EventHandlerFactory
+	maintenance         db.Maintenance
+	planner             builds.Planner
 	rejector            auth.Rejector
 }
 
@@ -27,6 +32,7 @@ func NewServer(
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
 	eventHandlerFactory EventHandlerFactory,
+	maintenance db.Maintenance,
 ) *Server {
 	return &Server{
 		logger: logger,
@@ -36,6 +42,8 @@ func NewServer(
 		teamFactory:         teamFactory,
 		buildFactory:        buildFactory,
 		eventHandlerFactory: eventHandlerFactory,
+		maintenance:         maintenance,
+		planner:             builds.NewPlanner(atc.NewPlanFactory(time.Now().Unix())),
 
 		rejector: auth.UnauthorizedRejector{},
 	}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -1107,4 +1109,165 @@ jobs:
 			})
 		})
 	})
+
+	Describe("POST /api/v1/teams/:team_name/pipelines/:name/config/check-creds", func() {
+		var (
+			request  *http.Request
+			response *http.Response
+
+			fakeSourceSecrets *credsfakes.FakeSecrets
+		)
+
+		BeforeEach(func() {
+			pipelineConfig = atc.Config{
+				VarSources: atc.VarSourceConfigs{
+					{
+						Name: "some-source",
+						Type: "dummy",
+						Config: map[string]interface{}{
+							"vars": map[string]interface{}{},
+						},
+					},
+				},
+
+				Resources: atc.ResourceConfigs{
+					{
+						Name: "some-resource",
+						Type: "some-type",
+						Source: atc.Source{
+							"found":   "((some-secret))",
+							"field":   "((some-secret.some-field))",
+							"missing": "((missing-secret))",
+							"sourced": "((some-source:sourced-secret))",
+						},
+					},
+				},
+
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						PlanSequence: []atc.Step{
+							{
+								Config: &atc.GetStep{
+									Name: "some-resource",
+								},
+							},
+							{
+								Config: &atc.TaskStep{
+									Name:       "some-task",
+									ConfigPath: "some-resource/task.yml",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			fakeSecretManager.NewSecretLookupPathsReturns([]creds.SecretLookupPath{
+				creds.NewSecretLookupWithPrefix("/a-team/"),
+			})
+			fakeSecretManager.GetStub = func(path string) (interface{}, *time.Time, bool, error) {
+				if path == "/a-team/some-secret" {
+					return "some-value", nil, true, nil
+				}
+
+				return nil, nil, false, nil
+			}
+
+			fakeSourceSecrets = new(credsfakes.FakeSecrets)
+			fakeSourceSecrets.NewSecretLookupPathsReturns([]creds.SecretLookupPath{
+				creds.NewSecretLookupWithPrefix("/source/"),
+			})
+			fakeSourceSecrets.GetReturns(nil, nil, false, errors.New("permission denied"))
+			fakeVarSourcePool.FindOrCreateReturns(fakeSourceSecrets, nil)
+
+			payload, err := json.Marshal(pipelineConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err = requestGenerator.CreateRequest(atc.CheckConfigCreds, rata.Params{
+				"team_name":     "a-team",
+				"pipeline_name": "a-pipeline",
+			}, bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header.Set("Content-Type", "application/json")
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("reports the result of looking up each var", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"vars": [
+						{
+							"name": "missing-secret",
+							"status": "not-found",
+							"paths": ["/a-team/missing-secret"]
+						},
+						{
+							"name": "some-secret",
+							"status": "found",
+							"paths": ["/a-team/some-secret"]
+						},
+						{
+							"name": "sourced-secret",
+							"source": "some-source",
+							"status": "access-denied",
+							"paths": ["/source/sourced-secret"],
+							"error": "permission denied"
+						}
+					],
+					"warnings": [
+						{
+							"type": "credentials",
+							"message": "jobs.some-job: vars in task file 'some-resource/task.yml' of step 'some-task' are resolved at runtime and were not checked"
+						}
+					]
+				}`))
+			})
+
+			It("does not save the config", func() {
+				Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+			})
+
+			Context("when a var source cannot be created", func() {
+				BeforeEach(func() {
+					fakeVarSourcePool.FindOrCreateReturns(nil, errors.New("nope"))
+				})
+
+				It("reports the error", func() {
+					var checkResponse atc.CheckCredsResponse
+					err := json.NewDecoder(response.Body).Decode(&checkResponse)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(checkResponse.Errors).To(Equal([]string{
+						"create var_source 'some-source' error: nope",
+					}))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
 })
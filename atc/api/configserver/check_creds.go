@@ -0,0 +1,137 @@
+package configserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/vars"
+	"github.com/tedsuo/rata"
+)
+
+// CheckCreds resolves every ((var)) in the given config against the team's
+// credential manager and the config's var_sources, without saving the config.
+func (s *Server) CheckCreds(w http.ResponseWriter, r *http.Request) {
+	session := s.logger.Session("check-creds")
+
+	config, ok := s.readConfig(w, r, session)
+	if !ok {
+		return
+	}
+
+	warnings, errorMessages := configvalidate.Validate(config)
+	if len(errorMessages) > 0 {
+		session.Info("ignoring-invalid-config", lager.Data{"errors": errorMessages})
+		s.handleBadRequest(w, errorMessages...)
+		return
+	}
+
+	pipelineName := rata.Param(r, "pipeline_name")
+	teamName := rata.Param(r, "team_name")
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		session.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		session.Debug("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	checker, err := creds.NewVarChecker(session, s.secretManager, s.varSourcePool, team.CredentialManager(), teamName, pipelineName, config.VarSources)
+	if err != nil {
+		s.handleBadRequest(w, fmt.Sprintf("invalid var_sources: %s", err))
+		return
+	}
+
+	varNames, err := configVarNames(config)
+	if err != nil {
+		session.Error("failed-to-extract-vars", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response := atc.CheckCredsResponse{
+		Vars:     []atc.VarCheck{},
+		Errors:   checker.Errors(),
+		Warnings: append(warnings, runtimeTaskWarnings(config)...),
+	}
+
+	checked := map[string]bool{}
+	for _, varName := range varNames {
+		result := checker.Check(varName)
+
+		key := result.Source + ":" + result.Name
+		if checked[key] {
+			continue
+		}
+
+		checked[key] = true
+		response.Vars = append(response.Vars, result)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		session.Error("failed-to-encode-response", err)
+	}
+}
+
+// configVarNames returns the vars referenced anywhere in the config,
+// excluding local vars set by load_var steps.
+func configVarNames(config atc.Config) ([]string, error) {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, name := range vars.NewTemplate(payload).ExtraVarNames() {
+		if strings.HasPrefix(name, ".:") {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// runtimeTaskWarnings reports task steps whose config is fetched from an
+// artifact while the build runs, since vars inside those files cannot be
+// known ahead of time. Vars passed to them via 'params' and 'vars' are still
+// checked.
+func runtimeTaskWarnings(config atc.Config) []atc.ConfigWarning {
+	warnings := []atc.ConfigWarning{}
+
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnTask: func(step *atc.TaskStep) error {
+				if step.ConfigPath != "" && step.Config == nil {
+					warnings = append(warnings, atc.ConfigWarning{
+						Type:    "credentials",
+						Message: fmt.Sprintf("jobs.%s: vars in task file '%s' of step '%s' are resolved at runtime and were not checked", job.Name, step.ConfigPath, step.Name),
+					})
+				}
+
+				return nil
+			},
+		})
+	}
+
+	return warnings
+}
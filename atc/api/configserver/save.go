@@ -38,26 +38,8 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var config atc.Config
-	switch r.Header.Get("Content-type") {
-	case "application/json", "application/x-yaml":
-		body, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			s.handleBadRequest(w, fmt.Sprintf("read failed: %s", err))
-			return
-		}
-
-		err = atc.UnmarshalConfig(body, &config)
-		if err != nil {
-			session.Error("malformed-request-payload", err, lager.Data{
-				"content-type": r.Header.Get("Content-Type"),
-			})
-
-			s.handleBadRequest(w, fmt.Sprintf("malformed config: %s", err))
-			return
-		}
-	default:
-		w.WriteHeader(http.StatusUnsupportedMediaType)
+	config, ok := s.readConfig(w, r, session)
+	if !ok {
 		return
 	}
 
@@ -71,18 +53,6 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
-	if checkCredentials {
-		variables := creds.NewVariables(s.secretManager, teamName, pipelineName, false)
-
-		errs := validateCredParams(variables, config, session)
-		if errs != nil {
-			s.handleBadRequest(w, fmt.Sprintf("credential validation failed\n\n%s", errs))
-			return
-		}
-	}
-
-	session.Info("saving")
-
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		session.Error("failed-to-find-team", err)
@@ -96,6 +66,22 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if checkCredentials {
+		variables, err := creds.NewTeamVariables(session, s.secretManager, s.varSourcePool, team.CredentialManager(), teamName, pipelineName)
+		if err != nil {
+			s.handleBadRequest(w, fmt.Sprintf("credential validation failed\n\n%s", err))
+			return
+		}
+
+		errs := ValidateCredParams(variables, config, session)
+		if errs != nil {
+			s.handleBadRequest(w, fmt.Sprintf("credential validation failed\n\n%s", errs))
+			return
+		}
+	}
+
+	session.Info("saving")
+
 	_, created, err := team.SavePipeline(pipelineName, config, version, true)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
@@ -123,8 +109,36 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	s.writeSaveConfigResponse(w, atc.SaveConfigResponse{Warnings: warnings})
 }
 
-// Simply validate that the credentials exist; don't do anything with the actual secrets
-func validateCredParams(credMgrVars vars.Variables, config atc.Config, session lager.Logger) error {
+func (s *Server) readConfig(w http.ResponseWriter, r *http.Request, session lager.Logger) (atc.Config, bool) {
+	var config atc.Config
+	switch r.Header.Get("Content-type") {
+	case "application/json", "application/x-yaml":
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			s.handleBadRequest(w, fmt.Sprintf("read failed: %s", err))
+			return atc.Config{}, false
+		}
+
+		err = atc.UnmarshalConfig(body, &config)
+		if err != nil {
+			session.Error("malformed-request-payload", err, lager.Data{
+				"content-type": r.Header.Get("Content-Type"),
+			})
+
+			s.handleBadRequest(w, fmt.Sprintf("malformed config: %s", err))
+			return atc.Config{}, false
+		}
+	default:
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return atc.Config{}, false
+	}
+
+	return config, true
+}
+
+// ValidateCredParams simply validates that the credentials exist; don't do
+// anything with the actual secrets
+func ValidateCredParams(credMgrVars vars.Variables, config atc.Config, session lager.Logger) error {
 	var errs error
 
 	for _, resourceType := range config.ResourceTypes {
@@ -991,6 +991,204 @@ var _ = Describe("Containers API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/a-team/containers/:id/files", func() {
+		var (
+			response      *http.Response
+			fakeContainer *workerfakes.FakeContainer
+			path          string
+		)
+
+		BeforeEach(func() {
+			path = "/tmp/build/some-dir"
+
+			fakeContainer = new(workerfakes.FakeContainer)
+			fakeWorkerClient.FindContainerReturns(fakeContainer, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/containers/some-handle/files", bytes.NewBufferString("some-tar"))
+			Expect(err).NotTo(HaveOccurred())
+
+			req.URL.RawQuery = url.Values{
+				"path": {path},
+				"user": {"snoopy"},
+			}.Encode()
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the container is a build container within the team", func() {
+				var streamedIn []byte
+
+				BeforeEach(func() {
+					dbTeam.IsCheckContainerReturns(false, nil)
+					dbTeam.IsContainerWithinTeamReturns(true, nil)
+
+					fakeContainer.StreamInStub = func(spec garden.StreamInSpec) error {
+						var err error
+						streamedIn, err = ioutil.ReadAll(spec.TarStream)
+						return err
+					}
+				})
+
+				It("streams the request body into the container", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					Expect(fakeContainer.StreamInCallCount()).To(Equal(1))
+					spec := fakeContainer.StreamInArgsForCall(0)
+					Expect(spec.Path).To(Equal("/tmp/build/some-dir"))
+					Expect(spec.User).To(Equal("snoopy"))
+					Expect(string(streamedIn)).To(Equal("some-tar"))
+				})
+
+				Context("when streaming in fails", func() {
+					BeforeEach(func() {
+						fakeContainer.StreamInStub = nil
+						fakeContainer.StreamInReturns(errors.New("disk full"))
+					})
+
+					It("returns 500 with the error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("disk full"))
+					})
+				})
+
+				Context("when no path is given", func() {
+					BeforeEach(func() {
+						path = ""
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the container is a check container and the user is not an admin", func() {
+				BeforeEach(func() {
+					dbTeam.IsCheckContainerReturns(true, nil)
+					fakeAccess.IsAdminReturns(false)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the container is not within the team", func() {
+				BeforeEach(func() {
+					dbTeam.IsCheckContainerReturns(false, nil)
+					dbTeam.IsContainerWithinTeamReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the container cannot be found", func() {
+				BeforeEach(func() {
+					fakeWorkerClient.FindContainerReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/a-team/containers/:id/files", func() {
+		var (
+			response      *http.Response
+			fakeContainer *workerfakes.FakeContainer
+		)
+
+		BeforeEach(func() {
+			fakeContainer = new(workerfakes.FakeContainer)
+			fakeWorkerClient.FindContainerReturns(fakeContainer, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/containers/some-handle/files?path=/tmp/core&user=snoopy")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated and the container is within the team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeam.IsCheckContainerReturns(false, nil)
+				dbTeam.IsContainerWithinTeamReturns(true, nil)
+
+				fakeContainer.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-tar")), nil)
+			})
+
+			It("responds with the tar stream of the path", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/x-tar"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).To(Equal("some-tar"))
+
+				Expect(fakeContainer.StreamOutCallCount()).To(Equal(1))
+				Expect(fakeContainer.StreamOutArgsForCall(0)).To(Equal(garden.StreamOutSpec{
+					Path: "/tmp/core",
+					User: "snoopy",
+				}))
+			})
+
+			Context("when streaming out fails", func() {
+				BeforeEach(func() {
+					fakeContainer.StreamOutReturns(nil, errors.New("no such file"))
+				})
+
+				It("returns 500 with the error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("no such file"))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/containers/destroying", func() {
 		BeforeEach(func() {
 			var err error
@@ -0,0 +1,97 @@
+package containerserver
+
+import (
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// StreamInContainer extracts the tar archive in the request body to the
+// directory given by the path query param in the container, as the user given
+// by the user query param.
+func (s *Server) StreamInContainer(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handle := r.FormValue(":id")
+		path := r.URL.Query().Get("path")
+
+		hLog := s.logger.Session("stream-in", lager.Data{
+			"handle": handle,
+			"path":   path,
+		})
+
+		if path == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, "missing path")
+			return
+		}
+
+		container, found := s.findTeamContainer(hLog, w, r, team, handle)
+		if !found {
+			return
+		}
+
+		err := container.StreamIn(garden.StreamInSpec{
+			Path:      path,
+			User:      r.URL.Query().Get("user"),
+			TarStream: r.Body,
+		})
+		if err != nil {
+			hLog.Error("failed-to-stream-in", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// StreamOutContainer responds with a tar archive of the file or directory
+// given by the path query param in the container, read as the user given by
+// the user query param.
+func (s *Server) StreamOutContainer(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handle := r.FormValue(":id")
+		path := r.URL.Query().Get("path")
+
+		hLog := s.logger.Session("stream-out", lager.Data{
+			"handle": handle,
+			"path":   path,
+		})
+
+		if path == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, "missing path")
+			return
+		}
+
+		container, found := s.findTeamContainer(hLog, w, r, team, handle)
+		if !found {
+			return
+		}
+
+		reader, err := container.StreamOut(garden.StreamOutSpec{
+			Path: path,
+			User: r.URL.Query().Get("user"),
+		})
+		if err != nil {
+			hLog.Error("failed-to-stream-out", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, err.Error())
+			return
+		}
+
+		defer reader.Close()
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			hLog.Error("failed-to-copy-stream", err)
+		}
+	})
+}
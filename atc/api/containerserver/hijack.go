@@ -89,45 +89,8 @@ func (s *Server) HijackContainer(team db.Team) http.Handler {
 			"handle": handle,
 		})
 
-		container, found, err := s.workerClient.FindContainer(hLog, team.ID(), handle)
-		if err != nil {
-			hLog.Error("failed-to-find-container", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
+		container, found := s.findTeamContainer(hLog, w, r, team, handle)
 		if !found {
-			hLog.Info("container-not-found")
-			w.WriteHeader(http.StatusNotFound)
-			return
-		}
-
-		isCheckContainer, err := team.IsCheckContainer(handle)
-		if err != nil {
-			hLog.Error("failed-to-find-container", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
-		if isCheckContainer {
-			acc := accessor.GetAccessor(r)
-			if !acc.IsAdmin() {
-				hLog.Error("user-not-authorized-to-hijack-check-container", err)
-				w.WriteHeader(http.StatusForbidden)
-				return
-			}
-		}
-
-		ok, err := team.IsContainerWithinTeam(handle, isCheckContainer)
-		if err != nil {
-			hLog.Error("failed-to-find-container-within-team", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
-		if !ok {
-			hLog.Error("container-not-found-within-team", err)
-			w.WriteHeader(http.StatusNotFound)
 			return
 		}
 
@@ -158,6 +121,61 @@ func (s *Server) HijackContainer(team db.Team) http.Handler {
 	})
 }
 
+// findTeamContainer looks up a container that the requester is allowed to
+// interact with. Check containers may only be used by admins. If the
+// container cannot be used, an appropriate status is written to w.
+func (s *Server) findTeamContainer(
+	hLog lager.Logger,
+	w http.ResponseWriter,
+	r *http.Request,
+	team db.Team,
+	handle string,
+) (worker.Container, bool) {
+	container, found, err := s.workerClient.FindContainer(hLog, team.ID(), handle)
+	if err != nil {
+		hLog.Error("failed-to-find-container", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		hLog.Info("container-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	isCheckContainer, err := team.IsCheckContainer(handle)
+	if err != nil {
+		hLog.Error("failed-to-find-container", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if isCheckContainer {
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAdmin() {
+			hLog.Error("user-not-authorized-to-hijack-check-container", err)
+			w.WriteHeader(http.StatusForbidden)
+			return nil, false
+		}
+	}
+
+	ok, err := team.IsContainerWithinTeam(handle, isCheckContainer)
+	if err != nil {
+		hLog.Error("failed-to-find-container-within-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !ok {
+		hLog.Error("container-not-found-within-team", err)
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	return container, true
+}
+
 type hijackRequest struct {
 	Container worker.Container
 	Process   atc.HijackProcessSpec
@@ -18,10 +18,14 @@ import (
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
+	"github.com/concourse/concourse/atc/api/maintenanceserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
+	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
+	"github.com/concourse/concourse/atc/api/sessionserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
+	"github.com/concourse/concourse/atc/api/tokenserver"
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/wallserver"
@@ -55,6 +59,8 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbAPITokenFactory db.APITokenFactory,
+	dbSessionFactory db.SessionFactory,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 
@@ -72,7 +78,11 @@ func NewHandler(
 	credsManagers creds.Managers,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
+	apiTokenMaxLifetime time.Duration,
 	dbWall db.Wall,
+	dbMaintenance db.Maintenance,
+	infoCacher infoserver.Cacher,
+	policyChecker policychecker.PolicyChecker,
 	clock clock.Clock,
 
 	enableArchivePipeline bool,
@@ -87,34 +97,39 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
+	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory, dbMaintenance)
 	checkServer := checkserver.NewServer(logger, dbCheckFactory)
 	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
 	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
 
 	versionServer := versionserver.NewServer(logger, externalURL)
-	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL, enableArchivePipeline)
-	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager)
+	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL, enableArchivePipeline, dbMaintenance)
+	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager, varSourcePool)
 	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
 	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
 	containerServer := containerserver.NewServer(logger, workerClient, secretManager, varSourcePool, interceptTimeoutFactory, interceptUpdateInterval, containerRepository, destroyer, clock)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
-	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
-	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
+	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL, secretManager, varSourcePool, policyChecker, enableArchivePipeline)
+	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers, infoCacher)
 	artifactServer := artifactserver.NewServer(logger, workerClient)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	maintenanceServer := maintenanceserver.NewServer(logger, dbMaintenance)
+	tokenServer := tokenserver.NewServer(logger, dbAPITokenFactory, apiTokenMaxLifetime)
+	sessionServer := sessionserver.NewServer(logger, dbSessionFactory, dbAPITokenFactory)
 
 	handlers := map[string]http.Handler{
-		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
-		atc.SaveConfig: http.HandlerFunc(configServer.SaveConfig),
+		atc.GetConfig:        http.HandlerFunc(configServer.GetConfig),
+		atc.SaveConfig:       http.HandlerFunc(configServer.SaveConfig),
+		atc.CheckConfigCreds: http.HandlerFunc(configServer.CheckCreds),
 
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
 		atc.ListBuilds:          http.HandlerFunc(buildServer.ListBuilds),
 		atc.CreateBuild:         teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
+		atc.CreateJobPlan:       teamHandlerFactory.HandlerFor(buildServer.CreateJobPlan),
 		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
 		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
@@ -130,6 +145,7 @@ func NewHandler(
 		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
 		atc.ListJobBuilds:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
 		atc.ListJobInputs:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
+		atc.ListJobStats:   pipelineHandlerFactory.HandlerFor(jobServer.ListJobStats),
 		atc.GetJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
 		atc.CreateJobBuild: pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
 		atc.RerunJobBuild:  pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
@@ -159,6 +175,9 @@ func NewHandler(
 		atc.ListPipelineBuilds:  pipelineHandlerFactory.HandlerFor(pipelineServer.ListPipelineBuilds),
 		atc.CreatePipelineBuild: pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:       pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
+		atc.PipelineEvents:      pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineEvents),
+
+		atc.ListNotificationDeliveries: pipelineHandlerFactory.HandlerFor(pipelineServer.ListNotificationDeliveries),
 
 		atc.ListAllResources:        http.HandlerFunc(resourceServer.ListAllResources),
 		atc.ListResources:           pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
@@ -200,6 +219,8 @@ func NewHandler(
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
+		atc.StreamInContainer:        teamHandlerFactory.HandlerFor(containerServer.StreamInContainer),
+		atc.StreamOutContainer:       teamHandlerFactory.HandlerFor(containerServer.StreamOutContainer),
 		atc.ListDestroyingContainers: http.HandlerFunc(containerServer.ListDestroyingContainers),
 		atc.ReportWorkerContainers:   http.HandlerFunc(containerServer.ReportWorkerContainers),
 
@@ -213,6 +234,11 @@ func NewHandler(
 		atc.RenameTeam:     http.HandlerFunc(teamServer.RenameTeam),
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
+		atc.TeamEvents:     http.HandlerFunc(teamServer.TeamEvents),
+
+		atc.ExplainTeamAuth: http.HandlerFunc(teamServer.ExplainTeamAuth),
+		atc.ExportTeam:      http.HandlerFunc(teamServer.ExportTeam),
+		atc.ImportTeam:      http.HandlerFunc(teamServer.ImportTeam),
 
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
@@ -220,6 +246,23 @@ func NewHandler(
 		atc.GetWall:   http.HandlerFunc(wallServer.GetWall),
 		atc.SetWall:   http.HandlerFunc(wallServer.SetWall),
 		atc.ClearWall: http.HandlerFunc(wallServer.ClearWall),
+
+		atc.ListWallMessages:  http.HandlerFunc(wallServer.ListWallMessages),
+		atc.CreateWallMessage: http.HandlerFunc(wallServer.CreateWallMessage),
+		atc.DeleteWallMessage: http.HandlerFunc(wallServer.DeleteWallMessage),
+
+		atc.GetMaintenance:     http.HandlerFunc(maintenanceServer.GetMaintenance),
+		atc.EnableMaintenance:  http.HandlerFunc(maintenanceServer.EnableMaintenance),
+		atc.DisableMaintenance: http.HandlerFunc(maintenanceServer.DisableMaintenance),
+
+		atc.ListAPITokens:  teamHandlerFactory.HandlerFor(tokenServer.ListAPITokens),
+		atc.CreateAPIToken: teamHandlerFactory.HandlerFor(tokenServer.CreateAPIToken),
+		atc.DeleteAPIToken: teamHandlerFactory.HandlerFor(tokenServer.DeleteAPIToken),
+
+		atc.ListSessions:         http.HandlerFunc(sessionServer.ListSessions),
+		atc.RevokeSession:        http.HandlerFunc(sessionServer.RevokeSession),
+		atc.RevokeCurrentSession: http.HandlerFunc(sessionServer.RevokeCurrentSession),
+		atc.RevokeUserSessions:   http.HandlerFunc(sessionServer.RevokeUserSessions),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	awsssm "github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds/credhub"
 	"github.com/concourse/concourse/atc/creds/secretsmanager"
 	"github.com/concourse/concourse/atc/creds/ssm"
@@ -72,6 +73,58 @@ var _ = Describe("Pipelines API", func() {
 				"cluster_name": "Test Cluster"
 			}`))
 		})
+
+		It("does not query the database", func() {
+			Expect(dbWall.Invocations()).To(BeEmpty())
+			Expect(dbMaintenance.Invocations()).To(BeEmpty())
+		})
+
+		Context("when maintenance mode is enabled", func() {
+			BeforeEach(func() {
+				fakeInfoCacher.MaintenanceModeReturns(atc.Maintenance{Enabled: true, Reason: "upgrading", EnabledAt: 100}, nil)
+			})
+
+			It("includes the maintenance mode", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"version": "1.2.3",
+					"worker_version": "4.5.6",
+					"external_url": "https://example.com",
+					"cluster_name": "Test Cluster",
+					"maintenance": {
+						"enabled": true,
+						"reason": "upgrading",
+						"enabled_at": 100
+					}
+				}`))
+			})
+		})
+
+		Context("when there are wall messages", func() {
+			BeforeEach(func() {
+				fakeInfoCacher.ActiveWallMessagesReturns([]atc.WallMessage{
+					{ID: 1, Message: "some message", Severity: atc.WallSeverityCritical, EndsAt: 200},
+					{ID: 2, Message: "some team", Severity: atc.WallSeverityInfo, Teams: []string{"other-team"}},
+				}, nil)
+			})
+
+			It("includes the messages visible to the user", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"version": "1.2.3",
+					"worker_version": "4.5.6",
+					"external_url": "https://example.com",
+					"cluster_name": "Test Cluster",
+					"wall": [
+						{"id":1,"message":"some message","severity":"critical","ends_at":200}
+					]
+				}`))
+			})
+		})
 	})
 
 	Describe("GET /api/v1/info/creds", func() {
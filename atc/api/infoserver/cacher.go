@@ -0,0 +1,127 @@
+package infoserver
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/patrickmn/go-cache"
+)
+
+const (
+	wallCacheName        = "wall"
+	maintenanceCacheName = "maintenance"
+)
+
+//go:generate counterfeiter . Cacher
+
+// Cacher keeps the wall messages and the maintenance mode in memory, so that
+// the info endpoint, which clients request before every command, does not
+// query the database. The cache is dropped whenever either of them changes.
+type Cacher interface {
+	ActiveWallMessages() ([]atc.WallMessage, error)
+	MaintenanceMode() (atc.Maintenance, error)
+}
+
+type cacher struct {
+	logger        lager.Logger
+	cache         *cache.Cache
+	notifications accessor.Notifications
+	wall          db.Wall
+	maintenance   db.Maintenance
+	clock         clock.Clock
+}
+
+func NewCacher(
+	logger lager.Logger,
+	notifications accessor.Notifications,
+	wall db.Wall,
+	maintenance db.Maintenance,
+	clock clock.Clock,
+	expiration time.Duration,
+) Cacher {
+	c := &cacher{
+		logger:        logger,
+		cache:         cache.New(expiration, expiration),
+		notifications: notifications,
+		wall:          wall,
+		maintenance:   maintenance,
+		clock:         clock,
+	}
+
+	go c.waitForNotifications(atc.WallCacheChannel, wallCacheName)
+	go c.waitForNotifications(atc.MaintenanceCacheChannel, maintenanceCacheName)
+
+	return c
+}
+
+// ActiveWallMessages returns the messages whose window includes the current
+// time. Every message that has not ended is cached, so that scheduled
+// messages show up once they start without waiting for the cache to expire.
+func (c *cacher) ActiveWallMessages() ([]atc.WallMessage, error) {
+	var messages []atc.WallMessage
+	if cached, found := c.cache.Get(wallCacheName); found {
+		messages = cached.([]atc.WallMessage)
+	} else {
+		var err error
+		messages, err = c.wall.Messages()
+		if err != nil {
+			return nil, err
+		}
+
+		c.cache.Set(wallCacheName, messages, cache.DefaultExpiration)
+	}
+
+	now := c.clock.Now().Unix()
+
+	active := []atc.WallMessage{}
+	for _, message := range messages {
+		if message.StartsAt > now {
+			continue
+		}
+
+		if message.EndsAt != 0 && message.EndsAt <= now {
+			continue
+		}
+
+		active = append(active, message)
+	}
+
+	return active, nil
+}
+
+// MaintenanceMode returns the maintenance mode of the cluster, without the
+// build counts, which change too often to be cached.
+func (c *cacher) MaintenanceMode() (atc.Maintenance, error) {
+	if cached, found := c.cache.Get(maintenanceCacheName); found {
+		return cached.(atc.Maintenance), nil
+	}
+
+	mode, err := c.maintenance.Mode()
+	if err != nil {
+		return atc.Maintenance{}, err
+	}
+
+	c.cache.Set(maintenanceCacheName, mode, cache.DefaultExpiration)
+
+	return mode, nil
+}
+
+func (c *cacher) waitForNotifications(channel string, name string) {
+	notifier, err := c.notifications.Listen(channel)
+	if err != nil {
+		c.logger.Error("failed-to-listen-for-"+name+"-cache", err)
+	}
+
+	defer c.notifications.Unlisten(channel, notifier)
+
+	for {
+		select {
+		case <-notifier:
+			c.cache.Delete(name)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package infoserver_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/api/infoserver"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cacher", func() {
+	var (
+		fakeNotifications *accessorfakes.FakeNotifications
+		fakeWall          *dbfakes.FakeWall
+		fakeMaintenance   *dbfakes.FakeMaintenance
+		fakeClock         *fakeclock.FakeClock
+
+		wallNotifier        chan bool
+		maintenanceNotifier chan bool
+
+		cacher infoserver.Cacher
+	)
+
+	BeforeEach(func() {
+		wall := make(chan bool, 1)
+		maintenance := make(chan bool, 1)
+		wallNotifier, maintenanceNotifier = wall, maintenance
+
+		fakeNotifications = new(accessorfakes.FakeNotifications)
+		fakeNotifications.ListenStub = func(channel string) (chan bool, error) {
+			switch channel {
+			case atc.WallCacheChannel:
+				return wall, nil
+			case atc.MaintenanceCacheChannel:
+				return maintenance, nil
+			}
+			return nil, nil
+		}
+
+		fakeWall = new(dbfakes.FakeWall)
+		fakeMaintenance = new(dbfakes.FakeMaintenance)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+
+		cacher = infoserver.NewCacher(lager.NewLogger("test"), fakeNotifications, fakeWall, fakeMaintenance, fakeClock, time.Minute)
+	})
+
+	Describe("ActiveWallMessages", func() {
+		BeforeEach(func() {
+			fakeWall.MessagesReturns([]atc.WallMessage{
+				{ID: 1, Message: "current", EndsAt: 2000},
+				{ID: 2, Message: "scheduled", StartsAt: 1500},
+				{ID: 3, Message: "forever"},
+			}, nil)
+		})
+
+		It("returns the messages that have started and not ended", func() {
+			messages, err := cacher.ActiveWallMessages()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(messages).To(Equal([]atc.WallMessage{
+				{ID: 1, Message: "current", EndsAt: 2000},
+				{ID: 3, Message: "forever"},
+			}))
+		})
+
+		It("fetches the messages from the DB once", func() {
+			_, err := cacher.ActiveWallMessages()
+			Expect(err).NotTo(HaveOccurred())
+			_, err = cacher.ActiveWallMessages()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWall.MessagesCallCount()).To(Equal(1))
+		})
+
+		It("shows and hides cached messages as time passes", func() {
+			_, err := cacher.ActiveWallMessages()
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(1000 * time.Second)
+
+			messages, err := cacher.ActiveWallMessages()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(messages).To(Equal([]atc.WallMessage{
+				{ID: 2, Message: "scheduled", StartsAt: 1500},
+				{ID: 3, Message: "forever"},
+			}))
+			Expect(fakeWall.MessagesCallCount()).To(Equal(1))
+		})
+
+		Context("when the wall changes", func() {
+			It("fetches the messages from the DB again", func() {
+				_, err := cacher.ActiveWallMessages()
+				Expect(err).NotTo(HaveOccurred())
+
+				wallNotifier <- true
+
+				Eventually(func() int {
+					_, err := cacher.ActiveWallMessages()
+					Expect(err).NotTo(HaveOccurred())
+					return fakeWall.MessagesCallCount()
+				}).Should(Equal(2))
+			})
+		})
+	})
+
+	Describe("MaintenanceMode", func() {
+		BeforeEach(func() {
+			fakeMaintenance.ModeReturns(atc.Maintenance{Enabled: true, Reason: "upgrading"}, nil)
+		})
+
+		It("fetches the mode from the DB once", func() {
+			mode, err := cacher.MaintenanceMode()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mode).To(Equal(atc.Maintenance{Enabled: true, Reason: "upgrading"}))
+
+			_, err = cacher.MaintenanceMode()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeMaintenance.ModeCallCount()).To(Equal(1))
+			Expect(fakeMaintenance.BuildCountsCallCount()).To(BeZero())
+		})
+
+		Context("when the maintenance mode changes", func() {
+			It("fetches the mode from the DB again", func() {
+				_, err := cacher.MaintenanceMode()
+				Expect(err).NotTo(HaveOccurred())
+
+				maintenanceNotifier <- true
+
+				Eventually(func() int {
+					_, err := cacher.MaintenanceMode()
+					Expect(err).NotTo(HaveOccurred())
+					return fakeMaintenance.ModeCallCount()
+				}).Should(Equal(2))
+			})
+		})
+	})
+})
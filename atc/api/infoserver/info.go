@@ -5,17 +5,40 @@ import (
 	"net/http"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/wallserver"
 )
 
 func (s *Server) Info(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("info")
 
-	w.Header().Set("Content-Type", "application/json")
-	err := json.NewEncoder(w).Encode(atc.Info{Version: s.version,
+	// the wall is shown alongside the info so that clients checking the
+	// version before every command can display it without another request
+	wall, err := s.cacher.ActiveWallMessages()
+	if err != nil {
+		logger.Error("failed-to-get-wall-messages", err)
+	}
+
+	info := atc.Info{Version: s.version,
 		WorkerVersion: s.workerVersion,
 		ExternalURL:   s.externalURL,
 		ClusterName:   s.clusterName,
-	})
+		Wall:          wallserver.FilterVisible(wall, accessor.GetAccessor(r)),
+	}
+
+	// likewise for maintenance mode, so that clients know why their builds
+	// are not starting
+	maintenance, err := s.cacher.MaintenanceMode()
+	if err != nil {
+		logger.Error("failed-to-get-maintenance-mode", err)
+	}
+
+	if maintenance.Enabled {
+		info.Maintenance = &maintenance
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(info)
 	if err != nil {
 		logger.Error("failed-to-encode-info", err)
 		w.WriteHeader(http.StatusInternalServerError)
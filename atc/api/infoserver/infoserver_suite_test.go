@@ -0,0 +1,13 @@
+package infoserver_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestInfoserver(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Infoserver Suite")
+}
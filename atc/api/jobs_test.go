@@ -234,6 +234,35 @@ var _ = Describe("Jobs API", func() {
 					Expect(dbJobFactory.AllActiveJobsCallCount()).To(Equal(1))
 				})
 			})
+
+			Context("when the user is only granted some pipelines of another team", func() {
+				BeforeEach(func() {
+					fakeAccess.PipelineTeamNamesReturns([]string{"other-team"})
+					fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, pipelineName string) bool {
+						return teamName == "other-team" && pipelineName == "granted-pipeline"
+					}
+
+					dbJobFactory.VisibleJobsReturnsOnCall(1, atc.Dashboard{
+						{ID: 2, Name: "granted-job", PipelineName: "granted-pipeline", TeamName: "other-team"},
+						{ID: 3, Name: "other-job", PipelineName: "other-pipeline", TeamName: "other-team"},
+					}, nil)
+				})
+
+				It("also returns the jobs of the granted pipelines", func() {
+					Expect(dbJobFactory.VisibleJobsCallCount()).To(Equal(2))
+					Expect(dbJobFactory.VisibleJobsArgsForCall(1)).To(ConsistOf("other-team"))
+
+					var jobs []atc.Job
+					err := json.NewDecoder(response.Body).Decode(&jobs)
+					Expect(err).NotTo(HaveOccurred())
+
+					var names []string
+					for _, job := range jobs {
+						names = append(names, job.Name)
+					}
+					Expect(names).To(ConsistOf("some-job", "granted-job"))
+				})
+			})
 		})
 	})
 
@@ -1177,6 +1206,156 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/job-stats", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/job-stats" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			Context("and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("and the pipeline is public", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(true)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.IsAuthenticatedReturns(true)
+
+				fakePipeline.JobStatsReturns([]atc.JobStats{
+					{
+						JobName:      "some-job",
+						Builds:       5,
+						Succeeded:    1,
+						Failed:       2,
+						Errored:      1,
+						Aborted:      1,
+						SuccessRate:  0.25,
+						DurationP50:  60,
+						DurationP95:  90.5,
+						QueueP50:     1,
+						QueueP95:     2,
+						FlakyRetries: 1,
+						Flakiness:    0.5,
+					},
+				}, nil)
+			})
+
+			It("returns the stats of each job", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response).Should(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"job_name": "some-job",
+						"builds": 5,
+						"succeeded": 1,
+						"failed": 2,
+						"errored": 1,
+						"aborted": 1,
+						"success_rate": 0.25,
+						"duration_p50": 60,
+						"duration_p95": 90.5,
+						"queue_p50": 1,
+						"queue_p95": 2,
+						"flaky_retries": 1,
+						"flakiness": 0.5
+					}
+				]`))
+			})
+
+			It("defaults to the last week", func() {
+				Expect(fakePipeline.JobStatsCallCount()).To(Equal(1))
+
+				since, until := fakePipeline.JobStatsArgsForCall(0)
+				Expect(until).To(BeTemporally("~", time.Now(), time.Minute))
+				Expect(until.Sub(since)).To(Equal(7 * 24 * time.Hour))
+			})
+
+			Context("when since and until are given", func() {
+				BeforeEach(func() {
+					query = "?since=100&until=200"
+				})
+
+				It("uses them as the window", func() {
+					since, until := fakePipeline.JobStatsArgsForCall(0)
+					Expect(since).To(Equal(time.Unix(100, 0)))
+					Expect(until).To(Equal(time.Unix(200, 0)))
+				})
+			})
+
+			Context("when since is not a timestamp", func() {
+				BeforeEach(func() {
+					query = "?since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakePipeline.JobStatsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when since is not before until", func() {
+				BeforeEach(func() {
+					query = "?since=200&until=200"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakePipeline.JobStatsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the stats fails", func() {
+				BeforeEach(func() {
+					fakePipeline.JobStatsReturns(nil, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", func() {
 		var response *http.Response
 		var queryParams string
@@ -29,6 +29,17 @@ func (s *Server) ListAllJobs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if pipelineTeams := acc.PipelineTeamNames(); len(pipelineTeams) > 0 {
+		pipelineJobs, err := s.jobFactory.VisibleJobs(pipelineTeams)
+		if err != nil {
+			logger.Error("failed-to-get-pipeline-jobs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		dashboard = appendPipelineJobs(acc, dashboard, pipelineJobs)
+	}
+
 	jobs := []atc.Job{}
 
 	for _, job := range dashboard {
@@ -48,3 +59,20 @@ func (s *Server) ListAllJobs(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// appendPipelineJobs adds the jobs of the pipelines that the user is granted
+// individually, skipping the ones already visible.
+func appendPipelineJobs(acc accessor.Access, dashboard atc.Dashboard, pipelineJobs atc.Dashboard) atc.Dashboard {
+	seen := map[int]bool{}
+	for _, job := range dashboard {
+		seen[job.ID] = true
+	}
+
+	for _, job := range pipelineJobs {
+		if !seen[job.ID] && acc.IsAuthorizedForPipeline(job.TeamName, job.PipelineName) {
+			dashboard = append(dashboard, job)
+		}
+	}
+
+	return dashboard
+}
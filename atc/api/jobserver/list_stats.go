@@ -0,0 +1,64 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// defaultJobStatsWindow is how far back stats are computed from when no
+// since is given.
+const defaultJobStatsWindow = 7 * 24 * time.Hour
+
+func (s *Server) ListJobStats(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-job-stats")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		until := time.Now()
+		if urlUntil := r.FormValue(atc.JobStatsQueryUntil); urlUntil != "" {
+			unix, err := strconv.ParseInt(urlUntil, 10, 64)
+			if err != nil {
+				http.Error(w, "until must be a unix timestamp", http.StatusBadRequest)
+				return
+			}
+
+			until = time.Unix(unix, 0)
+		}
+
+		since := until.Add(-defaultJobStatsWindow)
+		if urlSince := r.FormValue(atc.JobStatsQuerySince); urlSince != "" {
+			unix, err := strconv.ParseInt(urlSince, 10, 64)
+			if err != nil {
+				http.Error(w, "since must be a unix timestamp", http.StatusBadRequest)
+				return
+			}
+
+			since = time.Unix(unix, 0)
+		}
+
+		if !since.Before(until) {
+			http.Error(w, "since must be before until", http.StatusBadRequest)
+			return
+		}
+
+		stats, err := pipeline.JobStats(since, until)
+		if err != nil {
+			logger.Error("failed-to-get-job-stats", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(stats)
+		if err != nil {
+			logger.Error("failed-to-encode-job-stats", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
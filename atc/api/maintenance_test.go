@@ -0,0 +1,155 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Maintenance API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/maintenance", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/maintenance")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+
+				dbMaintenance.ModeReturns(atc.Maintenance{
+					Enabled:     true,
+					Reason:      "upgrading",
+					PauseChecks: true,
+					EnabledAt:   100,
+				}, nil)
+				dbMaintenance.BuildCountsReturns(3, 5, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response).Should(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+			})
+
+			It("returns the mode with the build counts", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"enabled": true,
+					"reason": "upgrading",
+					"pause_checks": true,
+					"enabled_at": 100,
+					"running_builds": 3,
+					"pending_builds": 5
+				}`))
+			})
+
+			Context("when counting the builds fails", func() {
+				BeforeEach(func() {
+					dbMaintenance.BuildCountsReturns(0, 0, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/maintenance", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/maintenance",
+				bytes.NewBufferString(`{"reason":"upgrading","pause_checks":true}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("enables maintenance mode", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(dbMaintenance.EnableCallCount()).To(Equal(1))
+
+				reason, pauseChecks := dbMaintenance.EnableArgsForCall(0)
+				Expect(reason).To(Equal("upgrading"))
+				Expect(pauseChecks).To(BeTrue())
+			})
+
+			Context("when enabling fails", func() {
+				BeforeEach(func() {
+					dbMaintenance.EnableReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbMaintenance.EnableCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/maintenance", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/maintenance", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("disables maintenance mode", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(dbMaintenance.DisableCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbMaintenance.DisableCallCount()).To(BeZero())
+			})
+		})
+	})
+})
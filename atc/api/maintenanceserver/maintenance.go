@@ -0,0 +1,75 @@
+package maintenanceserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+// GetMaintenance returns the maintenance mode of the cluster along with the
+// number of builds still running, so that operators can tell when it is safe
+// to upgrade.
+func (s *Server) GetMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-maintenance")
+
+	mode, err := s.maintenance.Mode()
+	if err != nil {
+		logger.Error("failed-to-get-maintenance-mode", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	mode.RunningBuilds, mode.PendingBuilds, err = s.maintenance.BuildCounts()
+	if err != nil {
+		logger.Error("failed-to-count-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(mode)
+	if err != nil {
+		logger.Error("failed-to-encode-json", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) EnableMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("enable-maintenance")
+
+	var mode atc.Maintenance
+	err := json.NewDecoder(r.Body).Decode(&mode)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = s.maintenance.Enable(mode.Reason, mode.PauseChecks)
+	if err != nil {
+		logger.Error("failed-to-enable-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("enabled", lager.Data{"reason": mode.Reason, "pause-checks": mode.PauseChecks})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) DisableMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("disable-maintenance")
+
+	err := s.maintenance.Disable()
+	if err != nil {
+		logger.Error("failed-to-disable-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("disabled")
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,35 @@
+package maintenanceserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// RejectNewBuilds responds with 503 Service Unavailable and returns true while
+// the cluster is in maintenance mode. Builds created through the API start
+// right away, bypassing the build starter which holds back pending builds
+// during maintenance.
+func RejectNewBuilds(logger lager.Logger, maintenance db.Maintenance, w http.ResponseWriter) bool {
+	mode, err := maintenance.Mode()
+	if err != nil {
+		logger.Error("failed-to-get-maintenance-mode", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return true
+	}
+
+	if !mode.Enabled {
+		return false
+	}
+
+	logger.Info("rejected-during-maintenance")
+
+	message := "the cluster is in maintenance mode and is not starting builds"
+	if mode.Reason != "" {
+		message += ": " + mode.Reason
+	}
+
+	http.Error(w, message, http.StatusServiceUnavailable)
+	return true
+}
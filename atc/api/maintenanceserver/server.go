@@ -0,0 +1,18 @@
+package maintenanceserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger      lager.Logger
+	maintenance db.Maintenance
+}
+
+func NewServer(logger lager.Logger, maintenance db.Maintenance) *Server {
+	return &Server{
+		logger:      logger,
+		maintenance: maintenance,
+	}
+}
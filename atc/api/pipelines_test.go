@@ -218,6 +218,38 @@ var _ = Describe("Pipelines API", func() {
 				})
 			})
 
+			Context("when the user is only granted some pipelines of a team", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamNamesReturns([]string{})
+					fakeAccess.PipelineTeamNamesReturns([]string{"main"})
+					fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, pipelineName string) bool {
+						return teamName == "main" && pipelineName == "private-pipeline"
+					}
+
+					otherPrivatePipeline := new(dbfakes.FakePipeline)
+					otherPrivatePipeline.IDReturns(4)
+					otherPrivatePipeline.TeamNameReturns("main")
+					otherPrivatePipeline.NameReturns("other-private-pipeline")
+
+					dbPipelineFactory.VisiblePipelinesReturns([]db.Pipeline{privatePipeline, otherPrivatePipeline, publicPipeline, anotherPublicPipeline}, nil)
+				})
+
+				It("returns the granted pipelines + all public pipelines", func() {
+					Expect(dbPipelineFactory.VisiblePipelinesArgsForCall(0)).To(ConsistOf("main"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					var pipelines []map[string]interface{}
+					err = json.Unmarshal(body, &pipelines)
+					Expect(pipelines).To(ConsistOf(
+						HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+						HaveKeyWithValue("id", BeNumerically("==", privatePipeline.ID())),
+						HaveKeyWithValue("id", BeNumerically("==", anotherPublicPipeline.ID())),
+					))
+				})
+			})
+
 			Context("when the call to get active pipelines fails", func() {
 				BeforeEach(func() {
 					dbPipelineFactory.VisiblePipelinesReturns(nil, errors.New("disaster"))
@@ -327,6 +359,36 @@ var _ = Describe("Pipelines API", func() {
 			})
 		})
 
+		Context("when only granted some pipelines of the requested team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.PipelineTeamNamesReturns([]string{"main"})
+				fakeAccess.IsAuthorizedForPipelineStub = func(teamName string, pipelineName string) bool {
+					return teamName == "main" && pipelineName == "private-pipeline"
+				}
+
+				otherPrivatePipeline := new(dbfakes.FakePipeline)
+				otherPrivatePipeline.IDReturns(4)
+				otherPrivatePipeline.TeamNameReturns("main")
+				otherPrivatePipeline.NameReturns("other-private-pipeline")
+
+				fakeTeam.PipelinesReturns([]db.Pipeline{privatePipeline, otherPrivatePipeline, publicPipeline}, nil)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns the granted pipelines + the team's public pipelines", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				var pipelines []map[string]interface{}
+				json.Unmarshal(body, &pipelines)
+
+				Expect(pipelines).To(ConsistOf(
+					HaveKeyWithValue("id", BeNumerically("==", publicPipeline.ID())),
+					HaveKeyWithValue("id", BeNumerically("==", privatePipeline.ID())),
+				))
+			})
+		})
+
 		Context("when authenticated as another team", func() {
 			BeforeEach(func() {
 				fakeAccess.IsAuthenticatedReturns(false)
@@ -516,6 +578,126 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/events", func() {
+		var (
+			request         *http.Request
+			response        *http.Response
+			fakeEventSource *dbfakes.FakePipelineEventSource
+		)
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/events", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			fakeTeam.PipelineReturns(dbPipeline, true, nil)
+
+			fakeEventSource = new(dbfakes.FakePipelineEventSource)
+			fakeEventSource.NextReturnsOnCall(0, atc.PipelineEvent{
+				ID:           42,
+				Type:         atc.PipelineEventPipelinePaused,
+				Time:         1594132020,
+				TeamName:     "a-team",
+				PipelineName: "a-pipeline",
+			}, nil)
+			fakeEventSource.NextReturns(atc.PipelineEvent{}, db.ErrPipelineEventStreamClosed)
+			dbPipeline.EventsReturns(fakeEventSource, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated and the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				dbPipeline.PublicReturns(true)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbPipeline.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not authorized and the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+				dbPipeline.PublicReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbPipeline.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("streams the pipeline's events as server-sent events", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(string(body)).To(ContainSubstring("id: 42\n"))
+				Expect(string(body)).To(ContainSubstring(`"type":"pipeline-paused"`))
+			})
+
+			It("starts from the latest event", func() {
+				Expect(dbPipeline.EventsCallCount()).To(Equal(1))
+				Expect(dbPipeline.EventsArgsForCall(0)).To(Equal(db.LatestPipelineEvent))
+			})
+
+			It("closes the event source", func() {
+				_, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(fakeEventSource.CloseCallCount).ShouldNot(BeZero())
+			})
+
+			Context("when resuming with Last-Event-ID", func() {
+				BeforeEach(func() {
+					request.Header.Set("Last-Event-ID", "41")
+				})
+
+				It("streams events after that one", func() {
+					Expect(dbPipeline.EventsCallCount()).To(Equal(1))
+					Expect(dbPipeline.EventsArgsForCall(0)).To(Equal(41))
+				})
+			})
+
+			Context("when Last-Event-ID is malformed", func() {
+				BeforeEach(func() {
+					request.Header.Set("Last-Event-ID", "nope")
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when subscribing to the events fails", func() {
+				BeforeEach(func() {
+					dbPipeline.EventsReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/badge", func() {
 		var response *http.Response
 		var jobWithNoBuilds, jobWithSucceededBuild, jobWithAbortedBuild, jobWithErroredBuild, jobWithFailedBuild *dbfakes.FakeJob
@@ -1544,6 +1726,135 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/notification-deliveries", func() {
+		var (
+			response *http.Response
+			query    string
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/notification-deliveries"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+			})
+
+			Context("when getting the deliveries works", func() {
+				BeforeEach(func() {
+					delivery := new(dbfakes.FakeNotificationDelivery)
+					delivery.IDReturns(3)
+					delivery.NotificationNameReturns("some-notification")
+					delivery.EventReturns(atc.PipelineEvent{
+						ID:   42,
+						Type: atc.PipelineEventBuildStatus,
+					})
+					delivery.StatusReturns(atc.NotificationDeliveryPending)
+					delivery.AttemptsReturns(2)
+					delivery.ResponseCodeReturns(502)
+					delivery.ErrorReturns("unexpected response: 502 Bad Gateway")
+					delivery.CreatedAtReturns(time.Unix(100, 0))
+					delivery.UpdatedAtReturns(time.Unix(200, 0))
+
+					dbPipeline.NotificationDeliveriesReturns([]db.NotificationDelivery{delivery}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns application/json", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("uses the default limit", func() {
+					Expect(dbPipeline.NotificationDeliveriesCallCount()).To(Equal(1))
+					Expect(dbPipeline.NotificationDeliveriesArgsForCall(0)).To(Equal(atc.PaginationAPIDefaultLimit))
+				})
+
+				It("returns the deliveries", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 3,
+							"notification_name": "some-notification",
+							"event_id": 42,
+							"event_type": "build-status",
+							"status": "pending",
+							"attempts": 2,
+							"response_code": 502,
+							"error": "unexpected response: 502 Bad Gateway",
+							"created_at": 100,
+							"updated_at": 200
+						}
+					]`))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=5"
+					})
+
+					It("uses it", func() {
+						Expect(dbPipeline.NotificationDeliveriesArgsForCall(0)).To(Equal(5))
+					})
+				})
+			})
+
+			Context("when there are no deliveries", func() {
+				BeforeEach(func() {
+					dbPipeline.NotificationDeliveriesReturns(nil, nil)
+				})
+
+				It("returns an empty list", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[]`))
+				})
+			})
+
+			Context("when getting the deliveries fails", func() {
+				BeforeEach(func() {
+					dbPipeline.NotificationDeliveriesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/rename", func() {
 		var response *http.Response
 
@@ -1564,9 +1875,11 @@ var _ = Describe("Pipelines API", func() {
 			Context("when requester belongs to the team", func() {
 				BeforeEach(func() {
 					fakeAccess.IsAuthorizedReturns(true)
+					fakeAccess.IsAuthorizedForPipelineReturns(true)
 
 					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
 					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+					dbPipeline.TeamNameReturns("a-team")
 				})
 
 				It("constructs teamDB with provided team name", func() {
@@ -1588,6 +1901,27 @@ var _ = Describe("Pipelines API", func() {
 					Expect(dbPipeline.RenameArgsForCall(0)).To(Equal("some-new-name"))
 				})
 
+				It("checks the requester is authorized for the new name", func() {
+					Expect(fakeAccess.IsAuthorizedForPipelineCallCount()).To(Equal(1))
+					teamName, pipelineName := fakeAccess.IsAuthorizedForPipelineArgsForCall(0)
+					Expect(teamName).To(Equal("a-team"))
+					Expect(pipelineName).To(Equal("some-new-name"))
+				})
+
+				Context("when the requester is not authorized for the new name", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedForPipelineReturns(false)
+					})
+
+					It("returns 403 Forbidden", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not rename the pipeline", func() {
+						Expect(dbPipeline.RenameCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when an error occurs on update", func() {
 					BeforeEach(func() {
 						fakeTeam.PipelineReturns(dbPipeline, true, nil)
@@ -1861,6 +2195,24 @@ var _ = Describe("Pipelines API", func() {
 					fakeTeam.PipelineReturns(dbPipeline, true, nil)
 				})
 
+				Context("when the cluster is in maintenance mode", func() {
+					BeforeEach(func() {
+						dbMaintenance.ModeReturns(atc.Maintenance{Enabled: true, Reason: "upgrading"}, nil)
+					})
+
+					It("returns 503 with the reason", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(ContainSubstring("upgrading"))
+					})
+
+					It("does not create a build", func() {
+						Expect(dbPipeline.CreateStartedBuildCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when creating a started build fails", func() {
 					BeforeEach(func() {
 						dbPipeline.CreateStartedBuildReturns(nil, errors.New("oh no!"))
@@ -35,6 +35,7 @@ var _ = Describe("Archive Handler", func() {
 			new(dbfakes.FakePipelineFactory),
 			"",
 			true, /* enableArchivePipeline */
+			new(dbfakes.FakeMaintenance),
 		)
 		dbPipeline = new(dbfakes.FakePipeline)
 		handler = server.ArchivePipeline(dbPipeline)
@@ -80,6 +81,7 @@ var _ = Describe("Archive Handler", func() {
 				new(dbfakes.FakePipelineFactory),
 				"",
 				false, /* enableArchivePipeline */
+				new(dbfakes.FakeMaintenance),
 			)
 			handler = server.ArchivePipeline(dbPipeline)
 		})
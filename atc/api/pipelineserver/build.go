@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/maintenanceserver"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -13,6 +14,10 @@ import (
 func (s *Server) CreateBuild(pipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("create-build")
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if maintenanceserver.RejectNewBuilds(logger, s.maintenance, w) {
+			return
+		}
+
 		var plan atc.Plan
 		err := json.NewDecoder(r.Body).Decode(&plan)
 		if err != nil {
@@ -0,0 +1,89 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/buildserver"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/vito/go-sse/sse"
+)
+
+func (s *Server) PipelineEvents(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("pipeline-events", lager.Data{"pipeline": pipeline.Name()})
+	return NewEventHandler(logger, pipeline.Events)
+}
+
+// NewEventHandler streams pipeline events as server-sent events, resuming
+// after the event given by the Last-Event-ID header if present, and
+// otherwise starting from the next event to happen.
+func NewEventHandler(logger lager.Logger, subscribe func(from int) (db.PipelineEventSource, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from := db.LatestPipelineEvent
+		if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+			id, err := strconv.Atoi(lastEventID)
+			if err != nil || id < 0 {
+				logger.Info("failed-to-parse-last-event-id", lager.Data{"last-event-id": lastEventID})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			from = id
+		}
+
+		events, err := subscribe(from)
+		if err != nil {
+			logger.Error("failed-to-get-pipeline-events", err, lager.Data{"from": from})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+		w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+		w.Header().Add("X-Accel-Buffering", "no")
+		w.Header().Add(buildserver.ProtocolVersionHeader, buildserver.CurrentProtocolVersion)
+		w.WriteHeader(http.StatusOK)
+
+		flusher := w.(http.Flusher)
+		flusher.Flush()
+
+		// closing the source is what unblocks Next once the client goes away
+		go func() {
+			<-r.Context().Done()
+			db.Close(events)
+		}()
+
+		defer db.Close(events)
+
+		for {
+			ev, err := events.Next()
+			if err != nil {
+				if err != db.ErrPipelineEventStreamClosed {
+					logger.Error("failed-to-get-next-pipeline-event", err)
+				}
+
+				return
+			}
+
+			payload, err := json.Marshal(ev)
+			if err != nil {
+				logger.Error("failed-to-marshal-pipeline-event", err)
+				return
+			}
+
+			err = sse.Event{
+				ID:   strconv.Itoa(ev.ID),
+				Name: "event",
+				Data: payload,
+			}.Write(w)
+			if err != nil {
+				logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+				return
+			}
+
+			flusher.Flush()
+		}
+	})
+}
@@ -30,6 +30,9 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 
 	if acc.IsAuthorized(requestTeamName) {
 		pipelines, err = team.Pipelines()
+	} else if isPipelineTeam(acc, requestTeamName) {
+		pipelines, err = team.Pipelines()
+		pipelines = visiblePipelines(acc, pipelines)
 	} else {
 		pipelines, err = team.PublicPipelines()
 	}
@@ -21,7 +21,9 @@ func (s *Server) ListAllPipelines(w http.ResponseWriter, r *http.Request) {
 	if acc.IsAdmin() {
 		pipelines, err = s.pipelineFactory.AllPipelines()
 	} else {
-		pipelines, err = s.pipelineFactory.VisiblePipelines(acc.TeamNames())
+		teamNames := append(acc.TeamNames(), acc.PipelineTeamNames()...)
+		pipelines, err = s.pipelineFactory.VisiblePipelines(teamNames)
+		pipelines = visiblePipelines(acc, pipelines)
 	}
 
 	if err != nil {
@@ -37,3 +39,31 @@ func (s *Server) ListAllPipelines(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// visiblePipelines filters out the private pipelines of the teams on which
+// the user is only granted some of the pipelines.
+func visiblePipelines(acc accessor.Access, pipelines []db.Pipeline) []db.Pipeline {
+	pipelineTeams := map[string]bool{}
+	for _, teamName := range acc.PipelineTeamNames() {
+		pipelineTeams[teamName] = true
+	}
+
+	visible := []db.Pipeline{}
+	for _, pipeline := range pipelines {
+		if !pipelineTeams[pipeline.TeamName()] ||
+			pipeline.Public() ||
+			acc.IsAuthorizedForPipeline(pipeline.TeamName(), pipeline.Name()) {
+			visible = append(visible, pipeline)
+		}
+	}
+	return visible
+}
+
+func isPipelineTeam(acc accessor.Access, teamName string) bool {
+	for _, pipelineTeam := range acc.PipelineTeamNames() {
+		if pipelineTeam == teamName {
+			return true
+		}
+	}
+	return false
+}
@@ -5,7 +5,9 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -28,6 +30,15 @@ func (s *Server) RenamePipeline(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		// a user granted a role on only some of the team's pipelines must not
+		// be able to move a pipeline out of them
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAuthorizedForPipeline(pipeline.TeamName(), rename.NewName) {
+			logger.Info("not-authorized-for-new-name", lager.Data{"name": rename.NewName})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		err = pipeline.Rename(rename.NewName)
 		if err != nil {
 			logger.Error("failed-to-update-name", err)
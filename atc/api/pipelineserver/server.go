@@ -13,6 +13,7 @@ type Server struct {
 	pipelineFactory       db.PipelineFactory
 	externalURL           string
 	enableArchivePipeline bool
+	maintenance           db.Maintenance
 }
 
 func NewServer(
@@ -21,6 +22,7 @@ func NewServer(
 	pipelineFactory db.PipelineFactory,
 	externalURL string,
 	enableArchivePipeline bool,
+	maintenance db.Maintenance,
 ) *Server {
 	return &Server{
 		logger:                logger,
@@ -29,5 +31,6 @@ func NewServer(
 		pipelineFactory:       pipelineFactory,
 		externalURL:           externalURL,
 		enableArchivePipeline: enableArchivePipeline,
+		maintenance:           maintenance,
 	}
 }
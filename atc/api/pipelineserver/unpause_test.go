@@ -31,6 +31,7 @@ var _ = Describe("Unpause Handler", func() {
 			new(dbfakes.FakePipelineFactory),
 			"",
 			true, /* enableArchivePipeline */
+			new(dbfakes.FakeMaintenance),
 		)
 		dbPipeline = new(dbfakes.FakePipeline)
 		handler = server.UnpausePipeline(dbPipeline)
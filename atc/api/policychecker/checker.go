@@ -14,7 +14,11 @@ import (
 //go:generate counterfeiter . PolicyChecker
 
 type PolicyChecker interface {
-	Check(string, accessor.Access, *http.Request) (bool, error)
+	Check(string, accessor.Access, *http.Request) (policy.PolicyCheckOutput, error)
+
+	// CheckInput checks an action which is not made through its own endpoint,
+	// such as the pipelines saved by a team import.
+	CheckInput(accessor.Access, policy.PolicyCheckInput) (policy.PolicyCheckOutput, error)
 }
 
 type checker struct {
@@ -28,22 +32,9 @@ func NewApiPolicyChecker(policyChecker *policy.Checker) PolicyChecker {
 	return &checker{policyChecker: policyChecker}
 }
 
-func (c *checker) Check(action string, acc accessor.Access, req *http.Request) (bool, error) {
-	// Ignore self invoked API calls.
-	if acc.IsSystem() {
-		return true, nil
-	}
-
-	// Actions in black will not go through policy check.
-	if c.policyChecker.ShouldSkipAction(action) {
-		return true, nil
-	}
-
-	// Only actions with specified http method will go through policy check.
-	// But actions in white list will always go through policy check.
-	if !c.policyChecker.ShouldCheckHttpMethod(req.Method) &&
-		!c.policyChecker.ShouldCheckAction(action) {
-		return true, nil
+func (c *checker) Check(action string, acc accessor.Access, req *http.Request) (policy.PolicyCheckOutput, error) {
+	if c.ignored(action, acc) || !c.checksMethod(action, req.Method) {
+		return policy.PassedPolicyCheck(), nil
 	}
 
 	input := policy.PolicyCheckInput{
@@ -58,7 +49,7 @@ func (c *checker) Check(action string, acc accessor.Access, req *http.Request) (
 	case "application/json", "text/vnd.yaml", "text/yaml", "text/x-yaml", "application/x-yaml":
 		body, err := ioutil.ReadAll(req.Body)
 		if err != nil {
-			return false, err
+			return policy.PolicyCheckOutput{}, err
 		} else if body != nil && len(body) > 0 {
 			if ct == "application/json" {
 				err = json.Unmarshal(body, &input.Data)
@@ -66,7 +57,7 @@ func (c *checker) Check(action string, acc accessor.Access, req *http.Request) (
 				err = yaml.Unmarshal(body, &input.Data)
 			}
 			if err != nil {
-				return false, err
+				return policy.PolicyCheckOutput{}, err
 			}
 
 			req.Body = ioutil.NopCloser(bytes.NewBuffer(body))
@@ -75,3 +66,30 @@ func (c *checker) Check(action string, acc accessor.Access, req *http.Request) (
 
 	return c.policyChecker.Check(input)
 }
+
+func (c *checker) CheckInput(acc accessor.Access, input policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+	if c.ignored(input.Action, acc) || !c.checksMethod(input.Action, input.HttpMethod) {
+		return policy.PassedPolicyCheck(), nil
+	}
+
+	input.User = acc.Claims().UserName
+
+	return c.policyChecker.Check(input)
+}
+
+func (c *checker) ignored(action string, acc accessor.Access) bool {
+	// Ignore self invoked API calls.
+	if acc.IsSystem() {
+		return true
+	}
+
+	// Actions in black will not go through policy check.
+	return c.policyChecker.ShouldSkipAction(action)
+}
+
+func (c *checker) checksMethod(action string, method string) bool {
+	// Only actions with specified http method will go through policy check.
+	// But actions in white list will always go through policy check.
+	return c.policyChecker.ShouldCheckHttpMethod(method) ||
+		c.policyChecker.ShouldCheckAction(action)
+}
@@ -22,7 +22,7 @@ var _ = Describe("PolicyChecker", func() {
 		policyFilter policy.Filter
 		fakeAccess   *accessorfakes.FakeAccess
 		fakeRequest  *http.Request
-		pass         bool
+		output       policy.PolicyCheckOutput
 		checkErr     error
 	)
 
@@ -39,10 +39,10 @@ var _ = Describe("PolicyChecker", func() {
 	})
 
 	JustBeforeEach(func() {
-		policyCheck, err := policy.Initialize(testLogger, "some-cluster", "some-version", policyFilter)
+		policyCheck, err := policy.Initialize(testLogger, "some-cluster", "some-version", policyFilter, policy.CombineAll)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(policyCheck).ToNot(BeNil())
-		pass, checkErr = policychecker.NewApiPolicyChecker(policyCheck).Check("some-action", fakeAccess, fakeRequest)
+		output, checkErr = policychecker.NewApiPolicyChecker(policyCheck).Check("some-action", fakeAccess, fakeRequest)
 	})
 
 	Context("when system action", func() {
@@ -51,7 +51,7 @@ var _ = Describe("PolicyChecker", func() {
 		})
 		It("should pass", func() {
 			Expect(checkErr).ToNot(HaveOccurred())
-			Expect(pass).To(BeTrue())
+			Expect(output.Allowed).To(BeTrue())
 		})
 		It("Agent should not be called", func() {
 			Expect(fakePolicyAgent.CheckCallCount()).To(Equal(0))
@@ -69,7 +69,7 @@ var _ = Describe("PolicyChecker", func() {
 			})
 			It("should pass", func() {
 				Expect(checkErr).ToNot(HaveOccurred())
-				Expect(pass).To(BeTrue())
+				Expect(output.Allowed).To(BeTrue())
 			})
 			It("Agent should not be called", func() {
 				Expect(fakePolicyAgent.CheckCallCount()).To(Equal(0))
@@ -83,7 +83,7 @@ var _ = Describe("PolicyChecker", func() {
 			})
 			It("should pass", func() {
 				Expect(checkErr).ToNot(HaveOccurred())
-				Expect(pass).To(BeTrue())
+				Expect(output.Allowed).To(BeTrue())
 			})
 			It("Agent should not be called", func() {
 				Expect(fakePolicyAgent.CheckCallCount()).To(Equal(0))
@@ -98,7 +98,7 @@ var _ = Describe("PolicyChecker", func() {
 			})
 			It("should pass", func() {
 				Expect(checkErr).ToNot(HaveOccurred())
-				Expect(pass).To(BeTrue())
+				Expect(output.Allowed).To(BeTrue())
 			})
 			It("Agent should not be called", func() {
 				Expect(fakePolicyAgent.CheckCallCount()).To(Equal(0))
@@ -121,7 +121,7 @@ var _ = Describe("PolicyChecker", func() {
 				It("should error", func() {
 					Expect(checkErr).To(HaveOccurred())
 					Expect(checkErr.Error()).To(Equal(`invalid character 'h' looking for beginning of value`))
-					Expect(pass).To(BeFalse())
+					Expect(output.Allowed).To(BeFalse())
 				})
 				It("Agent should not be called", func() {
 					Expect(fakePolicyAgent.CheckCallCount()).To(Equal(0))
@@ -138,7 +138,7 @@ var _ = Describe("PolicyChecker", func() {
 				It("should error", func() {
 					Expect(checkErr).To(HaveOccurred())
 					Expect(checkErr.Error()).To(Equal(`error converting YAML to JSON: yaml: line 3: could not find expected ':'`))
-					Expect(pass).To(BeFalse())
+					Expect(output.Allowed).To(BeFalse())
 				})
 				It("Agent should not be called", func() {
 					Expect(fakePolicyAgent.CheckCallCount()).To(Equal(0))
@@ -182,35 +182,36 @@ var _ = Describe("PolicyChecker", func() {
 
 				Context("when Agent says pass", func() {
 					BeforeEach(func() {
-						fakePolicyAgent.CheckReturns(true, nil)
+						fakePolicyAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
 					})
 
 					It("it should pass", func() {
 						Expect(checkErr).ToNot(HaveOccurred())
-						Expect(pass).To(BeTrue())
+						Expect(output.Allowed).To(BeTrue())
 					})
 				})
 
 				Context("when Agent says not-pass", func() {
 					BeforeEach(func() {
-						fakePolicyAgent.CheckReturns(false, nil)
+						fakePolicyAgent.CheckReturns(policy.PolicyCheckOutput{Reasons: []string{"some-reason"}}, nil)
 					})
 
 					It("should not pass", func() {
 						Expect(checkErr).ToNot(HaveOccurred())
-						Expect(pass).To(BeFalse())
+						Expect(output.Allowed).To(BeFalse())
+						Expect(output.Reasons).To(Equal([]string{"some-reason"}))
 					})
 				})
 
 				Context("when Agent says error", func() {
 					BeforeEach(func() {
-						fakePolicyAgent.CheckReturns(false, errors.New("some-error"))
+						fakePolicyAgent.CheckReturns(policy.PolicyCheckOutput{}, errors.New("some-error"))
 					})
 
 					It("should not pass", func() {
 						Expect(checkErr).To(HaveOccurred())
 						Expect(checkErr.Error()).To(Equal("some-error"))
-						Expect(pass).To(BeFalse())
+						Expect(output.Allowed).To(BeFalse())
 					})
 				})
 			})
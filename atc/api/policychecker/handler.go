@@ -1,11 +1,14 @@
 package policychecker
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 )
 
@@ -34,15 +37,35 @@ func (h policyCheckingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	acc := accessor.GetAccessor(r)
 
 	if h.policyChecker != nil {
-		pass, err := h.policyChecker.Check(h.action, acc, r)
+		output, err := h.policyChecker.Check(h.action, acc, r)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			fmt.Fprintf(w, fmt.Sprintf("policy check error: %s", err.Error()))
 			return
 		}
-		if !pass {
+
+		if output.ShouldBlock() || output.ShouldWarn() {
+			result, err := json.Marshal(atc.PolicyCheckResult{
+				Allowed:  output.Allowed,
+				Reasons:  output.Reasons,
+				Severity: string(output.Severity),
+			})
+			if err != nil {
+				h.logger.Error("failed-to-encode-policy-check-result", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Set(atc.PolicyCheckHeader, string(result))
+		}
+
+		if output.ShouldBlock() {
 			w.WriteHeader(http.StatusForbidden)
-			fmt.Fprintf(w, "policy check not pass")
+			if len(output.Reasons) == 0 {
+				fmt.Fprintf(w, "policy check not pass")
+			} else {
+				fmt.Fprintf(w, "policy check not pass: %s", strings.Join(output.Reasons, "; "))
+			}
 			return
 		}
 	}
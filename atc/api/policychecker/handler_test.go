@@ -8,8 +8,10 @@ import (
 
 	"code.cloudfoundry.org/lager/lagertest"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
+	"github.com/concourse/concourse/atc/policy"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -63,7 +65,7 @@ var _ = Describe("Handler", func() {
 
 		Context("policy check passes", func() {
 			BeforeEach(func() {
-				fakePolicyChecker.CheckReturns(true, nil)
+				fakePolicyChecker.CheckReturns(policy.PassedPolicyCheck(), nil)
 			})
 
 			It("calls the inner handler", func() {
@@ -73,7 +75,7 @@ var _ = Describe("Handler", func() {
 
 		Context("policy check doesn't pass", func() {
 			BeforeEach(func() {
-				fakePolicyChecker.CheckReturns(false, nil)
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{}, nil)
 			})
 
 			It("return http forbidden", func() {
@@ -87,11 +89,74 @@ var _ = Describe("Handler", func() {
 			It("not call the inner handler", func() {
 				Expect(innerHandlerCalled).To(BeFalse())
 			})
+
+			Context("with reasons", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+						Reasons:  []string{"some-reason", "another-reason"},
+						Severity: policy.SeverityBlock,
+					}, nil)
+				})
+
+				It("returns the reasons", func() {
+					Expect(responseWriter.Code).To(Equal(http.StatusForbidden))
+
+					msg, err := ioutil.ReadAll(responseWriter.Body)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(msg)).To(Equal("policy check not pass: some-reason; another-reason"))
+				})
+
+				It("sets the policy check header", func() {
+					Expect(responseWriter.Header().Get(atc.PolicyCheckHeader)).To(MatchJSON(`{
+						"allowed": false,
+						"reasons": ["some-reason", "another-reason"],
+						"severity": "block"
+					}`))
+				})
+			})
+		})
+
+		Context("policy check doesn't pass in warn mode", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+					Reasons:  []string{"some-reason"},
+					Severity: policy.SeverityWarn,
+				}, nil)
+			})
+
+			It("calls the inner handler", func() {
+				Expect(innerHandlerCalled).To(BeTrue())
+			})
+
+			It("sets the policy check header", func() {
+				Expect(responseWriter.Header().Get(atc.PolicyCheckHeader)).To(MatchJSON(`{
+					"allowed": false,
+					"reasons": ["some-reason"],
+					"severity": "warn"
+				}`))
+			})
+		})
+
+		Context("policy check doesn't pass in audit mode", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+					Reasons:  []string{"some-reason"},
+					Severity: policy.SeverityAudit,
+				}, nil)
+			})
+
+			It("calls the inner handler", func() {
+				Expect(innerHandlerCalled).To(BeTrue())
+			})
+
+			It("does not set the policy check header", func() {
+				Expect(responseWriter.Header().Get(atc.PolicyCheckHeader)).To(BeEmpty())
+			})
 		})
 
 		Context("policy check errors", func() {
 			BeforeEach(func() {
-				fakePolicyChecker.CheckReturns(false, errors.New("some-error"))
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{}, errors.New("some-error"))
 			})
 
 			It("return http bad request", func() {
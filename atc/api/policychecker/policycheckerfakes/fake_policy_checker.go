@@ -7,10 +7,11 @@ import (
 
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/policychecker"
+	"github.com/concourse/concourse/atc/policy"
 )
 
 type FakePolicyChecker struct {
-	CheckStub        func(string, accessor.Access, *http.Request) (bool, error)
+	CheckStub        func(string, accessor.Access, *http.Request) (policy.PolicyCheckOutput, error)
 	checkMutex       sync.RWMutex
 	checkArgsForCall []struct {
 		arg1 string
@@ -18,18 +19,32 @@ type FakePolicyChecker struct {
 		arg3 *http.Request
 	}
 	checkReturns struct {
-		result1 bool
+		result1 policy.PolicyCheckOutput
 		result2 error
 	}
 	checkReturnsOnCall map[int]struct {
-		result1 bool
+		result1 policy.PolicyCheckOutput
+		result2 error
+	}
+	CheckInputStub        func(accessor.Access, policy.PolicyCheckInput) (policy.PolicyCheckOutput, error)
+	checkInputMutex       sync.RWMutex
+	checkInputArgsForCall []struct {
+		arg1 accessor.Access
+		arg2 policy.PolicyCheckInput
+	}
+	checkInputReturns struct {
+		result1 policy.PolicyCheckOutput
+		result2 error
+	}
+	checkInputReturnsOnCall map[int]struct {
+		result1 policy.PolicyCheckOutput
 		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakePolicyChecker) Check(arg1 string, arg2 accessor.Access, arg3 *http.Request) (bool, error) {
+func (fake *FakePolicyChecker) Check(arg1 string, arg2 accessor.Access, arg3 *http.Request) (policy.PolicyCheckOutput, error) {
 	fake.checkMutex.Lock()
 	ret, specificReturn := fake.checkReturnsOnCall[len(fake.checkArgsForCall)]
 	fake.checkArgsForCall = append(fake.checkArgsForCall, struct {
@@ -55,7 +70,7 @@ func (fake *FakePolicyChecker) CheckCallCount() int {
 	return len(fake.checkArgsForCall)
 }
 
-func (fake *FakePolicyChecker) CheckCalls(stub func(string, accessor.Access, *http.Request) (bool, error)) {
+func (fake *FakePolicyChecker) CheckCalls(stub func(string, accessor.Access, *http.Request) (policy.PolicyCheckOutput, error)) {
 	fake.checkMutex.Lock()
 	defer fake.checkMutex.Unlock()
 	fake.CheckStub = stub
@@ -68,28 +83,92 @@ func (fake *FakePolicyChecker) CheckArgsForCall(i int) (string, accessor.Access,
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePolicyChecker) CheckReturns(result1 bool, result2 error) {
+func (fake *FakePolicyChecker) CheckReturns(result1 policy.PolicyCheckOutput, result2 error) {
 	fake.checkMutex.Lock()
 	defer fake.checkMutex.Unlock()
 	fake.CheckStub = nil
 	fake.checkReturns = struct {
-		result1 bool
+		result1 policy.PolicyCheckOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePolicyChecker) CheckReturnsOnCall(i int, result1 bool, result2 error) {
+func (fake *FakePolicyChecker) CheckReturnsOnCall(i int, result1 policy.PolicyCheckOutput, result2 error) {
 	fake.checkMutex.Lock()
 	defer fake.checkMutex.Unlock()
 	fake.CheckStub = nil
 	if fake.checkReturnsOnCall == nil {
 		fake.checkReturnsOnCall = make(map[int]struct {
-			result1 bool
+			result1 policy.PolicyCheckOutput
 			result2 error
 		})
 	}
 	fake.checkReturnsOnCall[i] = struct {
-		result1 bool
+		result1 policy.PolicyCheckOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePolicyChecker) CheckInput(arg1 accessor.Access, arg2 policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+	fake.checkInputMutex.Lock()
+	ret, specificReturn := fake.checkInputReturnsOnCall[len(fake.checkInputArgsForCall)]
+	fake.checkInputArgsForCall = append(fake.checkInputArgsForCall, struct {
+		arg1 accessor.Access
+		arg2 policy.PolicyCheckInput
+	}{arg1, arg2})
+	fake.recordInvocation("CheckInput", []interface{}{arg1, arg2})
+	fake.checkInputMutex.Unlock()
+	if fake.CheckInputStub != nil {
+		return fake.CheckInputStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkInputReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePolicyChecker) CheckInputCallCount() int {
+	fake.checkInputMutex.RLock()
+	defer fake.checkInputMutex.RUnlock()
+	return len(fake.checkInputArgsForCall)
+}
+
+func (fake *FakePolicyChecker) CheckInputCalls(stub func(accessor.Access, policy.PolicyCheckInput) (policy.PolicyCheckOutput, error)) {
+	fake.checkInputMutex.Lock()
+	defer fake.checkInputMutex.Unlock()
+	fake.CheckInputStub = stub
+}
+
+func (fake *FakePolicyChecker) CheckInputArgsForCall(i int) (accessor.Access, policy.PolicyCheckInput) {
+	fake.checkInputMutex.RLock()
+	defer fake.checkInputMutex.RUnlock()
+	argsForCall := fake.checkInputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePolicyChecker) CheckInputReturns(result1 policy.PolicyCheckOutput, result2 error) {
+	fake.checkInputMutex.Lock()
+	defer fake.checkInputMutex.Unlock()
+	fake.CheckInputStub = nil
+	fake.checkInputReturns = struct {
+		result1 policy.PolicyCheckOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePolicyChecker) CheckInputReturnsOnCall(i int, result1 policy.PolicyCheckOutput, result2 error) {
+	fake.checkInputMutex.Lock()
+	defer fake.checkInputMutex.Unlock()
+	fake.CheckInputStub = nil
+	if fake.checkInputReturnsOnCall == nil {
+		fake.checkInputReturnsOnCall = make(map[int]struct {
+			result1 policy.PolicyCheckOutput
+			result2 error
+		})
+	}
+	fake.checkInputReturnsOnCall[i] = struct {
+		result1 policy.PolicyCheckOutput
 		result2 error
 	}{result1, result2}
 }
@@ -99,6 +178,8 @@ func (fake *FakePolicyChecker) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.checkMutex.RLock()
 	defer fake.checkMutex.RUnlock()
+	fake.checkInputMutex.RLock()
+	defer fake.checkInputMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,25 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func APIToken(token db.APIToken) atc.APIToken {
+	presented := atc.APIToken{
+		ID:             token.ID(),
+		Name:           token.Name(),
+		TeamName:       token.TeamName(),
+		Role:           token.Role(),
+		ServiceAccount: token.ServiceAccount(),
+		CreatedBy:      token.CreatedBy(),
+		CreatedAt:      token.CreatedAt().Unix(),
+		ExpiresAt:      token.ExpiresAt().Unix(),
+	}
+
+	if !token.LastUsedAt().IsZero() {
+		presented.LastUsedAt = token.LastUsedAt().Unix()
+	}
+
+	return presented
+}
@@ -0,0 +1,21 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func NotificationDelivery(delivery db.NotificationDelivery) atc.NotificationDelivery {
+	return atc.NotificationDelivery{
+		ID:               delivery.ID(),
+		NotificationName: delivery.NotificationName(),
+		EventID:          delivery.Event().ID,
+		EventType:        delivery.Event().Type,
+		Status:           delivery.Status(),
+		Attempts:         delivery.Attempts(),
+		ResponseCode:     delivery.ResponseCode(),
+		Error:            delivery.Error(),
+		CreatedAt:        delivery.CreatedAt().Unix(),
+		UpdatedAt:        delivery.UpdatedAt().Unix(),
+	}
+}
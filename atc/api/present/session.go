@@ -0,0 +1,18 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func Session(session db.Session) atc.Session {
+	return atc.Session{
+		ID:         session.ID(),
+		Sub:        session.Sub(),
+		UserName:   session.UserName(),
+		Connector:  session.Connector(),
+		CreatedAt:  session.CreatedAt().Unix(),
+		LastUsedAt: session.LastUsedAt().Unix(),
+		ExpiresAt:  session.ExpiresAt().Unix(),
+	}
+}
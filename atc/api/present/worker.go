@@ -46,5 +46,9 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		atcWorker.StartTime = workerInfo.StartTime().Unix()
 	}
 
+	if !workerInfo.LandDeadline().IsZero() {
+		atcWorker.LandDeadline = workerInfo.LandDeadline().Unix()
+	}
+
 	return atcWorker
 }
@@ -2,6 +2,9 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -1382,12 +1385,16 @@ var _ = Describe("Resources API", func() {
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", func() {
 		var (
 			checkRequestBody atc.CheckRequestBody
+			webhookPayload   []byte
+			webhookSignature string
 			response         *http.Response
 			fakeResource     *dbfakes.FakeResource
 		)
 
 		BeforeEach(func() {
 			checkRequestBody = atc.CheckRequestBody{}
+			webhookPayload = nil
+			webhookSignature = ""
 
 			fakeResource = new(dbfakes.FakeResource)
 			fakeResource.NameReturns("resource-name")
@@ -1398,10 +1405,18 @@ var _ = Describe("Resources API", func() {
 			reqPayload, err := json.Marshal(checkRequestBody)
 			Expect(err).NotTo(HaveOccurred())
 
+			if webhookPayload != nil {
+				reqPayload = webhookPayload
+			}
+
 			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook?webhook_token=fake-token", bytes.NewBuffer(reqPayload))
 			Expect(err).NotTo(HaveOccurred())
 			request.Header.Set("Content-Type", "application/json")
 
+			if webhookSignature != "" {
+				request.Header.Set("X-Hub-Signature-256", webhookSignature)
+			}
+
 			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -1514,6 +1529,138 @@ var _ = Describe("Resources API", func() {
 				})
 			})
 
+			Context("when the resource has a webhook_version", func() {
+				var fakeScope *dbfakes.FakeResourceConfigScope
+
+				BeforeEach(func() {
+					fakePipeline.VariablesReturns(vars.StaticVariables{
+						"webhook-secret": "some-secret",
+						"uri":            "https://example.com/repo.git",
+					}, nil)
+
+					fakeResource.SourceReturns(atc.Source{"uri": "((uri))"})
+					fakeResource.WebhookVersionReturns(&atc.WebhookVersionConfig{
+						Version: map[string]string{"ref": "{{.after}}"},
+						Secret:  "((webhook-secret))",
+					})
+
+					fakePipeline.ResourceTypesReturns(db.ResourceTypes{}, nil)
+
+					fakeScope = new(dbfakes.FakeResourceConfigScope)
+					fakeScope.ResourceReturns(fakeResource)
+					fakeResource.SetResourceConfigReturns(fakeScope, nil)
+
+					webhookPayload = []byte(`{"after": "abc123"}`)
+					webhookSignature = signWebhookPayload("some-secret", webhookPayload)
+				})
+
+				Context("when the payload is signed with the secret", func() {
+					It("saves the version without checking", func() {
+						Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+
+						Expect(fakeResource.SetResourceConfigCallCount()).To(Equal(1))
+						source, _ := fakeResource.SetResourceConfigArgsForCall(0)
+						Expect(source).To(Equal(atc.Source{"uri": "https://example.com/repo.git"}))
+
+						Expect(fakeScope.SaveVersionsCallCount()).To(Equal(1))
+						_, versions := fakeScope.SaveVersionsArgsForCall(0)
+						Expect(versions).To(Equal([]atc.Version{{"ref": "abc123"}}))
+					})
+
+					It("returns 201 with the version", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{"ref": "abc123"}`))
+					})
+
+					Context("when saving the version fails", func() {
+						BeforeEach(func() {
+							fakeScope.SaveVersionsReturns(errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
+				Context("when the payload is signed with another secret", func() {
+					BeforeEach(func() {
+						webhookSignature = signWebhookPayload("other-secret", webhookPayload)
+					})
+
+					It("returns 401 without saving", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+						Expect(fakeResource.SetResourceConfigCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the payload is not signed", func() {
+					BeforeEach(func() {
+						webhookSignature = ""
+					})
+
+					It("returns 401", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+
+				Context("when the secret resolves to an empty string", func() {
+					BeforeEach(func() {
+						fakePipeline.VariablesReturns(vars.StaticVariables{
+							"webhook-secret": "",
+							"uri":            "https://example.com/repo.git",
+						}, nil)
+
+						webhookSignature = signWebhookPayload("", webhookPayload)
+					})
+
+					It("returns 401 without saving", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+						Expect(fakeResource.SetResourceConfigCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the resource's config scope is shared with other resources", func() {
+					BeforeEach(func() {
+						fakeScope.ResourceReturns(nil)
+					})
+
+					It("returns 409 without saving", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						Expect(fakeScope.SaveVersionsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the version cannot be extracted from the payload", func() {
+					BeforeEach(func() {
+						webhookPayload = []byte(`{"zen": "Keep it logically awesome."}`)
+						webhookSignature = signWebhookPayload("some-secret", webhookPayload)
+					})
+
+					It("returns 422 without saving", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+						Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("version field 'ref'"))
+						Expect(fakeResource.SetResourceConfigCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the resource's type has not been checked yet", func() {
+					BeforeEach(func() {
+						fakeResource.TypeReturns("custom-type")
+
+						fakeResourceType := new(dbfakes.FakeResourceType)
+						fakeResourceType.NameReturns("custom-type")
+						fakeResourceType.VersionReturns(nil)
+						fakePipeline.ResourceTypesReturns(db.ResourceTypes{fakeResourceType}, nil)
+					})
+
+					It("returns 409 without saving", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						Expect(fakeResource.SetResourceConfigCallCount()).To(BeZero())
+					})
+				})
+			})
+
 			Context("when finding the resource fails", func() {
 				BeforeEach(func() {
 					fakePipeline.ResourceReturns(nil, false, errors.New("oops"))
@@ -1546,3 +1693,9 @@ var _ = Describe("Resources API", func() {
 		})
 	})
 })
+
+func signWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -54,6 +54,11 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		if webhookVersion := dbResource.WebhookVersion(); webhookVersion != nil {
+			s.saveWebhookVersion(logger, w, r, dbPipeline, dbResource, variables, *webhookVersion)
+			return
+		}
+
 		dbResourceTypes, err := dbPipeline.ResourceTypes()
 		if err != nil {
 			logger.Error("failed-to-get-resource-types", err)
@@ -22,7 +22,9 @@ func (s *Server) ListAllResources(w http.ResponseWriter, r *http.Request) {
 	if acc.IsAdmin() {
 		dbResources, err = s.resourceFactory.AllResources()
 	} else {
-		dbResources, err = s.resourceFactory.VisibleResources(acc.TeamNames())
+		teamNames := append(acc.TeamNames(), acc.PipelineTeamNames()...)
+		dbResources, err = s.resourceFactory.VisibleResources(teamNames)
+		dbResources = visibleResources(acc, dbResources)
 	}
 	if err != nil {
 		logger.Error("failed-to-get-all-visible-resources", err)
@@ -50,3 +52,22 @@ func (s *Server) ListAllResources(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// visibleResources filters out the private resources of the teams on which
+// the user is only granted some of the pipelines.
+func visibleResources(acc accessor.Access, resources []db.Resource) []db.Resource {
+	pipelineTeams := map[string]bool{}
+	for _, teamName := range acc.PipelineTeamNames() {
+		pipelineTeams[teamName] = true
+	}
+
+	visible := []db.Resource{}
+	for _, resource := range resources {
+		if !pipelineTeams[resource.TeamName()] ||
+			resource.Public() ||
+			acc.IsAuthorizedForPipeline(resource.TeamName(), resource.PipelineName()) {
+			visible = append(visible, resource)
+		}
+	}
+	return visible
+}
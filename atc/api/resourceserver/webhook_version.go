@@ -0,0 +1,124 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/vars"
+)
+
+const maxWebhookPayloadSize = 10 * 1024 * 1024
+
+// saveWebhookVersion saves the version extracted from a webhook's payload,
+// skipping the check the webhook would otherwise trigger.
+func (s *Server) saveWebhookVersion(
+	logger lager.Logger,
+	w http.ResponseWriter,
+	r *http.Request,
+	dbPipeline db.Pipeline,
+	dbResource db.Resource,
+	variables vars.Variables,
+	config atc.WebhookVersionConfig,
+) {
+	payload, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		logger.Error("failed-to-read-payload", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	secret, err := creds.NewString(variables, config.Secret).Evaluate()
+	if err != nil {
+		logger.Error("failed-to-evaluate-secret", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// anyone could sign a payload with an empty secret
+	if secret == "" {
+		logger.Info("empty-secret")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !atc.VerifyWebhookSignature(secret, payload, r.Header.Get(config.Header())) {
+		logger.Info("invalid-signature")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	version, err := config.ExtractVersion(payload)
+	if err != nil {
+		logger.Info("failed-to-extract-version", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	dbResourceTypes, err := dbPipeline.ResourceTypes()
+	if err != nil {
+		logger.Error("failed-to-get-resource-types", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	parentType, found := dbResourceTypes.Parent(dbResource)
+	if found && parentType.Version() == nil {
+		logger.Info("resource-type-has-no-version", lager.Data{"resource-type": parentType.Name()})
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, "resource type '%s' has no version", parentType.Name())
+		return
+	}
+
+	source, err := creds.NewSource(variables, dbResource.Source()).Evaluate()
+	if err != nil {
+		logger.Error("failed-to-evaluate-source", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	versionedResourceTypes, err := creds.NewVersionedResourceTypes(variables, dbResourceTypes.Filter(dbResource).Deserialize()).Evaluate()
+	if err != nil {
+		logger.Error("failed-to-evaluate-resource-types", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	scope, err := dbResource.SetResourceConfig(source, versionedResourceTypes)
+	if err != nil {
+		logger.Error("failed-to-set-resource-config", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// the resource gets its own scope when it has webhook_version, but make
+	// sure a payload can never add versions to a scope shared with others
+	if scope.Resource() == nil {
+		logger.Info("resource-config-scope-is-shared")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintf(w, "resource '%s' shares its version history, so versions cannot be saved from webhooks", dbResource.Name())
+		return
+	}
+
+	err = scope.SaveVersions(db.NewSpanContext(r.Context()), []atc.Version{version})
+	if err != nil {
+		logger.Error("failed-to-save-version", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(version)
+	if err != nil {
+		logger.Error("failed-to-encode-version", err)
+	}
+}
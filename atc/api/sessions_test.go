@@ -0,0 +1,277 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sessions API", func() {
+	var (
+		response *http.Response
+
+		ownSession   *dbfakes.FakeSession
+		otherSession *dbfakes.FakeSession
+	)
+
+	fakeSession := func(id int, sub string, userName string) *dbfakes.FakeSession {
+		session := new(dbfakes.FakeSession)
+		session.IDReturns(id)
+		session.SubReturns(sub)
+		session.UserNameReturns(userName)
+		session.ConnectorReturns("github")
+		session.CreatedAtReturns(time.Unix(100, 0))
+		session.LastUsedAtReturns(time.Unix(150, 0))
+		session.ExpiresAtReturns(time.Unix(200, 0))
+		return session
+	}
+
+	BeforeEach(func() {
+		fakeAccess.IsAuthenticatedReturns(true)
+		fakeAccess.ClaimsReturns(accessor.Claims{
+			Sub:       "some-sub",
+			UserName:  "some-user",
+			Connector: "github",
+			SessionID: 1,
+		})
+
+		ownSession = fakeSession(1, "some-sub", "some-user")
+		otherSession = fakeSession(2, "other-sub", "other-user")
+	})
+
+	Describe("GET /api/v1/sessions", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+			dbSessionFactory.GetActiveSessionsForUserReturns([]db.Session{ownSession}, nil)
+			dbSessionFactory.GetActiveSessionsReturns([]db.Session{ownSession, otherSession}, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/sessions" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		It("returns the sessions of the user", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(dbSessionFactory.GetActiveSessionsForUserArgsForCall(0)).To(Equal("some-sub"))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(body).To(MatchJSON(`[
+				{
+					"id": 1,
+					"sub": "some-sub",
+					"user_name": "some-user",
+					"connector": "github",
+					"created_at": 100,
+					"last_used_at": 150,
+					"expires_at": 200,
+					"current": true
+				}
+			]`))
+		})
+
+		Context("when listing every session", func() {
+			BeforeEach(func() {
+				query = "?all=true"
+			})
+
+			Context("when the user is not an admin", func() {
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the user is an admin", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAdminReturns(true)
+				})
+
+				It("returns every active session", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(dbSessionFactory.GetActiveSessionsCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when fetching the sessions fails", func() {
+			BeforeEach(func() {
+				dbSessionFactory.GetActiveSessionsForUserReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/sessions/:session_id", func() {
+		var sessionID string
+
+		BeforeEach(func() {
+			sessionID = "1"
+			dbSessionFactory.FindSessionStub = func(id int) (db.Session, bool, error) {
+				for _, session := range []db.Session{ownSession, otherSession} {
+					if session.ID() == id {
+						return session, true, nil
+					}
+				}
+				return nil, false, nil
+			}
+			dbSessionFactory.RevokeSessionReturns(true, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/sessions/"+sessionID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("revokes the session", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			Expect(dbSessionFactory.RevokeSessionArgsForCall(0)).To(Equal(1))
+		})
+
+		Context("when the session id is invalid", func() {
+			BeforeEach(func() {
+				sessionID = "nope"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the session does not exist", func() {
+			BeforeEach(func() {
+				sessionID = "3"
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the session belongs to someone else", func() {
+			BeforeEach(func() {
+				sessionID = "2"
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbSessionFactory.RevokeSessionCallCount()).To(Equal(0))
+			})
+
+			Context("when the user is an admin", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAdminReturns(true)
+				})
+
+				It("revokes the session", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(dbSessionFactory.RevokeSessionArgsForCall(0)).To(Equal(2))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/session", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/session", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("revokes the session used to make the request", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			Expect(dbSessionFactory.RevokeSessionArgsForCall(0)).To(Equal(1))
+		})
+
+		Context("when the request was not made with a session token", func() {
+			BeforeEach(func() {
+				fakeAccess.ClaimsReturns(accessor.Claims{Connector: "api-token"})
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbSessionFactory.RevokeSessionCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/users/:connector/:user_sub/sessions", func() {
+		BeforeEach(func() {
+			dbSessionFactory.RevokeSessionsForUserReturns(3, nil)
+			dbAPITokenFactory.RevokeAPITokensForUserReturns(2, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/users/github/other-sub/sessions", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the user is not an admin", func() {
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbSessionFactory.RevokeSessionsForUserCallCount()).To(Equal(0))
+				Expect(dbAPITokenFactory.RevokeAPITokensForUserCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user is an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("revokes every session and personal api token of the user", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				connector, sub := dbSessionFactory.RevokeSessionsForUserArgsForCall(0)
+				Expect(connector).To(Equal("github"))
+				Expect(sub).To(Equal("other-sub"))
+				Expect(dbAPITokenFactory.RevokeAPITokensForUserArgsForCall(0)).To(Equal("other-sub"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"count":3,"api_tokens":2}`))
+			})
+
+			Context("when revoking the api tokens fails", func() {
+				BeforeEach(func() {
+					dbAPITokenFactory.RevokeAPITokensForUserReturns(0, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package sessionserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-sessions")
+
+	acc := accessor.GetAccessor(r)
+	claims := acc.Claims()
+
+	var (
+		sessions []db.Session
+		err      error
+	)
+
+	if r.FormValue("all") == "true" {
+		if !acc.IsAdmin() {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		sessions, err = s.sessionFactory.GetActiveSessions()
+	} else {
+		sessions, err = s.sessionFactory.GetActiveSessionsForUser(claims.Sub)
+	}
+	if err != nil {
+		logger.Error("failed-to-get-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := []atc.Session{}
+	for _, session := range sessions {
+		p := present.Session(session)
+		p.Current = session.ID() == claims.SessionID
+		presented = append(presented, p)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,102 @@
+package sessionserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+func (s *Server) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-session")
+
+	acc := accessor.GetAccessor(r)
+
+	sessionID, err := strconv.Atoi(r.FormValue(":session_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	session, found, err := s.sessionFactory.FindSession(sessionID)
+	if err != nil {
+		logger.Error("failed-to-find-session", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if !acc.IsAdmin() && session.Sub() != acc.Claims().Sub {
+		logger.Debug("not-allowed", lager.Data{"session": sessionID})
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	s.revoke(logger, w, sessionID)
+}
+
+func (s *Server) RevokeCurrentSession(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-current-session")
+
+	sessionID := accessor.GetAccessor(r).Claims().SessionID
+	if sessionID == 0 {
+		http.Error(w, "request was not made with a session token", http.StatusBadRequest)
+		return
+	}
+
+	s.revoke(logger, w, sessionID)
+}
+
+// RevokeUserSessions revokes every session of a user along with their
+// personal API tokens, whose role would otherwise outlive any change to the
+// user's grants.
+func (s *Server) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-user-sessions")
+
+	connector := r.FormValue(":connector")
+	sub := r.FormValue(":user_sub")
+
+	count, err := s.sessionFactory.RevokeSessionsForUser(connector, sub)
+	if err != nil {
+		logger.Error("failed-to-revoke-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	tokens, err := s.apiTokenFactory.RevokeAPITokensForUser(sub)
+	if err != nil {
+		logger.Error("failed-to-revoke-api-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("revoked-sessions", lager.Data{"connector": connector, "sub": sub, "count": count, "api-tokens": tokens})
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(atc.RevokedSessions{Count: count, APITokens: tokens})
+	if err != nil {
+		logger.Error("failed-to-encode-revoked-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) revoke(logger lager.Logger, w http.ResponseWriter, sessionID int) {
+	_, err := s.sessionFactory.RevokeSession(sessionID)
+	if err != nil {
+		logger.Error("failed-to-revoke-session", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("revoked-session", lager.Data{"session": sessionID})
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,24 @@
+package sessionserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger          lager.Logger
+	sessionFactory  db.SessionFactory
+	apiTokenFactory db.APITokenFactory
+}
+
+func NewServer(
+	logger lager.Logger,
+	sessionFactory db.SessionFactory,
+	apiTokenFactory db.APITokenFactory,
+) *Server {
+	return &Server{
+		logger:          logger,
+		sessionFactory:  sessionFactory,
+		apiTokenFactory: apiTokenFactory,
+	}
+}
@@ -0,0 +1,595 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/policy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Team Export API", func() {
+	var fakeTeam *dbfakes.FakeTeam
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/export", func() {
+		var (
+			response     *http.Response
+			fakePipeline *dbfakes.FakePipeline
+			fakeResource *dbfakes.FakeResource
+			config       atc.Config
+		)
+
+		BeforeEach(func() {
+			fakeTeam.IDReturns(5)
+			fakeTeam.NameReturns("some-team")
+			fakeTeam.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{"users": {"local:username"}},
+			})
+
+			config = atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "some-repo", Type: "git"},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						PlanSequence: []atc.Step{
+							{Config: &atc.GetStep{Name: "some-repo"}},
+						},
+					},
+				},
+			}
+
+			fakePipeline = new(dbfakes.FakePipeline)
+			fakePipeline.NameReturns("some-pipeline")
+			fakePipeline.PausedReturns(true)
+			fakePipeline.PublicReturns(true)
+			fakePipeline.ConfigReturns(config, nil)
+
+			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.NameReturns("some-repo")
+			fakeResource.APIPinnedVersionReturns(atc.Version{"ref": "abc"})
+			fakeResource.PinCommentReturns("hold")
+			fakeResource.DisabledVersionsReturns([]atc.Version{{"ref": "def"}}, nil)
+
+			unchangedResource := new(dbfakes.FakeResource)
+			unchangedResource.NameReturns("unchanged")
+
+			fakePipeline.ResourcesReturns(db.Resources{fakeResource, unchangedResource}, nil)
+			fakeTeam.PipelinesReturns([]db.Pipeline{fakePipeline}, nil)
+			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/export")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized on the team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns the team, its pipelines, and their resource state", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				var export atc.TeamExport
+				Expect(json.NewDecoder(response.Body).Decode(&export)).To(Succeed())
+
+				Expect(export).To(Equal(atc.TeamExport{
+					Version: atc.TeamExportVersion,
+					Team: atc.Team{
+						Name: "some-team",
+						Auth: atc.TeamAuth{
+							"owner": map[string][]string{"users": {"local:username"}},
+						},
+					},
+					Pipelines: []atc.PipelineExport{
+						{
+							Name:   "some-pipeline",
+							Paused: true,
+							Public: true,
+							Config: config,
+							Resources: []atc.ResourceExport{
+								{
+									Name:             "some-repo",
+									PinnedVersion:    atc.Version{"ref": "abc"},
+									PinComment:       "hold",
+									DisabledVersions: []atc.Version{{"ref": "def"}},
+								},
+							},
+						},
+					},
+				}))
+			})
+
+			Context("when the resource is pinned through its config", func() {
+				BeforeEach(func() {
+					fakeResource.ConfigPinnedVersionReturns(atc.Version{"ref": "abc"})
+				})
+
+				It("leaves the pin to the config", func() {
+					var export atc.TeamExport
+					Expect(json.NewDecoder(response.Body).Decode(&export)).To(Succeed())
+
+					Expect(export.Pipelines[0].Resources).To(Equal([]atc.ResourceExport{
+						{
+							Name:             "some-repo",
+							DisabledVersions: []atc.Version{{"ref": "def"}},
+						},
+					}))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when listing the pipelines fails", func() {
+				BeforeEach(func() {
+					fakeTeam.PipelinesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized on the team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/import", func() {
+		var (
+			response     *http.Response
+			export       atc.TeamExport
+			query        string
+			fakePipeline *dbfakes.FakePipeline
+			fakeResource *dbfakes.FakeResource
+		)
+
+		BeforeEach(func() {
+			query = ""
+
+			export = atc.TeamExport{
+				Version: atc.TeamExportVersion,
+				Team: atc.Team{
+					Name: "original-team",
+					Auth: atc.TeamAuth{
+						"owner": map[string][]string{"users": {"local:username"}},
+					},
+				},
+				Pipelines: []atc.PipelineExport{
+					{
+						Name:   "some-pipeline",
+						Public: true,
+						Config: atc.Config{
+							Resources: atc.ResourceConfigs{
+								{Name: "some-repo", Type: "git"},
+							},
+							Jobs: atc.JobConfigs{
+								{
+									Name: "some-job",
+									PlanSequence: []atc.Step{
+										{Config: &atc.GetStep{Name: "some-repo"}},
+									},
+								},
+							},
+						},
+						Resources: []atc.ResourceExport{
+							{
+								Name:             "some-repo",
+								PinnedVersion:    atc.Version{"ref": "abc"},
+								PinComment:       "hold",
+								DisabledVersions: []atc.Version{{"ref": "def"}},
+							},
+						},
+					},
+					{
+						Name:     "old-pipeline",
+						Archived: true,
+					},
+				},
+			}
+
+			fakeResource = new(dbfakes.FakeResource)
+
+			fakePipeline = new(dbfakes.FakePipeline)
+			fakePipeline.ResourceReturns(fakeResource, true, nil)
+
+			fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/import"+query, jsonEncode(export))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized on the team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team exists", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("updates the team's auth", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateProviderAuthArgsForCall(0)).To(Equal(export.Team.Auth))
+					Expect(dbTeamFactory.NotifyCacherCallCount()).To(Equal(1))
+				})
+
+				It("leaves the team's credential manager alone", func() {
+					Expect(fakeTeam.UpdateCredentialManagerCallCount()).To(BeZero())
+				})
+
+				It("reports what was applied", func() {
+					var importResponse atc.TeamImportResponse
+					Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+					Expect(importResponse.Team).To(Equal("some-team"))
+					Expect(importResponse.TeamCreated).To(BeFalse())
+					Expect(importResponse.Pipelines).To(Equal([]string{"some-pipeline", "old-pipeline"}))
+				})
+
+				It("checks the team and each unarchived pipeline against the policies for set-team and set-pipeline", func() {
+					Expect(fakePolicyChecker.CheckInputCallCount()).To(Equal(2))
+
+					_, input := fakePolicyChecker.CheckInputArgsForCall(0)
+					Expect(input.Action).To(Equal(atc.SetTeam))
+					Expect(input.HttpMethod).To(Equal(http.MethodPut))
+					Expect(input.Team).To(Equal("some-team"))
+
+					_, input = fakePolicyChecker.CheckInputArgsForCall(1)
+					Expect(input.Action).To(Equal(atc.SaveConfig))
+					Expect(input.HttpMethod).To(Equal(http.MethodPut))
+					Expect(input.Team).To(Equal("some-team"))
+					Expect(input.Pipeline).To(Equal("some-pipeline"))
+					Expect(input.Data).To(HaveKey("jobs"))
+				})
+
+				Context("when a pipeline is blocked by policy", func() {
+					BeforeEach(func() {
+						fakePolicyChecker.CheckInputStub = func(_ accessor.Access, input policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+							if input.Pipeline == "some-pipeline" {
+								return policy.PolicyCheckOutput{
+									Allowed:  false,
+									Reasons:  []string{"no git"},
+									Severity: policy.SeverityBlock,
+								}, nil
+							}
+
+							return policy.PassedPolicyCheck(), nil
+						}
+					})
+
+					It("returns 403 without changing anything", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(response.Header.Get(atc.PolicyCheckHeader)).To(ContainSubstring("pipeline some-pipeline: policy check not pass: no git"))
+
+						var importResponse atc.TeamImportResponse
+						Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+						Expect(importResponse.Errors).To(ConsistOf("pipeline some-pipeline: policy check not pass: no git"))
+
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+						Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when a pipeline only warns on policy", func() {
+					BeforeEach(func() {
+						fakePolicyChecker.CheckInputReturns(policy.PolicyCheckOutput{
+							Allowed:  false,
+							Reasons:  []string{"prefer https"},
+							Severity: policy.SeverityWarn,
+						}, nil)
+					})
+
+					It("imports and returns the warnings", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						var importResponse atc.TeamImportResponse
+						Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+						Expect(importResponse.Warnings).To(ContainElement("pipeline some-pipeline: policy check not pass: prefer https"))
+
+						Expect(fakeTeam.SavePipelineCallCount()).To(Equal(2))
+					})
+				})
+
+				Context("when checking policies fails", func() {
+					BeforeEach(func() {
+						fakePolicyChecker.CheckInputReturns(policy.PolicyCheckOutput{}, errors.New("agent down"))
+					})
+
+					It("returns 400 without changing anything", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+						Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the check_creds param is set", func() {
+					BeforeEach(func() {
+						query = "?" + atc.SaveConfigCheckCreds
+
+						export.Pipelines[0].Config.Resources[0].Source = atc.Source{"uri": "((repo-uri))"}
+					})
+
+					Context("when the credential exists in the credential manager", func() {
+						BeforeEach(func() {
+							fakeSecretManager.GetReturns("some-uri", nil, true, nil)
+						})
+
+						It("imports", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.SavePipelineCallCount()).To(Equal(2))
+						})
+					})
+
+					Context("when the credential is missing", func() {
+						BeforeEach(func() {
+							fakeSecretManager.GetReturns(nil, nil, false, nil)
+						})
+
+						It("returns 400 without changing anything", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+							var importResponse atc.TeamImportResponse
+							Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+							Expect(importResponse.Errors).To(ConsistOf(HavePrefix("pipeline some-pipeline: credential validation failed")))
+
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+							Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when the export has a credential manager", func() {
+					BeforeEach(func() {
+						creds.AllowTeamManagerTypes([]string{"dummy"})
+
+						export.Team.CredentialManager = &atc.TeamCredentialManager{
+							Type: "dummy",
+							Config: map[string]interface{}{
+								"vars": map[string]interface{}{"foo": "bar"},
+							},
+						}
+					})
+
+					AfterEach(func() {
+						creds.AllowTeamManagerTypes(nil)
+					})
+
+					It("updates the team's credential manager", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateCredentialManagerCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateCredentialManagerArgsForCall(0)).To(Equal(export.Team.CredentialManager))
+					})
+
+					Context("when it is invalid", func() {
+						BeforeEach(func() {
+							export.Team.CredentialManager.Type = "bogus"
+						})
+
+						It("returns 400 without changing anything", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+							var importResponse atc.TeamImportResponse
+							Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+							Expect(importResponse.Errors).To(ConsistOf(HavePrefix("invalid credential manager: ")))
+
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				It("saves each pipeline and restores its state", func() {
+					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(2))
+
+					name, config, from, paused := fakeTeam.SavePipelineArgsForCall(0)
+					Expect(name).To(Equal("some-pipeline"))
+					Expect(config).To(Equal(export.Pipelines[0].Config))
+					Expect(from).To(BeZero())
+					Expect(paused).To(BeFalse())
+
+					name, _, _, _ = fakeTeam.SavePipelineArgsForCall(1)
+					Expect(name).To(Equal("old-pipeline"))
+
+					Expect(fakePipeline.UnpauseCallCount()).To(Equal(2))
+					Expect(fakePipeline.ExposeCallCount()).To(Equal(1))
+					Expect(fakePipeline.HideCallCount()).To(Equal(1))
+					Expect(fakePipeline.ArchiveCallCount()).To(Equal(1))
+
+					Expect(fakeTeam.OrderPipelinesCallCount()).To(Equal(1))
+					Expect(fakeTeam.OrderPipelinesArgsForCall(0)).To(Equal([]string{"some-pipeline", "old-pipeline"}))
+				})
+
+				It("restores pins and disabled versions", func() {
+					Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("some-repo"))
+
+					Expect(fakeResource.RestorePinCallCount()).To(Equal(1))
+					version, comment := fakeResource.RestorePinArgsForCall(0)
+					Expect(version).To(Equal(atc.Version{"ref": "abc"}))
+					Expect(comment).To(Equal("hold"))
+
+					Expect(fakeResource.RestoreDisabledVersionsCallCount()).To(Equal(1))
+					Expect(fakeResource.RestoreDisabledVersionsArgsForCall(0)).To(Equal([]atc.Version{{"ref": "def"}}))
+				})
+
+				Context("when the pipeline already exists", func() {
+					BeforeEach(func() {
+						existingPipeline := new(dbfakes.FakePipeline)
+						existingPipeline.ConfigVersionReturns(42)
+						fakeTeam.PipelineReturns(existingPipeline, true, nil)
+					})
+
+					It("saves over its current config version", func() {
+						_, _, from, _ := fakeTeam.SavePipelineArgsForCall(0)
+						Expect(from).To(Equal(db.ConfigVersion(42)))
+					})
+				})
+
+				Context("when the resource is pinned through its config", func() {
+					BeforeEach(func() {
+						fakeResource.RestorePinReturns(db.ErrPinnedThroughConfig)
+					})
+
+					It("warns and carries on", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						var importResponse atc.TeamImportResponse
+						Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+						Expect(importResponse.Warnings).To(ConsistOf("pipeline some-pipeline: resource some-repo is pinned through config, skipping its pin"))
+
+						Expect(fakeResource.RestoreDisabledVersionsCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the resource is no longer in the pipeline", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, nil)
+					})
+
+					It("warns", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						var importResponse atc.TeamImportResponse
+						Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+						Expect(importResponse.Warnings).To(ConsistOf("pipeline some-pipeline: resource some-repo not found, skipping its pins and disabled versions"))
+					})
+				})
+
+				Context("when saving a pipeline fails", func() {
+					BeforeEach(func() {
+						fakeTeam.SavePipelineReturns(nil, false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+
+						var importResponse atc.TeamImportResponse
+						Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+						Expect(importResponse.Errors).To(ConsistOf("failed to import pipeline some-pipeline: nope"))
+						Expect(importResponse.Pipelines).To(BeEmpty())
+					})
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+					dbTeamFactory.CreateTeamReturns(fakeTeam, nil)
+				})
+
+				Context("when the requester is an admin", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAdminReturns(true)
+					})
+
+					It("creates the team under the new name", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(dbTeamFactory.CreateTeamCallCount()).To(Equal(1))
+						Expect(dbTeamFactory.CreateTeamArgsForCall(0)).To(Equal(atc.Team{
+							Name: "some-team",
+							Auth: export.Team.Auth,
+						}))
+					})
+
+					It("reports that the team was created", func() {
+						var importResponse atc.TeamImportResponse
+						Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+						Expect(importResponse.TeamCreated).To(BeTrue())
+					})
+				})
+
+				Context("when the requester is not an admin", func() {
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(dbTeamFactory.CreateTeamCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the export version is unsupported", func() {
+				BeforeEach(func() {
+					export.Version = 99
+				})
+
+				It("returns 400 without changing anything", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					var importResponse atc.TeamImportResponse
+					Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+					Expect(importResponse.Errors).To(ConsistOf("unsupported export version 99, expected 1"))
+
+					Expect(dbTeamFactory.FindTeamCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a pipeline config is invalid", func() {
+				BeforeEach(func() {
+					export.Pipelines[0].Config.Jobs[0].PlanSequence = []atc.Step{
+						{Config: &atc.GetStep{Name: "bogus"}},
+					}
+				})
+
+				It("returns 400 without changing anything", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					var importResponse atc.TeamImportResponse
+					Expect(json.NewDecoder(response.Body).Decode(&importResponse)).To(Succeed())
+					Expect(importResponse.Errors).To(ContainElement(HavePrefix("pipeline some-pipeline: ")))
+
+					Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when not authorized on the team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+			})
+		})
+	})
+})
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -285,6 +287,280 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+	Describe("GET /api/v1/teams/:team_name/events", func() {
+		var (
+			response        *http.Response
+			fakeEventSource *dbfakes.FakePipelineEventSource
+		)
+
+		BeforeEach(func() {
+			fakeEventSource = new(dbfakes.FakePipelineEventSource)
+			fakeEventSource.NextReturnsOnCall(0, atc.PipelineEvent{
+				ID:           42,
+				Type:         atc.PipelineEventConfig,
+				TeamName:     "a-team",
+				PipelineName: "a-pipeline",
+			}, nil)
+			fakeEventSource.NextReturns(atc.PipelineEvent{}, db.ErrPipelineEventStreamClosed)
+			fakeTeam.PipelineEventsReturns(fakeEventSource, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/events")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team exists", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("streams the events of all of the team's pipelines", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(string(body)).To(ContainSubstring("id: 42\n"))
+					Expect(string(body)).To(ContainSubstring(`"type":"pipeline-config"`))
+
+					Expect(fakeTeam.PipelineEventsCallCount()).To(Equal(1))
+					Expect(fakeTeam.PipelineEventsArgsForCall(0)).To(Equal(db.LatestPipelineEvent))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/auth/explain", func() {
+		var (
+			response *http.Response
+			request  *atc.ExplainTeamAuthRequest
+		)
+
+		BeforeEach(func() {
+			fakeTeam = new(dbfakes.FakeTeam)
+			fakeTeam.NameReturns("a-team")
+			fakeTeam.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{
+					"users": {"github:some-user"},
+				},
+				"member": map[string][]string{
+					"groups": {"github:some-org:some-team"},
+				},
+				"viewer": map[string][]string{
+					"groups":    {"github:some-org"},
+					"pipelines": {"some-pipeline"},
+				},
+			})
+
+			request = nil
+		})
+
+		JustBeforeEach(func() {
+			var body io.Reader
+			if request != nil {
+				body = jsonEncode(request)
+			}
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/auth/explain", body)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.AuthSubjectReturns(atc.AuthSubject{
+					Connector: "github",
+					UserName:  "some-user",
+					Groups:    []string{"some-org"},
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when finding the team fails", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the team exists", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				It("explains the requesting user's access", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"team": "a-team",
+						"subject": {
+							"connector": "github",
+							"user_name": "some-user",
+							"groups": ["some-org"]
+						},
+						"roles": ["owner"],
+						"rules": [
+							{"role": "owner", "type": "user", "value": "github:some-user"},
+							{"role": "viewer", "type": "group", "value": "github:some-org", "pipelines": ["some-pipeline"]}
+						]
+					}`))
+				})
+
+				Context("when explaining another user", func() {
+					BeforeEach(func() {
+						request = &atc.ExplainTeamAuthRequest{
+							Subject: &atc.AuthSubject{
+								Connector: "github",
+								UserName:  "other-user",
+								Groups:    []string{"some-org:some-team"},
+							},
+						}
+					})
+
+					Context("when not authorized on the team", func() {
+						BeforeEach(func() {
+							fakeAccess.IsAuthorizedReturns(false)
+						})
+
+						It("returns 403", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						})
+					})
+
+					Context("when authorized on the team", func() {
+						BeforeEach(func() {
+							fakeAccess.IsAuthorizedReturns(true)
+						})
+
+						It("explains the given user's access", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+							var explanation atc.TeamAuthExplanation
+							err := json.NewDecoder(response.Body).Decode(&explanation)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(explanation.Subject.UserName).To(Equal("other-user"))
+							Expect(explanation.Roles).To(Equal([]string{"member"}))
+							Expect(explanation.Rules).To(Equal([]atc.TeamAuthRule{
+								{Role: "member", Type: "group", Value: "github:some-org:some-team"},
+							}))
+						})
+
+						It("checks the access of the caller on the team", func() {
+							Expect(fakeAccess.IsAuthorizedCallCount()).To(Equal(1))
+							Expect(fakeAccess.IsAuthorizedArgsForCall(0)).To(Equal("a-team"))
+						})
+					})
+				})
+
+				Context("when explaining a proposed auth config", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+
+						request = &atc.ExplainTeamAuthRequest{
+							Auth: atc.TeamAuth{
+								"pipeline-operator": map[string][]string{
+									"groups": {"github:some-org"},
+								},
+							},
+						}
+					})
+
+					It("evaluates the proposed config instead of the team's", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						var explanation atc.TeamAuthExplanation
+						err := json.NewDecoder(response.Body).Decode(&explanation)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(explanation.Roles).To(Equal([]string{"pipeline-operator"}))
+					})
+				})
+
+				Context("when nothing matches", func() {
+					BeforeEach(func() {
+						fakeAccess.AuthSubjectReturns(atc.AuthSubject{
+							Connector: "local",
+							UserName:  "nobody",
+						})
+					})
+
+					It("returns empty roles and rules", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"team": "a-team",
+							"subject": {"connector": "local", "user_name": "nobody"},
+							"roles": [],
+							"rules": []
+						}`))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name", func() {
 		var (
 			response *http.Response
@@ -336,6 +612,176 @@ var _ = Describe("Teams API", func() {
 						Expect(updatedProviderAuth).To(Equal(atcTeam.Auth))
 					})
 
+					It("leaves the team's credential manager unchanged", func() {
+						Expect(fakeTeam.UpdateCredentialManagerCallCount()).To(Equal(0))
+					})
+
+					Context("when the credential manager is explicitly null", func() {
+						BeforeEach(func() {
+							atcTeam.RemoveCredentialManager = true
+						})
+
+						It("clears the team's credential manager", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.UpdateCredentialManagerCallCount()).To(Equal(1))
+							Expect(fakeTeam.UpdateCredentialManagerArgsForCall(0)).To(BeNil())
+						})
+					})
+
+					Context("when a credential manager is given", func() {
+						BeforeEach(func() {
+							creds.AllowTeamManagerTypes([]string{"dummy"})
+
+							atcTeam.CredentialManager = &atc.TeamCredentialManager{
+								Type: "dummy",
+								Config: map[string]interface{}{
+									"vars": map[string]interface{}{"foo": "bar"},
+								},
+							}
+						})
+
+						AfterEach(func() {
+							creds.AllowTeamManagerTypes(nil)
+						})
+
+						It("updates the team's credential manager", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.UpdateCredentialManagerCallCount()).To(Equal(1))
+							Expect(fakeTeam.UpdateCredentialManagerArgsForCall(0)).To(Equal(atcTeam.CredentialManager))
+						})
+
+						Context("when updating the credential manager fails", func() {
+							BeforeEach(func() {
+								fakeTeam.UpdateCredentialManagerReturns(errors.New("nope"))
+							})
+
+							It("returns 500 Internal Server error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+					})
+
+					Context("when the credential manager has no type", func() {
+						BeforeEach(func() {
+							atcTeam.CredentialManager = &atc.TeamCredentialManager{}
+						})
+
+						It("returns 400 Bad Request", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+							Expect(fakeTeam.UpdateCredentialManagerCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the credential manager type is not allowed for teams", func() {
+						BeforeEach(func() {
+							atcTeam.CredentialManager = &atc.TeamCredentialManager{
+								Type: "dummy",
+								Config: map[string]interface{}{
+									"vars": map[string]interface{}{"foo": "bar"},
+								},
+							}
+						})
+
+						It("returns 400 Bad Request with the reason", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("credential manager type dummy is not allowed for teams"))
+							Expect(fakeTeam.UpdateCredentialManagerCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the credential manager type is unknown", func() {
+						BeforeEach(func() {
+							atcTeam.CredentialManager = &atc.TeamCredentialManager{Type: "bogus"}
+						})
+
+						It("returns 400 Bad Request with the reason", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("unknown credential manager type: bogus"))
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+							Expect(fakeTeam.UpdateCredentialManagerCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when custom roles are given", func() {
+						BeforeEach(func() {
+							atcTeam.Auth["release-manager"] = map[string][]string{
+								"users": []string{"local:some-user"},
+							}
+							atcTeam.CustomRoles = []atc.TeamRole{
+								{
+									Name:    "release-manager",
+									Extends: "pipeline-operator",
+									Permissions: []atc.TeamRolePermission{
+										{Action: atc.SaveConfig, Pipelines: []string{"release"}},
+									},
+								},
+							}
+						})
+
+						It("updates the team's custom roles", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.UpdateCustomRolesCallCount()).To(Equal(1))
+							Expect(fakeTeam.UpdateCustomRolesArgsForCall(0)).To(Equal(atcTeam.CustomRoles))
+						})
+
+						Context("when updating the custom roles fails", func() {
+							BeforeEach(func() {
+								fakeTeam.UpdateCustomRolesReturns(errors.New("nope"))
+							})
+
+							It("returns 500 Internal Server error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+
+						Context("when a custom role shadows a built-in role", func() {
+							BeforeEach(func() {
+								atcTeam.CustomRoles[0].Name = "member"
+							})
+
+							It("returns 400 Bad Request with the reason", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("custom role 'member' conflicts with a built-in role"))
+								Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when a custom role extends an unknown role", func() {
+							BeforeEach(func() {
+								atcTeam.CustomRoles[0].Extends = "bogus"
+							})
+
+							It("returns 400 Bad Request with the reason", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("custom role 'release-manager' extends unknown role 'bogus'"))
+							})
+						})
+
+						Context("when a custom role grants an unknown action", func() {
+							BeforeEach(func() {
+								atcTeam.CustomRoles[0].Permissions[0].Action = "Bogus"
+							})
+
+							It("returns 400 Bad Request with the reason", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("custom role 'release-manager' grants unknown action 'Bogus'"))
+							})
+						})
+
+						Context("when a custom role grants nothing", func() {
+							BeforeEach(func() {
+								atcTeam.CustomRoles[0].Extends = ""
+								atcTeam.CustomRoles[0].Permissions = nil
+							})
+
+							It("returns 400 Bad Request", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(fakeTeam.UpdateCustomRolesCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Context("when updating provider auth fails", func() {
 						BeforeEach(func() {
 							fakeTeam.UpdateProviderAuthReturns(errors.New("stop trying to make fetch happen"))
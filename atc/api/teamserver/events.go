@@ -0,0 +1,27 @@
+package teamserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/pipelineserver"
+)
+
+func (s *Server) TeamEvents(w http.ResponseWriter, r *http.Request) {
+	teamName := r.FormValue(":team_name")
+	logger := s.logger.Session("team-events", lager.Data{"team": teamName})
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	pipelineserver.NewEventHandler(logger, team.PipelineEvents).ServeHTTP(w, r)
+}
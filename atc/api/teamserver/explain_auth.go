@@ -0,0 +1,97 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+// ExplainTeamAuth lists the rules of the team's auth config that grant roles
+// to a user. Any user may have their own access explained, while explaining
+// another user or a proposed auth config requires the team's owner role.
+func (s *Server) ExplainTeamAuth(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("explain-team-auth")
+
+	acc := accessor.GetAccessor(r)
+
+	teamName := r.FormValue(":team_name")
+
+	var request atc.ExplainTeamAuthRequest
+	if r.ContentLength != 0 {
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			hLog.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	if request.Subject != nil || request.Auth != nil {
+		if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
+			hLog.Debug("not-allowed")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	subject := acc.AuthSubject()
+	if request.Subject != nil {
+		subject = *request.Subject
+	}
+
+	auth := team.Auth()
+	if request.Auth != nil {
+		auth = request.Auth
+	}
+
+	rules := accessor.MatchTeamAuth(auth, subject)
+
+	roles := []string{}
+	for _, rule := range rules {
+		if len(rule.Pipelines) == 0 && !containsRole(roles, rule.Role) {
+			roles = append(roles, rule.Role)
+		}
+	}
+	sort.Strings(roles)
+
+	if rules == nil {
+		rules = []atc.TeamAuthRule{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(atc.TeamAuthExplanation{
+		Team:    team.Name(),
+		Subject: subject,
+		Roles:   roles,
+		Rules:   rules,
+	})
+	if err != nil {
+		hLog.Error("failed-to-encode-explanation", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func containsRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ExportTeam responds with the team's auth, its pipelines' configs in order,
+// and the pipeline and resource state which is not part of those configs.
+func (s *Server) ExportTeam(w http.ResponseWriter, r *http.Request) {
+	teamName := r.FormValue(":team_name")
+
+	hLog := s.logger.Session("export-team", lager.Data{"team": teamName})
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	export, err := exportTeam(team)
+	if err != nil {
+		hLog.Error("failed-to-export-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(export)
+	if err != nil {
+		hLog.Error("failed-to-encode-export", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func exportTeam(team db.Team) (atc.TeamExport, error) {
+	presentedTeam := present.Team(team)
+	presentedTeam.ID = 0
+
+	export := atc.TeamExport{
+		Version:   atc.TeamExportVersion,
+		Team:      presentedTeam,
+		Pipelines: []atc.PipelineExport{},
+	}
+
+	pipelines, err := team.Pipelines()
+	if err != nil {
+		return atc.TeamExport{}, err
+	}
+
+	for _, pipeline := range pipelines {
+		config, err := pipeline.Config()
+		if err != nil {
+			return atc.TeamExport{}, err
+		}
+
+		resources, err := exportResources(pipeline)
+		if err != nil {
+			return atc.TeamExport{}, err
+		}
+
+		export.Pipelines = append(export.Pipelines, atc.PipelineExport{
+			Name:      pipeline.Name(),
+			Paused:    pipeline.Paused(),
+			Public:    pipeline.Public(),
+			Archived:  pipeline.Archived(),
+			Config:    config,
+			Resources: resources,
+		})
+	}
+
+	return export, nil
+}
+
+func exportResources(pipeline db.Pipeline) ([]atc.ResourceExport, error) {
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return nil, err
+	}
+
+	var exports []atc.ResourceExport
+	for _, resource := range resources {
+		disabledVersions, err := resource.DisabledVersions()
+		if err != nil {
+			return nil, err
+		}
+
+		export := atc.ResourceExport{
+			Name:             resource.Name(),
+			DisabledVersions: disabledVersions,
+		}
+
+		// pins set through the config are restored along with it
+		if resource.ConfigPinnedVersion() == nil && resource.APIPinnedVersion() != nil {
+			export.PinnedVersion = resource.APIPinnedVersion()
+			export.PinComment = resource.PinComment()
+		}
+
+		if export.PinnedVersion == nil && len(export.DisabledVersions) == 0 {
+			continue
+		}
+
+		exports = append(exports, export)
+	}
+
+	return exports, nil
+}
@@ -0,0 +1,408 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/configserver"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/policy"
+)
+
+// ImportTeam restores a TeamExport into the named team, creating the team if
+// the user is an admin. Pipelines in the export are created or updated, but
+// pipelines which only exist on this cluster are left alone.
+//
+// The whole export goes through the same validation, policy and credential
+// checks as set-team and set-pipeline before anything is applied.
+func (s *Server) ImportTeam(w http.ResponseWriter, r *http.Request) {
+	teamName := r.FormValue(":team_name")
+
+	hLog := s.logger.Session("import-team", lager.Data{"team": teamName})
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	_, checkCredentials := r.URL.Query()[atc.SaveConfigCheckCreds]
+
+	var export atc.TeamExport
+	err := json.NewDecoder(r.Body).Decode(&export)
+	if err != nil {
+		hLog.Info("malformed-request", lager.Data{"error": err.Error()})
+		writeImportResponse(w, http.StatusBadRequest, atc.TeamImportResponse{
+			Team:   teamName,
+			Errors: []string{fmt.Sprintf("malformed export: %s", err)},
+		})
+		return
+	}
+
+	errorMessages := s.validateExport(export)
+	if len(errorMessages) > 0 {
+		hLog.Info("invalid-export", lager.Data{"errors": errorMessages})
+		writeImportResponse(w, http.StatusBadRequest, atc.TeamImportResponse{
+			Team:   teamName,
+			Errors: errorMessages,
+		})
+		return
+	}
+
+	atcTeam := export.Team
+	atcTeam.Name = teamName
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found && !acc.IsAdmin() {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	warnings, errorMessages, err := s.checkPolicies(acc, atcTeam, export.Pipelines)
+	if err != nil {
+		hLog.Error("failed-to-check-policies", err)
+		writeImportResponse(w, http.StatusBadRequest, atc.TeamImportResponse{
+			Team:   teamName,
+			Errors: []string{fmt.Sprintf("policy check error: %s", err)},
+		})
+		return
+	}
+
+	if len(errorMessages) > 0 {
+		hLog.Info("blocked-by-policy", lager.Data{"errors": errorMessages})
+
+		result, err := json.Marshal(atc.PolicyCheckResult{
+			Allowed:  false,
+			Reasons:  errorMessages,
+			Severity: string(policy.SeverityBlock),
+		})
+		if err != nil {
+			hLog.Error("failed-to-encode-policy-check-result", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set(atc.PolicyCheckHeader, string(result))
+		writeImportResponse(w, http.StatusForbidden, atc.TeamImportResponse{
+			Team:     teamName,
+			Errors:   errorMessages,
+			Warnings: warnings,
+		})
+		return
+	}
+
+	if checkCredentials {
+		credentialManager := atcTeam.CredentialManager
+		if credentialManager == nil && found {
+			credentialManager = team.CredentialManager()
+		}
+
+		errorMessages = s.checkCredentials(hLog, teamName, credentialManager, export.Pipelines)
+		if len(errorMessages) > 0 {
+			hLog.Info("invalid-credentials", lager.Data{"errors": errorMessages})
+			writeImportResponse(w, http.StatusBadRequest, atc.TeamImportResponse{
+				Team:     teamName,
+				Errors:   errorMessages,
+				Warnings: warnings,
+			})
+			return
+		}
+	}
+
+	response := atc.TeamImportResponse{
+		Team:     teamName,
+		Warnings: warnings,
+	}
+
+	if found {
+		err = team.UpdateProviderAuth(atcTeam.Auth)
+		if err != nil {
+			hLog.Error("failed-to-update-team", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if atcTeam.CredentialManager != nil {
+			err = team.UpdateCredentialManager(atcTeam.CredentialManager)
+			if err != nil {
+				hLog.Error("failed-to-update-credential-manager", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
+		err = team.UpdateCustomRoles(atcTeam.CustomRoles)
+		if err != nil {
+			hLog.Error("failed-to-update-custom-roles", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	} else {
+		team, err = s.teamFactory.CreateTeam(atcTeam)
+		if err != nil {
+			hLog.Error("failed-to-save-team", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		response.TeamCreated = true
+	}
+
+	err = s.teamFactory.NotifyCacher()
+	if err != nil {
+		hLog.Error("failed-to-notify-cacher", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	for _, pipelineExport := range export.Pipelines {
+		pipelineWarnings, err := importPipeline(team, pipelineExport)
+		if err != nil {
+			hLog.Error("failed-to-import-pipeline", err, lager.Data{"pipeline": pipelineExport.Name})
+			response.Errors = []string{fmt.Sprintf("failed to import pipeline %s: %s", pipelineExport.Name, err)}
+			writeImportResponse(w, http.StatusInternalServerError, response)
+			return
+		}
+
+		response.Warnings = append(response.Warnings, pipelineWarnings...)
+		response.Pipelines = append(response.Pipelines, pipelineExport.Name)
+	}
+
+	err = team.OrderPipelines(response.Pipelines)
+	if err != nil {
+		hLog.Error("failed-to-order-pipelines", err)
+		response.Errors = []string{fmt.Sprintf("failed to order pipelines: %s", err)}
+		writeImportResponse(w, http.StatusInternalServerError, response)
+		return
+	}
+
+	err = s.teamFactory.NotifyResourceScanner()
+	if err != nil {
+		hLog.Error("failed-to-notify-resource-scanner", err)
+	}
+
+	writeImportResponse(w, http.StatusOK, response)
+}
+
+func (s *Server) validateExport(export atc.TeamExport) []string {
+	if export.Version != atc.TeamExportVersion {
+		return []string{fmt.Sprintf("unsupported export version %d, expected %d", export.Version, atc.TeamExportVersion)}
+	}
+
+	var errorMessages []string
+
+	err := export.Team.Validate()
+	if err != nil {
+		errorMessages = append(errorMessages, fmt.Sprintf("invalid team auth: %s", err))
+	}
+
+	if export.Team.CredentialManager != nil {
+		err = configvalidate.ValidateTeamCredentialManager(*export.Team.CredentialManager)
+		if err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("invalid credential manager: %s", err))
+		}
+	}
+
+	err = accessor.ValidateCustomRoles(export.Team.CustomRoles)
+	if err != nil {
+		errorMessages = append(errorMessages, fmt.Sprintf("invalid custom roles: %s", err))
+	}
+
+	for _, pipeline := range export.Pipelines {
+		if pipeline.Name == "" {
+			errorMessages = append(errorMessages, "pipeline with no name")
+			continue
+		}
+
+		if pipeline.Archived {
+			if !s.enableArchivePipeline {
+				errorMessages = append(errorMessages, fmt.Sprintf("pipeline %s: archiving pipelines is not enabled on this cluster", pipeline.Name))
+			}
+
+			// archived pipelines have no config to validate
+			continue
+		}
+
+		_, configErrors := configvalidate.Validate(pipeline.Config)
+		for _, message := range configErrors {
+			errorMessages = append(errorMessages, fmt.Sprintf("pipeline %s: %s", pipeline.Name, message))
+		}
+	}
+
+	return errorMessages
+}
+
+// checkPolicies runs the team and each pipeline past the policy checker as if
+// they had been set through set-team and set-pipeline.
+func (s *Server) checkPolicies(acc accessor.Access, team atc.Team, pipelines []atc.PipelineExport) ([]string, []string, error) {
+	if s.policyChecker == nil {
+		return nil, nil, nil
+	}
+
+	inputs := []policy.PolicyCheckInput{{
+		HttpMethod: http.MethodPut,
+		Action:     atc.SetTeam,
+		Team:       team.Name,
+		Data:       team,
+	}}
+
+	for _, pipeline := range pipelines {
+		if pipeline.Archived {
+			continue
+		}
+
+		inputs = append(inputs, policy.PolicyCheckInput{
+			HttpMethod: http.MethodPut,
+			Action:     atc.SaveConfig,
+			Team:       team.Name,
+			Pipeline:   pipeline.Name,
+			Data:       pipeline.Config,
+		})
+	}
+
+	var warnings, errorMessages []string
+	for _, input := range inputs {
+		// policies are written against the decoded request body
+		data, err := json.Marshal(input.Data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		input.Data = nil
+		err = json.Unmarshal(data, &input.Data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		output, err := s.policyChecker.CheckInput(acc, input)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		subject := fmt.Sprintf("team %s", input.Team)
+		if input.Pipeline != "" {
+			subject = fmt.Sprintf("pipeline %s", input.Pipeline)
+		}
+
+		message := fmt.Sprintf("%s: policy check not pass", subject)
+		if len(output.Reasons) > 0 {
+			message = fmt.Sprintf("%s: %s", message, strings.Join(output.Reasons, "; "))
+		}
+
+		if output.ShouldBlock() {
+			errorMessages = append(errorMessages, message)
+		} else if output.ShouldWarn() {
+			warnings = append(warnings, message)
+		}
+	}
+
+	return warnings, errorMessages, nil
+}
+
+func (s *Server) checkCredentials(logger lager.Logger, teamName string, credentialManager *atc.TeamCredentialManager, pipelines []atc.PipelineExport) []string {
+	var errorMessages []string
+	for _, pipeline := range pipelines {
+		if pipeline.Archived {
+			continue
+		}
+
+		variables, err := creds.NewTeamVariables(logger, s.secretManager, s.varSourcePool, credentialManager, teamName, pipeline.Name)
+		if err == nil {
+			err = configserver.ValidateCredParams(variables, pipeline.Config, logger)
+		}
+
+		if err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("pipeline %s: credential validation failed\n\n%s", pipeline.Name, err))
+		}
+	}
+
+	return errorMessages
+}
+
+func importPipeline(team db.Team, export atc.PipelineExport) ([]string, error) {
+	var from db.ConfigVersion
+
+	existing, found, err := team.Pipeline(export.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if found {
+		from = existing.ConfigVersion()
+	}
+
+	pipeline, _, err := team.SavePipeline(export.Name, export.Config, from, export.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.Paused {
+		err = pipeline.Pause()
+	} else {
+		err = pipeline.Unpause()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if export.Public {
+		err = pipeline.Expose()
+	} else {
+		err = pipeline.Hide()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if export.Archived {
+		return nil, pipeline.Archive()
+	}
+
+	var warnings []string
+	for _, resourceExport := range export.Resources {
+		resource, found, err := pipeline.Resource(resourceExport.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			warnings = append(warnings, fmt.Sprintf("pipeline %s: resource %s not found, skipping its pins and disabled versions", export.Name, resourceExport.Name))
+			continue
+		}
+
+		if resourceExport.PinnedVersion != nil {
+			err = resource.RestorePin(resourceExport.PinnedVersion, resourceExport.PinComment)
+			if err == db.ErrPinnedThroughConfig {
+				warnings = append(warnings, fmt.Sprintf("pipeline %s: resource %s is pinned through config, skipping its pin", export.Name, resourceExport.Name))
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		err = resource.RestoreDisabledVersions(resourceExport.DisabledVersions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return warnings, nil
+}
+
+func writeImportResponse(w http.ResponseWriter, status int, response atc.TeamImportResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
@@ -2,23 +2,37 @@ package teamserver
 
 import (
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/policychecker"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 )
 
 type Server struct {
-	logger      lager.Logger
-	teamFactory db.TeamFactory
-	externalURL string
+	logger                lager.Logger
+	teamFactory           db.TeamFactory
+	externalURL           string
+	secretManager         creds.Secrets
+	varSourcePool         creds.VarSourcePool
+	policyChecker         policychecker.PolicyChecker
+	enableArchivePipeline bool
 }
 
 func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
 	externalURL string,
+	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
+	policyChecker policychecker.PolicyChecker,
+	enableArchivePipeline bool,
 ) *Server {
 	return &Server{
-		logger:      logger,
-		teamFactory: teamFactory,
-		externalURL: externalURL,
+		logger:                logger,
+		teamFactory:           teamFactory,
+		externalURL:           externalURL,
+		secretManager:         secretManager,
+		varSourcePool:         varSourcePool,
+		policyChecker:         policyChecker,
+		enableArchivePipeline: enableArchivePipeline,
 	}
 }
@@ -2,6 +2,7 @@ package teamserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -9,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/configvalidate"
 )
 
 func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
@@ -41,6 +43,24 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if atcTeam.CredentialManager != nil {
+		err = configvalidate.ValidateTeamCredentialManager(*atcTeam.CredentialManager)
+		if err != nil {
+			hLog.Info("invalid-credential-manager", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid credential manager: %s", err)
+			return
+		}
+	}
+
+	err = accessor.ValidateCustomRoles(atcTeam.CustomRoles)
+	if err != nil {
+		hLog.Info("invalid-custom-roles", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "invalid custom roles: %s", err)
+		return
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
@@ -57,6 +77,22 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if atcTeam.CredentialManager != nil || atcTeam.RemoveCredentialManager {
+			err = team.UpdateCredentialManager(atcTeam.CredentialManager)
+			if err != nil {
+				hLog.Error("failed-to-update-credential-manager", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
+		err = team.UpdateCustomRoles(atcTeam.CustomRoles)
+		if err != nil {
+			hLog.Error("failed-to-update-custom-roles", err, lager.Data{"teamName": teamName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
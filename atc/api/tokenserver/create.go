@@ -0,0 +1,149 @@
+package tokenserver
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+var tokenRoles = []string{
+	accessor.OwnerRole,
+	accessor.MemberRole,
+	accessor.OperatorRole,
+	accessor.ViewerRole,
+}
+
+func (s *Server) CreateAPIToken(team db.Team) http.Handler {
+	logger := s.logger.Session("create-api-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acc := accessor.GetAccessor(r)
+		claims := acc.Claims()
+
+		if claims.Connector == accessor.APITokenConnector {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "api tokens cannot be used to create api tokens")
+			return
+		}
+
+		var req atc.APITokenRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = req.Validate(s.maxLifetime)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		if !isTokenRole(req.Role) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "unknown role: %s", req.Role)
+			return
+		}
+
+		if req.ServiceAccount && !canManageTokens(acc, team) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "only team owners can create service account tokens")
+			return
+		}
+
+		if !canGrantRole(acc, team, req.Role) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, "cannot create a token with a role beyond your own: %s", req.Role)
+			return
+		}
+
+		value, err := generateToken()
+		if err != nil {
+			logger.Error("failed-to-generate-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		token, err := s.apiTokenFactory.CreateAPIToken(team.ID(), db.APITokenSpec{
+			Name:           req.Name,
+			Role:           req.Role,
+			ServiceAccount: req.ServiceAccount,
+			Token:          value,
+			CreatedBy:      claims.UserName,
+			CreatedBySub:   claims.Sub,
+			Creator:        acc.AuthSubject(),
+			ExpiresAt:      time.Now().Add(req.ExpiresIn),
+		})
+		if err != nil {
+			if err == db.ErrAPITokenAlreadyExists {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprintf(w, "token '%s' already exists", req.Name)
+				return
+			}
+
+			logger.Error("failed-to-create-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logger.Info("created-api-token", lager.Data{"team": team.Name(), "token": req.Name, "role": req.Role})
+
+		presented := present.APIToken(token)
+		presented.Token = value
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-api-token", err)
+		}
+	})
+}
+
+func isTokenRole(role string) bool {
+	for _, r := range tokenRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// canGrantRole ensures a token never carries more privileges than the
+// requester has on the team.
+func canGrantRole(acc accessor.Access, team db.Team, role string) bool {
+	if acc.IsAdmin() {
+		return true
+	}
+
+	for _, teamRole := range acc.TeamRoles()[team.Name()] {
+		if accessor.HasPermission(accessor.BaseRole(team, teamRole), role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func generateToken() (string, error) {
+	secret := make([]byte, 32)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return atc.APITokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
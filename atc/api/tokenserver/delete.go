@@ -0,0 +1,48 @@
+package tokenserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) DeleteAPIToken(team db.Team) http.Handler {
+	logger := s.logger.Session("delete-api-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acc := accessor.GetAccessor(r)
+
+		tokenName := r.FormValue(":token_name")
+
+		token, found, err := s.apiTokenFactory.FindAPIToken(team.ID(), tokenName)
+		if err != nil {
+			logger.Error("failed-to-find-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if !canManageTokens(acc, team) && !ownsToken(acc, token) {
+			logger.Debug("not-allowed", lager.Data{"token": tokenName})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		_, err = s.apiTokenFactory.DeleteAPIToken(team.ID(), tokenName)
+		if err != nil {
+			logger.Error("failed-to-delete-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logger.Info("deleted-api-token", lager.Data{"team": team.Name(), "token": tokenName})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,43 @@
+package tokenserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListAPITokens(team db.Team) http.Handler {
+	logger := s.logger.Session("list-api-tokens")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acc := accessor.GetAccessor(r)
+
+		tokens, err := s.apiTokenFactory.GetAPITokens(team.ID())
+		if err != nil {
+			logger.Error("failed-to-get-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		manage := canManageTokens(acc, team)
+
+		presentedTokens := []atc.APIToken{}
+		for _, token := range tokens {
+			if manage || ownsToken(acc, token) {
+				presentedTokens = append(presentedTokens, present.APIToken(token))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presentedTokens)
+		if err != nil {
+			logger.Error("failed-to-encode-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package tokenserver
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger          lager.Logger
+	apiTokenFactory db.APITokenFactory
+	maxLifetime     time.Duration
+}
+
+func NewServer(
+	logger lager.Logger,
+	apiTokenFactory db.APITokenFactory,
+	maxLifetime time.Duration,
+) *Server {
+	return &Server{
+		logger:          logger,
+		apiTokenFactory: apiTokenFactory,
+		maxLifetime:     maxLifetime,
+	}
+}
+
+// canManageTokens returns whether the requester may see and revoke every
+// token belonging to the team, rather than only the ones they created.
+func canManageTokens(acc accessor.Access, team db.Team) bool {
+	if acc.IsAdmin() {
+		return true
+	}
+
+	for _, role := range acc.TeamRoles()[team.Name()] {
+		if role == accessor.OwnerRole {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ownsToken(acc accessor.Access, token db.APIToken) bool {
+	return !token.ServiceAccount() && token.CreatedBySub() == acc.Claims().Sub
+}
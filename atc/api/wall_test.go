@@ -186,4 +186,224 @@ var _ = Describe("Wall API", func() {
 		})
 
 	})
+
+	Context("Lists wall messages", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+
+			dbWall.ActiveMessagesReturns([]atc.WallMessage{
+				{ID: 1, Message: "everyone", Severity: atc.WallSeverityInfo},
+				{ID: 2, Message: "some team", Severity: atc.WallSeverityWarning, Teams: []string{"some-team"}},
+			}, nil)
+			dbWall.MessagesReturns([]atc.WallMessage{
+				{ID: 3, Message: "scheduled", Severity: atc.WallSeverityInfo, StartsAt: 100},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/wall/messages"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the user is not authorized on the targeted team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns only the messages for every team", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{"id":1,"message":"everyone","severity":"info"}
+				]`))
+			})
+		})
+
+		Context("when the user is authorized on the targeted team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedStub = func(team string) bool {
+					return team == "some-team"
+				}
+			})
+
+			It("includes the messages for the team", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{"id":1,"message":"everyone","severity":"info"},
+					{"id":2,"message":"some team","severity":"warning","teams":["some-team"]}
+				]`))
+			})
+		})
+
+		Context("when listing every message", func() {
+			BeforeEach(func() {
+				query = "?all=true"
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("as an admin", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAdminReturns(true)
+				})
+
+				It("returns the messages that have not ended", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{"id":3,"message":"scheduled","severity":"info","starts_at":100}
+					]`))
+				})
+			})
+
+			Context("as a non-admin", func() {
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+	})
+
+	Context("Creates a wall message", func() {
+		var message atc.WallMessage
+
+		BeforeEach(func() {
+			message = atc.WallMessage{
+				Message:  "some message",
+				StartsAt: 100,
+				EndsAt:   200,
+				Teams:    []string{"some-team"},
+			}
+
+			dbWall.CreateMessageStub = func(m atc.WallMessage) (atc.WallMessage, error) {
+				m.ID = 42
+				return m, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(message)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/wall/messages", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 201 with the created message", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"id": 42,
+					"message": "some message",
+					"severity": "info",
+					"starts_at": 100,
+					"ends_at": 200,
+					"teams": ["some-team"]
+				}`))
+			})
+
+			It("defaults the severity to info", func() {
+				Expect(dbWall.CreateMessageCallCount()).To(Equal(1))
+				Expect(dbWall.CreateMessageArgsForCall(0).Severity).To(Equal(atc.WallSeverityInfo))
+			})
+
+			Context("when the message ends before it starts", func() {
+				BeforeEach(func() {
+					message.EndsAt = 50
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("must end after it starts"))
+					Expect(dbWall.CreateMessageCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the severity is unknown", func() {
+				BeforeEach(func() {
+					message.Severity = "bogus"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("unknown wall message severity 'bogus'"))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Context("Deletes a wall message", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/wall/messages/42", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when the message exists", func() {
+				BeforeEach(func() {
+					dbWall.DeleteMessageReturns(true, nil)
+				})
+
+				It("deletes it and returns 204", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(dbWall.DeleteMessageArgsForCall(0)).To(Equal(42))
+				})
+			})
+
+			Context("when the message does not exist", func() {
+				BeforeEach(func() {
+					dbWall.DeleteMessageReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
 })
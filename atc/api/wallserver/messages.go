@@ -0,0 +1,147 @@
+package wallserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListWallMessages returns the active messages shown to the requesting user.
+// Admins may pass all=true to list every message that has not ended,
+// including the ones scheduled for later and the ones targeting other teams.
+func (s *Server) ListWallMessages(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-wall-messages")
+
+	acc := accessor.GetAccessor(r)
+
+	var messages []atc.WallMessage
+	var err error
+
+	if r.URL.Query().Get("all") == "true" {
+		if !acc.IsAdmin() {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		messages, err = s.wall.Messages()
+	} else {
+		messages, err = VisibleMessages(s.wall, acc)
+	}
+	if err != nil {
+		logger.Error("failed-to-get-messages", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(messages)
+	if err != nil {
+		logger.Error("failed-to-encode-json", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) CreateWallMessage(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-wall-message")
+
+	var message atc.WallMessage
+	err := json.NewDecoder(r.Body).Decode(&message)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if message.Severity == "" {
+		message.Severity = atc.WallSeverityInfo
+	}
+
+	err = message.Validate()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "invalid wall message: %s", err)
+		return
+	}
+
+	created, err := s.wall.CreateMessage(message)
+	if err != nil {
+		logger.Error("failed-to-create-message", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(created)
+	if err != nil {
+		logger.Error("failed-to-encode-json", err)
+	}
+}
+
+func (s *Server) DeleteWallMessage(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("delete-wall-message")
+
+	id, err := strconv.Atoi(r.FormValue(":message_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := s.wall.DeleteMessage(id)
+	if err != nil {
+		logger.Error("failed-to-delete-message", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !deleted {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// VisibleMessages returns the active messages that target every team, or one
+// of the teams the user is authorized on.
+func VisibleMessages(wall db.Wall, acc accessor.Access) ([]atc.WallMessage, error) {
+	messages, err := wall.ActiveMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterVisible(messages, acc), nil
+}
+
+// FilterVisible returns the messages that target every team, or one of the
+// teams the user is authorized on.
+func FilterVisible(messages []atc.WallMessage, acc accessor.Access) []atc.WallMessage {
+	visible := []atc.WallMessage{}
+	for _, message := range messages {
+		if isVisible(message, acc) {
+			visible = append(visible, message)
+		}
+	}
+
+	return visible
+}
+
+func isVisible(message atc.WallMessage, acc accessor.Access) bool {
+	if len(message.Teams) == 0 || acc.IsAdmin() {
+		return true
+	}
+
+	for _, team := range message.Teams {
+		if acc.IsAuthorized(team) {
+			return true
+		}
+	}
+
+	return false
+}
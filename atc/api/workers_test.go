@@ -489,11 +489,12 @@ var _ = Describe("Workers API", func() {
 		var (
 			response   *http.Response
 			workerName string
+			query      string
 			fakeWorker *dbfakes.FakeWorker
 		)
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/land", nil)
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/land"+query, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			response, err = client.Do(req)
@@ -503,6 +504,7 @@ var _ = Describe("Workers API", func() {
 		BeforeEach(func() {
 			fakeWorker = new(dbfakes.FakeWorker)
 			workerName = "some-worker"
+			query = ""
 			fakeWorker.NameReturns(workerName)
 			fakeWorker.TeamNameReturns("some-team")
 			fakeWorker.LandReturns(nil)
@@ -524,6 +526,42 @@ var _ = Describe("Workers API", func() {
 				Expect(dbWorkerFactory.GetWorkerCallCount()).To(Equal(1))
 				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
 				Expect(fakeWorker.LandCallCount()).To(Equal(1))
+				Expect(fakeWorker.LandByCallCount()).To(BeZero())
+			})
+
+			Context("when a deadline is given", func() {
+				BeforeEach(func() {
+					query = "?deadline=1h"
+				})
+
+				It("lands the worker by the deadline", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeWorker.LandCallCount()).To(BeZero())
+					Expect(fakeWorker.LandByCallCount()).To(Equal(1))
+					Expect(fakeWorker.LandByArgsForCall(0)).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+				})
+
+				Context("when landing the worker fails", func() {
+					BeforeEach(func() {
+						fakeWorker.LandByReturns(errors.New("some-error"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the deadline is invalid", func() {
+				BeforeEach(func() {
+					query = "?deadline=soon"
+				})
+
+				It("returns 400 without landing the worker", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeWorker.LandCallCount()).To(BeZero())
+					Expect(fakeWorker.LandByCallCount()).To(BeZero())
+				})
 			})
 
 			Context("when landing the worker fails", func() {
@@ -1,11 +1,27 @@
 package workerserver
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
 
 func (s *Server) LandWorker(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("landing-worker")
 	workerName := r.FormValue(":worker_name")
 
+	var deadline time.Duration
+	if param := r.URL.Query().Get("deadline"); param != "" {
+		var err error
+		deadline, err = time.ParseDuration(param)
+		if err != nil || deadline <= 0 {
+			logger.Info("invalid-deadline", lager.Data{"deadline": param})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
 	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
 	if err != nil {
 		logger.Error("failed-finding-worker-to-land", err)
@@ -19,7 +35,11 @@ func (s *Server) LandWorker(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = worker.Land()
+	if deadline != 0 {
+		err = worker.LandBy(time.Now().Add(deadline))
+	} else {
+		err = worker.Land()
+	}
 	if err != nil {
 		logger.Error("failed-to-land-worker", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,50 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// APITokenPrefix is prepended to every generated API token so that they can
+// be told apart from the JWTs issued by skymarshal.
+const APITokenPrefix = "cpat_"
+
+type APIToken struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	TeamName       string `json:"team_name"`
+	Role           string `json:"role"`
+	ServiceAccount bool   `json:"service_account,omitempty"`
+	CreatedBy      string `json:"created_by,omitempty"`
+	CreatedAt      int64  `json:"created_at"`
+	ExpiresAt      int64  `json:"expires_at"`
+	LastUsedAt     int64  `json:"last_used_at,omitempty"`
+
+	// Token is only ever populated in the response to creating a token.
+	Token string `json:"token,omitempty"`
+}
+
+type APITokenRequest struct {
+	Name           string        `json:"name"`
+	Role           string        `json:"role"`
+	ServiceAccount bool          `json:"service_account,omitempty"`
+	ExpiresIn      time.Duration `json:"expires_in"`
+}
+
+// Validate checks the request, allowing an expiry of at most maxLifetime.
+func (req APITokenRequest) Validate(maxLifetime time.Duration) error {
+	if req.Name == "" {
+		return errors.New("token name must be specified")
+	}
+
+	if req.ExpiresIn <= 0 {
+		return errors.New("token expiry must be specified")
+	}
+
+	if req.ExpiresIn > maxLifetime {
+		return fmt.Errorf("token expiry cannot be longer than %s", maxLifetime)
+	}
+
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/auditor"
@@ -40,9 +41,13 @@ import (
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/builder"
+	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/idtoken"
+	"github.com/concourse/concourse/atc/landing"
 	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/notifier"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
@@ -80,11 +85,14 @@ import (
 
 	// dynamically registered policy checkers
 	_ "github.com/concourse/concourse/atc/policy/opa"
+	_ "github.com/concourse/concourse/atc/policy/rego"
 
 	// dynamically registered credential managers
 	_ "github.com/concourse/concourse/atc/creds/conjur"
 	_ "github.com/concourse/concourse/atc/creds/credhub"
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/encryptedfile"
+	_ "github.com/concourse/concourse/atc/creds/filesystem"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
@@ -114,6 +122,7 @@ type RunCommand struct {
 	Logger flag.Lager
 
 	varSourcePool creds.VarSourcePool
+	policyChecker *policy.Checker
 
 	BindIP   flag.IP `long:"bind-ip"   default:"0.0.0.0" description:"IP address on which to listen for web traffic."`
 	BindPort uint16  `long:"bind-port" default:"8080"    description:"Port on which to listen for HTTP traffic."`
@@ -146,6 +155,8 @@ type RunCommand struct {
 
 	InterceptIdleTimeout time.Duration `long:"intercept-idle-timeout" default:"0m" description:"Length of time for a intercepted session to be idle before terminating."`
 
+	APITokenMaxLifetime time.Duration `long:"api-token-max-lifetime" default:"2160h" description:"Longest expiry which personal and service account API tokens may be created with."`
+
 	EnableGlobalResources bool `long:"enable-global-resources" description:"Enable equivalent resources across pipelines and teams to share a single version history."`
 
 	ComponentRunnerInterval time.Duration `long:"component-runner-interval" default:"10s" description:"Interval on which runners are kicked off for builds, locks, scans, and checks"`
@@ -165,6 +176,8 @@ type RunCommand struct {
 
 	GardenRequestTimeout time.Duration `long:"garden-request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
 
+	LandingCachesPerInterval int `long:"landing-caches-per-interval" default:"10" description:"Maximum number of resource caches to copy off of landing workers every 30 seconds. 0 disables copying."`
+
 	CLIArtifactsDir flag.Dir `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
 
 	Metrics struct {
@@ -177,7 +190,8 @@ type RunCommand struct {
 	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
 
 	PolicyCheckers struct {
-		Filter policy.Filter
+		Filter  policy.Filter
+		Combine policy.CombineMode `long:"policy-check-combine" default:"all" choice:"all" choice:"any" description:"When multiple policy check agents are configured, whether all of them or any of them must pass."`
 	} `group:"Policy Checking"`
 
 	Server struct {
@@ -193,13 +207,21 @@ type RunCommand struct {
 	GC struct {
 		Interval time.Duration `long:"interval" default:"30s" description:"Interval on which to perform garbage collection."`
 
-		OneOffBuildGracePeriod time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
-		MissingGracePeriod     time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
-		HijackGracePeriod      time.Duration `long:"hijack-grace-period" default:"5m" description:"Period after which hijacked containers will be garbage collected"`
-		FailedGracePeriod      time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
-		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
+		OneOffBuildGracePeriod        time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
+		MissingGracePeriod            time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
+		HijackGracePeriod             time.Duration `long:"hijack-grace-period" default:"5m" description:"Period after which hijacked containers will be garbage collected"`
+		FailedGracePeriod             time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
+		CheckRecyclePeriod            time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
+		PipelineEventRetention        time.Duration `long:"pipeline-event-retention" default:"24h" description:"Period for which pipeline events are kept for event streams to resume from."`
+		NotificationDeliveryRetention time.Duration `long:"notification-delivery-retention" default:"168h" description:"Period for which finished notification deliveries are kept in the delivery log."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
+	Notifications struct {
+		AllowedHosts         []string `long:"allowed-host" description:"Host which pipeline notifications may be sent to. An entry of the form *.example.com matches any subdomain. Can be specified multiple times. When not set, any host is allowed."`
+		AllowPrivateNetworks bool     `long:"allow-private-networks" description:"Allow pipeline notifications to be sent to loopback, private, and link-local addresses."`
+		MaxInFlightPerHost   int      `long:"max-in-flight-per-host" default:"4" description:"Maximum number of pipeline notifications sent to the same host at once."`
+	} `group:"Pipeline Notifications" namespace:"notification"`
+
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
@@ -508,6 +530,9 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	creds.AllowTeamManagerTypes(cmd.CredentialManagement.TeamManagerTypes)
+	creds.SetFileVarSourceRoot(cmd.CredentialManagement.FileVarSourceRoot)
+
 	cmd.varSourcePool = creds.NewVarSourcePool(
 		logger.Session("var-source-pool"),
 		5*time.Minute,
@@ -547,6 +572,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		}
 
 		cmd.varSourcePool.Close()
+
+		if cmd.policyChecker != nil {
+			cmd.policyChecker.Close()
+		}
 	}
 
 	return run(grouper.NewParallel(os.Interrupt, members), onReady, onExit), nil
@@ -572,11 +601,13 @@ func (cmd *RunCommand) constructMembers(
 		}()
 	}
 
-	policyChecker, err := policy.Initialize(logger, cmd.Server.ClusterName, concourse.Version, cmd.PolicyCheckers.Filter)
+	policyChecker, err := policy.Initialize(logger, cmd.Server.ClusterName, concourse.Version, cmd.PolicyCheckers.Filter, cmd.PolicyCheckers.Combine)
 	if err != nil {
 		return nil, err
 	}
 
+	cmd.policyChecker = policyChecker
+
 	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager, policyChecker)
 	if err != nil {
 		return nil, err
@@ -664,6 +695,8 @@ func (cmd *RunCommand) constructAPIMembers(
 	}
 
 	userFactory := db.NewUserFactory(dbConn)
+	apiTokenFactory := db.NewAPITokenFactory(dbConn)
+	sessionFactory := db.NewSessionFactory(dbConn)
 
 	resourceFactory := resource.NewResourceFactory()
 	dbResourceCacheFactory := db.NewResourceCacheFactory(dbConn, lockFactory)
@@ -720,8 +753,20 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, secretManager, cmd.varSourcePool, cmd.GlobalResourceCheckTimeout)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbMaintenance := db.NewMaintenance(dbConn)
 
-	tokenVerifier := cmd.constructTokenVerifier(httpClient)
+	sessionVerifier := accessor.NewSessionVerifier(
+		logger.Session("session-verifier"),
+		cmd.constructTokenVerifier(httpClient),
+		sessionFactory,
+		dbConn.Bus(),
+		time.Minute,
+	)
+
+	tokenVerifier := accessor.NewAPITokenVerifier(
+		sessionVerifier,
+		apiTokenFactory,
+	)
 
 	accessFactory := accessor.NewAccessFactory(
 		cmd.SystemClaimKey,
@@ -745,11 +790,14 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		apiTokenFactory,
+		sessionFactory,
 		workerClient,
 		secretManager,
 		credsManagers,
 		accessFactory,
 		dbWall,
+		dbMaintenance,
 		tokenVerifier,
 		dbConn.Bus(),
 		policyChecker,
@@ -771,6 +819,11 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
+	idTokenHandler, err := cmd.constructIDTokenHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	loginHandler, err := cmd.constructLoginHandler(
 		logger,
 		httpClient,
@@ -822,6 +875,7 @@ func (cmd *RunCommand) constructAPIMembers(
 				externalHost:  cmd.ExternalURL.URL.Host,
 				baseHandler:   legacyHandler,
 			},
+			idTokenHandler,
 			middleware,
 		)
 
@@ -832,6 +886,7 @@ func (cmd *RunCommand) constructAPIMembers(
 			authHandler,
 			loginHandler,
 			legacyHandler,
+			idTokenHandler,
 			middleware,
 		)
 	} else {
@@ -842,6 +897,7 @@ func (cmd *RunCommand) constructAPIMembers(
 			authHandler,
 			loginHandler,
 			legacyHandler,
+			idTokenHandler,
 			middleware,
 		)
 	}
@@ -908,6 +964,7 @@ func (cmd *RunCommand) backendComponents(
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
 	dbCheckableCounter := db.NewCheckableCounter(dbConn)
+	dbMaintenance := db.NewMaintenance(dbConn)
 
 	alg := algorithm.New(db.NewVersionsDB(dbConn, algorithmLimitRows, schedulerCache))
 
@@ -963,6 +1020,11 @@ func (cmd *RunCommand) backendComponents(
 		return nil, err
 	}
 
+	idTokenIssuer, err := cmd.constructIDTokenIssuer()
+	if err != nil {
+		return nil, err
+	}
+
 	engine := cmd.constructEngine(
 		pool,
 		workerClient,
@@ -975,6 +1037,7 @@ func (cmd *RunCommand) backendComponents(
 		defaultLimits,
 		buildContainerStrategy,
 		lockFactory,
+		idTokenIssuer,
 	)
 
 	// In case that a user configures resource-checking-interval, but forgets to
@@ -1036,7 +1099,8 @@ func (cmd *RunCommand) backendComponents(
 						builds.NewPlanner(
 							atc.NewPlanFactory(time.Now().Unix()),
 						),
-						alg),
+						alg,
+						dbMaintenance),
 				},
 				cmd.JobSchedulingMaxInFlight,
 			),
@@ -1065,6 +1129,35 @@ func (cmd *RunCommand) backendComponents(
 				syslogDrainConfigured,
 			),
 		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentLandingDrainer,
+				Interval: 30 * time.Second,
+			},
+			Runnable: landing.NewDrainer(
+				dbBuildFactory,
+				dbWorkerFactory,
+				dbVolumeRepository,
+				workerProvider,
+				compressionLib,
+				cmd.LandingCachesPerInterval,
+			),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentNotifier,
+				Interval: 10 * time.Second,
+			},
+			Runnable: notifier.NewNotifier(
+				logger.Session(atc.ComponentNotifier),
+				db.NewNotificationFactory(dbConn, lockFactory),
+				secretManager,
+				cmd.varSourcePool,
+				notifier.NewHTTPClient(cmd.notificationDestinations(), 30*time.Second),
+				cmd.notificationDestinations(),
+				cmd.Notifications.MaxInFlightPerHost,
+			),
+		},
 	}
 
 	if syslogDrainConfigured {
@@ -1096,23 +1189,29 @@ func (cmd *RunCommand) gcComponents(
 	dbContainerRepository := db.NewContainerRepository(gcConn)
 	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
+	dbPipelineEventLifecycle := db.NewPipelineEventLifecycle(gcConn)
+	dbNotificationDeliveryLifecycle := db.NewNotificationDeliveryLifecycle(gcConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
+	dbSessionFactory := db.NewSessionFactory(gcConn)
 
 	dbVolumeRepository := db.NewVolumeRepository(gcConn)
 
 	collectors := map[string]component.Runnable{
-		atc.ComponentCollectorBuilds:            gc.NewBuildCollector(dbBuildFactory),
-		atc.ComponentCollectorWorkers:           gc.NewWorkerCollector(dbWorkerLifecycle),
-		atc.ComponentCollectorResourceConfigs:   gc.NewResourceConfigCollector(dbResourceConfigFactory),
-		atc.ComponentCollectorResourceCaches:    gc.NewResourceCacheCollector(dbResourceCacheLifecycle),
-		atc.ComponentCollectorResourceCacheUses: gc.NewResourceCacheUseCollector(dbResourceCacheLifecycle),
-		atc.ComponentCollectorArtifacts:         gc.NewArtifactCollector(dbArtifactLifecycle),
-		atc.ComponentCollectorChecks:            gc.NewCheckCollector(dbCheckLifecycle, cmd.GC.CheckRecyclePeriod),
-		atc.ComponentCollectorVolumes:           gc.NewVolumeCollector(dbVolumeRepository, cmd.GC.MissingGracePeriod),
-		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, cmd.GC.MissingGracePeriod, cmd.GC.HijackGracePeriod),
-		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
+		atc.ComponentCollectorBuilds:                 gc.NewBuildCollector(dbBuildFactory),
+		atc.ComponentCollectorWorkers:                gc.NewWorkerCollector(dbWorkerLifecycle),
+		atc.ComponentCollectorResourceConfigs:        gc.NewResourceConfigCollector(dbResourceConfigFactory),
+		atc.ComponentCollectorResourceCaches:         gc.NewResourceCacheCollector(dbResourceCacheLifecycle),
+		atc.ComponentCollectorResourceCacheUses:      gc.NewResourceCacheUseCollector(dbResourceCacheLifecycle),
+		atc.ComponentCollectorArtifacts:              gc.NewArtifactCollector(dbArtifactLifecycle),
+		atc.ComponentCollectorChecks:                 gc.NewCheckCollector(dbCheckLifecycle, cmd.GC.CheckRecyclePeriod),
+		atc.ComponentCollectorVolumes:                gc.NewVolumeCollector(dbVolumeRepository, cmd.GC.MissingGracePeriod),
+		atc.ComponentCollectorContainers:             gc.NewContainerCollector(dbContainerRepository, cmd.GC.MissingGracePeriod, cmd.GC.HijackGracePeriod),
+		atc.ComponentCollectorCheckSessions:          gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
+		atc.ComponentCollectorSessions:               gc.NewSessionCollector(dbSessionFactory),
+		atc.ComponentCollectorPipelineEvents:         gc.NewPipelineEventCollector(dbPipelineEventLifecycle, cmd.GC.PipelineEventRetention),
+		atc.ComponentCollectorNotificationDeliveries: gc.NewNotificationDeliveryCollector(dbNotificationDeliveryLifecycle, cmd.GC.NotificationDeliveryRetention),
 	}
 
 	var components []RunnableComponent
@@ -1560,6 +1659,7 @@ func (cmd *RunCommand) constructEngine(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	idTokenIssuer exec.IDTokenIssuer,
 ) engine.Engine {
 
 	stepFactory := builder.NewStepFactory(
@@ -1573,6 +1673,7 @@ func (cmd *RunCommand) constructEngine(
 		defaultLimits,
 		strategy,
 		lockFactory,
+		idTokenIssuer,
 		cmd.EnableBuildRerunWhenWorkerDisappears,
 	)
 
@@ -1595,6 +1696,7 @@ func (cmd *RunCommand) constructHTTPHandler(
 	authHandler http.Handler,
 	loginHandler http.Handler,
 	legacyHandler http.Handler,
+	idTokenHandler http.Handler,
 	middleware token.Middleware,
 ) http.Handler {
 
@@ -1607,6 +1709,7 @@ func (cmd *RunCommand) constructHTTPHandler(
 	webMux.Handle("/api/v1/", csrfHandler)
 	webMux.Handle("/sky/issuer/", authHandler)
 	webMux.Handle("/sky/", loginHandler)
+	webMux.Handle("/.well-known/", idTokenHandler)
 	webMux.Handle("/auth/", legacyHandler)
 	webMux.Handle("/login", legacyHandler)
 	webMux.Handle("/logout", legacyHandler)
@@ -1716,12 +1819,35 @@ func (cmd *RunCommand) constructTokenVerifier(httpClient *http.Client) accessor.
 	publicKeyPath, _ := url.Parse("/sky/issuer/keys")
 	publicKeyURL := cmd.ExternalURL.URL.ResolveReference(publicKeyPath)
 
+	return accessor.NewVerifier(httpClient, publicKeyURL, cmd.validClients())
+}
+
+func (cmd *RunCommand) validClients() []string {
 	validClients := []string{flyClientID}
 	for clientId, _ := range cmd.Auth.AuthFlags.Clients {
 		validClients = append(validClients, clientId)
 	}
+	return validClients
+}
+
+// constructIDTokenIssuer returns the issuer of the identity tokens requested by
+// builds. Tokens are signed with the same key as the tokens issued to users,
+// and cannot be issued for the audience of any client of the ATC itself.
+func (cmd *RunCommand) constructIDTokenIssuer() (*idtoken.Issuer, error) {
+	return idtoken.NewIssuer(
+		cmd.ExternalURL.String(),
+		cmd.Auth.AuthFlags.SigningKey.PrivateKey,
+		cmd.validClients(),
+	)
+}
+
+func (cmd *RunCommand) constructIDTokenHandler() (http.Handler, error) {
+	issuer, err := cmd.constructIDTokenIssuer()
+	if err != nil {
+		return nil, err
+	}
 
-	return accessor.NewVerifier(httpClient, publicKeyURL, validClients)
+	return idtoken.NewHandler(issuer), nil
 }
 
 func (cmd *RunCommand) constructAPIHandler(
@@ -1739,11 +1865,14 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbAPITokenFactory db.APITokenFactory,
+	dbSessionFactory db.SessionFactory,
 	workerClient worker.Client,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	dbMaintenance db.Maintenance,
 	tokenVerifier accessor.TokenVerifier,
 	notifications db.NotificationsBus,
 	policyChecker *policy.Checker,
@@ -1784,18 +1913,29 @@ func (cmd *RunCommand) constructAPIHandler(
 		time.Minute,
 	)
 
+	infoCacher := infoserver.NewCacher(
+		logger,
+		notifications,
+		dbWall,
+		dbMaintenance,
+		clock.NewClock(),
+		time.Minute,
+	)
+
 	customRoles, err := cmd.parseCustomRoles()
 	if err != nil {
 		return nil, err
 	}
 
+	apiPolicyChecker := policychecker.NewApiPolicyChecker(policyChecker)
+
 	apiWrapper := wrappa.MultiWrappa{
 		wrappa.NewConcurrentRequestLimitsWrappa(
 			logger,
 			wrappa.NewConcurrentRequestPolicy(cmd.ConcurrentRequestLimits),
 		),
 		wrappa.NewAPIMetricsWrappa(logger),
-		wrappa.NewPolicyCheckWrappa(logger, policychecker.NewApiPolicyChecker(policyChecker)),
+		wrappa.NewPolicyCheckWrappa(logger, apiPolicyChecker),
 		wrappa.NewAPIAuthWrappa(
 			checkPipelineAccessHandlerFactory,
 			checkBuildReadAccessHandlerFactory,
@@ -1834,6 +1974,8 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		dbAPITokenFactory,
+		dbSessionFactory,
 
 		buildserver.NewEventHandler,
 
@@ -1851,7 +1993,11 @@ func (cmd *RunCommand) constructAPIHandler(
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
+		cmd.APITokenMaxLifetime,
 		dbWall,
+		dbMaintenance,
+		infoCacher,
+		apiPolicyChecker,
 		clock.NewClock(),
 
 		cmd.EnableArchivePipeline,
@@ -1883,6 +2029,13 @@ func (cmd *RunCommand) isTLSEnabled() bool {
 	return cmd.TLSBindPort != 0
 }
 
+func (cmd *RunCommand) notificationDestinations() notifier.Destinations {
+	return notifier.Destinations{
+		AllowedHosts:         cmd.Notifications.AllowedHosts,
+		AllowPrivateNetworks: cmd.Notifications.AllowPrivateNetworks,
+	}
+}
+
 type drainRunner struct {
 	logger  lager.Logger
 	drainer component.Drainable
@@ -58,6 +58,7 @@ func (a *auditor) ValidateAction(action string) bool {
 	case atc.GetBuild,
 		atc.GetBuildPlan,
 		atc.CreateBuild,
+		atc.CreateJobPlan,
 		atc.RerunJobBuild,
 		atc.ListBuilds,
 		atc.BuildEvents,
@@ -73,6 +74,8 @@ func (a *auditor) ValidateAction(action string) bool {
 	case atc.ListContainers,
 		atc.GetContainer,
 		atc.HijackContainer,
+		atc.StreamInContainer,
+		atc.StreamOutContainer,
 		atc.ListDestroyingContainers,
 		atc.ReportWorkerContainers:
 		return a.EnableContainerAuditLog
@@ -82,6 +85,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListJobs,
 		atc.ListJobBuilds,
 		atc.ListJobInputs,
+		atc.ListJobStats,
 		atc.GetJobBuild,
 		atc.PauseJob,
 		atc.UnpauseJob,
@@ -102,7 +106,9 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenamePipeline,
 		atc.ListPipelineBuilds,
 		atc.CreatePipelineBuild,
-		atc.PipelineBadge:
+		atc.PipelineBadge,
+		atc.PipelineEvents,
+		atc.ListNotificationDeliveries:
 		return a.EnablePipelineAuditLog
 	case atc.ListAllResources,
 		atc.ListResources,
@@ -124,6 +130,7 @@ func (a *auditor) ValidateAction(action string) bool {
 	case
 		atc.SaveConfig,
 		atc.GetConfig,
+		atc.CheckConfigCreds,
 		atc.GetCC,
 		atc.GetVersionsDB,
 		atc.ClearTaskCache,
@@ -134,16 +141,33 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetInfoCreds,
 		atc.ListActiveUsersSince,
 		atc.GetUser,
+		atc.ListSessions,
+		atc.RevokeSession,
+		atc.RevokeCurrentSession,
+		atc.RevokeUserSessions,
 		atc.GetWall,
 		atc.SetWall,
-		atc.ClearWall:
+		atc.ClearWall,
+		atc.ListWallMessages,
+		atc.CreateWallMessage,
+		atc.DeleteWallMessage,
+		atc.GetMaintenance,
+		atc.EnableMaintenance,
+		atc.DisableMaintenance:
 		return a.EnableSystemAuditLog
 	case atc.ListTeams,
 		atc.SetTeam,
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
-		atc.GetTeam:
+		atc.TeamEvents,
+		atc.GetTeam,
+		atc.ExplainTeamAuth,
+		atc.ExportTeam,
+		atc.ImportTeam,
+		atc.ListAPITokens,
+		atc.CreateAPIToken,
+		atc.DeleteAPIToken:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
 		atc.LandWorker,
@@ -37,6 +37,44 @@ func (planner Planner) Create(
 	return visitor.plan, nil
 }
 
+// OneOffOptions configures how a job's steps are planned when they are run as
+// a one-off build rather than scheduled in a pipeline.
+type OneOffOptions struct {
+	// Artifacts maps the names of get steps to artifacts which are used in
+	// their place, e.g. local directories uploaded by fly.
+	Artifacts map[string]int
+
+	// SkipPuts replaces put steps, and the implicit get after them, with a
+	// no-op.
+	SkipPuts bool
+}
+
+func (planner Planner) CreateOneOff(
+	planConfig atc.StepConfig,
+	resources db.SchedulerResources,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+	options OneOffOptions,
+) (atc.Plan, error) {
+	visitor := &planVisitor{
+		planFactory: planner.planFactory,
+
+		resources:     resources,
+		resourceTypes: resourceTypes,
+		inputs:        inputs,
+
+		artifacts: options.Artifacts,
+		skipPuts:  options.SkipPuts,
+	}
+
+	err := planConfig.Visit(visitor)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	return visitor.plan, nil
+}
+
 type planVisitor struct {
 	planFactory atc.PlanFactory
 
@@ -44,6 +82,9 @@ type planVisitor struct {
 	resourceTypes atc.VersionedResourceTypes
 	inputs        []db.BuildInput
 
+	artifacts map[string]int
+	skipPuts  bool
+
 	plan atc.Plan
 }
 
@@ -59,6 +100,7 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		InputMapping:      step.InputMapping,
 		OutputMapping:     step.OutputMapping,
 		ImageArtifactName: step.ImageArtifactName,
+		IDToken:           step.IDToken,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -67,6 +109,15 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 }
 
 func (visitor *planVisitor) VisitGet(step *atc.GetStep) error {
+	if artifactID, found := visitor.artifacts[step.Name]; found {
+		visitor.plan = visitor.planFactory.NewPlan(atc.ArtifactInputPlan{
+			ArtifactID: artifactID,
+			Name:       step.Name,
+		})
+
+		return nil
+	}
+
 	resourceName := step.Resource
 	if resourceName == "" {
 		resourceName = step.Name
@@ -106,6 +157,11 @@ func (visitor *planVisitor) VisitGet(step *atc.GetStep) error {
 }
 
 func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
+	if visitor.skipPuts {
+		visitor.plan = visitor.planFactory.NewPlan(atc.DoPlan{})
+		return nil
+	}
+
 	logicalName := step.Name
 
 	resourceName := step.Resource
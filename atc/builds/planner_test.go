@@ -27,6 +27,7 @@ type PlannerTest struct {
 
 	Config atc.StepConfig
 	Inputs []db.BuildInput
+	OneOff *builds.OneOffOptions
 
 	CompareIDs bool
 	PlanJSON   string
@@ -105,6 +106,48 @@ var factoryTests = []PlannerTest{
 		},
 		Err: builds.VersionNotProvidedError{Input: "some-name"},
 	},
+	{
+		Title: "one-off get step with an artifact",
+		Config: &atc.GetStep{
+			Name:     "some-name",
+			Resource: "some-resource",
+		},
+		OneOff: &builds.OneOffOptions{
+			Artifacts: map[string]int{"some-name": 42},
+		},
+		PlanJSON: `{
+			"id": "(unique)",
+			"artifact_input": {
+				"artifact_id": 42,
+				"name": "some-name"
+			}
+		}`,
+	},
+	{
+		Title: "one-off get step without an artifact",
+		Config: &atc.GetStep{
+			Name:     "some-name",
+			Resource: "some-resource",
+		},
+		OneOff: &builds.OneOffOptions{
+			Artifacts: map[string]int{"some-other-name": 42},
+		},
+		Err: builds.VersionNotProvidedError{Input: "some-name"},
+	},
+	{
+		Title: "one-off put step when skipping puts",
+		Config: &atc.PutStep{
+			Name:     "some-name",
+			Resource: "some-resource",
+		},
+		OneOff: &builds.OneOffOptions{
+			SkipPuts: true,
+		},
+		PlanJSON: `{
+			"id": "(unique)",
+			"do": []
+		}`,
+	},
 	{
 		Title: "put step",
 		Config: &atc.PutStep{
@@ -658,7 +701,13 @@ var factoryTests = []PlannerTest{
 func (test PlannerTest) Run(s *PlannerSuite) {
 	factory := builds.NewPlanner(atc.NewPlanFactory(0))
 
-	actualPlan, actualErr := factory.Create(test.Config, resources, resourceTypes, test.Inputs)
+	var actualPlan atc.Plan
+	var actualErr error
+	if test.OneOff != nil {
+		actualPlan, actualErr = factory.CreateOneOff(test.Config, resources, resourceTypes, test.Inputs, *test.OneOff)
+	} else {
+		actualPlan, actualErr = factory.Create(test.Config, resources, resourceTypes, test.Inputs)
+	}
 
 	if test.Err != nil {
 		s.Equal(test.Err, actualErr)
@@ -3,4 +3,9 @@ package atc
 const (
 	TeamCacheName    = "teams"
 	TeamCacheChannel = "team_cache"
+
+	WallCacheChannel        = "wall_cache"
+	MaintenanceCacheChannel = "maintenance_cache"
+
+	SessionRevocationChannel = "session_revocation"
 )
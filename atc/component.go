@@ -3,22 +3,27 @@ package atc
 import "time"
 
 const (
-	ComponentScheduler                  = "scheduler"
-	ComponentBuildTracker               = "tracker"
-	ComponentLidarScanner               = "scanner"
-	ComponentLidarChecker               = "checker"
-	ComponentBuildReaper                = "reaper"
-	ComponentSyslogDrainer              = "drainer"
-	ComponentCollectorArtifacts         = "collector_artifacts"
-	ComponentCollectorBuilds            = "collector_builds"
-	ComponentCollectorCheckSessions     = "collector_check_sessions"
-	ComponentCollectorChecks            = "collector_checks"
-	ComponentCollectorContainers        = "collector_containers"
-	ComponentCollectorResourceCacheUses = "collector_resource_cache_uses"
-	ComponentCollectorResourceCaches    = "collector_resource_caches"
-	ComponentCollectorResourceConfigs   = "collector_resource_configs"
-	ComponentCollectorVolumes           = "collector_volumes"
-	ComponentCollectorWorkers           = "collector_workers"
+	ComponentScheduler                       = "scheduler"
+	ComponentBuildTracker                    = "tracker"
+	ComponentLidarScanner                    = "scanner"
+	ComponentLidarChecker                    = "checker"
+	ComponentBuildReaper                     = "reaper"
+	ComponentSyslogDrainer                   = "drainer"
+	ComponentLandingDrainer                  = "landing_drainer"
+	ComponentNotifier                        = "notifier"
+	ComponentCollectorArtifacts              = "collector_artifacts"
+	ComponentCollectorBuilds                 = "collector_builds"
+	ComponentCollectorCheckSessions          = "collector_check_sessions"
+	ComponentCollectorChecks                 = "collector_checks"
+	ComponentCollectorContainers             = "collector_containers"
+	ComponentCollectorNotificationDeliveries = "collector_notification_deliveries"
+	ComponentCollectorPipelineEvents         = "collector_pipeline_events"
+	ComponentCollectorResourceCacheUses      = "collector_resource_cache_uses"
+	ComponentCollectorResourceCaches         = "collector_resource_caches"
+	ComponentCollectorResourceConfigs        = "collector_resource_configs"
+	ComponentCollectorSessions               = "collector_sessions"
+	ComponentCollectorVolumes                = "collector_volumes"
+	ComponentCollectorWorkers                = "collector_workers"
 )
 
 type Component struct {
@@ -17,11 +17,12 @@ const DefaultTeamName = "main"
 type Tags []string
 
 type Config struct {
-	Groups        GroupConfigs     `json:"groups,omitempty"`
-	VarSources    VarSourceConfigs `json:"var_sources,omitempty"`
-	Resources     ResourceConfigs  `json:"resources,omitempty"`
-	ResourceTypes ResourceTypes    `json:"resource_types,omitempty"`
-	Jobs          JobConfigs       `json:"jobs,omitempty"`
+	Groups        GroupConfigs        `json:"groups,omitempty"`
+	VarSources    VarSourceConfigs    `json:"var_sources,omitempty"`
+	Resources     ResourceConfigs     `json:"resources,omitempty"`
+	ResourceTypes ResourceTypes       `json:"resource_types,omitempty"`
+	Jobs          JobConfigs          `json:"jobs,omitempty"`
+	Notifications NotificationConfigs `json:"notifications,omitempty"`
 }
 
 func UnmarshalConfig(payload []byte, config interface{}) error {
@@ -32,6 +33,7 @@ func UnmarshalConfig(payload []byte, config interface{}) error {
 		Resources     interface{} `json:"resources,omitempty"`
 		ResourceTypes interface{} `json:"resource_types,omitempty"`
 		Jobs          interface{} `json:"jobs,omitempty"`
+		Notifications interface{} `json:"notifications,omitempty"`
 	}
 
 	var stripped skeletonConfig
@@ -171,16 +173,17 @@ func (c VarSourceConfigs) OrderByDependency() (VarSourceConfigs, error) {
 }
 
 type ResourceConfig struct {
-	Name         string  `json:"name"`
-	Public       bool    `json:"public,omitempty"`
-	WebhookToken string  `json:"webhook_token,omitempty"`
-	Type         string  `json:"type"`
-	Source       Source  `json:"source"`
-	CheckEvery   string  `json:"check_every,omitempty"`
-	CheckTimeout string  `json:"check_timeout,omitempty"`
-	Tags         Tags    `json:"tags,omitempty"`
-	Version      Version `json:"version,omitempty"`
-	Icon         string  `json:"icon,omitempty"`
+	Name           string                `json:"name"`
+	Public         bool                  `json:"public,omitempty"`
+	WebhookToken   string                `json:"webhook_token,omitempty"`
+	WebhookVersion *WebhookVersionConfig `json:"webhook_version,omitempty"`
+	Type           string                `json:"type"`
+	Source         Source                `json:"source"`
+	CheckEvery     string                `json:"check_every,omitempty"`
+	CheckTimeout   string                `json:"check_timeout,omitempty"`
+	Tags           Tags                  `json:"tags,omitempty"`
+	Version        Version               `json:"version,omitempty"`
+	Icon           string                `json:"icon,omitempty"`
 }
 
 type ResourceType struct {
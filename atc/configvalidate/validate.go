@@ -3,7 +3,11 @@ package configvalidate
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path"
+	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/atc"
@@ -45,6 +49,11 @@ func Validate(c Config) ([]ConfigWarning, []string) {
 		errorMessages = append(errorMessages, formatErr("variable sources", varSourcesErr))
 	}
 
+	notificationsErr := validateNotifications(c)
+	if notificationsErr != nil {
+		errorMessages = append(errorMessages, formatErr("notifications", notificationsErr))
+	}
+
 	jobWarnings, jobsErr := validateJobs(c)
 	if jobsErr != nil {
 		errorMessages = append(errorMessages, formatErr("jobs", jobsErr))
@@ -139,6 +148,10 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resource.WebhookVersion != nil {
+			errorMessages = append(errorMessages, validateWebhookVersion(identifier, resource)...)
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -146,6 +159,39 @@ func validateResources(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateWebhookVersion(identifier string, resource ResourceConfig) []string {
+	var errorMessages []string
+
+	if resource.WebhookToken == "" {
+		errorMessages = append(errorMessages, identifier+" has a webhook_version but no webhook_token")
+	}
+
+	if resource.WebhookVersion.Secret == "" {
+		errorMessages = append(errorMessages, identifier+".webhook_version has no secret")
+	}
+
+	if len(resource.WebhookVersion.Version) == 0 {
+		errorMessages = append(errorMessages, identifier+".webhook_version has no version fields")
+	}
+
+	fields := make([]string, 0, len(resource.WebhookVersion.Version))
+	for field := range resource.WebhookVersion.Version {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		_, err := template.New(field).Parse(resource.WebhookVersion.Version[field])
+		if err != nil {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf("%s.webhook_version.version.%s has an invalid template: %s", identifier, field, err))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateResourceTypes(c Config) error {
 	var errorMessages []string
 
@@ -315,11 +361,7 @@ func validateVarSources(c Config) error {
 			return fmt.Errorf("unknown credential manager type: %s", cm.Type)
 		}
 
-		// TODO: this check should eventually be removed once all credential managers
-		// are supported in pipeline. - @evanchaoli
-		switch cm.Type {
-		case "vault", "dummy", "ssm":
-		default:
+		if !supportedVarSourceType(cm.Type) {
 			return fmt.Errorf("credential manager type %s is not supported in pipeline yet", cm.Type)
 		}
 
@@ -344,3 +386,116 @@ func validateVarSources(c Config) error {
 
 	return nil
 }
+
+func validateNotifications(c Config) error {
+	errorMessages := []string{}
+
+	names := map[string]bool{}
+	for i, notification := range c.Notifications {
+		var identifier string
+		if notification.Name == "" {
+			identifier = fmt.Sprintf("notifications[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("notifications.%s", notification.Name)
+		}
+
+		if notification.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		} else if names[notification.Name] {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s appears multiple times", identifier))
+		}
+		names[notification.Name] = true
+
+		if notification.URL == "" {
+			errorMessages = append(errorMessages, identifier+" has no url")
+		} else if !strings.Contains(notification.URL, "((") {
+			// urls with vars can only be checked once they are resolved
+			u, err := url.Parse(notification.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid url; it must be an http or https url", identifier))
+			}
+		}
+
+		for _, eventType := range notification.Events {
+			if !validPipelineEventType(eventType) {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an unknown event type '%s'", identifier, eventType))
+			}
+		}
+
+		for _, pattern := range notification.Jobs {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid job pattern '%s'", identifier, pattern))
+				continue
+			}
+
+			if !strings.ContainsAny(pattern, "*?[") {
+				if _, found := c.Jobs.Lookup(pattern); !found {
+					errorMessages = append(errorMessages, fmt.Sprintf("%s refers to a job '%s' that does not exist", identifier, pattern))
+				}
+			}
+		}
+
+		for _, status := range notification.Statuses {
+			switch status {
+			case StatusPending, StatusStarted, StatusSucceeded, StatusFailed, StatusErrored, StatusAborted:
+			default:
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an unknown build status '%s'", identifier, status))
+			}
+		}
+
+		if notification.Template != "" {
+			_, err := template.New(notification.Name).Parse(notification.Template)
+			if err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid template: %s", identifier, err))
+			}
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
+func validPipelineEventType(eventType PipelineEventType) bool {
+	for _, t := range PipelineEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateTeamCredentialManager checks a team's credential manager config.
+// Teams may only use the types allowed by the operator.
+func ValidateTeamCredentialManager(cm atc.TeamCredentialManager) error {
+	factory := creds.ManagerFactories()[cm.Type]
+	if factory == nil {
+		return fmt.Errorf("unknown credential manager type: %s", cm.Type)
+	}
+
+	if !creds.IsTeamManagerTypeAllowed(cm.Type) {
+		return fmt.Errorf("credential manager type %s is not allowed for teams", cm.Type)
+	}
+
+	manager, err := factory.NewInstance(cm.Config)
+	if err != nil {
+		return fmt.Errorf("failed to create credential manager: %s", err.Error())
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		return fmt.Errorf("credential manager is invalid: %s", err.Error())
+	}
+
+	return nil
+}
+
+// TODO: this check should eventually be removed once all credential managers
+// are supported in pipeline. - @evanchaoli
+func supportedVarSourceType(managerType string) bool {
+	switch managerType {
+	case "vault", "dummy", "ssm", "filesystem", "encryptedfile":
+		return true
+	default:
+		return false
+	}
+}
@@ -1,13 +1,17 @@
 package configvalidate_test
 
 import (
+	"io/ioutil"
+	"os"
 	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/atc/creds"
 
 	// load dummy credential manager
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/filesystem"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -219,6 +223,34 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a filesystem var source reads from outside the var source root", func() {
+			var root string
+
+			BeforeEach(func() {
+				var err error
+				root, err = ioutil.TempDir("", "var-source-root")
+				Expect(err).ToNot(HaveOccurred())
+
+				creds.SetFileVarSourceRoot(root)
+
+				config.VarSources = append(config.VarSources, atc.VarSourceConfig{
+					Name:   "some",
+					Type:   "filesystem",
+					Config: map[string]interface{}{"path": "/etc"},
+				})
+			})
+
+			AfterEach(func() {
+				creds.SetFileVarSourceRoot("")
+				Expect(os.RemoveAll(root)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("outside of the var source root"))
+			})
+		})
+
 		Context("when config is invalid", func() {
 			BeforeEach(func() {
 				config.VarSources = append(config.VarSources, atc.VarSourceConfig{
@@ -327,6 +359,89 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("notifications", func() {
+		BeforeEach(func() {
+			config.Notifications = atc.NotificationConfigs{
+				{
+					Name:     "chat",
+					URL:      "https://chat.example.com/hooks/((hook-token))",
+					Events:   []atc.PipelineEventType{atc.PipelineEventBuildStatus},
+					Jobs:     []string{"some-job", "some-*"},
+					Statuses: []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+					Template: `{"text": "{{.JobName}} {{.Build.Status}}"}`,
+				},
+			}
+		})
+
+		It("returns no errors for valid notifications", func() {
+			Expect(errorMessages).To(BeEmpty())
+		})
+
+		Context("when a notification has no name or url", func() {
+			BeforeEach(func() {
+				config.Notifications = append(config.Notifications, atc.NotificationConfig{})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid notifications:"))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications[1] has no name"))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications[1] has no url"))
+			})
+		})
+
+		Context("when notification names are duplicated", func() {
+			BeforeEach(func() {
+				config.Notifications = append(config.Notifications, atc.NotificationConfig{
+					Name: "chat",
+					URL:  "https://other.example.com",
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.chat appears multiple times"))
+			})
+		})
+
+		Context("when a notification's url is not http", func() {
+			BeforeEach(func() {
+				config.Notifications[0].URL = "ftp://chat.example.com"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.chat has an invalid url"))
+			})
+		})
+
+		Context("when a notification has an unknown event type, status, or job", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Events = append(config.Notifications[0].Events, "build-exploded")
+				config.Notifications[0].Statuses = append(config.Notifications[0].Statuses, "exploded")
+				config.Notifications[0].Jobs = append(config.Notifications[0].Jobs, "bogus-job")
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.chat has an unknown event type 'build-exploded'"))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.chat has an unknown build status 'exploded'"))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.chat refers to a job 'bogus-job' that does not exist"))
+			})
+		})
+
+		Context("when a notification's template does not parse", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Template = "{{.JobName"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.chat has an invalid template"))
+			})
+		})
+	})
+
 	Describe("invalid resources", func() {
 		Context("when a resource has no name", func() {
 			BeforeEach(func() {
@@ -373,6 +488,65 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource has a webhook_version", func() {
+			BeforeEach(func() {
+				config.Resources[0].WebhookToken = "some-token"
+				config.Resources[0].WebhookVersion = &atc.WebhookVersionConfig{
+					Version: map[string]string{"ref": "{{.after}}"},
+					Secret:  "((webhook-secret))",
+				}
+			})
+
+			It("returns no error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+
+			Context("without a webhook_token", func() {
+				BeforeEach(func() {
+					config.Resources[0].WebhookToken = ""
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has a webhook_version but no webhook_token"))
+				})
+			})
+
+			Context("without a secret", func() {
+				BeforeEach(func() {
+					config.Resources[0].WebhookVersion.Secret = ""
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook_version has no secret"))
+				})
+			})
+
+			Context("without version fields", func() {
+				BeforeEach(func() {
+					config.Resources[0].WebhookVersion.Version = nil
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook_version has no version fields"))
+				})
+			})
+
+			Context("with an invalid template", func() {
+				BeforeEach(func() {
+					config.Resources[0].WebhookVersion.Version["ref"] = "{{.after"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook_version.version.ref has an invalid template"))
+				})
+			})
+		})
+
 		Context("when two resources have the same name", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, config.Resources...)
@@ -813,6 +987,30 @@ var _ = Describe("ValidateConfig", func() {
 		})
 
 		Describe("plans", func() {
+			Context("when a task plan requests an invalid id token", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.TaskStep{
+							Name:       "lol",
+							ConfigPath: "task.yml",
+							IDToken: &atc.IDTokenConfig{
+								Expiry: "forever",
+								File:   "../token",
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(lol).id_token: must specify an `audience:`"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(lol).id_token: invalid expiry 'forever'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(lol).id_token: file '../token' must be relative to the task's working directory"))
+				})
+			})
+
 			Context("when a task plan has neither a config or a path set", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -0,0 +1,110 @@
+package encryptedfile_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/encryptedfile"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptedFile", func() {
+	var (
+		logger *lagertest.TestLogger
+		dir    string
+		path   string
+		key    *encryption.Key
+		vs     vars.Variables
+	)
+
+	writeSecrets := func(secrets map[string]interface{}) {
+		content, err := encryptedfile.Encrypt(key, secrets)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(path, content, 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		var err error
+		dir, err = ioutil.TempDir("", "encrypted-file-creds")
+		Expect(err).ToNot(HaveOccurred())
+
+		path = filepath.Join(dir, "secrets.yml")
+
+		block, err := aes.NewCipher([]byte("AES256Key-32Characters1234567890"))
+		Expect(err).ToNot(HaveOccurred())
+		aesgcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+		key = encryption.NewKey(aesgcm)
+
+		writeSecrets(map[string]interface{}{
+			"some-team/some-pipeline/some-secret": "pipeline-value",
+			"some-team/other-secret":              map[string]interface{}{"username": "some-user"},
+		})
+
+		file := encryptedfile.NewFile(path, key)
+		vs = creds.NewVariables(encryptedfile.NewSecretsFactory(logger, file).NewSecrets(), "some-team", "some-pipeline", false)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("finds pipeline-scoped secrets", func() {
+		value, found, err := vs.Get(vars.VariableDefinition{Name: "some-secret"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("pipeline-value"))
+	})
+
+	It("finds fields of team-scoped secrets", func() {
+		value, found, err := vs.Get(vars.VariableDefinition{Name: "other-secret"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal(map[string]interface{}{"username": "some-user"}))
+	})
+
+	It("reloads the file when it changes", func() {
+		_, found, err := vs.Get(vars.VariableDefinition{Name: "new-secret"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		writeSecrets(map[string]interface{}{
+			"some-team/new-secret": "new-value",
+		})
+		future := time.Now().Add(time.Minute)
+		Expect(os.Chtimes(path, future, future)).To(Succeed())
+
+		value, found, err := vs.Get(vars.VariableDefinition{Name: "new-secret"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("new-value"))
+	})
+
+	Context("when the file is encrypted with a different key", func() {
+		BeforeEach(func() {
+			block, err := aes.NewCipher([]byte("some-other-key-with-32-characters"[:32]))
+			Expect(err).ToNot(HaveOccurred())
+			aesgcm, err := cipher.NewGCM(block)
+			Expect(err).ToNot(HaveOccurred())
+
+			file := encryptedfile.NewFile(path, encryption.NewKey(aesgcm))
+			vs = creds.NewVariables(encryptedfile.NewSecretsFactory(logger, file).NewSecrets(), "some-team", "some-pipeline", false)
+		})
+
+		It("returns an error", func() {
+			_, _, err := vs.Get(vars.VariableDefinition{Name: "some-secret"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
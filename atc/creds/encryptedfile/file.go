@@ -0,0 +1,124 @@
+package encryptedfile
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"sigs.k8s.io/yaml"
+)
+
+// Envelope is the on-disk format of an encrypted secrets file. Both fields are
+// hex-encoded, as produced by encryption.Key.
+//
+// The decrypted plaintext is a YAML or JSON object mapping secret paths such
+// as 'main/my-pipeline/password' to their values.
+type Envelope struct {
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// Encrypt produces the contents of an encrypted secrets file for the given
+// secrets.
+func Encrypt(key *encryption.Key, secrets map[string]interface{}) ([]byte, error) {
+	plaintext, err := yaml.Marshal(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, nonce, err := key.Encrypt(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(Envelope{
+		Nonce:      *nonce,
+		Ciphertext: ciphertext,
+	})
+}
+
+// File holds the decrypted contents of an encrypted secrets file, and reloads
+// them whenever the file's modification time changes.
+type File struct {
+	path string
+	key  *encryption.Key
+
+	lock    sync.RWMutex
+	secrets map[string]interface{}
+	modTime time.Time
+}
+
+func NewFile(path string, key *encryption.Key) *File {
+	return &File{
+		path:    path,
+		key:     key,
+		secrets: map[string]interface{}{},
+	}
+}
+
+// Get returns the secret stored at the given path, reloading the file first
+// if it has changed since it was last read.
+func (file *File) Get(logger lager.Logger, secretPath string) (interface{}, bool, error) {
+	err := file.Load(logger)
+	if err != nil {
+		return nil, false, err
+	}
+
+	file.lock.RLock()
+	defer file.lock.RUnlock()
+
+	value, found := file.secrets[strings.TrimPrefix(secretPath, "/")]
+	return value, found, nil
+}
+
+// Load decrypts the file if it has been modified since it was last loaded.
+func (file *File) Load(logger lager.Logger) error {
+	info, err := os.Stat(file.path)
+	if err != nil {
+		return err
+	}
+
+	file.lock.RLock()
+	unchanged := info.ModTime().Equal(file.modTime)
+	file.lock.RUnlock()
+
+	if unchanged {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(file.path)
+	if err != nil {
+		return err
+	}
+
+	var envelope Envelope
+	err = yaml.Unmarshal(content, &envelope)
+	if err != nil {
+		return fmt.Errorf("malformed secrets file: %s", err)
+	}
+
+	plaintext, err := file.key.Decrypt(envelope.Ciphertext, &envelope.Nonce)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt secrets file: %s", err)
+	}
+
+	secrets := map[string]interface{}{}
+	err = yaml.Unmarshal(plaintext, &secrets)
+	if err != nil {
+		return fmt.Errorf("malformed secrets: %s", err)
+	}
+
+	file.lock.Lock()
+	file.secrets = secrets
+	file.modTime = info.ModTime()
+	file.lock.Unlock()
+
+	logger.Info("reloaded", lager.Data{"path": file.path, "secrets": len(secrets)})
+
+	return nil
+}
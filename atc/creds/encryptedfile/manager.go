@@ -0,0 +1,139 @@
+package encryptedfile
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db/encryption"
+)
+
+type Manager struct {
+	Path string `mapstructure:"path" long:"path" description:"Path to an encrypted YAML or JSON file containing secrets keyed by TEAM/PIPELINE/VAR, TEAM/VAR or VAR."`
+	Key  string `mapstructure:"key" long:"key" description:"A 16, 24 or 32 length key used to decrypt the secrets file."`
+
+	// confined is set when the manager comes from a var_source or a team's
+	// config rather than ATC flags
+	confined bool
+
+	file *File
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"path":   manager.Path,
+		"health": health,
+	})
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	if manager.confined {
+		path, err := creds.ResolveFileVarSourcePath(manager.Path)
+		if err != nil {
+			return err
+		}
+
+		manager.Path = path
+	}
+
+	key, err := NewKey(manager.Key)
+	if err != nil {
+		return err
+	}
+
+	manager.file = NewFile(manager.Path, key)
+
+	return manager.file.Load(log)
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Path != ""
+}
+
+func (manager *Manager) Validate() error {
+	if manager.Key == "" {
+		return errors.New("must provide a key to decrypt the secrets file")
+	}
+
+	_, err := NewKey(manager.Key)
+	if err != nil {
+		return err
+	}
+
+	path := manager.Path
+	if manager.confined {
+		// without a root, as when validating in fly, the path is left to be
+		// checked by Init
+		if creds.FileVarSourceRoot() == "" {
+			return nil
+		}
+
+		path, err = creds.ResolveFileVarSourcePath(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("invalid path: %s", err)
+	}
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "stat",
+	}
+
+	_, err := os.Stat(manager.Path)
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status": "UP",
+	}
+
+	return health, nil
+}
+
+func (manager *Manager) NewSecretsFactory(log lager.Logger) (creds.SecretsFactory, error) {
+	if manager.file == nil {
+		return nil, errors.New("encrypted file credential manager is not initialized")
+	}
+
+	return NewSecretsFactory(log, manager.file), nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+}
+
+// NewKey returns the AES-GCM key for a 16, 24 or 32 byte string, selecting
+// AES-128, AES-192 or AES-256 respectively.
+func NewKey(key string) (*encryption.Key, error) {
+	block, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct AES cipher: %s", err)
+	}
+
+	aesgcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct GCM: %s", err)
+	}
+
+	return encryption.NewKey(aesgcm), nil
+}
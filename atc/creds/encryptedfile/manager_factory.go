@@ -0,0 +1,51 @@
+package encryptedfile
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/mitchellh/mapstructure"
+)
+
+type managerFactory struct{}
+
+func init() {
+	creds.Register("encryptedfile", NewManagerFactory())
+}
+
+func NewManagerFactory() creds.ManagerFactory {
+	return &managerFactory{}
+}
+
+func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &Manager{}
+
+	subGroup, err := group.AddGroup("Encrypted File Credential Management", "", manager)
+	if err != nil {
+		panic(err)
+	}
+
+	subGroup.Namespace = "encrypted-file-creds"
+
+	return manager
+}
+
+func (factory *managerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	manager := &Manager{
+		confined: true,
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused: true,
+		Result:      &manager,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = decoder.Decode(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
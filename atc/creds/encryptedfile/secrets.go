@@ -0,0 +1,42 @@
+package encryptedfile
+
+import (
+	"path"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type Secrets struct {
+	logger lager.Logger
+	file   *File
+}
+
+// NewSecretLookupPaths defines how variables will be searched in the underlying secret manager
+func (secrets *Secrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+	if len(pipelineName) > 0 {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(path.Join(teamName, pipelineName)+"/"))
+	}
+	lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(teamName+"/"))
+	if allowRootPath {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(""))
+	}
+	return lookupPaths
+}
+
+// Get retrieves the value of an individual secret from the decrypted file
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	value, found, err := secrets.file.Get(secrets.logger, secretPath)
+	if err != nil {
+		secrets.logger.Error("failed-to-load-secrets-file", err)
+		return nil, nil, false, err
+	}
+
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	return value, nil, true, nil
+}
@@ -0,0 +1,25 @@
+package encryptedfile
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type SecretsFactory struct {
+	logger lager.Logger
+	file   *File
+}
+
+func NewSecretsFactory(logger lager.Logger, file *File) *SecretsFactory {
+	return &SecretsFactory{
+		logger: logger,
+		file:   file,
+	}
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return &Secrets{
+		logger: factory.logger,
+		file:   factory.file,
+	}
+}
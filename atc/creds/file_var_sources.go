@@ -0,0 +1,58 @@
+package creds
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var fileVarSourceRoot string
+
+// SetFileVarSourceRoot sets the directory which the filesystem and
+// encryptedfile credential managers must read from when they are configured
+// by a pipeline's var_sources or a team. When empty, they cannot be used
+// there at all.
+func SetFileVarSourceRoot(root string) {
+	fileVarSourceRoot = root
+}
+
+func FileVarSourceRoot() string {
+	return fileVarSourceRoot
+}
+
+// ResolveFileVarSourcePath resolves a path configured by a pipeline or team
+// for a file-based credential manager. Relative paths are relative to the
+// root, and paths which resolve outside of it, including through symlinks,
+// are rejected.
+func ResolveFileVarSourcePath(path string) (string, error) {
+	if fileVarSourceRoot == "" {
+		return "", errors.New("file-based var sources are disabled; the operator must set --filesystem-var-source-root")
+	}
+
+	root, err := filepath.EvalSymlinks(fileVarSourceRoot)
+	if err != nil {
+		return "", fmt.Errorf("invalid var source root: %s", err)
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		resolved = filepath.Clean(path)
+	}
+
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path %s is outside of the var source root", path)
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,116 @@
+package creds_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResolveFileVarSourcePath", func() {
+	var (
+		root    string
+		outside string
+
+		path     string
+		resolved string
+		err      error
+	)
+
+	BeforeEach(func() {
+		var err error
+		root, err = ioutil.TempDir("", "var-source-root")
+		Expect(err).ToNot(HaveOccurred())
+
+		root, err = filepath.EvalSymlinks(root)
+		Expect(err).ToNot(HaveOccurred())
+
+		outside, err = ioutil.TempDir("", "outside-var-source-root")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.MkdirAll(filepath.Join(root, "some-team"), 0755)).To(Succeed())
+
+		creds.SetFileVarSourceRoot(root)
+	})
+
+	AfterEach(func() {
+		creds.SetFileVarSourceRoot("")
+
+		Expect(os.RemoveAll(root)).To(Succeed())
+		Expect(os.RemoveAll(outside)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		resolved, err = creds.ResolveFileVarSourcePath(path)
+	})
+
+	Context("when the path is relative", func() {
+		BeforeEach(func() {
+			path = "some-team"
+		})
+
+		It("resolves it under the root", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved).To(Equal(filepath.Join(root, "some-team")))
+		})
+	})
+
+	Context("when the path is absolute and under the root", func() {
+		BeforeEach(func() {
+			path = filepath.Join(root, "some-team")
+		})
+
+		It("returns it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved).To(Equal(filepath.Join(root, "some-team")))
+		})
+	})
+
+	Context("when the path is absolute and outside the root", func() {
+		BeforeEach(func() {
+			path = "/etc"
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError(ContainSubstring("outside of the var source root")))
+		})
+	})
+
+	Context("when the path escapes the root with ..", func() {
+		BeforeEach(func() {
+			path = "some-team/../../etc"
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError(ContainSubstring("outside of the var source root")))
+		})
+	})
+
+	Context("when the path is a symlink out of the root", func() {
+		BeforeEach(func() {
+			Expect(os.Symlink(outside, filepath.Join(root, "some-link"))).To(Succeed())
+
+			path = "some-link"
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError(ContainSubstring("outside of the var source root")))
+		})
+	})
+
+	Context("when no root is configured", func() {
+		BeforeEach(func() {
+			creds.SetFileVarSourceRoot("")
+
+			path = "some-team"
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError(ContainSubstring("--filesystem-var-source-root")))
+		})
+	})
+})
@@ -0,0 +1,164 @@
+package filesystem_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/filesystem"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Filesystem", func() {
+	var (
+		logger *lagertest.TestLogger
+		dir    string
+		store  *filesystem.Store
+		vs     vars.Variables
+	)
+
+	writeFile := func(path string, content string) {
+		fullPath := filepath.Join(dir, path)
+		Expect(os.MkdirAll(filepath.Dir(fullPath), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(fullPath, []byte(content), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		var err error
+		dir, err = ioutil.TempDir("", "filesystem-creds")
+		Expect(err).ToNot(HaveOccurred())
+
+		store = filesystem.NewStore(dir)
+		vs = creds.NewVariables(filesystem.NewSecretsFactory(store).NewSecrets(), "some-team", "some-pipeline", false)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(store.Reload(logger)).To(Succeed())
+	})
+
+	Context("with a team-scoped secret", func() {
+		BeforeEach(func() {
+			writeFile("some-team/some-secret", "some-value\n")
+		})
+
+		It("finds the value without its trailing newline", func() {
+			value, found, err := vs.Get(vars.VariableDefinition{Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+		})
+	})
+
+	Context("with a pipeline-scoped secret shadowing a team-scoped one", func() {
+		BeforeEach(func() {
+			writeFile("some-team/some-secret", "team-value")
+			writeFile("some-team/some-pipeline/some-secret", "pipeline-value")
+		})
+
+		It("prefers the pipeline-scoped value", func() {
+			value, found, err := vs.Get(vars.VariableDefinition{Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("pipeline-value"))
+		})
+	})
+
+	Context("with a secret directory holding several keys", func() {
+		BeforeEach(func() {
+			writeFile("some-team/some-secret/username", "some-user")
+			writeFile("some-team/some-secret/password", "some-password")
+			writeFile("some-team/some-secret/..data/ignored", "ignored")
+		})
+
+		It("exposes the keys as fields", func() {
+			value, found, err := vs.Get(vars.VariableDefinition{Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[string]interface{}{
+				"username": "some-user",
+				"password": "some-password",
+			}))
+		})
+	})
+
+	Context("with a secret at the root", func() {
+		BeforeEach(func() {
+			writeFile("some-secret", "some-value")
+		})
+
+		It("is not found unless root lookups are allowed", func() {
+			_, found, err := vs.Get(vars.VariableDefinition{Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when a secret changes after being loaded", func() {
+		BeforeEach(func() {
+			writeFile("some-team/some-secret", "old-value")
+		})
+
+		JustBeforeEach(func() {
+			writeFile("some-team/some-secret", "new-value")
+			future := time.Now().Add(time.Minute)
+			Expect(os.Chtimes(filepath.Join(dir, "some-team/some-secret"), future, future)).To(Succeed())
+		})
+
+		It("returns the new value after reloading", func() {
+			value, _, err := vs.Get(vars.VariableDefinition{Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal("old-value"))
+
+			Expect(store.Reload(logger)).To(Succeed())
+
+			value, _, err = vs.Get(vars.VariableDefinition{Name: "some-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal("new-value"))
+		})
+	})
+
+	Context("when configured as a var_source", func() {
+		var manager creds.Manager
+
+		BeforeEach(func() {
+			creds.SetFileVarSourceRoot(dir)
+		})
+
+		AfterEach(func() {
+			creds.SetFileVarSourceRoot("")
+
+			if manager != nil {
+				manager.Close(logger)
+			}
+		})
+
+		It("reads a path under the var source root", func() {
+			Expect(os.MkdirAll(filepath.Join(dir, "mounted"), 0755)).To(Succeed())
+
+			var err error
+			manager, err = filesystem.NewManagerFactory().NewInstance(map[string]interface{}{"path": "mounted"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.Validate()).To(Succeed())
+			Expect(manager.Init(logger)).To(Succeed())
+		})
+
+		It("refuses a path outside of the var source root", func() {
+			var err error
+			manager, err = filesystem.NewManagerFactory().NewInstance(map[string]interface{}{"path": os.TempDir()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.Validate()).To(MatchError(ContainSubstring("outside of the var source root")))
+			Expect(manager.Init(logger)).To(MatchError(ContainSubstring("outside of the var source root")))
+		})
+	})
+})
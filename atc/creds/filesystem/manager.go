@@ -0,0 +1,150 @@
+package filesystem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+const DefaultReloadInterval = time.Minute
+
+type Manager struct {
+	Path           string        `mapstructure:"path" long:"path" description:"Directory containing secrets laid out as one file per key, e.g. a mounted Kubernetes secret volume. Secrets are looked up under TEAM/PIPELINE/ and TEAM/."`
+	ReloadInterval time.Duration `mapstructure:"reload_interval" long:"reload-interval" default:"1m" description:"Interval on which to check the directory for changes and reload secrets."`
+
+	// confined is set for managers configured by pipelines and teams, whose
+	// path must be under the operator's var source root
+	confined bool
+
+	store *Store
+	stop  chan struct{}
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"path":            manager.Path,
+		"reload_interval": manager.ReloadInterval.String(),
+		"health":          health,
+	})
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	if manager.confined {
+		path, err := creds.ResolveFileVarSourcePath(manager.Path)
+		if err != nil {
+			return err
+		}
+
+		manager.Path = path
+	}
+
+	manager.store = NewStore(manager.Path)
+
+	err := manager.store.Reload(log)
+	if err != nil {
+		return err
+	}
+
+	manager.stop = make(chan struct{})
+
+	go manager.reloadLoop(log.Session("reload"), manager.stop)
+
+	return nil
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Path != ""
+}
+
+func (manager *Manager) Validate() error {
+	if manager.ReloadInterval <= 0 {
+		return errors.New("reload interval must be greater than zero")
+	}
+
+	path := manager.Path
+	if manager.confined {
+		// var_sources are validated by fly too, which does not know the
+		// root; Init refuses to read the path if no root is configured
+		if creds.FileVarSourceRoot() == "" {
+			return nil
+		}
+
+		var err error
+		path, err = creds.ResolveFileVarSourcePath(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("invalid path: %s", err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("path %s is not a directory", manager.Path)
+	}
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "stat",
+	}
+
+	_, err := os.Stat(manager.Path)
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status": "UP",
+	}
+
+	return health, nil
+}
+
+func (manager *Manager) NewSecretsFactory(log lager.Logger) (creds.SecretsFactory, error) {
+	if manager.store == nil {
+		return nil, errors.New("filesystem credential manager is not initialized")
+	}
+
+	return NewSecretsFactory(manager.store), nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+	if manager.stop != nil {
+		close(manager.stop)
+		manager.stop = nil
+	}
+}
+
+func (manager *Manager) reloadLoop(logger lager.Logger, stop <-chan struct{}) {
+	ticker := time.NewTicker(manager.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			err := manager.store.Reload(logger)
+			if err != nil {
+				logger.Error("failed-to-reload-secrets", err)
+			}
+		}
+	}
+}
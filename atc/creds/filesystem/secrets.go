@@ -0,0 +1,35 @@
+package filesystem
+
+import (
+	"path"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type Secrets struct {
+	store *Store
+}
+
+// NewSecretLookupPaths defines how variables will be searched in the underlying secret manager
+func (secrets *Secrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+	if len(pipelineName) > 0 {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(path.Join(teamName, pipelineName)+"/"))
+	}
+	lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(teamName+"/"))
+	if allowRootPath {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(""))
+	}
+	return lookupPaths
+}
+
+// Get retrieves the value of an individual secret from the last loaded snapshot
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	value, found := secrets.store.Get(secretPath)
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	return value, nil, true, nil
+}
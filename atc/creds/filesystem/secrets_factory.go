@@ -0,0 +1,21 @@
+package filesystem
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type SecretsFactory struct {
+	store *Store
+}
+
+func NewSecretsFactory(store *Store) *SecretsFactory {
+	return &SecretsFactory{
+		store: store,
+	}
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return &Secrets{
+		store: factory.store,
+	}
+}
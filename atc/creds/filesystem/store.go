@@ -0,0 +1,161 @@
+package filesystem
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// Store holds an in-memory snapshot of the secrets found under a directory.
+//
+// Every regular file is exposed as a string secret keyed by its path relative
+// to the root, with a single trailing newline removed. Every directory is also
+// exposed as a secret whose value is a map of its files' names to their
+// contents, which mirrors how a Kubernetes secret with multiple keys is
+// projected into a volume. Entries whose names begin with a dot are ignored,
+// which skips the '..data' bookkeeping directories created by Kubernetes.
+type Store struct {
+	root string
+
+	lock        sync.RWMutex
+	secrets     map[string]interface{}
+	fingerprint string
+}
+
+func NewStore(root string) *Store {
+	return &Store{
+		root:    root,
+		secrets: map[string]interface{}{},
+	}
+}
+
+// Get returns the secret stored at the given slash-separated path.
+func (store *Store) Get(secretPath string) (interface{}, bool) {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	value, found := store.secrets[strings.Trim(secretPath, "/")]
+	return value, found
+}
+
+// Reload walks the directory and replaces the snapshot if any file has been
+// added, removed or modified since the last reload.
+func (store *Store) Reload(logger lager.Logger) error {
+	entries := []fileEntry{}
+
+	err := walk(store.root, "", &entries)
+	if err != nil {
+		return err
+	}
+
+	fingerprint := fingerprintEntries(entries)
+
+	store.lock.RLock()
+	unchanged := fingerprint == store.fingerprint
+	store.lock.RUnlock()
+
+	if unchanged {
+		return nil
+	}
+
+	secrets := map[string]interface{}{}
+	for _, entry := range entries {
+		content, err := ioutil.ReadFile(filepath.Join(store.root, filepath.FromSlash(entry.path)))
+		if err != nil {
+			return err
+		}
+
+		value := strings.TrimSuffix(string(content), "\n")
+
+		secrets[entry.path] = value
+
+		dir, name := path.Split(entry.path)
+		dir = strings.TrimSuffix(dir, "/")
+		if dir == "" {
+			continue
+		}
+
+		fields, ok := secrets[dir].(map[string]interface{})
+		if !ok {
+			fields = map[string]interface{}{}
+			secrets[dir] = fields
+		}
+
+		fields[name] = value
+	}
+
+	store.lock.Lock()
+	store.secrets = secrets
+	store.fingerprint = fingerprint
+	store.lock.Unlock()
+
+	logger.Info("reloaded", lager.Data{"secrets": len(entries)})
+
+	return nil
+}
+
+type fileEntry struct {
+	path string
+	info os.FileInfo
+}
+
+func walk(root string, rel string, entries *[]fileEntry) error {
+	infos, err := ioutil.ReadDir(filepath.Join(root, filepath.FromSlash(rel)))
+	if err != nil {
+		return err
+	}
+
+	for _, info := range infos {
+		if strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+
+		entryPath := path.Join(rel, info.Name())
+
+		// follow symlinks, as used by Kubernetes for projected volumes
+		info, err = os.Stat(filepath.Join(root, filepath.FromSlash(entryPath)))
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			err = walk(root, entryPath, entries)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		*entries = append(*entries, fileEntry{
+			path: entryPath,
+			info: info,
+		})
+	}
+
+	return nil
+}
+
+func fingerprintEntries(entries []fileEntry) string {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].path < entries[j].path
+	})
+
+	var builder strings.Builder
+	for _, entry := range entries {
+		fmt.Fprintf(&builder, "%s:%d:%d\n", entry.path, entry.info.Size(), entry.info.ModTime().UnixNano())
+	}
+
+	return builder.String()
+}
@@ -25,6 +25,10 @@ type Managers map[string]Manager
 type CredentialManagementConfig struct {
 	RetryConfig SecretRetryConfig
 	CacheConfig SecretCacheConfig
+
+	TeamManagerTypes []string `long:"team-credential-manager-type" description:"A credential manager type which teams may configure for themselves. Can be specified multiple times. Teams cannot configure their own credential manager unless this is set."`
+
+	FileVarSourceRoot string `long:"filesystem-var-source-root" description:"Directory which filesystem and encryptedfile var_sources and team credential managers must read from. Their paths are resolved relative to it, and they cannot be used unless this is set."`
 }
 
 type HealthResponse struct {
@@ -42,3 +46,18 @@ func Register(name string, managerFactory ManagerFactory) {
 func ManagerFactories() map[string]ManagerFactory {
 	return managerFactories
 }
+
+var teamManagerTypes = map[string]bool{}
+
+// AllowTeamManagerTypes sets the credential manager types which teams may
+// configure for themselves, replacing any previously allowed types.
+func AllowTeamManagerTypes(types []string) {
+	teamManagerTypes = map[string]bool{}
+	for _, t := range types {
+		teamManagerTypes[t] = true
+	}
+}
+
+func IsTeamManagerTypeAllowed(name string) bool {
+	return teamManagerTypes[name]
+}
@@ -0,0 +1,37 @@
+package creds
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+type NotificationConfig struct {
+	variablesResolver vars.Variables
+	rawConfig         atc.NotificationConfig
+}
+
+func NewNotificationConfig(variables vars.Variables, config atc.NotificationConfig) NotificationConfig {
+	return NotificationConfig{
+		variablesResolver: variables,
+		rawConfig:         config,
+	}
+}
+
+func (c NotificationConfig) Evaluate() (atc.NotificationConfig, error) {
+	// The name and payload template are not interpolated; the template is
+	// rendered with the event when delivering instead.
+	raw := c.rawConfig
+	raw.Name = ""
+	raw.Template = ""
+
+	var config atc.NotificationConfig
+	err := evaluate(c.variablesResolver, raw, &config)
+	if err != nil {
+		return atc.NotificationConfig{}, err
+	}
+
+	config.Name = c.rawConfig.Name
+	config.Template = c.rawConfig.Template
+
+	return config, nil
+}
@@ -0,0 +1,47 @@
+package creds_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationConfig", func() {
+	var config creds.NotificationConfig
+
+	BeforeEach(func() {
+		variables := vars.StaticVariables{
+			"hook-token": "some-token",
+			"auth":       "Bearer some-auth",
+			"secret":     "some-secret",
+		}
+
+		config = creds.NewNotificationConfig(variables, atc.NotificationConfig{
+			Name:     "chat-((hook-token))",
+			URL:      "https://chat.example.com/hooks/((hook-token))",
+			Events:   []atc.PipelineEventType{atc.PipelineEventBuildStatus},
+			Headers:  map[string]string{"Authorization": "((auth))"},
+			Template: `{"text": "((not-a-var)) {{.JobName}}"}`,
+			Secret:   "((secret))",
+		})
+	})
+
+	Describe("Evaluate", func() {
+		It("resolves vars in everything but the name and template", func() {
+			result, err := config.Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result).To(Equal(atc.NotificationConfig{
+				Name:     "chat-((hook-token))",
+				URL:      "https://chat.example.com/hooks/some-token",
+				Events:   []atc.PipelineEventType{atc.PipelineEventBuildStatus},
+				Headers:  map[string]string{"Authorization": "Bearer some-auth"},
+				Template: `{"text": "((not-a-var)) {{.JobName}}"}`,
+				Secret:   "some-secret",
+			}))
+		})
+	})
+})
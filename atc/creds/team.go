@@ -0,0 +1,61 @@
+package creds
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+// NewTeamVariables returns the default ((var)) lookup for a team's builds:
+// the team's own credential manager, if it has one, followed by the
+// cluster-wide credential manager.
+func NewTeamVariables(
+	logger lager.Logger,
+	globalSecrets Secrets,
+	varSourcePool VarSourcePool,
+	credentialManager *atc.TeamCredentialManager,
+	teamName string,
+	pipelineName string,
+) (vars.Variables, error) {
+	globalVars := NewVariables(globalSecrets, teamName, pipelineName, false)
+	if credentialManager == nil {
+		return globalVars, nil
+	}
+
+	teamSecrets, err := NewTeamSecrets(logger, varSourcePool, *credentialManager)
+	if err != nil {
+		return nil, err
+	}
+
+	teamVars := NewVariables(teamSecrets, teamName, pipelineName, true)
+
+	return vars.NewMultiVars([]vars.Variables{teamVars, globalVars}), nil
+}
+
+// NewTeamSecrets finds or creates the secrets for a team's credential
+// manager in the var source pool, so that teams sharing the same config share
+// a single manager.
+func NewTeamSecrets(logger lager.Logger, varSourcePool VarSourcePool, credentialManager atc.TeamCredentialManager) (Secrets, error) {
+	factory := ManagerFactories()[credentialManager.Type]
+	if factory == nil {
+		return nil, fmt.Errorf("unknown credential manager type: %s", credentialManager.Type)
+	}
+
+	if !IsTeamManagerTypeAllowed(credentialManager.Type) {
+		return nil, fmt.Errorf("credential manager type %s is not allowed for teams", credentialManager.Type)
+	}
+
+	config := credentialManager.Config
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+
+	secrets, err := varSourcePool.FindOrCreate(logger, config, factory)
+	if err != nil {
+		return nil, fmt.Errorf("create team credential manager error: %s", err)
+	}
+
+	return secrets, nil
+}
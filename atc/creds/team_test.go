@@ -0,0 +1,131 @@
+package creds_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/vars"
+
+	// load dummy credential manager
+	_ "github.com/concourse/concourse/atc/creds/dummy"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewTeamVariables", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeGlobalSecrets *credsfakes.FakeSecrets
+		varSourcePool     creds.VarSourcePool
+		credentialManager *atc.TeamCredentialManager
+
+		variables vars.Variables
+		err       error
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeGlobalSecrets = new(credsfakes.FakeSecrets)
+		fakeGlobalSecrets.GetStub = func(secretPath string) (interface{}, *time.Time, bool, error) {
+			switch secretPath {
+			case "foo", "bar":
+				return "global-" + secretPath, nil, true, nil
+			}
+			return nil, nil, false, nil
+		}
+
+		varSourcePool = creds.NewVarSourcePool(logger, 5*time.Minute, 1*time.Minute, fakeclock.NewFakeClock(time.Now()))
+		credentialManager = nil
+
+		creds.AllowTeamManagerTypes([]string{"dummy"})
+	})
+
+	AfterEach(func() {
+		varSourcePool.Close()
+		creds.AllowTeamManagerTypes(nil)
+	})
+
+	JustBeforeEach(func() {
+		variables, err = creds.NewTeamVariables(logger, fakeGlobalSecrets, varSourcePool, credentialManager, "some-team", "some-pipeline")
+	})
+
+	Context("when the team has no credential manager", func() {
+		It("uses the cluster-wide credential manager", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			val, found, err := variables.Get(vars.VariableDefinition{Name: "foo"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("global-foo"))
+
+			Expect(varSourcePool.Size()).To(Equal(0))
+		})
+	})
+
+	Context("when the team has a credential manager", func() {
+		BeforeEach(func() {
+			credentialManager = &atc.TeamCredentialManager{
+				Type: "dummy",
+				Config: map[string]interface{}{
+					"vars": map[string]interface{}{
+						"some-team/foo": "team-foo",
+					},
+				},
+			}
+		})
+
+		It("resolves vars from the team's credential manager first", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			val, found, err := variables.Get(vars.VariableDefinition{Name: "foo"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("team-foo"))
+		})
+
+		It("falls back to the cluster-wide credential manager", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			val, found, err := variables.Get(vars.VariableDefinition{Name: "bar"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("global-bar"))
+		})
+
+		It("adds the credential manager to the var source pool", func() {
+			Expect(varSourcePool.Size()).To(Equal(1))
+		})
+	})
+
+	Context("when the team's credential manager type is no longer allowed for teams", func() {
+		BeforeEach(func() {
+			credentialManager = &atc.TeamCredentialManager{
+				Type:   "dummy",
+				Config: map[string]interface{}{"vars": map[string]interface{}{}},
+			}
+
+			creds.AllowTeamManagerTypes(nil)
+		})
+
+		It("errors", func() {
+			Expect(err).To(MatchError("credential manager type dummy is not allowed for teams"))
+			Expect(varSourcePool.Size()).To(Equal(0))
+		})
+	})
+
+	Context("when the team's credential manager type is unknown", func() {
+		BeforeEach(func() {
+			credentialManager = &atc.TeamCredentialManager{Type: "bogus"}
+		})
+
+		It("errors", func() {
+			Expect(err).To(MatchError("unknown credential manager type: bogus"))
+		})
+	})
+})
@@ -0,0 +1,248 @@
+package creds
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+// ErrAccessDenied may be returned (or wrapped) by Secrets implementations when
+// the credential manager refused to serve a secret.
+var ErrAccessDenied = errors.New("access denied")
+
+// VarChecker resolves vars the same way a pipeline would, against the team's
+// credential manager, the cluster-wide credential manager and the pipeline's
+// var_sources, but records the secret paths tried rather than returning
+// values.
+type VarChecker struct {
+	defaults     []VariableLookupFromSecrets
+	defaultError error
+	sources      map[string]VariableLookupFromSecrets
+	sourceErrors map[string]error
+}
+
+func NewVarChecker(
+	logger lager.Logger,
+	globalSecrets Secrets,
+	varSourcePool VarSourcePool,
+	teamCredentialManager *atc.TeamCredentialManager,
+	teamName string,
+	pipelineName string,
+	varSources atc.VarSourceConfigs,
+) (*VarChecker, error) {
+	checker := &VarChecker{
+		sources:      map[string]VariableLookupFromSecrets{},
+		sourceErrors: map[string]error{},
+	}
+
+	if teamCredentialManager != nil {
+		teamSecrets, err := NewTeamSecrets(logger, varSourcePool, *teamCredentialManager)
+		if err != nil {
+			checker.defaultError = err
+		} else {
+			checker.defaults = append(checker.defaults, VariableLookupFromSecrets{
+				Secrets:     teamSecrets,
+				LookupPaths: teamSecrets.NewSecretLookupPaths(teamName, pipelineName, true),
+			})
+		}
+	}
+
+	checker.defaults = append(checker.defaults, VariableLookupFromSecrets{
+		Secrets:     globalSecrets,
+		LookupPaths: globalSecrets.NewSecretLookupPaths(teamName, pipelineName, false),
+	})
+
+	defaultVars := []vars.Variables{}
+	for _, lookup := range checker.defaults {
+		defaultVars = append(defaultVars, lookup)
+	}
+
+	namedVarsMap := vars.NamedVariables{}
+	allVars := vars.NewMultiVars(append([]vars.Variables{namedVarsMap}, defaultVars...))
+
+	orderedVarSources, err := varSources.OrderByDependency()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cm := range orderedVarSources {
+		factory := ManagerFactories()[cm.Type]
+		if factory == nil {
+			checker.sourceErrors[cm.Name] = fmt.Errorf("unknown credential manager type: %s", cm.Type)
+			continue
+		}
+
+		newConfig, err := NewParams(allVars, atc.Params{"config": cm.Config}).Evaluate()
+		if err != nil {
+			checker.sourceErrors[cm.Name] = fmt.Errorf("evaluate var_source '%s' error: %s", cm.Name, err)
+			continue
+		}
+
+		config, ok := newConfig["config"].(map[string]interface{})
+		if !ok {
+			checker.sourceErrors[cm.Name] = fmt.Errorf("var_source '%s' invalid config", cm.Name)
+			continue
+		}
+
+		secrets, err := varSourcePool.FindOrCreate(logger, config, factory)
+		if err != nil {
+			checker.sourceErrors[cm.Name] = fmt.Errorf("create var_source '%s' error: %s", cm.Name, err)
+			continue
+		}
+
+		lookup := VariableLookupFromSecrets{
+			Secrets:     secrets,
+			LookupPaths: secrets.NewSecretLookupPaths(teamName, pipelineName, true),
+		}
+
+		checker.sources[cm.Name] = lookup
+		namedVarsMap[cm.Name] = lookup
+	}
+
+	return checker, nil
+}
+
+// Errors returns the failures to set up the team's credential manager or any
+// of the var_sources, whether or not a var refers to them.
+func (checker *VarChecker) Errors() []string {
+	errs := []string{}
+	if checker.defaultError != nil {
+		errs = append(errs, fmt.Sprintf("team credential manager: %s", checker.defaultError))
+	}
+
+	names := []string{}
+	for name := range checker.sourceErrors {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		errs = append(errs, checker.sourceErrors[name].Error())
+	}
+
+	return errs
+}
+
+// Check resolves the var referenced by the given interpolation name, e.g.
+// 'foo', 'foo.bar' or 'some-source:foo.bar'. Fields are ignored, since only
+// the existence of the underlying secret is checked.
+func (checker *VarChecker) Check(name string) atc.VarCheck {
+	sourceName, varName := splitVarName(name)
+
+	result := atc.VarCheck{
+		Name:   varName,
+		Source: sourceName,
+	}
+
+	lookups := checker.defaults
+	if sourceName != "" {
+		if err, failed := checker.sourceErrors[sourceName]; failed {
+			result.Status = atc.VarCheckErrored
+			result.Error = err.Error()
+			return result
+		}
+
+		lookup, found := checker.sources[sourceName]
+		if !found {
+			result.Status = atc.VarCheckErrored
+			result.Error = fmt.Sprintf("unknown var source: %s", sourceName)
+			return result
+		}
+
+		lookups = []VariableLookupFromSecrets{lookup}
+	} else if checker.defaultError != nil {
+		result.Status = atc.VarCheckErrored
+		result.Error = checker.defaultError.Error()
+		return result
+	}
+
+	for _, lookup := range lookups {
+		if checker.checkLookup(&result, lookup, varName) {
+			return result
+		}
+	}
+
+	result.Status = atc.VarCheckNotFound
+	return result
+}
+
+// checkLookup tries each of the lookup's secret paths for the var, recording
+// them in the result. It returns true once the result's status is final,
+// i.e. the secret was found or the lookup failed.
+func (checker *VarChecker) checkLookup(result *atc.VarCheck, lookup VariableLookupFromSecrets, varName string) bool {
+	secretPaths := []string{varName}
+	if len(lookup.LookupPaths) > 0 {
+		secretPaths = []string{}
+		for _, rule := range lookup.LookupPaths {
+			secretPath, err := rule.VariableToSecretPath(varName)
+			if err != nil {
+				result.Status = atc.VarCheckErrored
+				result.Error = err.Error()
+				return true
+			}
+
+			secretPaths = append(secretPaths, secretPath)
+		}
+	}
+
+	for _, secretPath := range secretPaths {
+		result.Paths = append(result.Paths, secretPath)
+
+		_, _, found, err := lookup.Secrets.Get(secretPath)
+		if err != nil {
+			result.Status = atc.VarCheckErrored
+			if isAccessDenied(err) {
+				result.Status = atc.VarCheckAccessDenied
+			}
+			result.Error = err.Error()
+			return true
+		}
+
+		if found {
+			result.Status = atc.VarCheckFound
+			return true
+		}
+	}
+
+	return false
+}
+
+func splitVarName(name string) (string, string) {
+	var sourceName string
+	if i := strings.Index(name, ":"); i > 0 {
+		sourceName = name[:i]
+		name = name[i+1:]
+	}
+
+	if strings.HasPrefix(name, `"`) {
+		if end := strings.Index(name[1:], `"`); end >= 0 {
+			return sourceName, name[1 : end+1]
+		}
+	}
+
+	return sourceName, strings.SplitN(name, ".", 2)[0]
+}
+
+// isAccessDenied recognizes denials from credential managers that do not
+// return ErrAccessDenied, such as Vault's 403 responses and AWS AccessDenied
+// errors.
+func isAccessDenied(err error) bool {
+	if errors.Is(err, ErrAccessDenied) {
+		return true
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, indicator := range []string{"permission denied", "accessdenied", "access denied", "forbidden", "code: 403"} {
+		if strings.Contains(message, indicator) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . APIToken
+
+type APIToken interface {
+	ID() int
+	Name() string
+	TeamID() int
+	TeamName() string
+	Role() string
+	ServiceAccount() bool
+	CreatedBy() string
+	CreatedBySub() string
+
+	// Creator identifies the user who created the token as they were seen by
+	// the team auth rules at the time.
+	Creator() atc.AuthSubject
+
+	CreatedAt() time.Time
+	ExpiresAt() time.Time
+	LastUsedAt() time.Time
+}
+
+type apiToken struct {
+	id             int
+	name           string
+	teamID         int
+	teamName       string
+	role           string
+	serviceAccount bool
+	createdBy      string
+	createdBySub   string
+	creator        atc.AuthSubject
+	createdAt      time.Time
+	expiresAt      time.Time
+	lastUsedAt     time.Time
+}
+
+func (t apiToken) ID() int                  { return t.id }
+func (t apiToken) Name() string             { return t.name }
+func (t apiToken) TeamID() int              { return t.teamID }
+func (t apiToken) TeamName() string         { return t.teamName }
+func (t apiToken) Role() string             { return t.role }
+func (t apiToken) ServiceAccount() bool     { return t.serviceAccount }
+func (t apiToken) CreatedBy() string        { return t.createdBy }
+func (t apiToken) CreatedBySub() string     { return t.createdBySub }
+func (t apiToken) Creator() atc.AuthSubject { return t.creator }
+func (t apiToken) CreatedAt() time.Time     { return t.createdAt }
+func (t apiToken) ExpiresAt() time.Time     { return t.expiresAt }
+func (t apiToken) LastUsedAt() time.Time    { return t.lastUsedAt }
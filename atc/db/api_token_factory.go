@@ -0,0 +1,315 @@
+package db
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+var ErrAPITokenAlreadyExists = errors.New("api token already exists")
+
+//go:generate counterfeiter . APITokenFactory
+
+type APITokenFactory interface {
+	CreateAPIToken(teamID int, spec APITokenSpec) (APIToken, error)
+	GetAPITokens(teamID int) ([]APIToken, error)
+	FindAPIToken(teamID int, name string) (APIToken, bool, error)
+	DeleteAPIToken(teamID int, name string) (bool, error)
+
+	// UseAPIToken looks up an unexpired token by its raw value and records
+	// that it has been used. The last use is recorded at most once per
+	// APITokenLastUsedInterval so that verifying a token does not write on
+	// every request.
+	UseAPIToken(token string) (APIToken, bool, error)
+
+	// RevokeAPITokensForUser deletes the personal tokens created by the given
+	// user, returning how many were deleted. Service account tokens belong to
+	// their team and are kept.
+	RevokeAPITokensForUser(sub string) (int, error)
+}
+
+// APITokenLastUsedInterval is how stale a token's last use may get before it
+// is recorded again.
+const APITokenLastUsedInterval = time.Minute
+
+// APITokenSpec describes a token to be created. Only a hash of Token is ever
+// persisted.
+type APITokenSpec struct {
+	Name           string
+	Role           string
+	ServiceAccount bool
+	Token          string
+	CreatedBy      string
+	CreatedBySub   string
+	Creator        atc.AuthSubject
+	ExpiresAt      time.Time
+}
+
+var apiTokensQuery = psql.Select(
+	"t.id",
+	"t.name",
+	"t.team_id",
+	"tm.name",
+	"t.role",
+	"t.service_account",
+	"t.created_by",
+	"t.created_by_sub",
+	"t.created_by_connector",
+	"t.created_by_user_id",
+	"t.created_by_groups",
+	"t.created_at",
+	"t.expires_at",
+	"t.last_used_at",
+).
+	From("api_tokens t").
+	Join("teams tm ON tm.id = t.team_id")
+
+type apiTokenFactory struct {
+	conn Conn
+}
+
+func NewAPITokenFactory(conn Conn) APITokenFactory {
+	return &apiTokenFactory{
+		conn: conn,
+	}
+}
+
+func (f *apiTokenFactory) CreateAPIToken(teamID int, spec APITokenSpec) (APIToken, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	groups := spec.Creator.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	var id int
+	err = psql.Insert("api_tokens").
+		Columns(
+			"name",
+			"team_id",
+			"role",
+			"service_account",
+			"token_hash",
+			"created_by",
+			"created_by_sub",
+			"created_by_connector",
+			"created_by_user_id",
+			"created_by_groups",
+			"expires_at",
+		).
+		Values(
+			spec.Name,
+			teamID,
+			spec.Role,
+			spec.ServiceAccount,
+			hashAPIToken(spec.Token),
+			spec.CreatedBy,
+			spec.CreatedBySub,
+			spec.Creator.Connector,
+			spec.Creator.UserID,
+			pq.Array(groups),
+			spec.ExpiresAt,
+		).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return nil, ErrAPITokenAlreadyExists
+		}
+		return nil, err
+	}
+
+	token, err := scanAPIToken(apiTokensQuery.
+		Where(sq.Eq{"t.id": id}).
+		RunWith(tx).
+		QueryRow())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (f *apiTokenFactory) GetAPITokens(teamID int) ([]APIToken, error) {
+	rows, err := apiTokensQuery.
+		Where(sq.Eq{"t.team_id": teamID}).
+		OrderBy("t.name").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var tokens []APIToken
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func (f *apiTokenFactory) FindAPIToken(teamID int, name string) (APIToken, bool, error) {
+	token, err := scanAPIToken(apiTokensQuery.
+		Where(sq.Eq{
+			"t.team_id": teamID,
+			"t.name":    name,
+		}).
+		RunWith(f.conn).
+		QueryRow())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return token, true, nil
+}
+
+func (f *apiTokenFactory) DeleteAPIToken(teamID int, name string) (bool, error) {
+	result, err := psql.Delete("api_tokens").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    name,
+		}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (f *apiTokenFactory) UseAPIToken(token string) (APIToken, bool, error) {
+	found, err := scanAPIToken(apiTokensQuery.
+		Where(sq.Eq{"t.token_hash": hashAPIToken(token)}).
+		Where(sq.Expr("t.expires_at > now()")).
+		RunWith(f.conn).
+		QueryRow())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	apiToken := found.(apiToken)
+	if time.Since(apiToken.lastUsedAt) < APITokenLastUsedInterval {
+		return apiToken, true, nil
+	}
+
+	// the condition is repeated so that concurrent requests with the same
+	// token only record its use once
+	var lastUsedAt time.Time
+	err = psql.Update("api_tokens").
+		Set("last_used_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": apiToken.id}).
+		Where(sq.Or{
+			sq.Eq{"last_used_at": nil},
+			sq.Expr("last_used_at < now() - ? * interval '1 second'", APITokenLastUsedInterval.Seconds()),
+		}).
+		Suffix("RETURNING last_used_at").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&lastUsedAt)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, false, err
+	}
+
+	if err == nil {
+		apiToken.lastUsedAt = lastUsedAt
+	}
+
+	return apiToken, true, nil
+}
+
+func (f *apiTokenFactory) RevokeAPITokensForUser(sub string) (int, error) {
+	result, err := psql.Delete("api_tokens").
+		Where(sq.Eq{
+			"created_by_sub":  sub,
+			"service_account": false,
+		}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+func scanAPIToken(row scannable) (APIToken, error) {
+	var (
+		token      apiToken
+		lastUsedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&token.id,
+		&token.name,
+		&token.teamID,
+		&token.teamName,
+		&token.role,
+		&token.serviceAccount,
+		&token.createdBy,
+		&token.createdBySub,
+		&token.creator.Connector,
+		&token.creator.UserID,
+		pq.Array(&token.creator.Groups),
+		&token.createdAt,
+		&token.expiresAt,
+		&lastUsedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastUsedAt.Valid {
+		token.lastUsedAt = lastUsedAt.Time
+	}
+
+	token.creator.UserName = token.createdBy
+
+	return token, nil
+}
+
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
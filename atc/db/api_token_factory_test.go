@@ -0,0 +1,187 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Token Factory", func() {
+	var (
+		spec  db.APITokenSpec
+		token db.APIToken
+		err   error
+	)
+
+	BeforeEach(func() {
+		spec = db.APITokenSpec{
+			Name:         "some-token",
+			Role:         "member",
+			Token:        "cpat_some-secret",
+			CreatedBy:    "some-user",
+			CreatedBySub: "some-sub",
+			Creator: atc.AuthSubject{
+				Connector: "some-connector",
+				UserID:    "some-user-id",
+				UserName:  "some-user",
+				Groups:    []string{"some-group"},
+			},
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	})
+
+	JustBeforeEach(func() {
+		token, err = apiTokenFactory.CreateAPIToken(defaultTeam.ID(), spec)
+	})
+
+	Describe("CreateAPIToken", func() {
+		It("creates the token", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.Name()).To(Equal("some-token"))
+			Expect(token.TeamID()).To(Equal(defaultTeam.ID()))
+			Expect(token.TeamName()).To(Equal(defaultTeam.Name()))
+			Expect(token.Role()).To(Equal("member"))
+			Expect(token.ServiceAccount()).To(BeFalse())
+			Expect(token.CreatedBy()).To(Equal("some-user"))
+			Expect(token.CreatedBySub()).To(Equal("some-sub"))
+			Expect(token.Creator()).To(Equal(spec.Creator))
+			Expect(token.CreatedAt()).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(token.ExpiresAt()).To(BeTemporally("~", spec.ExpiresAt, time.Second))
+			Expect(token.LastUsedAt()).To(BeZero())
+		})
+
+		It("does not store the raw token", func() {
+			var hash string
+			err := dbConn.QueryRow("SELECT token_hash FROM api_tokens WHERE id = $1", token.ID()).Scan(&hash)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).ToNot(ContainSubstring("some-secret"))
+		})
+
+		Context("when a token with the same name already exists", func() {
+			It("returns ErrAPITokenAlreadyExists", func() {
+				spec.Token = "cpat_another-secret"
+				_, err := apiTokenFactory.CreateAPIToken(defaultTeam.ID(), spec)
+				Expect(err).To(Equal(db.ErrAPITokenAlreadyExists))
+			})
+		})
+	})
+
+	Describe("GetAPITokens", func() {
+		It("returns the tokens of the team", func() {
+			tokens, err := apiTokenFactory.GetAPITokens(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens).To(HaveLen(1))
+			Expect(tokens[0].ID()).To(Equal(token.ID()))
+		})
+	})
+
+	Describe("FindAPIToken", func() {
+		It("finds the token by name", func() {
+			found, ok, err := apiTokenFactory.FindAPIToken(defaultTeam.ID(), "some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(found.ID()).To(Equal(token.ID()))
+		})
+
+		It("does not find unknown tokens", func() {
+			_, ok, err := apiTokenFactory.FindAPIToken(defaultTeam.ID(), "bogus-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("DeleteAPIToken", func() {
+		It("deletes the token", func() {
+			deleted, err := apiTokenFactory.DeleteAPIToken(defaultTeam.ID(), "some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			_, ok, err := apiTokenFactory.FindAPIToken(defaultTeam.ID(), "some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("UseAPIToken", func() {
+		It("finds the token by its value and records its use", func() {
+			used, ok, err := apiTokenFactory.UseAPIToken("cpat_some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(used.ID()).To(Equal(token.ID()))
+			Expect(used.LastUsedAt()).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("records its use at most once per interval", func() {
+			first, _, err := apiTokenFactory.UseAPIToken("cpat_some-secret")
+			Expect(err).ToNot(HaveOccurred())
+
+			second, _, err := apiTokenFactory.UseAPIToken("cpat_some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.LastUsedAt()).To(BeTemporally("==", first.LastUsedAt()))
+
+			_, err = dbConn.Exec("UPDATE api_tokens SET last_used_at = now() - interval '1 hour' WHERE id = $1", token.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			third, _, err := apiTokenFactory.UseAPIToken("cpat_some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(third.LastUsedAt()).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("does not find unknown tokens", func() {
+			_, ok, err := apiTokenFactory.UseAPIToken("cpat_bogus")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+
+		Context("when the token has expired", func() {
+			BeforeEach(func() {
+				spec.ExpiresAt = time.Now().Add(-time.Hour)
+			})
+
+			It("does not find the token", func() {
+				_, ok, err := apiTokenFactory.UseAPIToken("cpat_some-secret")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RevokeAPITokensForUser", func() {
+		BeforeEach(func() {
+			serviceAccountSpec := spec
+			serviceAccountSpec.Name = "some-service-account"
+			serviceAccountSpec.Token = "cpat_service-account-secret"
+			serviceAccountSpec.ServiceAccount = true
+
+			_, err := apiTokenFactory.CreateAPIToken(defaultTeam.ID(), serviceAccountSpec)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherUserSpec := spec
+			otherUserSpec.Name = "other-user-token"
+			otherUserSpec.Token = "cpat_other-user-secret"
+			otherUserSpec.CreatedBySub = "other-sub"
+
+			_, err = apiTokenFactory.CreateAPIToken(defaultTeam.ID(), otherUserSpec)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes only the personal tokens of the user", func() {
+			count, err := apiTokenFactory.RevokeAPITokensForUser("some-sub")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+
+			tokens, err := apiTokenFactory.GetAPITokens(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			var names []string
+			for _, token := range tokens {
+				names = append(names, token.Name())
+			}
+			Expect(names).To(ConsistOf("other-user-token", "some-service-account"))
+		})
+	})
+})
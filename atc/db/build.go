@@ -14,9 +14,11 @@ import (
 	"go.opentelemetry.io/otel/api/propagators"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/vars"
 )
 
 const schema = "exec.v2"
@@ -163,6 +165,8 @@ type Build interface {
 
 	SpanContext() propagators.Supplier
 
+	Variables(lager.Logger, creds.Secrets, creds.VarSourcePool) (vars.Variables, error)
+
 	SavePipeline(
 		pipelineName string,
 		teamId int,
@@ -386,6 +390,11 @@ func (b *build) Start(plan atc.Plan) (bool, error) {
 		return false, err
 	}
 
+	err = b.saveStatusPipelineEvent(tx, BuildStatusStarted)
+	if err != nil {
+		return false, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return false, err
@@ -578,6 +587,11 @@ func (b *build) Finish(status BuildStatus) error {
 		}
 	}
 
+	err = b.saveStatusPipelineEvent(tx, status)
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -1040,6 +1054,11 @@ func (b *build) SaveOutput(
 		if err != nil {
 			return err
 		}
+
+		err = saveResourceVersionPipelineEvents(tx, resourceConfigScope.ID(), []atc.Version{version})
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
@@ -1488,6 +1507,31 @@ func (b *build) SpanContext() propagators.Supplier {
 	return b.spanContext
 }
 
+// Variables returns the vars the build's plan is interpolated with. Builds of a
+// pipeline use the pipeline's vars, while one-off builds only have the team's
+// credential manager and the cluster-wide one.
+func (b *build) Variables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool) (vars.Variables, error) {
+	if b.pipelineID == 0 {
+		teamCredentialManager, err := findTeamCredentialManager(b.conn, b.teamID)
+		if err != nil {
+			return nil, err
+		}
+
+		return creds.NewTeamVariables(logger, globalSecrets, varSourcePool, teamCredentialManager, b.teamName, b.pipelineName)
+	}
+
+	pipeline, found, err := b.Pipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.New("pipeline not found")
+	}
+
+	return pipeline.Variables(logger, globalSecrets, varSourcePool)
+}
+
 func (b *build) SavePipeline(
 	pipelineName string,
 	teamID int,
@@ -1694,7 +1738,30 @@ func createBuild(tx Tx, build *build, vals map[string]interface{}) error {
 		return err
 	}
 
-	return createBuildEventSeq(tx, buildID)
+	err = createBuildEventSeq(tx, buildID)
+	if err != nil {
+		return err
+	}
+
+	return build.saveStatusPipelineEvent(tx, build.status)
+}
+
+// saveStatusPipelineEvent records the build's status change as an event on
+// its pipeline, if it has one.
+func (b *build) saveStatusPipelineEvent(tx Tx, status BuildStatus) error {
+	if b.pipelineID == 0 {
+		return nil
+	}
+
+	return savePipelineEvent(tx, b.teamID, b.pipelineID, atc.PipelineEvent{
+		Type:    atc.PipelineEventBuildStatus,
+		JobName: b.jobName,
+		Build: &atc.PipelineEventBuild{
+			ID:     b.id,
+			Name:   b.name,
+			Status: atc.BuildStatus(status),
+		},
+	})
 }
 
 func buildStartedChannel() string {
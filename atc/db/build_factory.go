@@ -19,6 +19,7 @@ type BuildFactory interface {
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	GetBuildsOnOverdueLandingWorkers() ([]Build, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
 }
@@ -133,6 +134,27 @@ func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	return getBuilds(query, f.conn, f.lockFactory)
 }
 
+// GetBuildsOnOverdueLandingWorkers returns the incomplete builds which still
+// have containers on a landing worker whose land deadline has passed.
+func (f *buildFactory) GetBuildsOnOverdueLandingWorkers() ([]Build, error) {
+	query := buildsQuery.
+		Where(sq.Eq{
+			"b.completed": false,
+			"b.aborted":   false,
+		}).
+		Where(sq.Expr(`b.id IN (
+			SELECT c.build_id
+			FROM containers c
+			JOIN workers w ON w.name = c.worker_name
+			WHERE c.build_id IS NOT NULL
+			AND w.state = ?
+			AND w.land_deadline < NOW()
+		)`, string(WorkerStateLanding))).
+		OrderBy("b.id ASC")
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
 func getBuilds(buildsQuery sq.SelectBuilder, conn Conn, lockFactory lock.LockFactory) ([]Build, error) {
 	rows, err := buildsQuery.RunWith(conn).Query()
 	if err != nil {
@@ -491,6 +491,61 @@ var _ = Describe("BuildFactory", func() {
 		})
 	})
 
+	Describe("GetBuildsOnOverdueLandingWorkers", func() {
+		var (
+			overdueBuild db.Build
+			otherBuild   db.Build
+		)
+
+		BeforeEach(func() {
+			var err error
+			overdueBuild, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			otherBuild, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(overdueBuild.ID(), atc.PlanID("some-plan"), team.ID()), db.ContainerMetadata{})
+			Expect(err).NotTo(HaveOccurred())
+
+			otherWorkerPayload := defaultWorkerPayload
+			otherWorkerPayload.Name = "other-worker"
+			otherWorkerPayload.GardenAddr = "some-other-garden-addr"
+
+			otherWorker, err := workerFactory.SaveWorker(otherWorkerPayload, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = otherWorker.CreateContainer(db.NewBuildStepContainerOwner(otherBuild.ID(), atc.PlanID("some-plan"), team.ID()), db.ContainerMetadata{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = defaultWorker.LandBy(time.Now().Add(-time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+
+			err = otherWorker.LandBy(time.Now().Add(time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the builds on landing workers past their deadline", func() {
+			builds, err := buildFactory.GetBuildsOnOverdueLandingWorkers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+			Expect(builds[0].ID()).To(Equal(overdueBuild.ID()))
+		})
+
+		Context("when the build has been aborted", func() {
+			BeforeEach(func() {
+				err := overdueBuild.MarkAsAborted()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is not returned", func() {
+				builds, err := buildFactory.GetBuildsOnOverdueLandingWorkers()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("AllBuilds by date", func() {
 		var build1DB db.Build
 		var build2DB db.Build
@@ -48,6 +48,8 @@ var (
 	workerBaseResourceTypeFactory       db.WorkerBaseResourceTypeFactory
 	workerTaskCacheFactory              db.WorkerTaskCacheFactory
 	userFactory                         db.UserFactory
+	apiTokenFactory                     db.APITokenFactory
+	sessionFactory                      db.SessionFactory
 	dbWall                              db.Wall
 	fakeClock                           dbfakes.FakeClock
 
@@ -120,6 +122,8 @@ var _ = BeforeEach(func() {
 	workerBaseResourceTypeFactory = db.NewWorkerBaseResourceTypeFactory(dbConn)
 	workerTaskCacheFactory = db.NewWorkerTaskCacheFactory(dbConn)
 	userFactory = db.NewUserFactory(dbConn)
+	apiTokenFactory = db.NewAPITokenFactory(dbConn)
+	sessionFactory = db.NewSessionFactory(dbConn)
 	dbWall = db.NewWall(dbConn, &fakeClock)
 
 	var err error
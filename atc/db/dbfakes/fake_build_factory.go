@@ -50,6 +50,18 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetBuildsOnOverdueLandingWorkersStub        func() ([]db.Build, error)
+	getBuildsOnOverdueLandingWorkersMutex       sync.RWMutex
+	getBuildsOnOverdueLandingWorkersArgsForCall []struct {
+	}
+	getBuildsOnOverdueLandingWorkersReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getBuildsOnOverdueLandingWorkersReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	GetDrainableBuildsStub        func() ([]db.Build, error)
 	getDrainableBuildsMutex       sync.RWMutex
 	getDrainableBuildsArgsForCall []struct {
@@ -294,6 +306,61 @@ func (fake *FakeBuildFactory) GetAllStartedBuildsReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetBuildsOnOverdueLandingWorkers() ([]db.Build, error) {
+	fake.getBuildsOnOverdueLandingWorkersMutex.Lock()
+	ret, specificReturn := fake.getBuildsOnOverdueLandingWorkersReturnsOnCall[len(fake.getBuildsOnOverdueLandingWorkersArgsForCall)]
+	fake.getBuildsOnOverdueLandingWorkersArgsForCall = append(fake.getBuildsOnOverdueLandingWorkersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetBuildsOnOverdueLandingWorkers", []interface{}{})
+	fake.getBuildsOnOverdueLandingWorkersMutex.Unlock()
+	if fake.GetBuildsOnOverdueLandingWorkersStub != nil {
+		return fake.GetBuildsOnOverdueLandingWorkersStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getBuildsOnOverdueLandingWorkersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetBuildsOnOverdueLandingWorkersCallCount() int {
+	fake.getBuildsOnOverdueLandingWorkersMutex.RLock()
+	defer fake.getBuildsOnOverdueLandingWorkersMutex.RUnlock()
+	return len(fake.getBuildsOnOverdueLandingWorkersArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetBuildsOnOverdueLandingWorkersCalls(stub func() ([]db.Build, error)) {
+	fake.getBuildsOnOverdueLandingWorkersMutex.Lock()
+	defer fake.getBuildsOnOverdueLandingWorkersMutex.Unlock()
+	fake.GetBuildsOnOverdueLandingWorkersStub = stub
+}
+
+func (fake *FakeBuildFactory) GetBuildsOnOverdueLandingWorkersReturns(result1 []db.Build, result2 error) {
+	fake.getBuildsOnOverdueLandingWorkersMutex.Lock()
+	defer fake.getBuildsOnOverdueLandingWorkersMutex.Unlock()
+	fake.GetBuildsOnOverdueLandingWorkersStub = nil
+	fake.getBuildsOnOverdueLandingWorkersReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetBuildsOnOverdueLandingWorkersReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getBuildsOnOverdueLandingWorkersMutex.Lock()
+	defer fake.getBuildsOnOverdueLandingWorkersMutex.Unlock()
+	fake.GetBuildsOnOverdueLandingWorkersStub = nil
+	if fake.getBuildsOnOverdueLandingWorkersReturnsOnCall == nil {
+		fake.getBuildsOnOverdueLandingWorkersReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getBuildsOnOverdueLandingWorkersReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) GetDrainableBuilds() ([]db.Build, error) {
 	fake.getDrainableBuildsMutex.Lock()
 	ret, specificReturn := fake.getDrainableBuildsReturnsOnCall[len(fake.getDrainableBuildsArgsForCall)]
@@ -548,6 +615,8 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
 	defer fake.getAllStartedBuildsMutex.RUnlock()
+	fake.getBuildsOnOverdueLandingWorkersMutex.RLock()
+	defer fake.getBuildsOnOverdueLandingWorkersMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
@@ -168,6 +168,19 @@ type FakePipeline struct {
 	destroyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	EventsStub        func(int) (db.PipelineEventSource, error)
+	eventsMutex       sync.RWMutex
+	eventsArgsForCall []struct {
+		arg1 int
+	}
+	eventsReturns struct {
+		result1 db.PipelineEventSource
+		result2 error
+	}
+	eventsReturnsOnCall map[int]struct {
+		result1 db.PipelineEventSource
+		result2 error
+	}
 	ExposeStub        func() error
 	exposeMutex       sync.RWMutex
 	exposeArgsForCall []struct {
@@ -251,6 +264,20 @@ type FakePipeline struct {
 		result2 bool
 		result3 error
 	}
+	JobStatsStub        func(time.Time, time.Time) ([]atc.JobStats, error)
+	jobStatsMutex       sync.RWMutex
+	jobStatsArgsForCall []struct {
+		arg1 time.Time
+		arg2 time.Time
+	}
+	jobStatsReturns struct {
+		result1 []atc.JobStats
+		result2 error
+	}
+	jobStatsReturnsOnCall map[int]struct {
+		result1 []atc.JobStats
+		result2 error
+	}
 	JobsStub        func() (db.Jobs, error)
 	jobsMutex       sync.RWMutex
 	jobsArgsForCall []struct {
@@ -295,6 +322,31 @@ type FakePipeline struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(int) ([]db.NotificationDelivery, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}
+	NotificationsStub        func() (atc.NotificationConfigs, error)
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct {
+	}
+	notificationsReturns struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
 	ParentBuildIDStub        func() int
 	parentBuildIDMutex       sync.RWMutex
 	parentBuildIDArgsForCall []struct {
@@ -1289,6 +1341,69 @@ func (fake *FakePipeline) DestroyReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) Events(arg1 int) (db.PipelineEventSource, error) {
+	fake.eventsMutex.Lock()
+	ret, specificReturn := fake.eventsReturnsOnCall[len(fake.eventsArgsForCall)]
+	fake.eventsArgsForCall = append(fake.eventsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Events", []interface{}{arg1})
+	fake.eventsMutex.Unlock()
+	if fake.EventsStub != nil {
+		return fake.EventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.eventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) EventsCallCount() int {
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	return len(fake.eventsArgsForCall)
+}
+
+func (fake *FakePipeline) EventsCalls(stub func(int) (db.PipelineEventSource, error)) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = stub
+}
+
+func (fake *FakePipeline) EventsArgsForCall(i int) int {
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	argsForCall := fake.eventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) EventsReturns(result1 db.PipelineEventSource, result2 error) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = nil
+	fake.eventsReturns = struct {
+		result1 db.PipelineEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) EventsReturnsOnCall(i int, result1 db.PipelineEventSource, result2 error) {
+	fake.eventsMutex.Lock()
+	defer fake.eventsMutex.Unlock()
+	fake.EventsStub = nil
+	if fake.eventsReturnsOnCall == nil {
+		fake.eventsReturnsOnCall = make(map[int]struct {
+			result1 db.PipelineEventSource
+			result2 error
+		})
+	}
+	fake.eventsReturnsOnCall[i] = struct {
+		result1 db.PipelineEventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Expose() error {
 	fake.exposeMutex.Lock()
 	ret, specificReturn := fake.exposeReturnsOnCall[len(fake.exposeArgsForCall)]
@@ -1691,6 +1806,70 @@ func (fake *FakePipeline) JobReturnsOnCall(i int, result1 db.Job, result2 bool,
 	}{result1, result2, result3}
 }
 
+func (fake *FakePipeline) JobStats(arg1 time.Time, arg2 time.Time) ([]atc.JobStats, error) {
+	fake.jobStatsMutex.Lock()
+	ret, specificReturn := fake.jobStatsReturnsOnCall[len(fake.jobStatsArgsForCall)]
+	fake.jobStatsArgsForCall = append(fake.jobStatsArgsForCall, struct {
+		arg1 time.Time
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("JobStats", []interface{}{arg1, arg2})
+	fake.jobStatsMutex.Unlock()
+	if fake.JobStatsStub != nil {
+		return fake.JobStatsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.jobStatsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) JobStatsCallCount() int {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	return len(fake.jobStatsArgsForCall)
+}
+
+func (fake *FakePipeline) JobStatsCalls(stub func(time.Time, time.Time) ([]atc.JobStats, error)) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = stub
+}
+
+func (fake *FakePipeline) JobStatsArgsForCall(i int) (time.Time, time.Time) {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	argsForCall := fake.jobStatsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePipeline) JobStatsReturns(result1 []atc.JobStats, result2 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	fake.jobStatsReturns = struct {
+		result1 []atc.JobStats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) JobStatsReturnsOnCall(i int, result1 []atc.JobStats, result2 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	if fake.jobStatsReturnsOnCall == nil {
+		fake.jobStatsReturnsOnCall = make(map[int]struct {
+			result1 []atc.JobStats
+			result2 error
+		})
+	}
+	fake.jobStatsReturnsOnCall[i] = struct {
+		result1 []atc.JobStats
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Jobs() (db.Jobs, error) {
 	fake.jobsMutex.Lock()
 	ret, specificReturn := fake.jobsReturnsOnCall[len(fake.jobsArgsForCall)]
@@ -1905,6 +2084,124 @@ func (fake *FakePipeline) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakePipeline) NotificationDeliveries(arg1 int) ([]db.NotificationDelivery, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1})
+	fake.notificationDeliveriesMutex.Unlock()
+	if fake.NotificationDeliveriesStub != nil {
+		return fake.NotificationDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.notificationDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationDeliveriesCalls(stub func(int) ([]db.NotificationDelivery, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakePipeline) NotificationDeliveriesArgsForCall(i int) int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) NotificationDeliveriesReturns(result1 []db.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) NotificationDeliveriesReturnsOnCall(i int, result1 []db.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []db.NotificationDelivery
+			result2 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []db.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) Notifications() (atc.NotificationConfigs, error) {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if fake.NotificationsStub != nil {
+		return fake.NotificationsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.notificationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationsCalls(stub func() (atc.NotificationConfigs, error)) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = stub
+}
+
+func (fake *FakePipeline) NotificationsReturns(result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) NotificationsReturnsOnCall(i int, result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 atc.NotificationConfigs
+			result2 error
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) ParentBuildID() int {
 	fake.parentBuildIDMutex.Lock()
 	ret, specificReturn := fake.parentBuildIDReturnsOnCall[len(fake.parentBuildIDArgsForCall)]
@@ -3083,6 +3380,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.deleteBuildEventsByBuildIDsMutex.RUnlock()
 	fake.destroyMutex.RLock()
 	defer fake.destroyMutex.RUnlock()
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
 	fake.exposeMutex.RLock()
 	defer fake.exposeMutex.RUnlock()
 	fake.getBuildsWithVersionAsInputMutex.RLock()
@@ -3097,6 +3396,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.iDMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
 	fake.jobsMutex.RLock()
 	defer fake.jobsMutex.RUnlock()
 	fake.lastUpdatedMutex.RLock()
@@ -3105,6 +3406,10 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.loadDebugVersionsDBMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
 	fake.parentBuildIDMutex.RLock()
 	defer fake.parentBuildIDMutex.RUnlock()
 	fake.parentJobIDMutex.RLock()
@@ -91,6 +91,18 @@ type FakeResource struct {
 	disableVersionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisabledVersionsStub        func() ([]atc.Version, error)
+	disabledVersionsMutex       sync.RWMutex
+	disabledVersionsArgsForCall []struct {
+	}
+	disabledVersionsReturns struct {
+		result1 []atc.Version
+		result2 error
+	}
+	disabledVersionsReturnsOnCall map[int]struct {
+		result1 []atc.Version
+		result2 error
+	}
 	EnableVersionStub        func(int) error
 	enableVersionMutex       sync.RWMutex
 	enableVersionArgsForCall []struct {
@@ -286,6 +298,29 @@ type FakeResource struct {
 		result2 bool
 		result3 error
 	}
+	RestoreDisabledVersionsStub        func([]atc.Version) error
+	restoreDisabledVersionsMutex       sync.RWMutex
+	restoreDisabledVersionsArgsForCall []struct {
+		arg1 []atc.Version
+	}
+	restoreDisabledVersionsReturns struct {
+		result1 error
+	}
+	restoreDisabledVersionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestorePinStub        func(atc.Version, string) error
+	restorePinMutex       sync.RWMutex
+	restorePinArgsForCall []struct {
+		arg1 atc.Version
+		arg2 string
+	}
+	restorePinReturns struct {
+		result1 error
+	}
+	restorePinReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveUncheckedVersionStub        func(atc.Version, db.ResourceConfigMetadataFields, db.ResourceConfig, atc.VersionedResourceTypes) (bool, error)
 	saveUncheckedVersionMutex       sync.RWMutex
 	saveUncheckedVersionArgsForCall []struct {
@@ -440,6 +475,16 @@ type FakeResource struct {
 	webhookTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	WebhookVersionStub        func() *atc.WebhookVersionConfig
+	webhookVersionMutex       sync.RWMutex
+	webhookVersionArgsForCall []struct {
+	}
+	webhookVersionReturns struct {
+		result1 *atc.WebhookVersionConfig
+	}
+	webhookVersionReturnsOnCall map[int]struct {
+		result1 *atc.WebhookVersionConfig
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -868,6 +913,61 @@ func (fake *FakeResource) DisableVersionReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeResource) DisabledVersions() ([]atc.Version, error) {
+	fake.disabledVersionsMutex.Lock()
+	ret, specificReturn := fake.disabledVersionsReturnsOnCall[len(fake.disabledVersionsArgsForCall)]
+	fake.disabledVersionsArgsForCall = append(fake.disabledVersionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DisabledVersions", []interface{}{})
+	fake.disabledVersionsMutex.Unlock()
+	if fake.DisabledVersionsStub != nil {
+		return fake.DisabledVersionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.disabledVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) DisabledVersionsCallCount() int {
+	fake.disabledVersionsMutex.RLock()
+	defer fake.disabledVersionsMutex.RUnlock()
+	return len(fake.disabledVersionsArgsForCall)
+}
+
+func (fake *FakeResource) DisabledVersionsCalls(stub func() ([]atc.Version, error)) {
+	fake.disabledVersionsMutex.Lock()
+	defer fake.disabledVersionsMutex.Unlock()
+	fake.DisabledVersionsStub = stub
+}
+
+func (fake *FakeResource) DisabledVersionsReturns(result1 []atc.Version, result2 error) {
+	fake.disabledVersionsMutex.Lock()
+	defer fake.disabledVersionsMutex.Unlock()
+	fake.DisabledVersionsStub = nil
+	fake.disabledVersionsReturns = struct {
+		result1 []atc.Version
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) DisabledVersionsReturnsOnCall(i int, result1 []atc.Version, result2 error) {
+	fake.disabledVersionsMutex.Lock()
+	defer fake.disabledVersionsMutex.Unlock()
+	fake.DisabledVersionsStub = nil
+	if fake.disabledVersionsReturnsOnCall == nil {
+		fake.disabledVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Version
+			result2 error
+		})
+	}
+	fake.disabledVersionsReturnsOnCall[i] = struct {
+		result1 []atc.Version
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) EnableVersion(arg1 int) error {
 	fake.enableVersionMutex.Lock()
 	ret, specificReturn := fake.enableVersionReturnsOnCall[len(fake.enableVersionArgsForCall)]
@@ -1846,6 +1946,132 @@ func (fake *FakeResource) ResourceConfigVersionIDReturnsOnCall(i int, result1 in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeResource) RestoreDisabledVersions(arg1 []atc.Version) error {
+	var arg1Copy []atc.Version
+	if arg1 != nil {
+		arg1Copy = make([]atc.Version, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.restoreDisabledVersionsMutex.Lock()
+	ret, specificReturn := fake.restoreDisabledVersionsReturnsOnCall[len(fake.restoreDisabledVersionsArgsForCall)]
+	fake.restoreDisabledVersionsArgsForCall = append(fake.restoreDisabledVersionsArgsForCall, struct {
+		arg1 []atc.Version
+	}{arg1Copy})
+	fake.recordInvocation("RestoreDisabledVersions", []interface{}{arg1Copy})
+	fake.restoreDisabledVersionsMutex.Unlock()
+	if fake.RestoreDisabledVersionsStub != nil {
+		return fake.RestoreDisabledVersionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.restoreDisabledVersionsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) RestoreDisabledVersionsCallCount() int {
+	fake.restoreDisabledVersionsMutex.RLock()
+	defer fake.restoreDisabledVersionsMutex.RUnlock()
+	return len(fake.restoreDisabledVersionsArgsForCall)
+}
+
+func (fake *FakeResource) RestoreDisabledVersionsCalls(stub func([]atc.Version) error) {
+	fake.restoreDisabledVersionsMutex.Lock()
+	defer fake.restoreDisabledVersionsMutex.Unlock()
+	fake.RestoreDisabledVersionsStub = stub
+}
+
+func (fake *FakeResource) RestoreDisabledVersionsArgsForCall(i int) []atc.Version {
+	fake.restoreDisabledVersionsMutex.RLock()
+	defer fake.restoreDisabledVersionsMutex.RUnlock()
+	argsForCall := fake.restoreDisabledVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) RestoreDisabledVersionsReturns(result1 error) {
+	fake.restoreDisabledVersionsMutex.Lock()
+	defer fake.restoreDisabledVersionsMutex.Unlock()
+	fake.RestoreDisabledVersionsStub = nil
+	fake.restoreDisabledVersionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) RestoreDisabledVersionsReturnsOnCall(i int, result1 error) {
+	fake.restoreDisabledVersionsMutex.Lock()
+	defer fake.restoreDisabledVersionsMutex.Unlock()
+	fake.RestoreDisabledVersionsStub = nil
+	if fake.restoreDisabledVersionsReturnsOnCall == nil {
+		fake.restoreDisabledVersionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restoreDisabledVersionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) RestorePin(arg1 atc.Version, arg2 string) error {
+	fake.restorePinMutex.Lock()
+	ret, specificReturn := fake.restorePinReturnsOnCall[len(fake.restorePinArgsForCall)]
+	fake.restorePinArgsForCall = append(fake.restorePinArgsForCall, struct {
+		arg1 atc.Version
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RestorePin", []interface{}{arg1, arg2})
+	fake.restorePinMutex.Unlock()
+	if fake.RestorePinStub != nil {
+		return fake.RestorePinStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.restorePinReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) RestorePinCallCount() int {
+	fake.restorePinMutex.RLock()
+	defer fake.restorePinMutex.RUnlock()
+	return len(fake.restorePinArgsForCall)
+}
+
+func (fake *FakeResource) RestorePinCalls(stub func(atc.Version, string) error) {
+	fake.restorePinMutex.Lock()
+	defer fake.restorePinMutex.Unlock()
+	fake.RestorePinStub = stub
+}
+
+func (fake *FakeResource) RestorePinArgsForCall(i int) (atc.Version, string) {
+	fake.restorePinMutex.RLock()
+	defer fake.restorePinMutex.RUnlock()
+	argsForCall := fake.restorePinArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) RestorePinReturns(result1 error) {
+	fake.restorePinMutex.Lock()
+	defer fake.restorePinMutex.Unlock()
+	fake.RestorePinStub = nil
+	fake.restorePinReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) RestorePinReturnsOnCall(i int, result1 error) {
+	fake.restorePinMutex.Lock()
+	defer fake.restorePinMutex.Unlock()
+	fake.RestorePinStub = nil
+	if fake.restorePinReturnsOnCall == nil {
+		fake.restorePinReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restorePinReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResource) SaveUncheckedVersion(arg1 atc.Version, arg2 db.ResourceConfigMetadataFields, arg3 db.ResourceConfig, arg4 atc.VersionedResourceTypes) (bool, error) {
 	fake.saveUncheckedVersionMutex.Lock()
 	ret, specificReturn := fake.saveUncheckedVersionReturnsOnCall[len(fake.saveUncheckedVersionArgsForCall)]
@@ -2594,6 +2820,58 @@ func (fake *FakeResource) WebhookTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) WebhookVersion() *atc.WebhookVersionConfig {
+	fake.webhookVersionMutex.Lock()
+	ret, specificReturn := fake.webhookVersionReturnsOnCall[len(fake.webhookVersionArgsForCall)]
+	fake.webhookVersionArgsForCall = append(fake.webhookVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WebhookVersion", []interface{}{})
+	fake.webhookVersionMutex.Unlock()
+	if fake.WebhookVersionStub != nil {
+		return fake.WebhookVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookVersionCallCount() int {
+	fake.webhookVersionMutex.RLock()
+	defer fake.webhookVersionMutex.RUnlock()
+	return len(fake.webhookVersionArgsForCall)
+}
+
+func (fake *FakeResource) WebhookVersionCalls(stub func() *atc.WebhookVersionConfig) {
+	fake.webhookVersionMutex.Lock()
+	defer fake.webhookVersionMutex.Unlock()
+	fake.WebhookVersionStub = stub
+}
+
+func (fake *FakeResource) WebhookVersionReturns(result1 *atc.WebhookVersionConfig) {
+	fake.webhookVersionMutex.Lock()
+	defer fake.webhookVersionMutex.Unlock()
+	fake.WebhookVersionStub = nil
+	fake.webhookVersionReturns = struct {
+		result1 *atc.WebhookVersionConfig
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookVersionReturnsOnCall(i int, result1 *atc.WebhookVersionConfig) {
+	fake.webhookVersionMutex.Lock()
+	defer fake.webhookVersionMutex.Unlock()
+	fake.WebhookVersionStub = nil
+	if fake.webhookVersionReturnsOnCall == nil {
+		fake.webhookVersionReturnsOnCall = make(map[int]struct {
+			result1 *atc.WebhookVersionConfig
+		})
+	}
+	fake.webhookVersionReturnsOnCall[i] = struct {
+		result1 *atc.WebhookVersionConfig
+	}{result1}
+}
+
 func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2613,6 +2891,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.currentPinnedVersionMutex.RUnlock()
 	fake.disableVersionMutex.RLock()
 	defer fake.disableVersionMutex.RUnlock()
+	fake.disabledVersionsMutex.RLock()
+	defer fake.disabledVersionsMutex.RUnlock()
 	fake.enableVersionMutex.RLock()
 	defer fake.enableVersionMutex.RUnlock()
 	fake.hasWebhookMutex.RLock()
@@ -2649,6 +2929,10 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.resourceConfigScopeIDMutex.RUnlock()
 	fake.resourceConfigVersionIDMutex.RLock()
 	defer fake.resourceConfigVersionIDMutex.RUnlock()
+	fake.restoreDisabledVersionsMutex.RLock()
+	defer fake.restoreDisabledVersionsMutex.RUnlock()
+	fake.restorePinMutex.RLock()
+	defer fake.restorePinMutex.RUnlock()
 	fake.saveUncheckedVersionMutex.RLock()
 	defer fake.saveUncheckedVersionMutex.RUnlock()
 	fake.setCheckSetupErrorMutex.RLock()
@@ -2675,6 +2959,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.versionsMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
+	fake.webhookVersionMutex.RLock()
+	defer fake.webhookVersionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
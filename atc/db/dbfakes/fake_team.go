@@ -99,6 +99,26 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
+	CredentialManagerStub        func() *atc.TeamCredentialManager
+	credentialManagerMutex       sync.RWMutex
+	credentialManagerArgsForCall []struct {
+	}
+	credentialManagerReturns struct {
+		result1 *atc.TeamCredentialManager
+	}
+	credentialManagerReturnsOnCall map[int]struct {
+		result1 *atc.TeamCredentialManager
+	}
+	CustomRolesStub        func() []atc.TeamRole
+	customRolesMutex       sync.RWMutex
+	customRolesArgsForCall []struct {
+	}
+	customRolesReturns struct {
+		result1 []atc.TeamRole
+	}
+	customRolesReturnsOnCall map[int]struct {
+		result1 []atc.TeamRole
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -289,6 +309,19 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	PipelineEventsStub        func(int) (db.PipelineEventSource, error)
+	pipelineEventsMutex       sync.RWMutex
+	pipelineEventsArgsForCall []struct {
+		arg1 int
+	}
+	pipelineEventsReturns struct {
+		result1 db.PipelineEventSource
+		result2 error
+	}
+	pipelineEventsReturnsOnCall map[int]struct {
+		result1 db.PipelineEventSource
+		result2 error
+	}
 	PipelinesStub        func() ([]db.Pipeline, error)
 	pipelinesMutex       sync.RWMutex
 	pipelinesArgsForCall []struct {
@@ -371,6 +404,28 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	UpdateCredentialManagerStub        func(*atc.TeamCredentialManager) error
+	updateCredentialManagerMutex       sync.RWMutex
+	updateCredentialManagerArgsForCall []struct {
+		arg1 *atc.TeamCredentialManager
+	}
+	updateCredentialManagerReturns struct {
+		result1 error
+	}
+	updateCredentialManagerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateCustomRolesStub        func([]atc.TeamRole) error
+	updateCustomRolesMutex       sync.RWMutex
+	updateCustomRolesArgsForCall []struct {
+		arg1 []atc.TeamRole
+	}
+	updateCustomRolesReturns struct {
+		result1 error
+	}
+	updateCustomRolesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -807,6 +862,110 @@ func (fake *FakeTeam) CreateStartedBuildReturnsOnCall(i int, result1 db.Build, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CredentialManager() *atc.TeamCredentialManager {
+	fake.credentialManagerMutex.Lock()
+	ret, specificReturn := fake.credentialManagerReturnsOnCall[len(fake.credentialManagerArgsForCall)]
+	fake.credentialManagerArgsForCall = append(fake.credentialManagerArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CredentialManager", []interface{}{})
+	fake.credentialManagerMutex.Unlock()
+	if fake.CredentialManagerStub != nil {
+		return fake.CredentialManagerStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.credentialManagerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) CredentialManagerCallCount() int {
+	fake.credentialManagerMutex.RLock()
+	defer fake.credentialManagerMutex.RUnlock()
+	return len(fake.credentialManagerArgsForCall)
+}
+
+func (fake *FakeTeam) CredentialManagerCalls(stub func() *atc.TeamCredentialManager) {
+	fake.credentialManagerMutex.Lock()
+	defer fake.credentialManagerMutex.Unlock()
+	fake.CredentialManagerStub = stub
+}
+
+func (fake *FakeTeam) CredentialManagerReturns(result1 *atc.TeamCredentialManager) {
+	fake.credentialManagerMutex.Lock()
+	defer fake.credentialManagerMutex.Unlock()
+	fake.CredentialManagerStub = nil
+	fake.credentialManagerReturns = struct {
+		result1 *atc.TeamCredentialManager
+	}{result1}
+}
+
+func (fake *FakeTeam) CredentialManagerReturnsOnCall(i int, result1 *atc.TeamCredentialManager) {
+	fake.credentialManagerMutex.Lock()
+	defer fake.credentialManagerMutex.Unlock()
+	fake.CredentialManagerStub = nil
+	if fake.credentialManagerReturnsOnCall == nil {
+		fake.credentialManagerReturnsOnCall = make(map[int]struct {
+			result1 *atc.TeamCredentialManager
+		})
+	}
+	fake.credentialManagerReturnsOnCall[i] = struct {
+		result1 *atc.TeamCredentialManager
+	}{result1}
+}
+
+func (fake *FakeTeam) CustomRoles() []atc.TeamRole {
+	fake.customRolesMutex.Lock()
+	ret, specificReturn := fake.customRolesReturnsOnCall[len(fake.customRolesArgsForCall)]
+	fake.customRolesArgsForCall = append(fake.customRolesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CustomRoles", []interface{}{})
+	fake.customRolesMutex.Unlock()
+	if fake.CustomRolesStub != nil {
+		return fake.CustomRolesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.customRolesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) CustomRolesCallCount() int {
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
+	return len(fake.customRolesArgsForCall)
+}
+
+func (fake *FakeTeam) CustomRolesCalls(stub func() []atc.TeamRole) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = stub
+}
+
+func (fake *FakeTeam) CustomRolesReturns(result1 []atc.TeamRole) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	fake.customRolesReturns = struct {
+		result1 []atc.TeamRole
+	}{result1}
+}
+
+func (fake *FakeTeam) CustomRolesReturnsOnCall(i int, result1 []atc.TeamRole) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	if fake.customRolesReturnsOnCall == nil {
+		fake.customRolesReturnsOnCall = make(map[int]struct {
+			result1 []atc.TeamRole
+		})
+	}
+	fake.customRolesReturnsOnCall[i] = struct {
+		result1 []atc.TeamRole
+	}{result1}
+}
+
 func (fake *FakeTeam) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1684,6 +1843,69 @@ func (fake *FakeTeam) PipelineReturnsOnCall(i int, result1 db.Pipeline, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) PipelineEvents(arg1 int) (db.PipelineEventSource, error) {
+	fake.pipelineEventsMutex.Lock()
+	ret, specificReturn := fake.pipelineEventsReturnsOnCall[len(fake.pipelineEventsArgsForCall)]
+	fake.pipelineEventsArgsForCall = append(fake.pipelineEventsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("PipelineEvents", []interface{}{arg1})
+	fake.pipelineEventsMutex.Unlock()
+	if fake.PipelineEventsStub != nil {
+		return fake.PipelineEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pipelineEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PipelineEventsCallCount() int {
+	fake.pipelineEventsMutex.RLock()
+	defer fake.pipelineEventsMutex.RUnlock()
+	return len(fake.pipelineEventsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineEventsCalls(stub func(int) (db.PipelineEventSource, error)) {
+	fake.pipelineEventsMutex.Lock()
+	defer fake.pipelineEventsMutex.Unlock()
+	fake.PipelineEventsStub = stub
+}
+
+func (fake *FakeTeam) PipelineEventsArgsForCall(i int) int {
+	fake.pipelineEventsMutex.RLock()
+	defer fake.pipelineEventsMutex.RUnlock()
+	argsForCall := fake.pipelineEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineEventsReturns(result1 db.PipelineEventSource, result2 error) {
+	fake.pipelineEventsMutex.Lock()
+	defer fake.pipelineEventsMutex.Unlock()
+	fake.PipelineEventsStub = nil
+	fake.pipelineEventsReturns = struct {
+		result1 db.PipelineEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PipelineEventsReturnsOnCall(i int, result1 db.PipelineEventSource, result2 error) {
+	fake.pipelineEventsMutex.Lock()
+	defer fake.pipelineEventsMutex.Unlock()
+	fake.PipelineEventsStub = nil
+	if fake.pipelineEventsReturnsOnCall == nil {
+		fake.pipelineEventsReturnsOnCall = make(map[int]struct {
+			result1 db.PipelineEventSource
+			result2 error
+		})
+	}
+	fake.pipelineEventsReturnsOnCall[i] = struct {
+		result1 db.PipelineEventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Pipelines() ([]db.Pipeline, error) {
 	fake.pipelinesMutex.Lock()
 	ret, specificReturn := fake.pipelinesReturnsOnCall[len(fake.pipelinesArgsForCall)]
@@ -2053,6 +2275,131 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) UpdateCredentialManager(arg1 *atc.TeamCredentialManager) error {
+	fake.updateCredentialManagerMutex.Lock()
+	ret, specificReturn := fake.updateCredentialManagerReturnsOnCall[len(fake.updateCredentialManagerArgsForCall)]
+	fake.updateCredentialManagerArgsForCall = append(fake.updateCredentialManagerArgsForCall, struct {
+		arg1 *atc.TeamCredentialManager
+	}{arg1})
+	fake.recordInvocation("UpdateCredentialManager", []interface{}{arg1})
+	fake.updateCredentialManagerMutex.Unlock()
+	if fake.UpdateCredentialManagerStub != nil {
+		return fake.UpdateCredentialManagerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateCredentialManagerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateCredentialManagerCallCount() int {
+	fake.updateCredentialManagerMutex.RLock()
+	defer fake.updateCredentialManagerMutex.RUnlock()
+	return len(fake.updateCredentialManagerArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateCredentialManagerCalls(stub func(*atc.TeamCredentialManager) error) {
+	fake.updateCredentialManagerMutex.Lock()
+	defer fake.updateCredentialManagerMutex.Unlock()
+	fake.UpdateCredentialManagerStub = stub
+}
+
+func (fake *FakeTeam) UpdateCredentialManagerArgsForCall(i int) *atc.TeamCredentialManager {
+	fake.updateCredentialManagerMutex.RLock()
+	defer fake.updateCredentialManagerMutex.RUnlock()
+	argsForCall := fake.updateCredentialManagerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateCredentialManagerReturns(result1 error) {
+	fake.updateCredentialManagerMutex.Lock()
+	defer fake.updateCredentialManagerMutex.Unlock()
+	fake.UpdateCredentialManagerStub = nil
+	fake.updateCredentialManagerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateCredentialManagerReturnsOnCall(i int, result1 error) {
+	fake.updateCredentialManagerMutex.Lock()
+	defer fake.updateCredentialManagerMutex.Unlock()
+	fake.UpdateCredentialManagerStub = nil
+	if fake.updateCredentialManagerReturnsOnCall == nil {
+		fake.updateCredentialManagerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateCredentialManagerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateCustomRoles(arg1 []atc.TeamRole) error {
+	var arg1Copy []atc.TeamRole
+	if arg1 != nil {
+		arg1Copy = make([]atc.TeamRole, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.updateCustomRolesMutex.Lock()
+	ret, specificReturn := fake.updateCustomRolesReturnsOnCall[len(fake.updateCustomRolesArgsForCall)]
+	fake.updateCustomRolesArgsForCall = append(fake.updateCustomRolesArgsForCall, struct {
+		arg1 []atc.TeamRole
+	}{arg1Copy})
+	fake.recordInvocation("UpdateCustomRoles", []interface{}{arg1Copy})
+	fake.updateCustomRolesMutex.Unlock()
+	if fake.UpdateCustomRolesStub != nil {
+		return fake.UpdateCustomRolesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateCustomRolesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateCustomRolesCallCount() int {
+	fake.updateCustomRolesMutex.RLock()
+	defer fake.updateCustomRolesMutex.RUnlock()
+	return len(fake.updateCustomRolesArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateCustomRolesCalls(stub func([]atc.TeamRole) error) {
+	fake.updateCustomRolesMutex.Lock()
+	defer fake.updateCustomRolesMutex.Unlock()
+	fake.UpdateCustomRolesStub = stub
+}
+
+func (fake *FakeTeam) UpdateCustomRolesArgsForCall(i int) []atc.TeamRole {
+	fake.updateCustomRolesMutex.RLock()
+	defer fake.updateCustomRolesMutex.RUnlock()
+	argsForCall := fake.updateCustomRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateCustomRolesReturns(result1 error) {
+	fake.updateCustomRolesMutex.Lock()
+	defer fake.updateCustomRolesMutex.Unlock()
+	fake.UpdateCustomRolesStub = nil
+	fake.updateCustomRolesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateCustomRolesReturnsOnCall(i int, result1 error) {
+	fake.updateCustomRolesMutex.Lock()
+	defer fake.updateCustomRolesMutex.Unlock()
+	fake.UpdateCustomRolesStub = nil
+	if fake.updateCustomRolesReturnsOnCall == nil {
+		fake.updateCustomRolesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateCustomRolesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2185,6 +2532,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
+	fake.credentialManagerMutex.RLock()
+	defer fake.credentialManagerMutex.RUnlock()
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
@@ -2213,6 +2564,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.orderPipelinesMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
+	fake.pipelineEventsMutex.RLock()
+	defer fake.pipelineEventsMutex.RUnlock()
 	fake.pipelinesMutex.RLock()
 	defer fake.pipelinesMutex.RUnlock()
 	fake.privateAndPublicBuildsMutex.RLock()
@@ -2225,6 +2578,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.updateCredentialManagerMutex.RLock()
+	defer fake.updateCredentialManagerMutex.RUnlock()
+	fake.updateCustomRolesMutex.RLock()
+	defer fake.updateCustomRolesMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
 	fake.workersMutex.RLock()
@@ -255,6 +255,20 @@ type FakeVolumeRepository struct {
 		result1 []db.CreatedVolume
 		result2 error
 	}
+	GetUnreplicatedResourceCacheVolumesStub        func(string, int) ([]db.CreatedVolume, error)
+	getUnreplicatedResourceCacheVolumesMutex       sync.RWMutex
+	getUnreplicatedResourceCacheVolumesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	getUnreplicatedResourceCacheVolumesReturns struct {
+		result1 []db.CreatedVolume
+		result2 error
+	}
+	getUnreplicatedResourceCacheVolumesReturnsOnCall map[int]struct {
+		result1 []db.CreatedVolume
+		result2 error
+	}
 	RemoveDestroyingVolumesStub        func(string, []string) (int, error)
 	removeDestroyingVolumesMutex       sync.RWMutex
 	removeDestroyingVolumesArgsForCall []struct {
@@ -1391,6 +1405,70 @@ func (fake *FakeVolumeRepository) GetTeamVolumesReturnsOnCall(i int, result1 []d
 	}{result1, result2}
 }
 
+func (fake *FakeVolumeRepository) GetUnreplicatedResourceCacheVolumes(arg1 string, arg2 int) ([]db.CreatedVolume, error) {
+	fake.getUnreplicatedResourceCacheVolumesMutex.Lock()
+	ret, specificReturn := fake.getUnreplicatedResourceCacheVolumesReturnsOnCall[len(fake.getUnreplicatedResourceCacheVolumesArgsForCall)]
+	fake.getUnreplicatedResourceCacheVolumesArgsForCall = append(fake.getUnreplicatedResourceCacheVolumesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetUnreplicatedResourceCacheVolumes", []interface{}{arg1, arg2})
+	fake.getUnreplicatedResourceCacheVolumesMutex.Unlock()
+	if fake.GetUnreplicatedResourceCacheVolumesStub != nil {
+		return fake.GetUnreplicatedResourceCacheVolumesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getUnreplicatedResourceCacheVolumesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeRepository) GetUnreplicatedResourceCacheVolumesCallCount() int {
+	fake.getUnreplicatedResourceCacheVolumesMutex.RLock()
+	defer fake.getUnreplicatedResourceCacheVolumesMutex.RUnlock()
+	return len(fake.getUnreplicatedResourceCacheVolumesArgsForCall)
+}
+
+func (fake *FakeVolumeRepository) GetUnreplicatedResourceCacheVolumesCalls(stub func(string, int) ([]db.CreatedVolume, error)) {
+	fake.getUnreplicatedResourceCacheVolumesMutex.Lock()
+	defer fake.getUnreplicatedResourceCacheVolumesMutex.Unlock()
+	fake.GetUnreplicatedResourceCacheVolumesStub = stub
+}
+
+func (fake *FakeVolumeRepository) GetUnreplicatedResourceCacheVolumesArgsForCall(i int) (string, int) {
+	fake.getUnreplicatedResourceCacheVolumesMutex.RLock()
+	defer fake.getUnreplicatedResourceCacheVolumesMutex.RUnlock()
+	argsForCall := fake.getUnreplicatedResourceCacheVolumesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeRepository) GetUnreplicatedResourceCacheVolumesReturns(result1 []db.CreatedVolume, result2 error) {
+	fake.getUnreplicatedResourceCacheVolumesMutex.Lock()
+	defer fake.getUnreplicatedResourceCacheVolumesMutex.Unlock()
+	fake.GetUnreplicatedResourceCacheVolumesStub = nil
+	fake.getUnreplicatedResourceCacheVolumesReturns = struct {
+		result1 []db.CreatedVolume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeRepository) GetUnreplicatedResourceCacheVolumesReturnsOnCall(i int, result1 []db.CreatedVolume, result2 error) {
+	fake.getUnreplicatedResourceCacheVolumesMutex.Lock()
+	defer fake.getUnreplicatedResourceCacheVolumesMutex.Unlock()
+	fake.GetUnreplicatedResourceCacheVolumesStub = nil
+	if fake.getUnreplicatedResourceCacheVolumesReturnsOnCall == nil {
+		fake.getUnreplicatedResourceCacheVolumesReturnsOnCall = make(map[int]struct {
+			result1 []db.CreatedVolume
+			result2 error
+		})
+	}
+	fake.getUnreplicatedResourceCacheVolumesReturnsOnCall[i] = struct {
+		result1 []db.CreatedVolume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeRepository) RemoveDestroyingVolumes(arg1 string, arg2 []string) (int, error) {
 	var arg2Copy []string
 	if arg2 != nil {
@@ -1626,6 +1704,8 @@ func (fake *FakeVolumeRepository) Invocations() map[string][][]interface{} {
 	defer fake.getOrphanedVolumesMutex.RUnlock()
 	fake.getTeamVolumesMutex.RLock()
 	defer fake.getTeamVolumesMutex.RUnlock()
+	fake.getUnreplicatedResourceCacheVolumesMutex.RLock()
+	defer fake.getUnreplicatedResourceCacheVolumesMutex.RUnlock()
 	fake.removeDestroyingVolumesMutex.RLock()
 	defer fake.removeDestroyingVolumesMutex.RUnlock()
 	fake.removeMissingVolumesMutex.RLock()
@@ -9,6 +9,18 @@ import (
 )
 
 type FakeWall struct {
+	ActiveMessagesStub        func() ([]atc.WallMessage, error)
+	activeMessagesMutex       sync.RWMutex
+	activeMessagesArgsForCall []struct {
+	}
+	activeMessagesReturns struct {
+		result1 []atc.WallMessage
+		result2 error
+	}
+	activeMessagesReturnsOnCall map[int]struct {
+		result1 []atc.WallMessage
+		result2 error
+	}
 	ClearStub        func() error
 	clearMutex       sync.RWMutex
 	clearArgsForCall []struct {
@@ -19,6 +31,32 @@ type FakeWall struct {
 	clearReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateMessageStub        func(atc.WallMessage) (atc.WallMessage, error)
+	createMessageMutex       sync.RWMutex
+	createMessageArgsForCall []struct {
+		arg1 atc.WallMessage
+	}
+	createMessageReturns struct {
+		result1 atc.WallMessage
+		result2 error
+	}
+	createMessageReturnsOnCall map[int]struct {
+		result1 atc.WallMessage
+		result2 error
+	}
+	DeleteMessageStub        func(int) (bool, error)
+	deleteMessageMutex       sync.RWMutex
+	deleteMessageArgsForCall []struct {
+		arg1 int
+	}
+	deleteMessageReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteMessageReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	GetWallStub        func() (atc.Wall, error)
 	getWallMutex       sync.RWMutex
 	getWallArgsForCall []struct {
@@ -31,6 +69,18 @@ type FakeWall struct {
 		result1 atc.Wall
 		result2 error
 	}
+	MessagesStub        func() ([]atc.WallMessage, error)
+	messagesMutex       sync.RWMutex
+	messagesArgsForCall []struct {
+	}
+	messagesReturns struct {
+		result1 []atc.WallMessage
+		result2 error
+	}
+	messagesReturnsOnCall map[int]struct {
+		result1 []atc.WallMessage
+		result2 error
+	}
 	SetWallStub        func(atc.Wall) error
 	setWallMutex       sync.RWMutex
 	setWallArgsForCall []struct {
@@ -46,6 +96,61 @@ type FakeWall struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeWall) ActiveMessages() ([]atc.WallMessage, error) {
+	fake.activeMessagesMutex.Lock()
+	ret, specificReturn := fake.activeMessagesReturnsOnCall[len(fake.activeMessagesArgsForCall)]
+	fake.activeMessagesArgsForCall = append(fake.activeMessagesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ActiveMessages", []interface{}{})
+	fake.activeMessagesMutex.Unlock()
+	if fake.ActiveMessagesStub != nil {
+		return fake.ActiveMessagesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.activeMessagesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWall) ActiveMessagesCallCount() int {
+	fake.activeMessagesMutex.RLock()
+	defer fake.activeMessagesMutex.RUnlock()
+	return len(fake.activeMessagesArgsForCall)
+}
+
+func (fake *FakeWall) ActiveMessagesCalls(stub func() ([]atc.WallMessage, error)) {
+	fake.activeMessagesMutex.Lock()
+	defer fake.activeMessagesMutex.Unlock()
+	fake.ActiveMessagesStub = stub
+}
+
+func (fake *FakeWall) ActiveMessagesReturns(result1 []atc.WallMessage, result2 error) {
+	fake.activeMessagesMutex.Lock()
+	defer fake.activeMessagesMutex.Unlock()
+	fake.ActiveMessagesStub = nil
+	fake.activeMessagesReturns = struct {
+		result1 []atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWall) ActiveMessagesReturnsOnCall(i int, result1 []atc.WallMessage, result2 error) {
+	fake.activeMessagesMutex.Lock()
+	defer fake.activeMessagesMutex.Unlock()
+	fake.ActiveMessagesStub = nil
+	if fake.activeMessagesReturnsOnCall == nil {
+		fake.activeMessagesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WallMessage
+			result2 error
+		})
+	}
+	fake.activeMessagesReturnsOnCall[i] = struct {
+		result1 []atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWall) Clear() error {
 	fake.clearMutex.Lock()
 	ret, specificReturn := fake.clearReturnsOnCall[len(fake.clearArgsForCall)]
@@ -98,6 +203,132 @@ func (fake *FakeWall) ClearReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWall) CreateMessage(arg1 atc.WallMessage) (atc.WallMessage, error) {
+	fake.createMessageMutex.Lock()
+	ret, specificReturn := fake.createMessageReturnsOnCall[len(fake.createMessageArgsForCall)]
+	fake.createMessageArgsForCall = append(fake.createMessageArgsForCall, struct {
+		arg1 atc.WallMessage
+	}{arg1})
+	fake.recordInvocation("CreateMessage", []interface{}{arg1})
+	fake.createMessageMutex.Unlock()
+	if fake.CreateMessageStub != nil {
+		return fake.CreateMessageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createMessageReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWall) CreateMessageCallCount() int {
+	fake.createMessageMutex.RLock()
+	defer fake.createMessageMutex.RUnlock()
+	return len(fake.createMessageArgsForCall)
+}
+
+func (fake *FakeWall) CreateMessageCalls(stub func(atc.WallMessage) (atc.WallMessage, error)) {
+	fake.createMessageMutex.Lock()
+	defer fake.createMessageMutex.Unlock()
+	fake.CreateMessageStub = stub
+}
+
+func (fake *FakeWall) CreateMessageArgsForCall(i int) atc.WallMessage {
+	fake.createMessageMutex.RLock()
+	defer fake.createMessageMutex.RUnlock()
+	argsForCall := fake.createMessageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWall) CreateMessageReturns(result1 atc.WallMessage, result2 error) {
+	fake.createMessageMutex.Lock()
+	defer fake.createMessageMutex.Unlock()
+	fake.CreateMessageStub = nil
+	fake.createMessageReturns = struct {
+		result1 atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWall) CreateMessageReturnsOnCall(i int, result1 atc.WallMessage, result2 error) {
+	fake.createMessageMutex.Lock()
+	defer fake.createMessageMutex.Unlock()
+	fake.CreateMessageStub = nil
+	if fake.createMessageReturnsOnCall == nil {
+		fake.createMessageReturnsOnCall = make(map[int]struct {
+			result1 atc.WallMessage
+			result2 error
+		})
+	}
+	fake.createMessageReturnsOnCall[i] = struct {
+		result1 atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWall) DeleteMessage(arg1 int) (bool, error) {
+	fake.deleteMessageMutex.Lock()
+	ret, specificReturn := fake.deleteMessageReturnsOnCall[len(fake.deleteMessageArgsForCall)]
+	fake.deleteMessageArgsForCall = append(fake.deleteMessageArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteMessage", []interface{}{arg1})
+	fake.deleteMessageMutex.Unlock()
+	if fake.DeleteMessageStub != nil {
+		return fake.DeleteMessageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteMessageReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWall) DeleteMessageCallCount() int {
+	fake.deleteMessageMutex.RLock()
+	defer fake.deleteMessageMutex.RUnlock()
+	return len(fake.deleteMessageArgsForCall)
+}
+
+func (fake *FakeWall) DeleteMessageCalls(stub func(int) (bool, error)) {
+	fake.deleteMessageMutex.Lock()
+	defer fake.deleteMessageMutex.Unlock()
+	fake.DeleteMessageStub = stub
+}
+
+func (fake *FakeWall) DeleteMessageArgsForCall(i int) int {
+	fake.deleteMessageMutex.RLock()
+	defer fake.deleteMessageMutex.RUnlock()
+	argsForCall := fake.deleteMessageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWall) DeleteMessageReturns(result1 bool, result2 error) {
+	fake.deleteMessageMutex.Lock()
+	defer fake.deleteMessageMutex.Unlock()
+	fake.DeleteMessageStub = nil
+	fake.deleteMessageReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWall) DeleteMessageReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteMessageMutex.Lock()
+	defer fake.deleteMessageMutex.Unlock()
+	fake.DeleteMessageStub = nil
+	if fake.deleteMessageReturnsOnCall == nil {
+		fake.deleteMessageReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteMessageReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWall) GetWall() (atc.Wall, error) {
 	fake.getWallMutex.Lock()
 	ret, specificReturn := fake.getWallReturnsOnCall[len(fake.getWallArgsForCall)]
@@ -153,6 +384,61 @@ func (fake *FakeWall) GetWallReturnsOnCall(i int, result1 atc.Wall, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeWall) Messages() ([]atc.WallMessage, error) {
+	fake.messagesMutex.Lock()
+	ret, specificReturn := fake.messagesReturnsOnCall[len(fake.messagesArgsForCall)]
+	fake.messagesArgsForCall = append(fake.messagesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Messages", []interface{}{})
+	fake.messagesMutex.Unlock()
+	if fake.MessagesStub != nil {
+		return fake.MessagesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.messagesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWall) MessagesCallCount() int {
+	fake.messagesMutex.RLock()
+	defer fake.messagesMutex.RUnlock()
+	return len(fake.messagesArgsForCall)
+}
+
+func (fake *FakeWall) MessagesCalls(stub func() ([]atc.WallMessage, error)) {
+	fake.messagesMutex.Lock()
+	defer fake.messagesMutex.Unlock()
+	fake.MessagesStub = stub
+}
+
+func (fake *FakeWall) MessagesReturns(result1 []atc.WallMessage, result2 error) {
+	fake.messagesMutex.Lock()
+	defer fake.messagesMutex.Unlock()
+	fake.MessagesStub = nil
+	fake.messagesReturns = struct {
+		result1 []atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWall) MessagesReturnsOnCall(i int, result1 []atc.WallMessage, result2 error) {
+	fake.messagesMutex.Lock()
+	defer fake.messagesMutex.Unlock()
+	fake.MessagesStub = nil
+	if fake.messagesReturnsOnCall == nil {
+		fake.messagesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WallMessage
+			result2 error
+		})
+	}
+	fake.messagesReturnsOnCall[i] = struct {
+		result1 []atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWall) SetWall(arg1 atc.Wall) error {
 	fake.setWallMutex.Lock()
 	ret, specificReturn := fake.setWallReturnsOnCall[len(fake.setWallArgsForCall)]
@@ -216,10 +502,18 @@ func (fake *FakeWall) SetWallReturnsOnCall(i int, result1 error) {
 func (fake *FakeWall) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.activeMessagesMutex.RLock()
+	defer fake.activeMessagesMutex.RUnlock()
 	fake.clearMutex.RLock()
 	defer fake.clearMutex.RUnlock()
+	fake.createMessageMutex.RLock()
+	defer fake.createMessageMutex.RUnlock()
+	fake.deleteMessageMutex.RLock()
+	defer fake.deleteMessageMutex.RUnlock()
 	fake.getWallMutex.RLock()
 	defer fake.getWallMutex.RUnlock()
+	fake.messagesMutex.RLock()
+	defer fake.messagesMutex.RUnlock()
 	fake.setWallMutex.RLock()
 	defer fake.setWallMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
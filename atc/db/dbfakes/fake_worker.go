@@ -181,6 +181,27 @@ type FakeWorker struct {
 	landReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LandByStub        func(time.Time) error
+	landByMutex       sync.RWMutex
+	landByArgsForCall []struct {
+		arg1 time.Time
+	}
+	landByReturns struct {
+		result1 error
+	}
+	landByReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LandDeadlineStub        func() time.Time
+	landDeadlineMutex       sync.RWMutex
+	landDeadlineArgsForCall []struct {
+	}
+	landDeadlineReturns struct {
+		result1 time.Time
+	}
+	landDeadlineReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -1192,6 +1213,118 @@ func (fake *FakeWorker) LandReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) LandBy(arg1 time.Time) error {
+	fake.landByMutex.Lock()
+	ret, specificReturn := fake.landByReturnsOnCall[len(fake.landByArgsForCall)]
+	fake.landByArgsForCall = append(fake.landByArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("LandBy", []interface{}{arg1})
+	fake.landByMutex.Unlock()
+	if fake.LandByStub != nil {
+		return fake.LandByStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.landByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LandByCallCount() int {
+	fake.landByMutex.RLock()
+	defer fake.landByMutex.RUnlock()
+	return len(fake.landByArgsForCall)
+}
+
+func (fake *FakeWorker) LandByCalls(stub func(time.Time) error) {
+	fake.landByMutex.Lock()
+	defer fake.landByMutex.Unlock()
+	fake.LandByStub = stub
+}
+
+func (fake *FakeWorker) LandByArgsForCall(i int) time.Time {
+	fake.landByMutex.RLock()
+	defer fake.landByMutex.RUnlock()
+	argsForCall := fake.landByArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) LandByReturns(result1 error) {
+	fake.landByMutex.Lock()
+	defer fake.landByMutex.Unlock()
+	fake.LandByStub = nil
+	fake.landByReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) LandByReturnsOnCall(i int, result1 error) {
+	fake.landByMutex.Lock()
+	defer fake.landByMutex.Unlock()
+	fake.LandByStub = nil
+	if fake.landByReturnsOnCall == nil {
+		fake.landByReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.landByReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) LandDeadline() time.Time {
+	fake.landDeadlineMutex.Lock()
+	ret, specificReturn := fake.landDeadlineReturnsOnCall[len(fake.landDeadlineArgsForCall)]
+	fake.landDeadlineArgsForCall = append(fake.landDeadlineArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LandDeadline", []interface{}{})
+	fake.landDeadlineMutex.Unlock()
+	if fake.LandDeadlineStub != nil {
+		return fake.LandDeadlineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.landDeadlineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LandDeadlineCallCount() int {
+	fake.landDeadlineMutex.RLock()
+	defer fake.landDeadlineMutex.RUnlock()
+	return len(fake.landDeadlineArgsForCall)
+}
+
+func (fake *FakeWorker) LandDeadlineCalls(stub func() time.Time) {
+	fake.landDeadlineMutex.Lock()
+	defer fake.landDeadlineMutex.Unlock()
+	fake.LandDeadlineStub = stub
+}
+
+func (fake *FakeWorker) LandDeadlineReturns(result1 time.Time) {
+	fake.landDeadlineMutex.Lock()
+	defer fake.landDeadlineMutex.Unlock()
+	fake.LandDeadlineStub = nil
+	fake.landDeadlineReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeWorker) LandDeadlineReturnsOnCall(i int, result1 time.Time) {
+	fake.landDeadlineMutex.Lock()
+	defer fake.landDeadlineMutex.Unlock()
+	fake.LandDeadlineStub = nil
+	if fake.landDeadlineReturnsOnCall == nil {
+		fake.landDeadlineReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.landDeadlineReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1964,6 +2097,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.increaseActiveTasksMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
+	fake.landByMutex.RLock()
+	defer fake.landByMutex.RUnlock()
+	fake.landDeadlineMutex.RLock()
+	defer fake.landDeadlineMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.noProxyMutex.RLock()
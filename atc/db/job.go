@@ -724,6 +724,19 @@ func (j *job) EnsurePendingBuildExists(ctx context.Context) error {
 			return err
 		}
 
+		err = savePipelineEvent(tx, j.teamID, j.pipelineID, atc.PipelineEvent{
+			Type:    atc.PipelineEventBuildStatus,
+			JobName: j.name,
+			Build: &atc.PipelineEventBuild{
+				ID:     buildID,
+				Name:   buildName,
+				Status: atc.StatusPending,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
 		return tx.Commit()
 	}
 
@@ -1088,10 +1101,17 @@ func (j *job) getNextPendingBuildBySerialGroup(tx Tx, serialGroups []string) (Bu
 }
 
 func (j *job) updatePausedJob(pause bool) error {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
 	result, err := psql.Update("jobs").
 		Set("paused", pause).
 		Where(sq.Eq{"id": j.id}).
-		RunWith(j.conn).
+		RunWith(tx).
 		Exec()
 	if err != nil {
 		return err
@@ -1107,13 +1127,26 @@ func (j *job) updatePausedJob(pause bool) error {
 	}
 
 	if !pause {
-		err = j.RequestSchedule()
+		err = requestSchedule(tx, j.id)
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	eventType := atc.PipelineEventJobPaused
+	if !pause {
+		eventType = atc.PipelineEventJobUnpaused
+	}
+
+	err = savePipelineEvent(tx, j.teamID, j.pipelineID, atc.PipelineEvent{
+		Type:    eventType,
+		JobName: j.name,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (j *job) getNewBuildName(tx Tx) (string, error) {
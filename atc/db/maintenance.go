@@ -0,0 +1,220 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . Maintenance
+
+// Maintenance stores whether the cluster is in maintenance mode. The mode is
+// enabled for as long as its row exists.
+type Maintenance interface {
+	Enable(reason string, pauseChecks bool) error
+	Disable() error
+
+	Mode() (atc.Maintenance, error)
+	BuildCounts() (running int, pending int, err error)
+}
+
+// maintenancePausedComponents are the components paused while maintenance
+// mode is enabled with PauseChecks. Only the ones this mode paused are resumed
+// when it is disabled.
+var maintenancePausedComponents = []string{
+	atc.ComponentLidarScanner,
+	atc.ComponentLidarChecker,
+}
+
+type maintenance struct {
+	conn Conn
+}
+
+func NewMaintenance(conn Conn) Maintenance {
+	return &maintenance{
+		conn: conn,
+	}
+}
+
+func (m *maintenance) Enable(reason string, pauseChecks bool) error {
+	tx, err := m.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	// the components paused by this mode, as opposed to ones an admin had
+	// already paused, which are left as they are
+	paused := []string{}
+	err = tx.QueryRow(`SELECT paused_components FROM maintenance FOR UPDATE`).Scan(pq.Array(&paused))
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	if pauseChecks {
+		newlyPaused, err := pauseComponents(tx, maintenancePausedComponents)
+		if err != nil {
+			return err
+		}
+
+		paused = append(paused, newlyPaused...)
+	} else {
+		err = resumeComponents(tx, paused)
+		if err != nil {
+			return err
+		}
+
+		paused = []string{}
+	}
+
+	_, err = psql.Insert("maintenance").
+		Columns("reason", "pause_checks", "paused_components").
+		Values(reason, pauseChecks, pq.Array(paused)).
+		Suffix(`ON CONFLICT (id) DO UPDATE SET
+			reason = EXCLUDED.reason,
+			pause_checks = EXCLUDED.pause_checks,
+			paused_components = EXCLUDED.paused_components`).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return m.notifyCacher()
+}
+
+func (m *maintenance) Disable() error {
+	tx, err := m.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	var paused []string
+	err = tx.QueryRow(`DELETE FROM maintenance RETURNING paused_components`).Scan(pq.Array(&paused))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+
+	err = resumeComponents(tx, paused)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return m.notifyCacher()
+}
+
+// notifyCacher tells every ATC to drop the mode it has cached for the info
+// endpoint.
+func (m *maintenance) notifyCacher() error {
+	return m.conn.Bus().Notify(atc.MaintenanceCacheChannel)
+}
+
+// Mode returns the maintenance mode of the cluster, without the build counts.
+func (m *maintenance) Mode() (atc.Maintenance, error) {
+	var (
+		mode      atc.Maintenance
+		enabledAt time.Time
+	)
+
+	err := psql.Select("reason", "pause_checks", "enabled_at").
+		From("maintenance").
+		RunWith(m.conn).
+		QueryRow().
+		Scan(&mode.Reason, &mode.PauseChecks, &enabledAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.Maintenance{}, nil
+		}
+		return atc.Maintenance{}, err
+	}
+
+	mode.Enabled = true
+	mode.EnabledAt = enabledAt.Unix()
+
+	return mode, nil
+}
+
+// BuildCounts returns the number of builds that are running and the number of
+// builds that are waiting to be started.
+func (m *maintenance) BuildCounts() (int, int, error) {
+	var running, pending int
+
+	err := psql.Select(
+		"COUNT(*) FILTER (WHERE status = 'started')",
+		"COUNT(*) FILTER (WHERE status = 'pending')",
+	).
+		From("builds").
+		Where(sq.Eq{"status": []string{string(BuildStatusStarted), string(BuildStatusPending)}}).
+		RunWith(m.conn).
+		QueryRow().
+		Scan(&running, &pending)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return running, pending, nil
+}
+
+// pauseComponents pauses the given components that are not paused yet, and
+// returns their names.
+func pauseComponents(tx Tx, names []string) ([]string, error) {
+	rows, err := psql.Update("components").
+		Set("paused", true).
+		Where(sq.Eq{
+			"name":   names,
+			"paused": false,
+		}).
+		Suffix("RETURNING name").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var paused []string
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+
+		paused = append(paused, name)
+	}
+
+	return paused, rows.Err()
+}
+
+func resumeComponents(tx Tx, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	_, err := psql.Update("components").
+		Set("paused", false).
+		Where(sq.Eq{"name": names}).
+		RunWith(tx).
+		Exec()
+	return err
+}
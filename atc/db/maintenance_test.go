@@ -0,0 +1,184 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Maintenance", func() {
+	var maintenance db.Maintenance
+
+	BeforeEach(func() {
+		maintenance = db.NewMaintenance(dbConn)
+	})
+
+	It("is disabled by default", func() {
+		mode, err := maintenance.Mode()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mode).To(Equal(atc.Maintenance{}))
+	})
+
+	Context("when enabled", func() {
+		var pauseChecks bool
+
+		BeforeEach(func() {
+			pauseChecks = false
+
+			_, err := componentFactory.CreateOrUpdate(atc.Component{Name: atc.ComponentLidarScanner})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = componentFactory.CreateOrUpdate(atc.Component{Name: atc.ComponentScheduler})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			err := maintenance.Enable("upgrading", pauseChecks)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the mode", func() {
+			mode, err := maintenance.Mode()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mode.Enabled).To(BeTrue())
+			Expect(mode.Reason).To(Equal("upgrading"))
+			Expect(mode.PauseChecks).To(BeFalse())
+			Expect(mode.EnabledAt).ToNot(BeZero())
+		})
+
+		It("notifies the info cacher when disabled", func() {
+			notified, err := dbConn.Bus().Listen(atc.MaintenanceCacheChannel)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer dbConn.Bus().Unlisten(atc.MaintenanceCacheChannel, notified)
+
+			Expect(maintenance.Disable()).To(Succeed())
+
+			Eventually(notified).Should(Receive())
+		})
+
+		It("can be enabled again to update the reason", func() {
+			err := maintenance.Enable("still upgrading", false)
+			Expect(err).ToNot(HaveOccurred())
+
+			mode, err := maintenance.Mode()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mode.Reason).To(Equal("still upgrading"))
+		})
+
+		It("does not pause the checks", func() {
+			scanner, found, err := componentFactory.Find(atc.ComponentLidarScanner)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(scanner.Paused()).To(BeFalse())
+		})
+
+		Context("with checks paused", func() {
+			BeforeEach(func() {
+				pauseChecks = true
+			})
+
+			It("pauses only the lidar components", func() {
+				scanner, _, err := componentFactory.Find(atc.ComponentLidarScanner)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scanner.Paused()).To(BeTrue())
+
+				scheduler, _, err := componentFactory.Find(atc.ComponentScheduler)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scheduler.Paused()).To(BeFalse())
+			})
+
+			It("resumes the checks when disabled", func() {
+				err := maintenance.Disable()
+				Expect(err).ToNot(HaveOccurred())
+
+				scanner, _, err := componentFactory.Find(atc.ComponentLidarScanner)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scanner.Paused()).To(BeFalse())
+			})
+
+			It("resumes the checks when enabled again without pausing them", func() {
+				err := maintenance.Enable("upgrading", false)
+				Expect(err).ToNot(HaveOccurred())
+
+				scanner, _, err := componentFactory.Find(atc.ComponentLidarScanner)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scanner.Paused()).To(BeFalse())
+			})
+
+			Context("when a component had been paused by an admin", func() {
+				BeforeEach(func() {
+					_, err := componentFactory.CreateOrUpdate(atc.Component{Name: atc.ComponentLidarChecker})
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = dbConn.Exec(`UPDATE components SET paused = true WHERE name = $1`, atc.ComponentLidarChecker)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("leaves it paused when disabled", func() {
+					err := maintenance.Disable()
+					Expect(err).ToNot(HaveOccurred())
+
+					scanner, _, err := componentFactory.Find(atc.ComponentLidarScanner)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scanner.Paused()).To(BeFalse())
+
+					checker, _, err := componentFactory.Find(atc.ComponentLidarChecker)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(checker.Paused()).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when a component had been paused by an admin", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec(`UPDATE components SET paused = true WHERE name = $1`, atc.ComponentLidarScanner)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("leaves it paused when enabled and disabled without pausing the checks", func() {
+				err := maintenance.Disable()
+				Expect(err).ToNot(HaveOccurred())
+
+				scanner, _, err := componentFactory.Find(atc.ComponentLidarScanner)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scanner.Paused()).To(BeTrue())
+			})
+		})
+
+		It("is disabled by Disable", func() {
+			err := maintenance.Disable()
+			Expect(err).ToNot(HaveOccurred())
+
+			mode, err := maintenance.Mode()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mode.Enabled).To(BeFalse())
+		})
+	})
+
+	It("does nothing when disabled while not enabled", func() {
+		Expect(maintenance.Disable()).To(Succeed())
+	})
+
+	Describe("BuildCounts", func() {
+		BeforeEach(func() {
+			_, err := defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err := defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = started.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("counts the running and pending builds", func() {
+			running, pending, err := maintenance.BuildCounts()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(running).To(Equal(1))
+			Expect(pending).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,3 @@
+BEGIN;
+    ALTER TABLE teams ADD COLUMN credential_manager text, ADD COLUMN credential_manager_nonce text;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE api_tokens;
+COMMIT;
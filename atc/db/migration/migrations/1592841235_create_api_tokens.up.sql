@@ -0,0 +1,17 @@
+BEGIN;
+  CREATE TABLE api_tokens (
+    "id" serial NOT NULL PRIMARY KEY,
+    "name" text NOT NULL,
+    "team_id" integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    "role" text NOT NULL,
+    "service_account" boolean DEFAULT false NOT NULL,
+    "token_hash" text NOT NULL,
+    "created_by" text NOT NULL,
+    "created_by_sub" text NOT NULL,
+    "created_at" timestamp with time zone DEFAULT now() NOT NULL,
+    "expires_at" timestamp with time zone NOT NULL,
+    "last_used_at" timestamp with time zone
+  );
+  ALTER TABLE ONLY api_tokens ADD CONSTRAINT api_tokens_team_id_name_key UNIQUE (team_id, name);
+  ALTER TABLE ONLY api_tokens ADD CONSTRAINT api_tokens_token_hash_key UNIQUE (token_hash);
+COMMIT;
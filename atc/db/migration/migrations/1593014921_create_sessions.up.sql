@@ -0,0 +1,15 @@
+BEGIN;
+  CREATE TABLE sessions (
+    "id" serial NOT NULL PRIMARY KEY,
+    "token_hash" text NOT NULL,
+    "sub" text NOT NULL,
+    "username" text NOT NULL,
+    "connector" text NOT NULL,
+    "created_at" timestamp with time zone DEFAULT now() NOT NULL,
+    "last_used_at" timestamp with time zone DEFAULT now() NOT NULL,
+    "expires_at" timestamp with time zone NOT NULL,
+    "revoked_at" timestamp with time zone
+  );
+  ALTER TABLE ONLY sessions ADD CONSTRAINT sessions_token_hash_key UNIQUE (token_hash);
+  CREATE INDEX sessions_sub_idx ON sessions (sub);
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+    ALTER TABLE teams DROP COLUMN custom_roles;
+COMMIT;
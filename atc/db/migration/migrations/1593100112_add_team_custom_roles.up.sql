@@ -0,0 +1,3 @@
+BEGIN;
+    ALTER TABLE teams ADD COLUMN custom_roles json;
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+  ALTER TABLE wall
+    DROP COLUMN id,
+    DROP COLUMN severity,
+    DROP COLUMN starts_at,
+    DROP COLUMN team_names;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+  ALTER TABLE wall
+    ADD COLUMN id serial PRIMARY KEY,
+    ADD COLUMN severity text NOT NULL DEFAULT 'info',
+    ADD COLUMN starts_at timestamp with time zone,
+    ADD COLUMN team_names text[];
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+  CREATE TABLE maintenance (
+    id integer PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    reason text NOT NULL DEFAULT '',
+    pause_checks boolean NOT NULL DEFAULT false,
+    enabled_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE workers DROP COLUMN land_deadline;
+
+COMMIT;
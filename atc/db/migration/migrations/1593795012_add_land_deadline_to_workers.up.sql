@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE workers ADD COLUMN land_deadline timestamp with time zone;
+
+COMMIT;
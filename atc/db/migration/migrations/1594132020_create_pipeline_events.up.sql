@@ -0,0 +1,16 @@
+BEGIN;
+
+  CREATE TABLE pipeline_events (
+    id bigserial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+    type text NOT NULL,
+    payload text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX pipeline_events_team_id_id_idx ON pipeline_events (team_id, id);
+  CREATE INDEX pipeline_events_pipeline_id_id_idx ON pipeline_events (pipeline_id, id);
+  CREATE INDEX pipeline_events_created_at_idx ON pipeline_events (created_at);
+
+COMMIT;
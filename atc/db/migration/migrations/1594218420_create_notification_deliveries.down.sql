@@ -0,0 +1,13 @@
+BEGIN;
+
+  DROP TABLE IF EXISTS notification_deliveries;
+
+  DROP INDEX IF EXISTS pipeline_events_undispatched_idx;
+
+  ALTER TABLE pipeline_events DROP COLUMN IF EXISTS dispatched;
+
+  ALTER TABLE pipelines
+    DROP COLUMN IF EXISTS notifications,
+    DROP COLUMN IF EXISTS notifications_nonce;
+
+COMMIT;
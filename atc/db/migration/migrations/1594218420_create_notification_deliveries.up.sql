@@ -0,0 +1,33 @@
+BEGIN;
+
+  ALTER TABLE pipelines
+    ADD COLUMN notifications text,
+    ADD COLUMN notifications_nonce text;
+
+  -- events which happened before notifications existed are not dispatched
+  ALTER TABLE pipeline_events ADD COLUMN dispatched boolean NOT NULL DEFAULT true;
+  ALTER TABLE pipeline_events ALTER COLUMN dispatched SET DEFAULT false;
+
+  CREATE INDEX pipeline_events_undispatched_idx ON pipeline_events (id) WHERE NOT dispatched;
+
+  CREATE TABLE notification_deliveries (
+    id bigserial PRIMARY KEY,
+    pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+    notification_name text NOT NULL,
+    event_id bigint NOT NULL,
+    event_type text NOT NULL,
+    payload text NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+    response_code integer,
+    error text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX notification_deliveries_pending_idx ON notification_deliveries (next_attempt_at) WHERE status = 'pending';
+  CREATE INDEX notification_deliveries_pipeline_id_id_idx ON notification_deliveries (pipeline_id, id);
+  CREATE INDEX notification_deliveries_updated_at_idx ON notification_deliveries (updated_at);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE maintenance DROP COLUMN IF EXISTS paused_components;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE maintenance ADD COLUMN paused_components text[] NOT NULL DEFAULT '{}';
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+  DROP INDEX IF EXISTS pipeline_events_team_id_txid_id_idx;
+  DROP INDEX IF EXISTS pipeline_events_pipeline_id_txid_id_idx;
+
+  CREATE INDEX IF NOT EXISTS pipeline_events_team_id_id_idx ON pipeline_events (team_id, id);
+  CREATE INDEX IF NOT EXISTS pipeline_events_pipeline_id_id_idx ON pipeline_events (pipeline_id, id);
+
+  ALTER TABLE pipeline_events DROP COLUMN IF EXISTS txid;
+
+COMMIT;
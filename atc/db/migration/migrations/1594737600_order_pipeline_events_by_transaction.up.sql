@@ -0,0 +1,11 @@
+BEGIN;
+
+  ALTER TABLE pipeline_events ADD COLUMN txid bigint NOT NULL DEFAULT txid_current();
+
+  DROP INDEX pipeline_events_team_id_id_idx;
+  DROP INDEX pipeline_events_pipeline_id_id_idx;
+
+  CREATE INDEX pipeline_events_team_id_txid_id_idx ON pipeline_events (team_id, txid, id);
+  CREATE INDEX pipeline_events_pipeline_id_txid_id_idx ON pipeline_events (pipeline_id, txid, id);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+  ALTER TABLE api_tokens
+    DROP COLUMN IF EXISTS created_by_connector,
+    DROP COLUMN IF EXISTS created_by_user_id,
+    DROP COLUMN IF EXISTS created_by_groups;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+  ALTER TABLE api_tokens
+    ADD COLUMN created_by_connector text NOT NULL DEFAULT '',
+    ADD COLUMN created_by_user_id text NOT NULL DEFAULT '',
+    ADD COLUMN created_by_groups text[] NOT NULL DEFAULT '{}';
+
+COMMIT;
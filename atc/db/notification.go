@@ -0,0 +1,418 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . NotificationFactory
+
+type NotificationFactory interface {
+	// DispatchPipelineEvents queues a delivery for each notification rule
+	// matching each pipeline event which has not been dispatched yet. It
+	// returns the number of deliveries queued.
+	DispatchPipelineEvents(limit int) (int, error)
+
+	// DueNotificationDeliveries returns pending deliveries which are due to
+	// be attempted.
+	DueNotificationDeliveries(limit int) ([]NotificationDelivery, error)
+}
+
+type notificationFactory struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func NewNotificationFactory(conn Conn, lockFactory lock.LockFactory) NotificationFactory {
+	return &notificationFactory{
+		conn:        conn,
+		lockFactory: lockFactory,
+	}
+}
+
+type undispatchedPipelineEvent struct {
+	pipelineID         int
+	event              atc.PipelineEvent
+	notifications      sql.NullString
+	notificationsNonce sql.NullString
+}
+
+func (f *notificationFactory) DispatchPipelineEvents(limit int) (int, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	defer Rollback(tx)
+
+	rows, err := psql.Select("e.id", "e.pipeline_id", "e.payload", "e.created_at", "t.name", "p.name", "p.notifications", "p.notifications_nonce").
+		From("pipeline_events e").
+		Join("teams t ON t.id = e.team_id").
+		Join("pipelines p ON p.id = e.pipeline_id").
+		Where(sq.Expr("NOT e.dispatched")).
+		OrderBy("e.id ASC").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE OF e SKIP LOCKED").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return 0, err
+	}
+
+	var events []undispatchedPipelineEvent
+	for rows.Next() {
+		var (
+			ue        undispatchedPipelineEvent
+			payload   string
+			createdAt time.Time
+		)
+
+		err = rows.Scan(&ue.event.ID, &ue.pipelineID, &payload, &createdAt, &ue.event.TeamName, &ue.event.PipelineName, &ue.notifications, &ue.notificationsNonce)
+		if err != nil {
+			Close(rows)
+			return 0, err
+		}
+
+		id, teamName, pipelineName := ue.event.ID, ue.event.TeamName, ue.event.PipelineName
+
+		err = json.Unmarshal([]byte(payload), &ue.event)
+		if err != nil {
+			Close(rows)
+			return 0, err
+		}
+
+		ue.event.ID = id
+		ue.event.Time = createdAt.Unix()
+		ue.event.TeamName = teamName
+		ue.event.PipelineName = pipelineName
+
+		events = append(events, ue)
+	}
+
+	Close(rows)
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	pipelineNotifications := map[int]atc.NotificationConfigs{}
+	eventIDs := make([]int64, len(events))
+	queued := 0
+
+	for i, ue := range events {
+		eventIDs[i] = int64(ue.event.ID)
+
+		notifications, cached := pipelineNotifications[ue.pipelineID]
+		if !cached {
+			notifications, err = decryptNotifications(tx.EncryptionStrategy(), ue.notifications, ue.notificationsNonce)
+			if err != nil {
+				return 0, err
+			}
+
+			pipelineNotifications[ue.pipelineID] = notifications
+		}
+
+		var payload []byte
+		for _, notification := range notifications {
+			if !notification.Matches(ue.event) {
+				continue
+			}
+
+			if payload == nil {
+				payload, err = json.Marshal(ue.event)
+				if err != nil {
+					return 0, err
+				}
+			}
+
+			_, err = psql.Insert("notification_deliveries").
+				Columns("pipeline_id", "notification_name", "event_id", "event_type", "payload").
+				Values(ue.pipelineID, notification.Name, ue.event.ID, string(ue.event.Type), string(payload)).
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return 0, err
+			}
+
+			queued++
+		}
+	}
+
+	_, err = psql.Update("pipeline_events").
+		Set("dispatched", true).
+		Where("id = ANY(?)", pq.Array(eventIDs)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return queued, nil
+}
+
+func (f *notificationFactory) DueNotificationDeliveries(limit int) ([]NotificationDelivery, error) {
+	rows, err := notificationDeliveriesQuery.
+		Where(sq.Eq{"d.status": atc.NotificationDeliveryPending}).
+		Where(sq.Expr("d.next_attempt_at <= now()")).
+		OrderBy("d.id ASC").
+		Limit(uint64(limit)).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanNotificationDeliveries(f.conn, f.lockFactory, rows)
+}
+
+// Notifications returns the pipeline's notification rules.
+func (p *pipeline) Notifications() (atc.NotificationConfigs, error) {
+	var notifications, nonce sql.NullString
+	err := psql.Select("notifications", "notifications_nonce").
+		From("pipelines").
+		Where(sq.Eq{"id": p.id}).
+		RunWith(p.conn).
+		QueryRow().
+		Scan(&notifications, &nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptNotifications(p.conn.EncryptionStrategy(), notifications, nonce)
+}
+
+// NotificationDeliveries returns the pipeline's most recent notification
+// deliveries, newest first.
+func (p *pipeline) NotificationDeliveries(limit int) ([]NotificationDelivery, error) {
+	rows, err := notificationDeliveriesQuery.
+		Where(sq.Eq{"d.pipeline_id": p.id}).
+		OrderBy("d.id DESC").
+		Limit(uint64(limit)).
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanNotificationDeliveries(p.conn, p.lockFactory, rows)
+}
+
+// saveNotifications stores the pipeline's notification rules. They are
+// encrypted with their own nonce, as they may hold credentials.
+func saveNotifications(tx Tx, pipelineID int, notifications atc.NotificationConfigs) error {
+	payload, err := json.Marshal(notifications)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("pipelines").
+		Set("notifications", encryptedPayload).
+		Set("notifications_nonce", nonce).
+		Where(sq.Eq{"id": pipelineID}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func decryptNotifications(strategy encryption.Strategy, notifications sql.NullString, nonce sql.NullString) (atc.NotificationConfigs, error) {
+	if !notifications.Valid {
+		return nil, nil
+	}
+
+	var nonceStr *string
+	if nonce.Valid {
+		nonceStr = &nonce.String
+	}
+
+	decrypted, err := strategy.Decrypt(notifications.String, nonceStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs atc.NotificationConfigs
+	err = json.Unmarshal(decrypted, &configs)
+	if err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
+
+//go:generate counterfeiter . NotificationDelivery
+
+type NotificationDelivery interface {
+	ID() int
+	PipelineID() int
+	NotificationName() string
+	Event() atc.PipelineEvent
+	Status() string
+	Attempts() int
+	ResponseCode() int
+	Error() string
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+
+	Pipeline() (Pipeline, bool, error)
+
+	// Succeed records a successful attempt.
+	Succeed(responseCode int) error
+
+	// Retry records a failed attempt, to be tried again at the given time.
+	Retry(responseCode int, reason string, at time.Time) error
+
+	// Fail records a failed attempt and gives up on the delivery.
+	Fail(responseCode int, reason string) error
+}
+
+var notificationDeliveriesQuery = psql.Select(
+	"d.id",
+	"d.pipeline_id",
+	"d.notification_name",
+	"d.payload",
+	"d.status",
+	"d.attempts",
+	"d.response_code",
+	"d.error",
+	"d.created_at",
+	"d.updated_at",
+).From("notification_deliveries d")
+
+type notificationDelivery struct {
+	id               int
+	pipelineID       int
+	notificationName string
+	event            atc.PipelineEvent
+	status           string
+	attempts         int
+	responseCode     int
+	err              string
+	createdAt        time.Time
+	updatedAt        time.Time
+
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func (d *notificationDelivery) ID() int                  { return d.id }
+func (d *notificationDelivery) PipelineID() int          { return d.pipelineID }
+func (d *notificationDelivery) NotificationName() string { return d.notificationName }
+func (d *notificationDelivery) Event() atc.PipelineEvent { return d.event }
+func (d *notificationDelivery) Status() string           { return d.status }
+func (d *notificationDelivery) Attempts() int            { return d.attempts }
+func (d *notificationDelivery) ResponseCode() int        { return d.responseCode }
+func (d *notificationDelivery) Error() string            { return d.err }
+func (d *notificationDelivery) CreatedAt() time.Time     { return d.createdAt }
+func (d *notificationDelivery) UpdatedAt() time.Time     { return d.updatedAt }
+
+func (d *notificationDelivery) Pipeline() (Pipeline, bool, error) {
+	pipeline := newPipeline(d.conn, d.lockFactory)
+	err := scanPipeline(
+		pipeline,
+		pipelinesQuery.
+			Where(sq.Eq{"p.id": d.pipelineID}).
+			RunWith(d.conn).
+			QueryRow(),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return pipeline, true, nil
+}
+
+func (d *notificationDelivery) Succeed(responseCode int) error {
+	return d.recordAttempt(atc.NotificationDeliverySucceeded, responseCode, "", time.Time{})
+}
+
+func (d *notificationDelivery) Retry(responseCode int, reason string, at time.Time) error {
+	return d.recordAttempt(atc.NotificationDeliveryPending, responseCode, reason, at)
+}
+
+func (d *notificationDelivery) Fail(responseCode int, reason string) error {
+	return d.recordAttempt(atc.NotificationDeliveryFailed, responseCode, reason, time.Time{})
+}
+
+func (d *notificationDelivery) recordAttempt(status string, responseCode int, reason string, nextAttempt time.Time) error {
+	update := psql.Update("notification_deliveries").
+		Set("status", status).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("response_code", sql.NullInt64{Int64: int64(responseCode), Valid: responseCode != 0}).
+		Set("error", sql.NullString{String: reason, Valid: reason != ""}).
+		Set("updated_at", sq.Expr("now()"))
+
+	if !nextAttempt.IsZero() {
+		update = update.Set("next_attempt_at", nextAttempt)
+	}
+
+	err := update.
+		Where(sq.Eq{"id": d.id}).
+		Suffix("RETURNING attempts, updated_at").
+		RunWith(d.conn).
+		QueryRow().
+		Scan(&d.attempts, &d.updatedAt)
+	if err != nil {
+		return err
+	}
+
+	d.status = status
+	d.responseCode = responseCode
+	d.err = reason
+
+	return nil
+}
+
+func scanNotificationDeliveries(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) ([]NotificationDelivery, error) {
+	defer Close(rows)
+
+	var deliveries []NotificationDelivery
+	for rows.Next() {
+		var (
+			d            = &notificationDelivery{conn: conn, lockFactory: lockFactory}
+			payload      string
+			responseCode sql.NullInt64
+			reason       sql.NullString
+		)
+
+		err := rows.Scan(&d.id, &d.pipelineID, &d.notificationName, &payload, &d.status, &d.attempts, &responseCode, &reason, &d.createdAt, &d.updatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(payload), &d.event)
+		if err != nil {
+			return nil, err
+		}
+
+		d.responseCode = int(responseCode.Int64)
+		d.err = reason.String
+
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . NotificationDeliveryLifecycle
+
+type NotificationDeliveryLifecycle interface {
+	RemoveExpiredNotificationDeliveries(time.Duration) (int, error)
+}
+
+type notificationDeliveryLifecycle struct {
+	conn Conn
+}
+
+func NewNotificationDeliveryLifecycle(conn Conn) *notificationDeliveryLifecycle {
+	return &notificationDeliveryLifecycle{
+		conn: conn,
+	}
+}
+
+// RemoveExpiredNotificationDeliveries removes finished deliveries which were
+// last attempted longer ago than the retention period.
+func (lifecycle *notificationDeliveryLifecycle) RemoveExpiredNotificationDeliveries(retention time.Duration) (int, error) {
+	result, err := psql.Delete("notification_deliveries").
+		Where(sq.NotEq{"status": atc.NotificationDeliveryPending}).
+		Where(sq.Expr("updated_at < now() - ?::interval", fmt.Sprintf("%.0f seconds", retention.Seconds()))).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
@@ -0,0 +1,53 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationDeliveryLifecycle", func() {
+	var (
+		notificationDeliveryLifecycle db.NotificationDeliveryLifecycle
+		removedDeliveries             int
+		err                           error
+	)
+
+	BeforeEach(func() {
+		notificationDeliveryLifecycle = db.NewNotificationDeliveryLifecycle(dbConn)
+	})
+
+	Describe("RemoveExpiredNotificationDeliveries", func() {
+		JustBeforeEach(func() {
+			removedDeliveries, err = notificationDeliveryLifecycle.RemoveExpiredNotificationDeliveries(time.Hour * 24)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when deliveries are older and newer than the retention period", func() {
+			BeforeEach(func() {
+				for _, delivery := range []struct {
+					status string
+					age    string
+				}{
+					{"succeeded", "25 hours"},
+					{"failed", "25 hours"},
+					{"pending", "25 hours"},
+					{"succeeded", "23 hours"},
+				} {
+					_, err := dbConn.Exec("INSERT INTO notification_deliveries(pipeline_id, notification_name, event_id, event_type, payload, status, updated_at) VALUES($1, 'some-notification', 1, 'pipeline-paused', '{}', $2, NOW() - $3::interval)", defaultPipeline.ID(), delivery.status, delivery.age)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			It("removes only the expired finished deliveries", func() {
+				var count int
+				err := dbConn.QueryRow("SELECT count(*) from notification_deliveries").Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(2))
+				Expect(removedDeliveries).To(Equal(2))
+			})
+		})
+	})
+})
@@ -0,0 +1,194 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Notifications", func() {
+	var (
+		notificationFactory db.NotificationFactory
+		pipeline            db.Pipeline
+		notifications       atc.NotificationConfigs
+	)
+
+	BeforeEach(func() {
+		notificationFactory = db.NewNotificationFactory(dbConn, lockFactory)
+
+		notifications = atc.NotificationConfigs{
+			{
+				Name:   "paused",
+				URL:    "https://example.com/((hook))",
+				Events: []atc.PipelineEventType{atc.PipelineEventPipelinePaused},
+				Secret: "((secret))",
+			},
+			{
+				Name:     "failures",
+				URL:      "https://example.com/failures",
+				Jobs:     []string{"some-*"},
+				Statuses: []atc.BuildStatus{atc.StatusFailed},
+			},
+		}
+
+		config := atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "some-job"},
+			},
+			Notifications: notifications,
+		}
+
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline("notifying-pipeline", config, db.ConfigVersion(0), false)
+		Expect(err).ToNot(HaveOccurred())
+
+		// the pipeline-config event matches no rule; dispatching it up front
+		// keeps it out of the way
+		_, err = notificationFactory.DispatchPipelineEvents(100)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("pipeline.Notifications", func() {
+		It("returns the saved rules", func() {
+			saved, err := pipeline.Notifications()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(saved).To(Equal(notifications))
+		})
+
+		It("includes them in the pipeline's config", func() {
+			config, err := pipeline.Config()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Notifications).To(Equal(notifications))
+		})
+	})
+
+	Describe("DispatchPipelineEvents", func() {
+		var queued int
+
+		JustBeforeEach(func() {
+			var err error
+			queued, err = notificationFactory.DispatchPipelineEvents(100)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when an event matches a rule", func() {
+			BeforeEach(func() {
+				Expect(pipeline.Pause()).To(Succeed())
+			})
+
+			It("queues a delivery for it", func() {
+				Expect(queued).To(Equal(1))
+
+				deliveries, err := notificationFactory.DueNotificationDeliveries(100)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+
+				delivery := deliveries[0]
+				Expect(delivery.PipelineID()).To(Equal(pipeline.ID()))
+				Expect(delivery.NotificationName()).To(Equal("paused"))
+				Expect(delivery.Event().Type).To(Equal(atc.PipelineEventPipelinePaused))
+				Expect(delivery.Event().PipelineName).To(Equal("notifying-pipeline"))
+				Expect(delivery.Status()).To(Equal(atc.NotificationDeliveryPending))
+				Expect(delivery.Attempts()).To(BeZero())
+			})
+
+			It("does not queue it again", func() {
+				queued, err := notificationFactory.DispatchPipelineEvents(100)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queued).To(BeZero())
+			})
+		})
+
+		Context("when no event matches a rule", func() {
+			BeforeEach(func() {
+				job, found, err := pipeline.Job("some-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				_, err = job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("queues nothing", func() {
+				Expect(queued).To(BeZero())
+
+				deliveries, err := pipeline.NotificationDeliveries(100)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("recording attempts", func() {
+		var delivery db.NotificationDelivery
+
+		BeforeEach(func() {
+			Expect(pipeline.Pause()).To(Succeed())
+
+			_, err := notificationFactory.DispatchPipelineEvents(100)
+			Expect(err).ToNot(HaveOccurred())
+
+			deliveries, err := notificationFactory.DueNotificationDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+
+			delivery = deliveries[0]
+		})
+
+		It("can be retried later", func() {
+			err := delivery.Retry(502, "unexpected response: 502 Bad Gateway", time.Now().Add(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(delivery.Status()).To(Equal(atc.NotificationDeliveryPending))
+			Expect(delivery.Attempts()).To(Equal(1))
+
+			due, err := notificationFactory.DueNotificationDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(due).To(BeEmpty())
+
+			deliveries, err := pipeline.NotificationDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].ResponseCode()).To(Equal(502))
+			Expect(deliveries[0].Error()).To(Equal("unexpected response: 502 Bad Gateway"))
+		})
+
+		It("can succeed", func() {
+			Expect(delivery.Succeed(200)).To(Succeed())
+
+			due, err := notificationFactory.DueNotificationDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(due).To(BeEmpty())
+
+			deliveries, err := pipeline.NotificationDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries[0].Status()).To(Equal(atc.NotificationDeliverySucceeded))
+			Expect(deliveries[0].ResponseCode()).To(Equal(200))
+			Expect(deliveries[0].Error()).To(BeEmpty())
+		})
+
+		It("can fail", func() {
+			Expect(delivery.Fail(0, "connection refused")).To(Succeed())
+
+			due, err := notificationFactory.DueNotificationDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(due).To(BeEmpty())
+
+			deliveries, err := pipeline.NotificationDeliveries(100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries[0].Status()).To(Equal(atc.NotificationDeliveryFailed))
+			Expect(deliveries[0].ResponseCode()).To(BeZero())
+			Expect(deliveries[0].Error()).To(Equal("connection refused"))
+		})
+
+		It("can find its pipeline", func() {
+			found, ok, err := delivery.Pipeline()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(found.ID()).To(Equal(pipeline.ID()))
+		})
+	})
+})
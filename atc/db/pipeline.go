@@ -81,6 +81,11 @@ type Pipeline interface {
 	Job(name string) (Job, bool, error)
 	Jobs() (Jobs, error)
 	Dashboard() (atc.Dashboard, error)
+	JobStats(since, until time.Time) ([]atc.JobStats, error)
+	Events(from int) (PipelineEventSource, error)
+
+	Notifications() (atc.NotificationConfigs, error)
+	NotificationDeliveries(limit int) ([]NotificationDelivery, error)
 
 	Expose() error
 	Hide() error
@@ -262,12 +267,18 @@ func (p *pipeline) Config() (atc.Config, error) {
 		return atc.Config{}, fmt.Errorf("failed to get job configs: %w", err)
 	}
 
+	notifications, err := p.Notifications()
+	if err != nil {
+		return atc.Config{}, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
 	config := atc.Config{
 		Groups:        p.Groups(),
 		VarSources:    p.VarSources(),
 		Resources:     resources.Configs(),
 		ResourceTypes: resourceTypes.Configs(),
 		Jobs:          jobConfigs,
+		Notifications: notifications,
 	}
 
 	return config, nil
@@ -597,16 +608,132 @@ func (p *pipeline) Dashboard() (atc.Dashboard, error) {
 	return dashboard, nil
 }
 
+// JobStats summarizes the completed builds of each of the pipeline's jobs
+// which were created in [since, until). A succeeded build is counted as a
+// flaky retry when the job's previous build in the window failed with the
+// same set of input versions.
+func (p *pipeline) JobStats(since, until time.Time) ([]atc.JobStats, error) {
+	rows, err := p.conn.Query(`
+		WITH windowed AS (
+			SELECT b.id, b.job_id, b.status, b.create_time, b.start_time, b.end_time,
+				(
+					SELECT string_agg(i.name || ':' || i.resource_id || ':' || i.version_md5, ',' ORDER BY i.name)
+					FROM build_resource_config_version_inputs i
+					WHERE i.build_id = b.id
+				) AS inputs
+			FROM builds b
+			INNER JOIN jobs j ON j.id = b.job_id
+			WHERE j.pipeline_id = $1
+			AND j.active
+			AND b.status IN ('succeeded', 'failed', 'errored', 'aborted')
+			AND b.create_time >= $2
+			AND b.create_time < $3
+		), sequenced AS (
+			SELECT w.*,
+				lag(w.status) OVER (PARTITION BY w.job_id ORDER BY w.id) AS prev_status,
+				lag(w.inputs) OVER (PARTITION BY w.job_id ORDER BY w.id) AS prev_inputs
+			FROM windowed w
+		)
+		SELECT j.name,
+			count(s.id),
+			count(s.id) FILTER (WHERE s.status = 'succeeded'),
+			count(s.id) FILTER (WHERE s.status = 'failed'),
+			count(s.id) FILTER (WHERE s.status = 'errored'),
+			count(s.id) FILTER (WHERE s.status = 'aborted'),
+			count(s.id) FILTER (WHERE s.status = 'succeeded' AND s.prev_status = 'failed' AND s.inputs IS NOT DISTINCT FROM s.prev_inputs),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM s.end_time - s.start_time)::float8) FILTER (WHERE s.start_time IS NOT NULL AND s.end_time IS NOT NULL),
+			percentile_cont(0.95) WITHIN GROUP (ORDER BY extract(epoch FROM s.end_time - s.start_time)::float8) FILTER (WHERE s.start_time IS NOT NULL AND s.end_time IS NOT NULL),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM s.start_time - s.create_time)::float8) FILTER (WHERE s.start_time IS NOT NULL),
+			percentile_cont(0.95) WITHIN GROUP (ORDER BY extract(epoch FROM s.start_time - s.create_time)::float8) FILTER (WHERE s.start_time IS NOT NULL)
+		FROM jobs j
+		LEFT JOIN sequenced s ON s.job_id = j.id
+		WHERE j.pipeline_id = $1
+		AND j.active
+		GROUP BY j.id, j.name
+		ORDER BY j.id ASC
+	`, p.id, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	stats := []atc.JobStats{}
+	for rows.Next() {
+		var (
+			jobStats                                     atc.JobStats
+			durationP50, durationP95, queueP50, queueP95 sql.NullFloat64
+		)
+
+		err = rows.Scan(
+			&jobStats.JobName,
+			&jobStats.Builds,
+			&jobStats.Succeeded,
+			&jobStats.Failed,
+			&jobStats.Errored,
+			&jobStats.Aborted,
+			&jobStats.FlakyRetries,
+			&durationP50,
+			&durationP95,
+			&queueP50,
+			&queueP95,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		jobStats.DurationP50 = durationP50.Float64
+		jobStats.DurationP95 = durationP95.Float64
+		jobStats.QueueP50 = queueP50.Float64
+		jobStats.QueueP95 = queueP95.Float64
+
+		if ran := jobStats.Succeeded + jobStats.Failed + jobStats.Errored; ran > 0 {
+			jobStats.SuccessRate = float64(jobStats.Succeeded) / float64(ran)
+		}
+
+		if finished := jobStats.Succeeded + jobStats.Failed; finished > 0 {
+			jobStats.Flakiness = float64(jobStats.FlakyRetries) / float64(finished)
+		}
+
+		stats = append(stats, jobStats)
+	}
+
+	return stats, rows.Err()
+}
+
+// Events streams the pipeline's events which happen after the event with the
+// given ID.
+func (p *pipeline) Events(from int) (PipelineEventSource, error) {
+	return newPipelineEventSource(p.conn, sq.Eq{"e.pipeline_id": p.id}, pipelineEventsChannel(p.id), from)
+}
+
 func (p *pipeline) Pause() error {
-	_, err := psql.Update("pipelines").
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("pipelines").
 		Set("paused", true).
 		Where(sq.Eq{
 			"id": p.id,
 		}).
-		RunWith(p.conn).
+		RunWith(tx).
 		Exec()
+	if err != nil {
+		return err
+	}
 
-	return err
+	err = savePipelineEvent(tx, p.teamID, p.id, atc.PipelineEvent{
+		Type: atc.PipelineEventPipelinePaused,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (p *pipeline) Unpause() error {
@@ -634,6 +761,13 @@ func (p *pipeline) Unpause() error {
 		return err
 	}
 
+	err = savePipelineEvent(tx, p.teamID, p.id, atc.PipelineEvent{
+		Type: atc.PipelineEventPipelineUnpaused,
+	})
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
@@ -1102,12 +1236,23 @@ func (p *pipeline) getBuildsFrom(tx Tx, col string) (map[string]Build, error) {
 // var_sources, a vars.MultiVars containing all pipeline specific var_sources
 // plug the global variables, otherwise just return the global variables.
 func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool) (vars.Variables, error) {
-	globalVars := creds.NewVariables(globalSecrets, p.TeamName(), p.Name(), false)
+	teamCredentialManager, err := findTeamCredentialManager(p.conn, p.teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The team's credential manager, if any, is consulted ahead of the
+	// cluster-wide one for vars without a var_source name.
+	defaultVars, err := creds.NewTeamVariables(logger, globalSecrets, varSourcePool, teamCredentialManager, p.TeamName(), p.Name())
+	if err != nil {
+		return nil, err
+	}
+
 	namedVarsMap := vars.NamedVariables{}
 
 	// It's safe to add NamedVariables to allVars via an array here, because
 	// a map is passed by reference.
-	allVars := vars.NewMultiVars([]vars.Variables{namedVarsMap, globalVars})
+	allVars := vars.NewMultiVars([]vars.Variables{namedVarsMap, defaultVars})
 
 	orderedVarSources, err := p.varSources.OrderByDependency()
 	if err != nil {
@@ -1137,10 +1282,10 @@ func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, v
 		namedVarsMap[cm.Name] = creds.NewVariables(secrets, p.TeamName(), p.Name(), true)
 	}
 
-	// If there is no var_source from the pipeline, then just return the global
+	// If there is no var_source from the pipeline, then just return the default
 	// vars.
 	if len(namedVarsMap) == 0 {
-		return globalVars, nil
+		return defaultVars, nil
 	}
 
 	return allVars, nil
@@ -0,0 +1,319 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrPipelineEventStreamClosed = errors.New("pipeline event stream closed")
+
+// LatestPipelineEvent can be passed as the ID to stream pipeline events from
+// to only receive events which happen after the stream is opened.
+const LatestPipelineEvent = -1
+
+// pipelineEventPollInterval is how often a stream looks again for events which
+// were committed but held back behind an older transaction which was still
+// running, as that transaction may finish without notifying the stream.
+const pipelineEventPollInterval = 5 * time.Second
+
+func teamPipelineEventsChannel(teamID int) string {
+	return fmt.Sprintf("pipeline_events_team_%d", teamID)
+}
+
+func pipelineEventsChannel(pipelineID int) string {
+	return fmt.Sprintf("pipeline_events_pipeline_%d", pipelineID)
+}
+
+//go:generate counterfeiter . PipelineEventSource
+
+type PipelineEventSource interface {
+	Next() (atc.PipelineEvent, error)
+	Close() error
+}
+
+type pipelineEventRecord struct {
+	teamID     int
+	pipelineID int
+	event      atc.PipelineEvent
+}
+
+// savePipelineEvent records the event and notifies any streams of its team
+// or pipeline once the transaction is committed.
+func savePipelineEvent(tx Tx, teamID int, pipelineID int, ev atc.PipelineEvent) error {
+	return savePipelineEvents(tx, []pipelineEventRecord{{teamID, pipelineID, ev}})
+}
+
+// pipelineEventInsertBatchSize bounds the rows inserted per statement, well
+// below postgres' limit on the number of bind parameters.
+const pipelineEventInsertBatchSize = 1000
+
+// savePipelineEvents records the events with as few inserts as possible. Each
+// event stores the ID of the transaction which recorded it, which is what
+// streams are ordered by; see pipelineEventSource.fetch.
+func savePipelineEvents(tx Tx, records []pipelineEventRecord) error {
+	channels := map[string]bool{}
+
+	for start := 0; start < len(records); start += pipelineEventInsertBatchSize {
+		end := start + pipelineEventInsertBatchSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		insert := psql.Insert("pipeline_events").
+			Columns("team_id", "pipeline_id", "type", "payload")
+
+		for _, record := range records[start:end] {
+			payload, err := json.Marshal(record.event)
+			if err != nil {
+				return err
+			}
+
+			insert = insert.Values(record.teamID, record.pipelineID, string(record.event.Type), string(payload))
+
+			channels[teamPipelineEventsChannel(record.teamID)] = true
+			channels[pipelineEventsChannel(record.pipelineID)] = true
+		}
+
+		_, err := insert.RunWith(tx).Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	for channel := range channels {
+		_, err := tx.Exec("NOTIFY " + channel)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pipelineEventCursor is the position of a stream: events are streamed in
+// order of the transaction which recorded them, then by ID.
+type pipelineEventCursor struct {
+	txid int64
+	id   int
+}
+
+func newPipelineEventSource(conn Conn, filter sq.Eq, channel string, from int) (*pipelineEventSource, error) {
+	cursor, err := pipelineEventCursorFrom(conn, from)
+	if err != nil {
+		return nil, err
+	}
+
+	notifier, err := newConditionNotifier(conn.Bus(), channel, func() (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	source := &pipelineEventSource{
+		conn:     conn,
+		filter:   filter,
+		notifier: notifier,
+
+		events: make(chan atc.PipelineEvent, 100),
+		stop:   make(chan struct{}),
+		wg:     new(sync.WaitGroup),
+	}
+
+	source.wg.Add(1)
+	go source.collectEvents(cursor)
+
+	return source, nil
+}
+
+func pipelineEventCursorFrom(conn Conn, from int) (pipelineEventCursor, error) {
+	if from == LatestPipelineEvent {
+		// every transaction before the oldest one still running has finished,
+		// so anything it recorded has already happened
+		var xmin int64
+		err := conn.QueryRow(`SELECT txid_snapshot_xmin(txid_current_snapshot())`).Scan(&xmin)
+		if err != nil {
+			return pipelineEventCursor{}, err
+		}
+
+		return pipelineEventCursor{txid: xmin}, nil
+	}
+
+	cursor := pipelineEventCursor{id: from}
+	err := psql.Select("txid").
+		From("pipeline_events").
+		Where(sq.Eq{"id": from}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&cursor.txid)
+	if err == nil {
+		return cursor, nil
+	}
+
+	if err != sql.ErrNoRows {
+		return pipelineEventCursor{}, err
+	}
+
+	// the event has been removed, so resume from the oldest transaction which
+	// recorded a later event. This may repeat some events, but skips none.
+	err = psql.Select("COALESCE(MIN(txid), 0)").
+		From("pipeline_events").
+		Where(sq.Gt{"id": from}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&cursor.txid)
+	if err != nil {
+		return pipelineEventCursor{}, err
+	}
+
+	return pipelineEventCursor{txid: cursor.txid}, nil
+}
+
+type pipelineEventSource struct {
+	conn     Conn
+	filter   sq.Eq
+	notifier Notifier
+
+	events    chan atc.PipelineEvent
+	stop      chan struct{}
+	err       error
+	wg        *sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func (source *pipelineEventSource) Next() (atc.PipelineEvent, error) {
+	e, ok := <-source.events
+	if !ok {
+		return atc.PipelineEvent{}, source.err
+	}
+
+	return e, nil
+}
+
+func (source *pipelineEventSource) Close() error {
+	var err error
+	source.closeOnce.Do(func() {
+		close(source.stop)
+		source.wg.Wait()
+		err = source.notifier.Close()
+	})
+
+	return err
+}
+
+func (source *pipelineEventSource) collectEvents(cursor pipelineEventCursor) {
+	defer source.wg.Done()
+	defer close(source.events)
+
+	var batchSize = cap(source.events)
+
+	for {
+		events, next, held, err := source.fetch(cursor, batchSize)
+		if err != nil {
+			source.err = err
+			return
+		}
+
+		for _, ev := range events {
+			select {
+			case source.events <- ev:
+			case <-source.stop:
+				source.err = ErrPipelineEventStreamClosed
+				return
+			}
+		}
+
+		cursor = next
+
+		if len(events) == batchSize {
+			// still more events
+			continue
+		}
+
+		var poll <-chan time.Time
+		if held {
+			poll = time.After(pipelineEventPollInterval)
+		}
+
+		select {
+		case <-source.notifier.Notify():
+		case <-poll:
+		case <-source.stop:
+			source.err = ErrPipelineEventStreamClosed
+			return
+		}
+	}
+}
+
+// fetch returns the events after the cursor, and the cursor to fetch from
+// next.
+//
+// IDs are allocated when events are inserted, not when they are committed,
+// so a stream which followed IDs could move past an event whose transaction
+// commits late. Instead, events are ordered by the transaction which recorded
+// them, and only those recorded by transactions older than the oldest one
+// still running are returned: no more events can appear before them. held is
+// true when later committed events are being held back until then.
+func (source *pipelineEventSource) fetch(cursor pipelineEventCursor, limit int) ([]atc.PipelineEvent, pipelineEventCursor, bool, error) {
+	rows, err := psql.Select("e.id", "e.txid", "e.txid < txid_snapshot_xmin(txid_current_snapshot())", "e.payload", "e.created_at", "t.name", "p.name").
+		From("pipeline_events e").
+		Join("teams t ON t.id = e.team_id").
+		Join("pipelines p ON p.id = e.pipeline_id").
+		Where(source.filter).
+		Where(sq.Expr("(e.txid, e.id) > (?, ?)", cursor.txid, cursor.id)).
+		OrderBy("e.txid ASC", "e.id ASC").
+		Limit(uint64(limit)).
+		RunWith(source.conn).
+		Query()
+	if err != nil {
+		return nil, cursor, false, err
+	}
+
+	defer Close(rows)
+
+	var events []atc.PipelineEvent
+	for rows.Next() {
+		var (
+			id        int
+			txid      int64
+			finished  bool
+			payload   string
+			createdAt time.Time
+			teamName  string
+			pipeline  string
+		)
+
+		err = rows.Scan(&id, &txid, &finished, &payload, &createdAt, &teamName, &pipeline)
+		if err != nil {
+			return nil, cursor, false, err
+		}
+
+		if !finished {
+			// every later row is from a transaction at least as new
+			return events, cursor, true, rows.Err()
+		}
+
+		var ev atc.PipelineEvent
+		err = json.Unmarshal([]byte(payload), &ev)
+		if err != nil {
+			return nil, cursor, false, err
+		}
+
+		ev.ID = id
+		ev.Time = createdAt.Unix()
+		ev.TeamName = teamName
+		ev.PipelineName = pipeline
+
+		events = append(events, ev)
+		cursor = pipelineEventCursor{txid: txid, id: id}
+	}
+
+	return events, cursor, false, rows.Err()
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . PipelineEventLifecycle
+
+type PipelineEventLifecycle interface {
+	RemoveExpiredPipelineEvents(time.Duration) (int, error)
+}
+
+type pipelineEventLifecycle struct {
+	conn Conn
+}
+
+func NewPipelineEventLifecycle(conn Conn) *pipelineEventLifecycle {
+	return &pipelineEventLifecycle{
+		conn: conn,
+	}
+}
+
+func (lifecycle *pipelineEventLifecycle) RemoveExpiredPipelineEvents(retention time.Duration) (int, error) {
+	result, err := psql.Delete("pipeline_events").
+		Where(sq.Expr("created_at < now() - ?::interval", fmt.Sprintf("%.0f seconds", retention.Seconds()))).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
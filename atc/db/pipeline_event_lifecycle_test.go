@@ -0,0 +1,49 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineEventLifecycle", func() {
+	var (
+		pipelineEventLifecycle db.PipelineEventLifecycle
+		removedEvents          int
+		err                    error
+	)
+
+	BeforeEach(func() {
+		pipelineEventLifecycle = db.NewPipelineEventLifecycle(dbConn)
+
+		_, err := dbConn.Exec("DELETE FROM pipeline_events")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("RemoveExpiredPipelineEvents", func() {
+		JustBeforeEach(func() {
+			removedEvents, err = pipelineEventLifecycle.RemoveExpiredPipelineEvents(time.Hour * 24)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when events are older and newer than the retention period", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec("INSERT INTO pipeline_events(team_id, pipeline_id, type, payload, created_at) VALUES($1, $2, 'pipeline-paused', '{}', NOW() - '25 hours'::interval)", defaultTeam.ID(), defaultPipeline.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec("INSERT INTO pipeline_events(team_id, pipeline_id, type, payload, created_at) VALUES($1, $2, 'pipeline-paused', '{}', NOW() - '23 hours'::interval)", defaultTeam.ID(), defaultPipeline.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("removes only the expired events", func() {
+				var count int
+				err := dbConn.QueryRow("SELECT count(*) from pipeline_events").Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(1))
+				Expect(removedEvents).To(Equal(1))
+			})
+		})
+	})
+})
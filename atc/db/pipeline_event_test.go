@@ -0,0 +1,271 @@
+package db_test
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline events", func() {
+	var source db.PipelineEventSource
+
+	AfterEach(func() {
+		if source != nil {
+			Expect(source.Close()).To(Succeed())
+		}
+	})
+
+	Describe("pipeline.Events", func() {
+		Context("when streaming from the latest event", func() {
+			BeforeEach(func() {
+				var err error
+				source, err = defaultPipeline.Events(db.LatestPipelineEvent)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("only receives events which happen afterwards", func() {
+				Expect(defaultPipeline.Pause()).To(Succeed())
+
+				ev, err := source.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ev.Type).To(Equal(atc.PipelineEventPipelinePaused))
+				Expect(ev.TeamName).To(Equal("default-team"))
+				Expect(ev.PipelineName).To(Equal("default-pipeline"))
+				Expect(ev.ID).ToNot(BeZero())
+				Expect(ev.Time).ToNot(BeZero())
+			})
+
+			It("receives events for the pipeline's jobs and builds in order", func() {
+				Expect(defaultJob.Pause()).To(Succeed())
+
+				build, err := defaultJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				ev, err := source.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ev.Type).To(Equal(atc.PipelineEventJobPaused))
+				Expect(ev.JobName).To(Equal("some-job"))
+
+				ev, err = source.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ev.Type).To(Equal(atc.PipelineEventBuildStatus))
+				Expect(ev.JobName).To(Equal("some-job"))
+				Expect(ev.Build).To(Equal(&atc.PipelineEventBuild{
+					ID:     build.ID(),
+					Name:   build.Name(),
+					Status: atc.StatusPending,
+				}))
+			})
+
+			It("receives events for pinning the pipeline's resources", func() {
+				scope, err := defaultResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = scope.SaveVersions(nil, []atc.Version{{"version": "v1"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				ev, err := source.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ev.Type).To(Equal(atc.PipelineEventResourceVersion))
+				Expect(ev.ResourceName).To(Equal("some-resource"))
+				Expect(ev.Version).To(Equal(atc.Version{"version": "v1"}))
+
+				rcv, found, err := scope.FindVersion(atc.Version{"version": "v1"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				found, err = defaultResource.PinVersion(rcv.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				ev, err = source.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ev.Type).To(Equal(atc.PipelineEventResourcePinned))
+				Expect(ev.ResourceName).To(Equal("some-resource"))
+				Expect(ev.Version).To(Equal(atc.Version{"version": "v1"}))
+			})
+		})
+
+		Context("when streaming from an earlier event", func() {
+			var firstID int
+
+			BeforeEach(func() {
+				latest, err := defaultPipeline.Events(db.LatestPipelineEvent)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(defaultPipeline.Pause()).To(Succeed())
+				Expect(defaultPipeline.Unpause()).To(Succeed())
+
+				ev, err := latest.Next()
+				Expect(err).ToNot(HaveOccurred())
+				firstID = ev.ID
+
+				Expect(latest.Close()).To(Succeed())
+
+				source, err = defaultPipeline.Events(firstID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("resumes after that event", func() {
+				ev, err := source.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ev.ID).To(BeNumerically(">", firstID))
+				Expect(ev.Type).To(Equal(atc.PipelineEventPipelineUnpaused))
+			})
+		})
+
+		Context("when streaming from an event which has been removed", func() {
+			BeforeEach(func() {
+				latest, err := defaultPipeline.Events(db.LatestPipelineEvent)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(defaultPipeline.Pause()).To(Succeed())
+				Expect(defaultPipeline.Unpause()).To(Succeed())
+
+				ev, err := latest.Next()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(latest.Close()).To(Succeed())
+
+				_, err = dbConn.Exec(`DELETE FROM pipeline_events WHERE id = $1`, ev.ID)
+				Expect(err).ToNot(HaveOccurred())
+
+				source, err = defaultPipeline.Events(ev.ID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("resumes from the next event still recorded", func() {
+				ev, err := source.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ev.Type).To(Equal(atc.PipelineEventPipelineUnpaused))
+			})
+		})
+
+		Context("when an event is committed after a later one", func() {
+			var tx db.Tx
+
+			BeforeEach(func() {
+				var err error
+				source, err = defaultPipeline.Events(db.LatestPipelineEvent)
+				Expect(err).ToNot(HaveOccurred())
+
+				tx, err = dbConn.Begin()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = tx.Exec(`
+					INSERT INTO pipeline_events (team_id, pipeline_id, type, payload)
+					VALUES ($1, $2, 'pipeline_paused', '{"type":"pipeline_paused"}')
+				`, defaultTeam.ID(), defaultPipeline.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = tx.Exec(fmt.Sprintf("NOTIFY pipeline_events_pipeline_%d", defaultPipeline.ID()))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(defaultPipeline.Unpause()).To(Succeed())
+			})
+
+			AfterEach(func() {
+				db.Rollback(tx)
+			})
+
+			It("holds the later event back until the earlier one is committed", func() {
+				received := make(chan atc.PipelineEvent, 2)
+				go func() {
+					defer GinkgoRecover()
+
+					for i := 0; i < 2; i++ {
+						ev, err := source.Next()
+						if err != nil {
+							return
+						}
+
+						received <- ev
+					}
+				}()
+
+				Consistently(received).ShouldNot(Receive())
+
+				Expect(tx.Commit()).To(Succeed())
+
+				var ev atc.PipelineEvent
+				Eventually(received).Should(Receive(&ev))
+				Expect(ev.Type).To(Equal(atc.PipelineEventPipelinePaused))
+
+				Eventually(received).Should(Receive(&ev))
+				Expect(ev.Type).To(Equal(atc.PipelineEventPipelineUnpaused))
+			})
+		})
+
+		It("receives an event for each version saved by a check", func() {
+			var err error
+			source, err = defaultPipeline.Events(db.LatestPipelineEvent)
+			Expect(err).ToNot(HaveOccurred())
+
+			scope, err := defaultResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = scope.SaveVersions(nil, []atc.Version{{"version": "v1"}, {"version": "v2"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, version := range []string{"v1", "v2"} {
+				ev, err := source.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ev.Type).To(Equal(atc.PipelineEventResourceVersion))
+				Expect(ev.Version).To(Equal(atc.Version{"version": version}))
+			}
+		})
+
+		It("does not receive events for other pipelines", func() {
+			otherPipeline, _, err := defaultTeam.SavePipeline("other-pipeline", atc.Config{}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			source, err = defaultPipeline.Events(db.LatestPipelineEvent)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(otherPipeline.Pause()).To(Succeed())
+			Expect(defaultPipeline.Pause()).To(Succeed())
+
+			ev, err := source.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.PipelineName).To(Equal("default-pipeline"))
+		})
+
+		It("returns an error from Next once closed", func() {
+			var err error
+			source, err = defaultPipeline.Events(db.LatestPipelineEvent)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(source.Close()).To(Succeed())
+
+			_, err = source.Next()
+			Expect(err).To(Equal(db.ErrPipelineEventStreamClosed))
+		})
+	})
+
+	Describe("team.PipelineEvents", func() {
+		It("receives events for all of the team's pipelines", func() {
+			var err error
+			source, err = defaultTeam.PipelineEvents(db.LatestPipelineEvent)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherPipeline, _, err := defaultTeam.SavePipeline("other-pipeline", atc.Config{}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			ev, err := source.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Type).To(Equal(atc.PipelineEventConfig))
+			Expect(ev.PipelineName).To(Equal("other-pipeline"))
+			Expect(ev.ConfigVersion).ToNot(BeZero())
+
+			Expect(otherPipeline.Pause()).To(Succeed())
+
+			ev, err = source.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Type).To(Equal(atc.PipelineEventPipelinePaused))
+			Expect(ev.PipelineName).To(Equal("other-pipeline"))
+		})
+	})
+})
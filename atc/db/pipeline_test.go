@@ -1478,6 +1478,104 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("JobStats", func() {
+		var (
+			job      db.Job
+			resource db.Resource
+		)
+
+		runBuild := func(version string, status db.BuildStatus) {
+			build, err := job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = job.SaveNextInputMapping(db.InputMapping{
+				"some-input": db.InputResult{
+					Input: &db.AlgorithmInput{
+						AlgorithmVersion: db.AlgorithmVersion{
+							Version:    db.ResourceVersion(convertToMD5(atc.Version{"version": version})),
+							ResourceID: resource.ID(),
+						},
+						FirstOccurrence: true,
+					},
+					PassedBuildIDs: []int{},
+				}}, true)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := build.AdoptInputsAndPipes()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			if status != db.BuildStatusAborted {
+				found, err = build.Start(atc.Plan{ID: "some-id"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			}
+
+			err = build.Finish(status)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			job, found, err = pipeline.Job("a-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceConfigScope, err := resource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resourceConfigScope.SaveVersions(nil, []atc.Version{
+				{"version": "v1"},
+				{"version": "v2"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			runBuild("v1", db.BuildStatusFailed)
+			runBuild("v1", db.BuildStatusSucceeded)
+			runBuild("v2", db.BuildStatusFailed)
+			runBuild("v2", db.BuildStatusErrored)
+			runBuild("v2", db.BuildStatusAborted)
+
+			_, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("summarizes the completed builds of every job", func() {
+			stats, err := pipeline.JobStats(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats).To(HaveLen(9))
+
+			Expect(stats[0].JobName).To(Equal("job-name"))
+			Expect(stats[0].Builds).To(BeZero())
+
+			Expect(stats[2].JobName).To(Equal("a-job"))
+			Expect(stats[2].Builds).To(Equal(5))
+			Expect(stats[2].Succeeded).To(Equal(1))
+			Expect(stats[2].Failed).To(Equal(2))
+			Expect(stats[2].Errored).To(Equal(1))
+			Expect(stats[2].Aborted).To(Equal(1))
+			Expect(stats[2].SuccessRate).To(Equal(0.25))
+			Expect(stats[2].FlakyRetries).To(Equal(1))
+			Expect(stats[2].Flakiness).To(BeNumerically("~", 1.0/3))
+			Expect(stats[2].DurationP95).To(BeNumerically(">=", stats[2].DurationP50))
+			Expect(stats[2].QueueP95).To(BeNumerically(">=", stats[2].QueueP50))
+		})
+
+		It("only counts builds created within the window", func() {
+			stats, err := pipeline.JobStats(time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats).To(HaveLen(9))
+			Expect(stats[2].JobName).To(Equal("a-job"))
+			Expect(stats[2].Builds).To(BeZero())
+			Expect(stats[2].SuccessRate).To(BeZero())
+		})
+	})
+
 	Describe("GetBuildsWithVersionAsInput", func() {
 		var (
 			resourceConfigVersion int
@@ -2150,6 +2248,46 @@ var _ = Describe("Pipeline", func() {
 			Expect(found).To(BeFalse())
 		})
 
+		Context("when the team has a credential manager", func() {
+			BeforeEach(func() {
+				creds.AllowTeamManagerTypes([]string{"dummy"})
+
+				err := team.UpdateCredentialManager(&atc.TeamCredentialManager{
+					Type: "dummy",
+					Config: map[string]interface{}{
+						"vars": map[string]interface{}{"tk": "tv", "gk": "team-gv"},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				creds.AllowTeamManagerTypes(nil)
+			})
+
+			It("should get var from the team's credential manager", func() {
+				v, found, err := pvars.Get(vars.VariableDefinition{Name: "tk"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(v.(string)).To(Equal("tv"))
+			})
+
+			It("should prefer the team's credential manager over global secrets", func() {
+				v, found, err := pvars.Get(vars.VariableDefinition{Name: "gk"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(v.(string)).To(Equal("team-gv"))
+				Expect(fakeGlobalSecrets.GetCallCount()).To(Equal(0))
+			})
+
+			It("should still get var from pipeline var source", func() {
+				v, found, err := pvars.Get(vars.VariableDefinition{Name: "some-var-source:pk"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(v.(string)).To(Equal("pv"))
+			})
+		})
+
 		Context("with the second var_source", func() {
 			BeforeEach(func() {
 				pipelineConfig.VarSources = append(pipelineConfig.VarSources, atc.VarSourceConfig{
@@ -37,6 +37,7 @@ type Resource interface {
 	CheckSetupError() error
 	CheckError() error
 	WebhookToken() string
+	WebhookVersion() *atc.WebhookVersionConfig
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
 	PinComment() string
@@ -56,9 +57,12 @@ type Resource interface {
 
 	EnableVersion(rcvID int) error
 	DisableVersion(rcvID int) error
+	DisabledVersions() ([]atc.Version, error)
+	RestoreDisabledVersions([]atc.Version) error
 
 	PinVersion(rcvID int) (bool, error)
 	UnpinVersion() error
+	RestorePin(version atc.Version, comment string) error
 
 	SetResourceConfig(atc.Source, atc.VersionedResourceTypes) (ResourceConfigScope, error)
 	SetCheckSetupError(error) error
@@ -112,6 +116,7 @@ type resource struct {
 	checkSetupError       error
 	checkError            error
 	webhookToken          string
+	webhookVersion        *atc.WebhookVersionConfig
 	configPinnedVersion   atc.Version
 	apiPinnedVersion      atc.Version
 	pinComment            string
@@ -149,15 +154,16 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 
 	for _, r := range resources {
 		configs = append(configs, atc.ResourceConfig{
-			Name:         r.Name(),
-			Public:       r.Public(),
-			WebhookToken: r.WebhookToken(),
-			Type:         r.Type(),
-			Source:       r.Source(),
-			CheckEvery:   r.CheckEvery(),
-			Tags:         r.Tags(),
-			Version:      r.ConfigPinnedVersion(),
-			Icon:         r.Icon(),
+			Name:           r.Name(),
+			Public:         r.Public(),
+			WebhookToken:   r.WebhookToken(),
+			WebhookVersion: r.WebhookVersion(),
+			Type:           r.Type(),
+			Source:         r.Source(),
+			CheckEvery:     r.CheckEvery(),
+			Tags:           r.Tags(),
+			Version:        r.ConfigPinnedVersion(),
+			Icon:           r.Icon(),
 		})
 	}
 
@@ -188,6 +194,8 @@ func (r *resource) Icon() string                     { return r.icon }
 
 func (r *resource) HasWebhook() bool { return r.WebhookToken() != "" }
 
+func (r *resource) WebhookVersion() *atc.WebhookVersionConfig { return r.webhookVersion }
+
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
 		RunWith(r.conn).
@@ -636,6 +644,31 @@ func (r *resource) PinVersion(rcvID int) (bool, error) {
 		return false, err
 	}
 
+	var pinnedVersion string
+	err = tx.QueryRow(`
+		SELECT version
+		FROM resource_pins
+		WHERE resource_id = $1
+	`, r.id).Scan(&pinnedVersion)
+	if err != nil {
+		return false, err
+	}
+
+	var version atc.Version
+	err = json.Unmarshal([]byte(pinnedVersion), &version)
+	if err != nil {
+		return false, err
+	}
+
+	err = savePipelineEvent(tx, r.teamID, r.pipelineID, atc.PipelineEvent{
+		Type:         atc.PipelineEventResourcePinned,
+		ResourceName: r.name,
+		Version:      version,
+	})
+	if err != nil {
+		return false, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return false, err
@@ -674,6 +707,14 @@ func (r *resource) UnpinVersion() error {
 		return err
 	}
 
+	err = savePipelineEvent(tx, r.teamID, r.pipelineID, atc.PipelineEvent{
+		Type:         atc.PipelineEventResourceUnpinned,
+		ResourceName: r.name,
+	})
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -682,6 +723,119 @@ func (r *resource) UnpinVersion() error {
 	return nil
 }
 
+// RestorePin pins the resource to the given version through the API, even if
+// the version has not been discovered yet.
+func (r *resource) RestorePin(version atc.Version, comment string) error {
+	versionJSON, err := json.Marshal(version)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	results, err := tx.Exec(`
+		INSERT INTO resource_pins(resource_id, version, comment_text, config)
+		VALUES ($1, $2, $3, false)
+		ON CONFLICT (resource_id) DO UPDATE SET
+			version = EXCLUDED.version,
+			comment_text = EXCLUDED.comment_text
+		WHERE NOT resource_pins.config`, r.id, versionJSON, comment)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return ErrPinnedThroughConfig
+	}
+
+	err = requestScheduleForJobsUsingResource(tx, r.id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DisabledVersions returns the disabled versions of the resource which are
+// known to its current resource config scope.
+func (r *resource) DisabledVersions() ([]atc.Version, error) {
+	rows, err := r.conn.Query(`
+		SELECT DISTINCT v.version
+		FROM resource_disabled_versions d
+		JOIN resources r ON r.id = d.resource_id
+		JOIN resource_config_versions v
+			ON v.resource_config_scope_id = r.resource_config_scope_id
+			AND v.version_md5 = d.version_md5
+		WHERE d.resource_id = $1`, r.id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var versions []atc.Version
+	for rows.Next() {
+		var versionJSON []byte
+		err := rows.Scan(&versionJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal(versionJSON, &version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// RestoreDisabledVersions disables the given versions of the resource, even if
+// they have not been discovered yet.
+func (r *resource) RestoreDisabledVersions(versions []atc.Version) error {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for _, version := range versions {
+		versionJSON, err := json.Marshal(version)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO resource_disabled_versions (resource_id, version_md5)
+			VALUES ($1, md5($2))
+			ON CONFLICT DO NOTHING`, r.id, string(versionJSON))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = requestScheduleForJobsUsingResource(tx, r.id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (r *resource) toggleVersion(rcvID int, enable bool) error {
 	tx, err := r.conn.Begin()
 	if err != nil {
@@ -774,6 +928,7 @@ func scanResource(r *resource, row scannable) error {
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
 	r.webhookToken = config.WebhookToken
+	r.webhookVersion = config.WebhookVersion
 	r.icon = config.Icon
 
 	if pinnedVersion.Valid {
@@ -297,7 +297,10 @@ func findOrCreateResourceConfigScope(
 	var resourceID *int
 
 	if resource != nil {
-		if !atc.EnableGlobalResources {
+		// versions saved from webhook payloads are only trusted for the
+		// resource whose webhook secret signed them, so they must not be
+		// shared with other resources through a global scope
+		if !atc.EnableGlobalResources || resource.WebhookVersion() != nil {
 			unique = true
 		} else {
 			customType, found := resourceTypes.Lookup(resourceType)
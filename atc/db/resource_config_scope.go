@@ -78,16 +78,20 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 
 	defer Rollback(tx)
 
-	var containsNewVersion bool
+	var newVersions []atc.Version
 	for _, version := range versions {
 		newVersion, err := saveResourceVersion(tx, rcsID, version, nil, spanContext)
 		if err != nil {
 			return err
 		}
 
-		containsNewVersion = containsNewVersion || newVersion
+		if newVersion {
+			newVersions = append(newVersions, version)
+		}
 	}
 
+	containsNewVersion := len(newVersions) > 0
+
 	if containsNewVersion {
 		// bump the check order of all the versions returned by the check if there
 		// is at least one new version within the set of returned versions
@@ -107,6 +111,11 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 		if err != nil {
 			return err
 		}
+
+		err = saveResourceVersionPipelineEvents(tx, rcsID, newVersions)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
@@ -117,6 +126,60 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 	return nil
 }
 
+// saveResourceVersionPipelineEvents records the new versions as events on
+// every pipeline with a resource using the scope.
+func saveResourceVersionPipelineEvents(tx Tx, rcsID int, versions []atc.Version) error {
+	rows, err := psql.Select("r.name", "r.pipeline_id", "p.team_id").
+		From("resources r").
+		Join("pipelines p ON p.id = r.pipeline_id").
+		Where(sq.Eq{
+			"r.resource_config_scope_id": rcsID,
+			"r.active":                   true,
+		}).
+		OrderBy("r.id ASC").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	type pipelineResource struct {
+		name       string
+		pipelineID int
+		teamID     int
+	}
+
+	var resources []pipelineResource
+	for rows.Next() {
+		var resource pipelineResource
+		err = rows.Scan(&resource.name, &resource.pipelineID, &resource.teamID)
+		if err != nil {
+			return err
+		}
+
+		resources = append(resources, resource)
+	}
+
+	var records []pipelineEventRecord
+	for _, resource := range resources {
+		for _, version := range versions {
+			records = append(records, pipelineEventRecord{
+				teamID:     resource.teamID,
+				pipelineID: resource.pipelineID,
+				event: atc.PipelineEvent{
+					Type:         atc.PipelineEventResourceVersion,
+					ResourceName: resource.name,
+					Version:      version,
+				},
+			})
+		}
+	}
+
+	return savePipelineEvents(tx, records)
+}
+
 func (r *resourceConfigScope) FindVersion(v atc.Version) (ResourceConfigVersion, bool, error) {
 	rcv := &resourceConfigVersion{
 		resourceConfigScope: r,
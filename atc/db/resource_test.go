@@ -28,8 +28,12 @@ var _ = Describe("Resource", func() {
 						Name:         "some-resource",
 						Type:         "registry-image",
 						WebhookToken: "some-token",
-						Source:       atc.Source{"some": "repository"},
-						Version:      atc.Version{"ref": "abcdef"},
+						WebhookVersion: &atc.WebhookVersionConfig{
+							Version: map[string]string{"ref": "{{.after}}"},
+							Secret:  "((webhook-secret))",
+						},
+						Source:  atc.Source{"some": "repository"},
+						Version: atc.Version{"ref": "abcdef"},
 					},
 					{
 						Name:   "some-other-resource",
@@ -98,10 +102,15 @@ var _ = Describe("Resource", func() {
 					Expect(r.ConfigPinnedVersion()).To(Equal(atc.Version{"ref": "abcdef"}))
 					Expect(r.CurrentPinnedVersion()).To(Equal(r.ConfigPinnedVersion()))
 					Expect(r.HasWebhook()).To(BeTrue())
+					Expect(r.WebhookVersion()).To(Equal(&atc.WebhookVersionConfig{
+						Version: map[string]string{"ref": "{{.after}}"},
+						Secret:  "((webhook-secret))",
+					}))
 				case "some-other-resource":
 					Expect(r.Type()).To(Equal("git"))
 					Expect(r.Source()).To(Equal(atc.Source{"some": "other-repository"}))
 					Expect(r.HasWebhook()).To(BeFalse())
+					Expect(r.WebhookVersion()).To(BeNil())
 				case "some-secret-resource":
 					Expect(r.Type()).To(Equal("git"))
 					Expect(r.Source()).To(Equal(atc.Source{"some": "((secret-repository))"}))
@@ -311,6 +320,46 @@ var _ = Describe("Resource", func() {
 			})
 		})
 
+		Context("when restoring disabled versions", func() {
+			BeforeEach(func() {
+				err := resource.RestoreDisabledVersions([]atc.Version{
+					{"disabled": "version"},
+					{"not-yet": "discovered"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("disables the known version", func() {
+				versions, _, found, err := resource.Versions(db.Page{Limit: 3}, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(HaveLen(1))
+				Expect(versions[0].Enabled).To(BeFalse())
+			})
+
+			It("returns only the known versions as disabled", func() {
+				Expect(resource.DisabledVersions()).To(ConsistOf(atc.Version{"disabled": "version"}))
+			})
+
+			It("disables versions once they are discovered", func() {
+				resourceScope, err := resource.SetResourceConfig(atc.Source{"some": "other-repository"}, atc.VersionedResourceTypes{})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = resourceScope.SaveVersions(nil, []atc.Version{{"not-yet": "discovered"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(resource.DisabledVersions()).To(ConsistOf(
+					atc.Version{"disabled": "version"},
+					atc.Version{"not-yet": "discovered"},
+				))
+			})
+
+			It("is idempotent", func() {
+				err := resource.RestoreDisabledVersions([]atc.Version{{"disabled": "version"}})
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("when disabling version that does not exist", func() {
 			var disableErr error
 			BeforeEach(func() {
@@ -375,6 +424,16 @@ var _ = Describe("Resource", func() {
 						Type:   "some-resourceType",
 						Source: atc.Source{"some": "repository"},
 					},
+					{
+						Name:         "webhook-resource",
+						Type:         "some-type",
+						Source:       atc.Source{"some": "repository"},
+						WebhookToken: "some-token",
+						WebhookVersion: &atc.WebhookVersionConfig{
+							Version: map[string]string{"ref": "{{.after}}"},
+							Secret:  "some-secret",
+						},
+					},
 				},
 				Jobs: atc.JobConfigs{
 					{
@@ -478,6 +537,40 @@ var _ = Describe("Resource", func() {
 				})
 			})
 
+			Context("when the resource saves versions from webhook payloads", func() {
+				It("gets its own resource config scope", func() {
+					setupTx, err := dbConn.Begin()
+					Expect(err).ToNot(HaveOccurred())
+
+					brt := db.BaseResourceType{
+						Name: "some-type",
+					}
+
+					_, err = brt.FindOrCreate(setupTx, false)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(setupTx.Commit()).To(Succeed())
+
+					sharedResource, found, err := pipeline.Resource("some-resource")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					sharedScope, err := sharedResource.SetResourceConfig(atc.Source{"some": "repository"}, atc.VersionedResourceTypes{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(sharedScope.Resource()).To(BeNil())
+
+					webhookResource, found, err := pipeline.Resource("webhook-resource")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					webhookScope, err := webhookResource.SetResourceConfig(atc.Source{"some": "repository"}, atc.VersionedResourceTypes{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(webhookScope.ResourceConfig().ID()).To(Equal(sharedScope.ResourceConfig().ID()))
+					Expect(webhookScope.ID()).ToNot(Equal(sharedScope.ID()))
+					Expect(webhookScope.Resource()).ToNot(BeNil())
+					Expect(webhookScope.Resource().ID()).To(Equal(webhookResource.ID()))
+				})
+			})
+
 			Context("when the resource uses a base resource type that has unique version history", func() {
 				var (
 					resourceScope1 db.ResourceConfigScope
@@ -1735,6 +1828,53 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("RestorePin", func() {
+		Context("when the resource is not pinned through config", func() {
+			var resource db.Resource
+
+			BeforeEach(func() {
+				var found bool
+				var err error
+				resource, found, err = pipeline.Resource("some-other-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				err = resource.RestorePin(atc.Version{"version": "undiscovered"}, "restored from backup")
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err = resource.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("pins the version with the comment even though it has not been discovered", func() {
+				Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"version": "undiscovered"}))
+				Expect(resource.PinComment()).To(Equal("restored from backup"))
+			})
+
+			It("replaces an existing API pin", func() {
+				err := resource.RestorePin(atc.Version{"version": "other"}, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = resource.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"version": "other"}))
+				Expect(resource.PinComment()).To(BeEmpty())
+			})
+		})
+
+		Context("when the resource is pinned through config", func() {
+			It("returns ErrPinnedThroughConfig", func() {
+				resource, found, err := pipeline.Resource("some-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				err = resource.RestorePin(atc.Version{"version": "v2"}, "")
+				Expect(err).To(Equal(db.ErrPinnedThroughConfig))
+			})
+		})
+	})
+
 	Describe("Public", func() {
 		var (
 			resource db.Resource
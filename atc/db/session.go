@@ -0,0 +1,38 @@
+package db
+
+import (
+	"time"
+)
+
+//go:generate counterfeiter . Session
+
+type Session interface {
+	ID() int
+	Sub() string
+	UserName() string
+	Connector() string
+	CreatedAt() time.Time
+	LastUsedAt() time.Time
+	ExpiresAt() time.Time
+	Revoked() bool
+}
+
+type session struct {
+	id         int
+	sub        string
+	userName   string
+	connector  string
+	createdAt  time.Time
+	lastUsedAt time.Time
+	expiresAt  time.Time
+	revoked    bool
+}
+
+func (s session) ID() int               { return s.id }
+func (s session) Sub() string           { return s.sub }
+func (s session) UserName() string      { return s.userName }
+func (s session) Connector() string     { return s.connector }
+func (s session) CreatedAt() time.Time  { return s.createdAt }
+func (s session) LastUsedAt() time.Time { return s.lastUsedAt }
+func (s session) ExpiresAt() time.Time  { return s.expiresAt }
+func (s session) Revoked() bool         { return s.revoked }
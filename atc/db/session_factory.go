@@ -0,0 +1,256 @@
+package db
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . SessionFactory
+
+type SessionFactory interface {
+	// SaveSession records a session for the given token, or marks an already
+	// recorded session as used.
+	SaveSession(spec SessionSpec) (Session, error)
+
+	GetActiveSessions() ([]Session, error)
+	GetActiveSessionsForUser(sub string) ([]Session, error)
+	FindSession(id int) (Session, bool, error)
+
+	RevokeSession(id int) (bool, error)
+	// RevokeSessionsForUser revokes the sessions of the user identified by
+	// the connector and sub, since user names are not unique across
+	// connectors.
+	RevokeSessionsForUser(connector string, sub string) (int, error)
+
+	// RevokedSessionIDs returns the revoked sessions whose tokens have not
+	// yet expired.
+	RevokedSessionIDs() ([]int, error)
+
+	RemoveExpiredSessions() (int, error)
+}
+
+// SessionSpec describes the token backing a session. Only a hash of Token is
+// ever persisted.
+type SessionSpec struct {
+	Token     string
+	Sub       string
+	UserName  string
+	Connector string
+	ExpiresAt time.Time
+}
+
+var sessionColumns = []string{
+	"id",
+	"sub",
+	"username",
+	"connector",
+	"created_at",
+	"last_used_at",
+	"expires_at",
+	"revoked_at",
+}
+
+var activeSessionsQuery = psql.Select(sessionColumns...).
+	From("sessions").
+	Where(sq.Expr("revoked_at IS NULL")).
+	Where(sq.Expr("expires_at > now()"))
+
+type sessionFactory struct {
+	conn Conn
+}
+
+func NewSessionFactory(conn Conn) SessionFactory {
+	return &sessionFactory{
+		conn: conn,
+	}
+}
+
+func (f *sessionFactory) SaveSession(spec SessionSpec) (Session, error) {
+	return scanSession(psql.Insert("sessions").
+		Columns(
+			"token_hash",
+			"sub",
+			"username",
+			"connector",
+			"expires_at",
+		).
+		Values(
+			hashSessionToken(spec.Token),
+			spec.Sub,
+			spec.UserName,
+			spec.Connector,
+			spec.ExpiresAt,
+		).
+		Suffix(`ON CONFLICT (token_hash) DO UPDATE SET
+			last_used_at = now()
+			RETURNING id, sub, username, connector, created_at, last_used_at, expires_at, revoked_at`).
+		RunWith(f.conn).
+		QueryRow())
+}
+
+func (f *sessionFactory) GetActiveSessions() ([]Session, error) {
+	return f.getSessions(activeSessionsQuery)
+}
+
+func (f *sessionFactory) GetActiveSessionsForUser(sub string) ([]Session, error) {
+	return f.getSessions(activeSessionsQuery.Where(sq.Eq{"sub": sub}))
+}
+
+func (f *sessionFactory) getSessions(query sq.SelectBuilder) ([]Session, error) {
+	rows, err := query.
+		OrderBy("created_at DESC").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var sessions []Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func (f *sessionFactory) FindSession(id int) (Session, bool, error) {
+	session, err := scanSession(psql.Select(sessionColumns...).
+		From("sessions").
+		Where(sq.Eq{"id": id}).
+		RunWith(f.conn).
+		QueryRow())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return session, true, nil
+}
+
+func (f *sessionFactory) RevokeSession(id int) (bool, error) {
+	revoked, err := f.revoke(sq.Eq{"id": id})
+	if err != nil {
+		return false, err
+	}
+
+	return revoked > 0, nil
+}
+
+func (f *sessionFactory) RevokeSessionsForUser(connector string, sub string) (int, error) {
+	return f.revoke(sq.Eq{"connector": connector, "sub": sub})
+}
+
+func (f *sessionFactory) revoke(where sq.Eq) (int, error) {
+	result, err := psql.Update("sessions").
+		Set("revoked_at", sq.Expr("now()")).
+		Where(where).
+		Where(sq.Expr("revoked_at IS NULL")).
+		Where(sq.Expr("expires_at > now()")).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if affected > 0 {
+		err = f.conn.Bus().Notify(atc.SessionRevocationChannel)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return int(affected), nil
+}
+
+func (f *sessionFactory) RevokedSessionIDs() ([]int, error) {
+	rows, err := psql.Select("id").
+		From("sessions").
+		Where(sq.Expr("revoked_at IS NOT NULL")).
+		Where(sq.Expr("expires_at > now()")).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (f *sessionFactory) RemoveExpiredSessions() (int, error) {
+	result, err := psql.Delete("sessions").
+		Where(sq.Expr("expires_at < now()")).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+func scanSession(row scannable) (Session, error) {
+	var (
+		s         session
+		revokedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&s.id,
+		&s.sub,
+		&s.userName,
+		&s.connector,
+		&s.createdAt,
+		&s.lastUsedAt,
+		&s.expiresAt,
+		&revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.revoked = revokedAt.Valid
+
+	return s, nil
+}
+
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
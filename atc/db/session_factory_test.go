@@ -0,0 +1,215 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Session Factory", func() {
+	var (
+		spec    db.SessionSpec
+		session db.Session
+		err     error
+	)
+
+	BeforeEach(func() {
+		spec = db.SessionSpec{
+			Token:     "some-token",
+			Sub:       "some-sub",
+			UserName:  "some-user",
+			Connector: "github",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	})
+
+	JustBeforeEach(func() {
+		session, err = sessionFactory.SaveSession(spec)
+	})
+
+	Describe("SaveSession", func() {
+		It("records the session", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(session.Sub()).To(Equal("some-sub"))
+			Expect(session.UserName()).To(Equal("some-user"))
+			Expect(session.Connector()).To(Equal("github"))
+			Expect(session.CreatedAt()).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(session.ExpiresAt()).To(BeTemporally("~", spec.ExpiresAt, time.Second))
+			Expect(session.Revoked()).To(BeFalse())
+		})
+
+		It("does not store the raw token", func() {
+			var hash string
+			err := dbConn.QueryRow("SELECT token_hash FROM sessions WHERE id = $1", session.ID()).Scan(&hash)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).ToNot(Equal("some-token"))
+		})
+
+		Context("when the session has already been recorded", func() {
+			It("returns the same session", func() {
+				again, err := sessionFactory.SaveSession(spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(again.ID()).To(Equal(session.ID()))
+				Expect(again.LastUsedAt()).To(BeTemporally(">=", session.LastUsedAt()))
+			})
+
+			Context("when it has been revoked", func() {
+				It("is returned as revoked", func() {
+					_, err := sessionFactory.RevokeSession(session.ID())
+					Expect(err).ToNot(HaveOccurred())
+
+					again, err := sessionFactory.SaveSession(spec)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(again.Revoked()).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("GetActiveSessions", func() {
+		var otherSession db.Session
+
+		JustBeforeEach(func() {
+			otherSession, err = sessionFactory.SaveSession(db.SessionSpec{
+				Token:     "other-token",
+				Sub:       "other-sub",
+				UserName:  "other-user",
+				Connector: "local",
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = sessionFactory.SaveSession(db.SessionSpec{
+				Token:     "expired-token",
+				Sub:       "some-sub",
+				UserName:  "some-user",
+				Connector: "github",
+				ExpiresAt: time.Now().Add(-time.Hour),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns unexpired sessions", func() {
+			sessions, err := sessionFactory.GetActiveSessions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(2))
+		})
+
+		It("can be limited to a single user", func() {
+			sessions, err := sessionFactory.GetActiveSessionsForUser("other-sub")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].ID()).To(Equal(otherSession.ID()))
+		})
+
+		It("omits revoked sessions", func() {
+			_, err := sessionFactory.RevokeSession(otherSession.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			sessions, err := sessionFactory.GetActiveSessions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].ID()).To(Equal(session.ID()))
+		})
+	})
+
+	Describe("FindSession", func() {
+		It("finds the session", func() {
+			found, ok, err := sessionFactory.FindSession(session.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(found.UserName()).To(Equal("some-user"))
+		})
+
+		Context("when the session does not exist", func() {
+			It("returns false", func() {
+				_, ok, err := sessionFactory.FindSession(session.ID() + 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RevokeSession", func() {
+		It("adds the session to the revoked sessions", func() {
+			revoked, err := sessionFactory.RevokeSession(session.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			ids, err := sessionFactory.RevokedSessionIDs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(ConsistOf(session.ID()))
+		})
+
+		Context("when the session is already revoked", func() {
+			It("returns false", func() {
+				_, err := sessionFactory.RevokeSession(session.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				revoked, err := sessionFactory.RevokeSession(session.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(revoked).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RevokeSessionsForUser", func() {
+		JustBeforeEach(func() {
+			spec.Token = "another-token"
+			_, err = sessionFactory.SaveSession(spec)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("revokes every session of the user", func() {
+			count, err := sessionFactory.RevokeSessionsForUser("github", "some-sub")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(2))
+
+			sessions, err := sessionFactory.GetActiveSessionsForUser("some-sub")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(BeEmpty())
+		})
+
+		Context("when another user has the same name", func() {
+			JustBeforeEach(func() {
+				spec.Token = "other-connector-token"
+				spec.Sub = "other-sub"
+				spec.Connector = "local"
+				_, err = sessionFactory.SaveSession(spec)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("only revokes the sessions of the given user", func() {
+				count, err := sessionFactory.RevokeSessionsForUser("github", "some-sub")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(2))
+
+				sessions, err := sessionFactory.GetActiveSessionsForUser("other-sub")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sessions).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("RemoveExpiredSessions", func() {
+		JustBeforeEach(func() {
+			spec.Token = "expired-token"
+			spec.ExpiresAt = time.Now().Add(-time.Hour)
+			_, err = sessionFactory.SaveSession(spec)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes only the expired sessions", func() {
+			removed, err := sessionFactory.RemoveExpiredSessions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			_, found, err := sessionFactory.FindSession(session.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+	})
+})
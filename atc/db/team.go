@@ -14,6 +14,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
 )
@@ -28,6 +29,8 @@ type Team interface {
 	Admin() bool
 
 	Auth() atc.TeamAuth
+	CredentialManager() *atc.TeamCredentialManager
+	CustomRoles() []atc.TeamRole
 
 	Delete() error
 	Rename(string) error
@@ -43,6 +46,7 @@ type Team interface {
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	OrderPipelines([]string) error
+	PipelineEvents(from int) (PipelineEventSource, error)
 
 	CreateOneOffBuild() (Build, error)
 	CreateStartedBuild(plan atc.Plan) (Build, error)
@@ -67,6 +71,8 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdateCredentialManager(credentialManager *atc.TeamCredentialManager) error
+	UpdateCustomRoles(roles []atc.TeamRole) error
 }
 
 type team struct {
@@ -78,6 +84,10 @@ type team struct {
 	admin bool
 
 	auth atc.TeamAuth
+
+	credentialManager *atc.TeamCredentialManager
+
+	customRoles []atc.TeamRole
 }
 
 func (t *team) ID() int      { return t.id }
@@ -86,6 +96,10 @@ func (t *team) Admin() bool  { return t.admin }
 
 func (t *team) Auth() atc.TeamAuth { return t.auth }
 
+func (t *team) CredentialManager() *atc.TeamCredentialManager { return t.credentialManager }
+
+func (t *team) CustomRoles() []atc.TeamRole { return t.customRoles }
+
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
 		Where(sq.Eq{
@@ -375,7 +389,7 @@ func savePipeline(
 		return 0, false, err
 	}
 
-	var pipelineID int
+	var pipelineID, configVersion int
 	if !existingConfig {
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
@@ -391,9 +405,9 @@ func savePipeline(
 				"parent_job_id":   jobID,
 				"parent_build_id": buildID,
 			}).
-			Suffix("RETURNING id").
+			Suffix("RETURNING id, version").
 			RunWith(tx).
-			QueryRow().Scan(&pipelineID)
+			QueryRow().Scan(&pipelineID, &configVersion)
 		if err != nil {
 			return 0, false, err
 		}
@@ -418,10 +432,10 @@ func savePipeline(
 			q = q.Where(sq.Or{sq.Lt{"parent_build_id": buildID}, sq.Eq{"parent_build_id": nil}})
 		}
 
-		err := q.Suffix("RETURNING id").
+		err := q.Suffix("RETURNING id, version").
 			RunWith(tx).
 			QueryRow().
-			Scan(&pipelineID)
+			Scan(&pipelineID, &configVersion)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				var currentParentBuildID sql.NullInt64
@@ -492,11 +506,24 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	err = saveNotifications(tx, pipelineID, config.Notifications)
+	if err != nil {
+		return 0, false, err
+	}
+
 	err = requestScheduleForJobsInPipeline(tx, pipelineID)
 	if err != nil {
 		return 0, false, err
 	}
 
+	err = savePipelineEvent(tx, teamID, pipelineID, atc.PipelineEvent{
+		Type:          atc.PipelineEventConfig,
+		ConfigVersion: configVersion,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
 	return pipelineID, !existingConfig, nil
 }
 
@@ -637,6 +664,12 @@ func (t *team) OrderPipelines(pipelineNames []string) error {
 }
 
 // XXX: This is only begin used by tests, replace all tests to CreateBuild on a job
+// PipelineEvents streams the events of all of the team's pipelines which
+// happen after the event with the given ID.
+func (t *team) PipelineEvents(from int) (PipelineEventSource, error) {
+	return newPipelineEventSource(t.conn, sq.Eq{"e.team_id": t.id}, teamPipelineEventsChannel(t.id), from)
+}
+
 func (t *team) CreateOneOffBuild() (Build, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -772,7 +805,7 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, credential_manager, credential_manager_nonce, custom_roles
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -782,6 +815,61 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+func (t *team) UpdateCredentialManager(credentialManager *atc.TeamCredentialManager) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	encryptedCredentialManager, nonce, err := encryptCredentialManager(tx.EncryptionStrategy(), credentialManager)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE teams
+		SET credential_manager = $1, credential_manager_nonce = $2
+		WHERE id = $3
+		RETURNING id, name, admin, auth, credential_manager, credential_manager_nonce, custom_roles
+	`
+	err = t.queryTeam(tx, query, encryptedCredentialManager, nonce, t.id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *team) UpdateCustomRoles(roles []atc.TeamRole) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	var jsonEncodedRoles interface{}
+	if len(roles) > 0 {
+		jsonEncodedRoles, err = json.Marshal(roles)
+		if err != nil {
+			return err
+		}
+	}
+
+	query := `
+		UPDATE teams
+		SET custom_roles = $1
+		WHERE id = $2
+		RETURNING id, name, admin, auth, credential_manager, credential_manager_nonce, custom_roles
+	`
+	err = t.queryTeam(tx, query, jsonEncodedRoles, t.id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineName)
 	if err != nil {
@@ -1248,19 +1336,31 @@ func scanContainers(rows *sql.Rows, conn Conn, initContainers []Container) ([]Co
 }
 
 func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
-	var providerAuth, nonce sql.NullString
+	var providerAuth, credentialManager, credentialManagerNonce, customRoles sql.NullString
 
 	err := tx.QueryRow(query, params...).Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
-		&nonce,
+		&credentialManager,
+		&credentialManagerNonce,
+		&customRoles,
 	)
 	if err != nil {
 		return err
 	}
 
+	t.customRoles, err = unmarshalCustomRoles(customRoles)
+	if err != nil {
+		return err
+	}
+
+	t.credentialManager, err = decryptCredentialManager(tx.EncryptionStrategy(), credentialManager, credentialManagerNonce)
+	if err != nil {
+		return err
+	}
+
 	if providerAuth.Valid {
 		var auth atc.TeamAuth
 		err = json.Unmarshal([]byte(providerAuth.String), &auth)
@@ -1273,6 +1373,82 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 	return nil
 }
 
+func unmarshalCustomRoles(payload sql.NullString) ([]atc.TeamRole, error) {
+	if !payload.Valid {
+		return nil, nil
+	}
+
+	var roles []atc.TeamRole
+	err := json.Unmarshal([]byte(payload.String), &roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func encryptCredentialManager(es encryption.Strategy, credentialManager *atc.TeamCredentialManager) (interface{}, *string, error) {
+	if credentialManager == nil {
+		return nil, nil, nil
+	}
+
+	payload, err := json.Marshal(credentialManager)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encryptedPayload, nonce, err := es.Encrypt(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encryptedPayload, nonce, nil
+}
+
+func decryptCredentialManager(es encryption.Strategy, payload sql.NullString, nonce sql.NullString) (*atc.TeamCredentialManager, error) {
+	if !payload.Valid {
+		return nil, nil
+	}
+
+	var nonceStr *string
+	if nonce.Valid {
+		nonceStr = &nonce.String
+	}
+
+	decryptedPayload, err := es.Decrypt(payload.String, nonceStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var credentialManager atc.TeamCredentialManager
+	err = json.Unmarshal(decryptedPayload, &credentialManager)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialManager, nil
+}
+
+// findTeamCredentialManager loads the credential manager configured for the
+// given team, if any.
+func findTeamCredentialManager(conn Conn, teamID int) (*atc.TeamCredentialManager, error) {
+	var payload, nonce sql.NullString
+	err := psql.Select("credential_manager, credential_manager_nonce").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&payload, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return decryptCredentialManager(conn.EncryptionStrategy(), payload, nonce)
+}
+
 func resetDependentTableStates(tx Tx, pipelineID int) error {
 	_, err := psql.Delete("jobs_serial_groups").
 		Where(sq.Expr(`job_id in (
@@ -52,10 +52,23 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	credentialManager, credentialManagerNonce, err := encryptCredentialManager(tx.EncryptionStrategy(), t.CredentialManager)
+	if err != nil {
+		return nil, err
+	}
+
+	var customRoles interface{}
+	if len(t.CustomRoles) > 0 {
+		customRoles, err = json.Marshal(t.CustomRoles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, credential_manager, credential_manager_nonce, custom_roles").
+		Values(t.Name, auth, admin, credentialManager, credentialManagerNonce, customRoles).
+		Suffix("RETURNING id, name, admin, auth, credential_manager, credential_manager_nonce, custom_roles").
 		RunWith(tx).
 		QueryRow()
 
@@ -91,7 +104,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, credential_manager, credential_manager_nonce, custom_roles").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -110,7 +123,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, credential_manager, credential_manager_nonce, custom_roles").
 		From("teams").
 		OrderBy("name ASC").
 		RunWith(factory.conn).
@@ -176,13 +189,16 @@ func (factory *teamFactory) NotifyCacher() error {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var providerAuth, credentialManager, credentialManagerNonce, customRoles sql.NullString
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&credentialManager,
+		&credentialManagerNonce,
+		&customRoles,
 	)
 
 	if providerAuth.Valid {
@@ -192,5 +208,15 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		}
 	}
 
+	if err != nil {
+		return err
+	}
+
+	t.customRoles, err = unmarshalCustomRoles(customRoles)
+	if err != nil {
+		return err
+	}
+
+	t.credentialManager, err = decryptCredentialManager(factory.conn.EncryptionStrategy(), credentialManager, credentialManagerNonce)
 	return err
 }
@@ -858,6 +858,102 @@ var _ = Describe("Team", func() {
 				})
 			})
 		})
+
+		Describe("UpdateCredentialManager", func() {
+			var credentialManager *atc.TeamCredentialManager
+
+			BeforeEach(func() {
+				credentialManager = &atc.TeamCredentialManager{
+					Type: "vault",
+					Config: map[string]interface{}{
+						"url":          "https://vault.example.com",
+						"client_token": "some-token",
+					},
+				}
+			})
+
+			It("saves the credential manager to the team", func() {
+				err := team.UpdateCredentialManager(credentialManager)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.CredentialManager()).To(Equal(credentialManager))
+
+				foundTeam, found, err := teamFactory.FindTeam(team.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(foundTeam.CredentialManager()).To(Equal(credentialManager))
+			})
+
+			It("keeps the team's auth", func() {
+				auth := team.Auth()
+
+				err := team.UpdateCredentialManager(credentialManager)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.Auth()).To(Equal(auth))
+			})
+
+			Context("when the credential manager is removed", func() {
+				BeforeEach(func() {
+					err := team.UpdateCredentialManager(credentialManager)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("clears the credential manager", func() {
+					err := team.UpdateCredentialManager(nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(team.CredentialManager()).To(BeNil())
+
+					var payload sql.NullString
+					err = dbConn.QueryRow("SELECT credential_manager FROM teams WHERE id = $1", team.ID()).Scan(&payload)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(payload.Valid).To(BeFalse())
+				})
+			})
+		})
+
+		Describe("UpdateCustomRoles", func() {
+			var roles []atc.TeamRole
+
+			BeforeEach(func() {
+				roles = []atc.TeamRole{
+					{
+						Name:    "release-manager",
+						Extends: "pipeline-operator",
+						Permissions: []atc.TeamRolePermission{
+							{Action: atc.SaveConfig, Pipelines: []string{"release"}},
+						},
+					},
+				}
+			})
+
+			It("saves the custom roles to the team", func() {
+				err := team.UpdateCustomRoles(roles)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.CustomRoles()).To(Equal(roles))
+
+				foundTeam, found, err := teamFactory.FindTeam(team.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(foundTeam.CustomRoles()).To(Equal(roles))
+			})
+
+			Context("when the custom roles are removed", func() {
+				BeforeEach(func() {
+					err := team.UpdateCustomRoles(roles)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("clears the custom roles", func() {
+					err := team.UpdateCustomRoles(nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(team.CustomRoles()).To(BeNil())
+				})
+			})
+		})
 	})
 
 	Describe("Pipelines", func() {
@@ -21,6 +21,7 @@ type VolumeRepository interface {
 	CreateBaseResourceTypeVolume(*UsedWorkerBaseResourceType) (CreatingVolume, error)
 
 	FindResourceCacheVolume(workerName string, resourceCache UsedResourceCache) (CreatedVolume, bool, error)
+	GetUnreplicatedResourceCacheVolumes(workerName string, limit int) ([]CreatedVolume, error)
 
 	FindTaskCacheVolume(teamID int, workerName string, taskCache UsedTaskCache) (CreatedVolume, bool, error)
 	CreateTaskCacheVolume(teamID int, uwtc *UsedWorkerTaskCache) (CreatingVolume, error)
@@ -276,11 +277,9 @@ func (repository *volumeRepository) CreateBaseResourceTypeVolume(uwbrt *UsedWork
 
 func (repository *volumeRepository) CreateVolume(teamID int, workerName string, volumeType VolumeType) (CreatingVolume, error) {
 	volume, err := repository.createVolume(
-		0,
+		teamID,
 		workerName,
-		map[string]interface{}{
-			"team_id": teamID,
-		},
+		map[string]interface{}{},
 		volumeType,
 	)
 	if err != nil {
@@ -452,6 +451,59 @@ func (repository *volumeRepository) FindResourceCacheVolume(workerName string, r
 	return createdVolume, true, nil
 }
 
+// GetUnreplicatedResourceCacheVolumes returns the resource cache volumes on
+// the given worker for which no running worker has a copy, preferring caches
+// which are currently in use and then the most recently created ones.
+func (repository *volumeRepository) GetUnreplicatedResourceCacheVolumes(workerName string, limit int) ([]CreatedVolume, error) {
+	query, args, err := psql.Select(volumeColumns...).
+		From("volumes v").
+		LeftJoin("workers w ON v.worker_name = w.name").
+		LeftJoin("containers c ON v.container_id = c.id").
+		LeftJoin("volumes pv ON v.parent_id = pv.id").
+		Join("worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id").
+		Where(sq.Eq{
+			"v.state":       VolumeStateCreated,
+			"v.worker_name": workerName,
+		}).
+		Where(sq.Expr(`NOT EXISTS (
+			SELECT 1
+			FROM volumes ov
+			JOIN worker_resource_caches owrc ON owrc.id = ov.worker_resource_cache_id
+			JOIN workers ow ON ow.name = ov.worker_name
+			WHERE owrc.resource_cache_id = wrc.resource_cache_id
+			AND ov.state = ?
+			AND ow.state = ?
+		)`, VolumeStateCreated, WorkerStateRunning)).
+		OrderBy(
+			"EXISTS (SELECT 1 FROM resource_cache_uses rcu WHERE rcu.resource_cache_id = wrc.resource_cache_id) DESC",
+			"v.id DESC",
+		).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := repository.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer Close(rows)
+
+	var createdVolumes []CreatedVolume
+
+	for rows.Next() {
+		_, createdVolume, _, _, err := scanVolume(rows, repository.conn)
+		if err != nil {
+			return nil, err
+		}
+
+		createdVolumes = append(createdVolumes, createdVolume)
+	}
+
+	return createdVolumes, nil
+}
+
 func (repository *volumeRepository) FindCreatedVolume(handle string) (CreatedVolume, bool, error) {
 	_, createdVolume, err := getVolume(repository.conn, map[string]interface{}{
 		"v.handle": handle,
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"database/sql"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -426,6 +427,21 @@ var _ = Describe("VolumeRepository", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(teamID).To(Equal(defaultTeam.ID()))
 			Expect(workerName).To(Equal(defaultWorker.Name()))
+
+			createdVolume, err := volume.Created()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(createdVolume.TeamID()).To(Equal(defaultTeam.ID()))
+		})
+
+		It("creates a CreatingVolume without a team when the teamID is 0", func() {
+			volume, err := volumeRepository.CreateVolume(0, defaultWorker.Name(), db.VolumeTypeResource)
+			Expect(err).NotTo(HaveOccurred())
+
+			var teamID sql.NullInt64
+			err = psql.Select("team_id").From("volumes").
+				Where(sq.Eq{"handle": volume.Handle()}).RunWith(dbConn).QueryRow().Scan(&teamID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(teamID.Valid).To(BeFalse())
 		})
 	})
 
@@ -2,18 +2,37 @@ package db
 
 import (
 	"database/sql"
+	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
 
 	sq "github.com/Masterminds/squirrel"
 )
 
 //go:generate counterfeiter . Wall
 
+// Wall stores the messages shown to the users of the cluster. SetWall, GetWall
+// and Clear treat the wall as a single message, and are kept for clients that
+// predate messages being scheduled and targeted at teams.
 type Wall interface {
 	SetWall(atc.Wall) error
 	GetWall() (atc.Wall, error)
 	Clear() error
+
+	CreateMessage(atc.WallMessage) (atc.WallMessage, error)
+	ActiveMessages() ([]atc.WallMessage, error)
+	Messages() ([]atc.WallMessage, error)
+	DeleteMessage(id int) (bool, error)
+}
+
+// legacyWall matches the messages the single-message wall may replace or
+// clear: ones shown to every team without a scheduled start. Scheduled and
+// team-targeted messages are only managed through the message API.
+var legacyWall = sq.Eq{
+	"team_names": nil,
+	"starts_at":  nil,
 }
 
 type wall struct {
@@ -23,7 +42,7 @@ type wall struct {
 
 func NewWall(conn Conn, clock Clock) Wall {
 	return &wall{
-		conn: conn,
+		conn:  conn,
 		clock: clock,
 	}
 }
@@ -36,7 +55,10 @@ func (w wall) SetWall(wall atc.Wall) error {
 
 	defer Rollback(tx)
 
-	_, err = psql.Delete("wall").RunWith(tx).Exec()
+	_, err = psql.Delete("wall").
+		Where(legacyWall).
+		RunWith(tx).
+		Exec()
 	if err != nil {
 		return err
 	}
@@ -61,18 +83,23 @@ func (w wall) SetWall(wall atc.Wall) error {
 		return err
 	}
 
-	return nil
+	return w.notifyCacher()
 }
 
 func (w wall) GetWall() (atc.Wall, error) {
 	var wall atc.Wall
 
+	now := w.clock.Now()
+
 	row := psql.Select("message", "expires_at").
 		From("wall").
-		Where(sq.Or{
-			sq.Gt{"expires_at": w.clock.Now()},
-			sq.Eq{"expires_at": nil},
+		Where(sq.And{
+			notEnded(now),
+			started(now),
+			sq.Eq{"team_names": nil},
 		}).
+		OrderBy("id DESC").
+		Limit(1).
 		RunWith(w.conn).QueryRow()
 
 	err := w.scanWall(&wall, row)
@@ -99,9 +126,161 @@ func (w *wall) scanWall(wall *atc.Wall, scan scannable) error {
 }
 
 func (w wall) Clear() error {
-	_, err := psql.Delete("wall").RunWith(w.conn).Exec()
+	_, err := psql.Delete("wall").
+		Where(legacyWall).
+		RunWith(w.conn).
+		Exec()
 	if err != nil {
 		return err
 	}
+
+	return w.notifyCacher()
+}
+
+var wallMessageColumns = []string{"id", "message", "severity", "starts_at", "expires_at", "team_names"}
+
+func (w wall) CreateMessage(message atc.WallMessage) (atc.WallMessage, error) {
+	var startsAt, endsAt *time.Time
+	if message.StartsAt != 0 {
+		t := time.Unix(message.StartsAt, 0)
+		startsAt = &t
+	}
+	if message.EndsAt != 0 {
+		t := time.Unix(message.EndsAt, 0)
+		endsAt = &t
+	}
+
+	var teamNames interface{}
+	if len(message.Teams) != 0 {
+		teamNames = pq.Array(message.Teams)
+	}
+
+	row := psql.Insert("wall").
+		Columns("message", "severity", "starts_at", "expires_at", "team_names").
+		Values(message.Message, message.Severity, startsAt, endsAt, teamNames).
+		Suffix("RETURNING " + strings.Join(wallMessageColumns, ", ")).
+		RunWith(w.conn).
+		QueryRow()
+
+	var created atc.WallMessage
+	err := scanWallMessage(&created, row)
+	if err != nil {
+		return atc.WallMessage{}, err
+	}
+
+	err = w.notifyCacher()
+	if err != nil {
+		return atc.WallMessage{}, err
+	}
+
+	return created, nil
+}
+
+// ActiveMessages returns the messages whose window includes the current time,
+// regardless of the teams they target.
+func (w wall) ActiveMessages() ([]atc.WallMessage, error) {
+	now := w.clock.Now()
+
+	return w.queryMessages(sq.And{
+		notEnded(now),
+		started(now),
+	})
+}
+
+// Messages returns every message that has not ended yet, including the ones
+// scheduled to start later.
+func (w wall) Messages() ([]atc.WallMessage, error) {
+	return w.queryMessages(notEnded(w.clock.Now()))
+}
+
+func (w wall) queryMessages(where sq.Sqlizer) ([]atc.WallMessage, error) {
+	rows, err := psql.Select(wallMessageColumns...).
+		From("wall").
+		Where(where).
+		OrderBy("id ASC").
+		RunWith(w.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	messages := []atc.WallMessage{}
+	for rows.Next() {
+		var message atc.WallMessage
+		err = scanWallMessage(&message, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func (w wall) DeleteMessage(id int) (bool, error) {
+	result, err := psql.Delete("wall").
+		Where(sq.Eq{"id": id}).
+		RunWith(w.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if affected == 0 {
+		return false, nil
+	}
+
+	return true, w.notifyCacher()
+}
+
+// notifyCacher tells every ATC to drop the messages it has cached for the
+// info endpoint.
+func (w wall) notifyCacher() error {
+	return w.conn.Bus().Notify(atc.WallCacheChannel)
+}
+
+func scanWallMessage(message *atc.WallMessage, scan scannable) error {
+	var startsAt, endsAt sql.NullTime
+	var teamNames []string
+
+	err := scan.Scan(&message.ID, &message.Message, &message.Severity, &startsAt, &endsAt, pq.Array(&teamNames))
+	if err != nil {
+		return err
+	}
+
+	if startsAt.Valid {
+		message.StartsAt = startsAt.Time.Unix()
+	}
+
+	if endsAt.Valid {
+		message.EndsAt = endsAt.Time.Unix()
+	}
+
+	if len(teamNames) != 0 {
+		message.Teams = teamNames
+	}
+
 	return nil
 }
+
+func notEnded(now time.Time) sq.Sqlizer {
+	return sq.Or{
+		sq.Gt{"expires_at": now},
+		sq.Eq{"expires_at": nil},
+	}
+}
+
+func started(now time.Time) sq.Sqlizer {
+	return sq.Or{
+		sq.LtOrEq{"starts_at": now},
+		sq.Eq{"starts_at": nil},
+	}
+}
@@ -116,4 +116,165 @@ var _ = Describe("Wall", func() {
 			Expect(actualWall).To(Equal(atc.Wall{}))
 		})
 	})
+
+	Context("wall messages", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Unix(1600000000, 0)
+
+			fakeClock = dbfakes.FakeClock{}
+			fakeClock.NowReturns(now)
+		})
+
+		It("creates a message", func() {
+			created, err := dbWall.CreateMessage(atc.WallMessage{
+				Message:  "upgrading tonight",
+				Severity: atc.WallSeverityWarning,
+				StartsAt: now.Add(-time.Hour).Unix(),
+				EndsAt:   now.Add(time.Hour).Unix(),
+				Teams:    []string{"some-team"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.ID).ToNot(BeZero())
+			Expect(created).To(Equal(atc.WallMessage{
+				ID:       created.ID,
+				Message:  "upgrading tonight",
+				Severity: atc.WallSeverityWarning,
+				StartsAt: now.Add(-time.Hour).Unix(),
+				EndsAt:   now.Add(time.Hour).Unix(),
+				Teams:    []string{"some-team"},
+			}))
+		})
+
+		It("notifies the info cacher when a message is created", func() {
+			notified, err := dbConn.Bus().Listen(atc.WallCacheChannel)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer dbConn.Bus().Unlisten(atc.WallCacheChannel, notified)
+
+			_, err = dbWall.CreateMessage(atc.WallMessage{
+				Message:  "upgrading tonight",
+				Severity: atc.WallSeverityWarning,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(notified).Should(Receive())
+		})
+
+		Context("when there are messages in and out of their window", func() {
+			var active, scheduled atc.WallMessage
+
+			BeforeEach(func() {
+				var err error
+				active, err = dbWall.CreateMessage(atc.WallMessage{
+					Message:  "active",
+					Severity: atc.WallSeverityInfo,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				scheduled, err = dbWall.CreateMessage(atc.WallMessage{
+					Message:  "scheduled",
+					Severity: atc.WallSeverityCritical,
+					StartsAt: now.Add(time.Hour).Unix(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbWall.CreateMessage(atc.WallMessage{
+					Message:  "ended",
+					Severity: atc.WallSeverityInfo,
+					EndsAt:   now.Add(-time.Hour).Unix(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns only the active messages", func() {
+				messages, err := dbWall.ActiveMessages()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(Equal([]atc.WallMessage{active}))
+			})
+
+			It("returns the active and scheduled messages", func() {
+				messages, err := dbWall.Messages()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(Equal([]atc.WallMessage{active, scheduled}))
+			})
+
+			It("deletes a message", func() {
+				deleted, err := dbWall.DeleteMessage(scheduled.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+
+				messages, err := dbWall.Messages()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(Equal([]atc.WallMessage{active}))
+			})
+
+			It("does not delete a message that does not exist", func() {
+				deleted, err := dbWall.DeleteMessage(scheduled.ID + 100)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted).To(BeFalse())
+			})
+		})
+
+		Context("when the legacy wall is set or cleared", func() {
+			var scheduled, targeted atc.WallMessage
+
+			BeforeEach(func() {
+				var err error
+				scheduled, err = dbWall.CreateMessage(atc.WallMessage{
+					Message:  "scheduled",
+					Severity: atc.WallSeverityInfo,
+					StartsAt: now.Add(time.Hour).Unix(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				targeted, err = dbWall.CreateMessage(atc.WallMessage{
+					Message:  "for some team",
+					Severity: atc.WallSeverityInfo,
+					Teams:    []string{"some-team"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps the scheduled and team-targeted messages", func() {
+				err := dbWall.SetWall(atc.Wall{Message: "legacy"})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = dbWall.SetWall(atc.Wall{Message: "newer legacy"})
+				Expect(err).ToNot(HaveOccurred())
+
+				messages, err := dbWall.Messages()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(HaveLen(3))
+				Expect(messages[0]).To(Equal(scheduled))
+				Expect(messages[1]).To(Equal(targeted))
+				Expect(messages[2].Message).To(Equal("newer legacy"))
+
+				err = dbWall.Clear()
+				Expect(err).ToNot(HaveOccurred())
+
+				messages, err = dbWall.Messages()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(Equal([]atc.WallMessage{scheduled, targeted}))
+			})
+		})
+
+		Context("when the only active message targets teams", func() {
+			BeforeEach(func() {
+				_, err := dbWall.CreateMessage(atc.WallMessage{
+					Message:  "for some team",
+					Severity: atc.WallSeverityInfo,
+					Teams:    []string{"some-team"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("is not returned as the global wall", func() {
+				wall, err := dbWall.GetWall()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(wall).To(Equal(atc.Wall{}))
+			})
+		})
+	})
 })
@@ -68,11 +68,13 @@ type Worker interface {
 	TeamName() string
 	StartTime() time.Time
 	ExpiresAt() time.Time
+	LandDeadline() time.Time
 	Ephemeral() bool
 
 	Reload() (bool, error)
 
 	Land() error
+	LandBy(deadline time.Time) error
 	Retire() error
 	Prune() error
 	Delete() error
@@ -106,6 +108,7 @@ type worker struct {
 	teamName         string
 	startTime        time.Time
 	expiresAt        time.Time
+	landDeadline     time.Time
 	certsPath        *string
 	ephemeral        bool
 }
@@ -132,6 +135,11 @@ func (worker *worker) Ephemeral() bool                         { return worker.e
 func (worker *worker) StartTime() time.Time { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
 
+// LandDeadline is the time after which the builds still running on a landing
+// worker are interrupted. It is zero if the worker is landing without a
+// deadline.
+func (worker *worker) LandDeadline() time.Time { return worker.landDeadline }
+
 func (worker *worker) Reload() (bool, error) {
 	row := workersQuery.Where(sq.Eq{"w.name": worker.name}).
 		RunWith(worker.conn).
@@ -148,7 +156,19 @@ func (worker *worker) Reload() (bool, error) {
 	return true, nil
 }
 
+// Land lands the worker without a deadline, dropping any deadline it was
+// given by an earlier LandBy.
 func (worker *worker) Land() error {
+	return worker.land(psql.Update("workers").Set("land_deadline", nil))
+}
+
+// LandBy lands the worker like Land, but interrupts the builds still running
+// on it once the deadline has passed.
+func (worker *worker) LandBy(deadline time.Time) error {
+	return worker.land(psql.Update("workers").Set("land_deadline", deadline))
+}
+
+func (worker *worker) land(update sq.UpdateBuilder) error {
 	cSQL, _, err := sq.Case("state").
 		When("'landed'::worker_state", "'landed'::worker_state").
 		Else("'landing'::worker_state").
@@ -157,7 +177,7 @@ func (worker *worker) Land() error {
 		return err
 	}
 
-	result, err := psql.Update("workers").
+	result, err := update.
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": worker.name}).
 		RunWith(worker.conn).
@@ -54,7 +54,8 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.land_deadline
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -140,6 +141,7 @@ func scanWorker(worker *worker, row scannable) error {
 		startTime     pq.NullTime
 		expiresAt     pq.NullTime
 		ephemeral     sql.NullBool
+		landDeadline  pq.NullTime
 	)
 
 	err := row.Scan(
@@ -162,6 +164,7 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&landDeadline,
 	)
 	if err != nil {
 		return err
@@ -186,6 +189,7 @@ func scanWorker(worker *worker, row scannable) error {
 	worker.state = WorkerState(state)
 	worker.startTime = startTime.Time
 	worker.expiresAt = expiresAt.Time
+	worker.landDeadline = landDeadline.Time
 
 	if httpProxyURL.Valid {
 		worker.httpProxyURL = httpProxyURL.String
@@ -466,7 +470,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				version = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				land_deadline = (CASE WHEN EXCLUDED.state = 'landing'::worker_state THEN workers.land_deadline END)
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -98,6 +98,61 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("LandBy", func() {
+		var deadline time.Time
+
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			deadline = time.Now().Add(time.Hour)
+		})
+
+		It("marks the worker as `landing` with the deadline", func() {
+			err := worker.LandBy(deadline)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateLanding))
+			Expect(worker.LandDeadline()).To(BeTemporally("~", deadline, time.Second))
+		})
+
+		Context("when the worker registers again as running", func() {
+			BeforeEach(func() {
+				err := worker.LandBy(deadline)
+				Expect(err).NotTo(HaveOccurred())
+
+				worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("clears the deadline", func() {
+				_, err := worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.LandDeadline()).To(BeZero())
+			})
+		})
+
+		Context("when the worker is landed again without a deadline", func() {
+			BeforeEach(func() {
+				err := worker.LandBy(deadline)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = worker.Land()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("clears the deadline", func() {
+				_, err := worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateLanding))
+				Expect(worker.LandDeadline()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("Retire", func() {
 		BeforeEach(func() {
 			var err error
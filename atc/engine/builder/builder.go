@@ -79,8 +79,11 @@ func (builder *stepBuilder) BuildStep(logger lager.Logger, build db.Build) (exec
 
 	// "fly execute" generated build will have no pipeline.
 	if build.PipelineID() == 0 {
-		globalVars := creds.NewVariables(builder.globalSecrets, build.TeamName(), build.PipelineName(), false)
-		credVarsTracker = vars.NewCredVarsTracker(globalVars, builder.redactSecrets)
+		varss, err := build.Variables(logger, builder.globalSecrets, builder.varSourcePool)
+		if err != nil {
+			return exec.IdentityStep{}, err
+		}
+		credVarsTracker = vars.NewCredVarsTracker(varss, builder.redactSecrets)
 	} else {
 		pipeline, found, err := build.Pipeline()
 		if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
@@ -337,6 +338,10 @@ func (*checkDelegate) Stderr() io.Writer                                 { retur
 func (*checkDelegate) ImageVersionDetermined(db.UsedResourceCache) error { return nil }
 func (*checkDelegate) Errored(lager.Logger, string)                      { return }
 
+// policy checks of checks have no build to show events in; they are logged
+// by the policy checker.
+func (*checkDelegate) PolicyCheckFailed(lager.Logger, string, policy.PolicyCheckOutput) {}
+
 func NewBuildStepDelegate(
 	build db.Build,
 	planID atc.PlanID,
@@ -519,6 +524,22 @@ func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
+func (delegate *buildStepDelegate) PolicyCheckFailed(logger lager.Logger, action string, output policy.PolicyCheckOutput) {
+	err := delegate.build.SaveEvent(event.PolicyCheck{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time:     delegate.clock.Now().Unix(),
+		Action:   action,
+		Allowed:  output.Allowed,
+		Severity: string(output.Severity),
+		Reasons:  output.Reasons,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-policy-check-event", err)
+	}
+}
+
 func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.WriteCloser {
 	return &dbEventWriter{
 		build:  build,
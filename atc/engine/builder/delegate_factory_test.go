@@ -18,6 +18,7 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
@@ -522,6 +523,30 @@ var _ = Describe("DelegateFactory", func() {
 			})
 		})
 
+		Describe("PolicyCheckFailed", func() {
+			JustBeforeEach(func() {
+				delegate.PolicyCheckFailed(logger, "some-action", policy.PolicyCheckOutput{
+					Allowed:  false,
+					Reasons:  []string{"some-reason"},
+					Severity: policy.SeverityWarn,
+				})
+			})
+
+			It("saves a policy check event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.PolicyCheck{
+					Time:     123456789,
+					Action:   "some-action",
+					Allowed:  false,
+					Severity: "warn",
+					Reasons:  []string{"some-reason"},
+					Origin: event.Origin{
+						ID: "some-plan-id",
+					},
+				}))
+			})
+		})
+
 		Describe("No line buffer without secrets redaction", func() {
 			BeforeEach(func() {
 				credVars := vars.StaticVariables{}
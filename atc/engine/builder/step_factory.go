@@ -24,6 +24,7 @@ type stepFactory struct {
 	defaultLimits                   atc.ContainerLimits
 	strategy                        worker.ContainerPlacementStrategy
 	lockFactory                     lock.LockFactory
+	idTokenIssuer                   exec.IDTokenIssuer
 	enableRerunWhenWorkerDisappears bool
 }
 
@@ -38,6 +39,7 @@ func NewStepFactory(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	idTokenIssuer exec.IDTokenIssuer,
 	enableRerunWhenWorkerDisappears bool,
 ) *stepFactory {
 	return &stepFactory{
@@ -51,6 +53,7 @@ func NewStepFactory(
 		defaultLimits:                   defaultLimits,
 		strategy:                        strategy,
 		lockFactory:                     lockFactory,
+		idTokenIssuer:                   idTokenIssuer,
 		enableRerunWhenWorkerDisappears: enableRerunWhenWorkerDisappears,
 	}
 }
@@ -151,6 +154,7 @@ func (factory *stepFactory) TaskStep(
 		factory.client,
 		delegate,
 		factory.lockFactory,
+		factory.idTokenIssuer,
 	)
 
 	taskStep = exec.LogError(taskStep, delegate)
@@ -191,3 +191,15 @@ type Finish struct {
 
 func (Finish) EventType() atc.EventType  { return EventTypeFinish }
 func (Finish) Version() atc.EventVersion { return "1.0" }
+
+type PolicyCheck struct {
+	Origin   Origin   `json:"origin"`
+	Time     int64    `json:"time"`
+	Action   string   `json:"action"`
+	Allowed  bool     `json:"allowed"`
+	Severity string   `json:"severity"`
+	Reasons  []string `json:"reasons,omitempty"`
+}
+
+func (PolicyCheck) EventType() atc.EventType  { return EventTypePolicyCheck }
+func (PolicyCheck) Version() atc.EventVersion { return "1.0" }
@@ -68,5 +68,6 @@ var _ = Describe("ParseEvent", func() {
 		Entry("Status", event.Status{}),
 		Entry("Log", event.Log{}),
 		Entry("Error", event.Error{}),
+		Entry("PolicyCheck", event.PolicyCheck{}),
 	)
 })
@@ -47,4 +47,7 @@ const (
 
 	// error occurred
 	EventTypeError atc.EventType = "error"
+
+	// step did not pass a policy check
+	EventTypePolicyCheck atc.EventType = "policy-check"
 )
@@ -7,6 +7,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -15,6 +16,7 @@ import (
 type BuildStepDelegate interface {
 	ImageVersionDetermined(db.UsedResourceCache) error
 	RedactImageSource(source atc.Source) (atc.Source, error)
+	PolicyCheckFailed(lager.Logger, string, policy.PolicyCheckOutput)
 
 	Stdout() io.Writer
 	Stderr() io.Writer
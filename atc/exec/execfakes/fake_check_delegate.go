@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -54,6 +55,13 @@ type FakeCheckDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	PolicyCheckFailedStub        func(lager.Logger, string, policy.PolicyCheckOutput)
+	policyCheckFailedMutex       sync.RWMutex
+	policyCheckFailedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 policy.PolicyCheckOutput
+	}
 	SaveVersionsStub        func(db.SpanContext, []atc.Version) error
 	saveVersionsMutex       sync.RWMutex
 	saveVersionsArgsForCall []struct {
@@ -323,6 +331,39 @@ func (fake *FakeCheckDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeCheckDelegate) PolicyCheckFailed(arg1 lager.Logger, arg2 string, arg3 policy.PolicyCheckOutput) {
+	fake.policyCheckFailedMutex.Lock()
+	fake.policyCheckFailedArgsForCall = append(fake.policyCheckFailedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 policy.PolicyCheckOutput
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PolicyCheckFailed", []interface{}{arg1, arg2, arg3})
+	fake.policyCheckFailedMutex.Unlock()
+	if fake.PolicyCheckFailedStub != nil {
+		fake.PolicyCheckFailedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeCheckDelegate) PolicyCheckFailedCallCount() int {
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
+	return len(fake.policyCheckFailedArgsForCall)
+}
+
+func (fake *FakeCheckDelegate) PolicyCheckFailedCalls(stub func(lager.Logger, string, policy.PolicyCheckOutput)) {
+	fake.policyCheckFailedMutex.Lock()
+	defer fake.policyCheckFailedMutex.Unlock()
+	fake.PolicyCheckFailedStub = stub
+}
+
+func (fake *FakeCheckDelegate) PolicyCheckFailedArgsForCall(i int) (lager.Logger, string, policy.PolicyCheckOutput) {
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
+	argsForCall := fake.policyCheckFailedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeCheckDelegate) SaveVersions(arg1 db.SpanContext, arg2 []atc.Version) error {
 	var arg2Copy []atc.Version
 	if arg2 != nil {
@@ -589,6 +630,8 @@ func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
 	fake.saveVersionsMutex.RLock()
 	defer fake.saveVersionsMutex.RUnlock()
 	fake.startingMutex.RLock()
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
@@ -56,6 +57,13 @@ type FakeGetDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	PolicyCheckFailedStub        func(lager.Logger, string, policy.PolicyCheckOutput)
+	policyCheckFailedMutex       sync.RWMutex
+	policyCheckFailedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 policy.PolicyCheckOutput
+	}
 	StartingStub        func(lager.Logger)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -321,6 +329,39 @@ func (fake *FakeGetDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeGetDelegate) PolicyCheckFailed(arg1 lager.Logger, arg2 string, arg3 policy.PolicyCheckOutput) {
+	fake.policyCheckFailedMutex.Lock()
+	fake.policyCheckFailedArgsForCall = append(fake.policyCheckFailedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 policy.PolicyCheckOutput
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PolicyCheckFailed", []interface{}{arg1, arg2, arg3})
+	fake.policyCheckFailedMutex.Unlock()
+	if fake.PolicyCheckFailedStub != nil {
+		fake.PolicyCheckFailedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeGetDelegate) PolicyCheckFailedCallCount() int {
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
+	return len(fake.policyCheckFailedArgsForCall)
+}
+
+func (fake *FakeGetDelegate) PolicyCheckFailedCalls(stub func(lager.Logger, string, policy.PolicyCheckOutput)) {
+	fake.policyCheckFailedMutex.Lock()
+	defer fake.policyCheckFailedMutex.Unlock()
+	fake.PolicyCheckFailedStub = stub
+}
+
+func (fake *FakeGetDelegate) PolicyCheckFailedArgsForCall(i int) (lager.Logger, string, policy.PolicyCheckOutput) {
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
+	argsForCall := fake.policyCheckFailedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeGetDelegate) Starting(arg1 lager.Logger) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -554,6 +595,8 @@ func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
@@ -56,6 +57,13 @@ type FakePutDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	PolicyCheckFailedStub        func(lager.Logger, string, policy.PolicyCheckOutput)
+	policyCheckFailedMutex       sync.RWMutex
+	policyCheckFailedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 policy.PolicyCheckOutput
+	}
 	SaveOutputStub        func(lager.Logger, atc.PutPlan, atc.Source, atc.VersionedResourceTypes, runtime.VersionResult)
 	saveOutputMutex       sync.RWMutex
 	saveOutputArgsForCall []struct {
@@ -323,6 +331,39 @@ func (fake *FakePutDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakePutDelegate) PolicyCheckFailed(arg1 lager.Logger, arg2 string, arg3 policy.PolicyCheckOutput) {
+	fake.policyCheckFailedMutex.Lock()
+	fake.policyCheckFailedArgsForCall = append(fake.policyCheckFailedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 policy.PolicyCheckOutput
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PolicyCheckFailed", []interface{}{arg1, arg2, arg3})
+	fake.policyCheckFailedMutex.Unlock()
+	if fake.PolicyCheckFailedStub != nil {
+		fake.PolicyCheckFailedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakePutDelegate) PolicyCheckFailedCallCount() int {
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
+	return len(fake.policyCheckFailedArgsForCall)
+}
+
+func (fake *FakePutDelegate) PolicyCheckFailedCalls(stub func(lager.Logger, string, policy.PolicyCheckOutput)) {
+	fake.policyCheckFailedMutex.Lock()
+	defer fake.policyCheckFailedMutex.Unlock()
+	fake.PolicyCheckFailedStub = stub
+}
+
+func (fake *FakePutDelegate) PolicyCheckFailedArgsForCall(i int) (lager.Logger, string, policy.PolicyCheckOutput) {
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
+	argsForCall := fake.policyCheckFailedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakePutDelegate) SaveOutput(arg1 lager.Logger, arg2 atc.PutPlan, arg3 atc.Source, arg4 atc.VersionedResourceTypes, arg5 runtime.VersionResult) {
 	fake.saveOutputMutex.Lock()
 	fake.saveOutputArgsForCall = append(fake.saveOutputArgsForCall, struct {
@@ -558,6 +599,8 @@ func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
 	fake.startingMutex.RLock()
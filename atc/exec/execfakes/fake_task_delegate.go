@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -54,6 +55,13 @@ type FakeTaskDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	PolicyCheckFailedStub        func(lager.Logger, string, policy.PolicyCheckOutput)
+	policyCheckFailedMutex       sync.RWMutex
+	policyCheckFailedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 policy.PolicyCheckOutput
+	}
 	SetTaskConfigStub        func(atc.TaskConfig)
 	setTaskConfigMutex       sync.RWMutex
 	setTaskConfigArgsForCall []struct {
@@ -316,6 +324,39 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTaskDelegate) PolicyCheckFailed(arg1 lager.Logger, arg2 string, arg3 policy.PolicyCheckOutput) {
+	fake.policyCheckFailedMutex.Lock()
+	fake.policyCheckFailedArgsForCall = append(fake.policyCheckFailedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 policy.PolicyCheckOutput
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PolicyCheckFailed", []interface{}{arg1, arg2, arg3})
+	fake.policyCheckFailedMutex.Unlock()
+	if fake.PolicyCheckFailedStub != nil {
+		fake.PolicyCheckFailedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTaskDelegate) PolicyCheckFailedCallCount() int {
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
+	return len(fake.policyCheckFailedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) PolicyCheckFailedCalls(stub func(lager.Logger, string, policy.PolicyCheckOutput)) {
+	fake.policyCheckFailedMutex.Lock()
+	defer fake.policyCheckFailedMutex.Unlock()
+	fake.PolicyCheckFailedStub = stub
+}
+
+func (fake *FakeTaskDelegate) PolicyCheckFailedArgsForCall(i int) (lager.Logger, string, policy.PolicyCheckOutput) {
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
+	argsForCall := fake.policyCheckFailedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeTaskDelegate) SetTaskConfig(arg1 atc.TaskConfig) {
 	fake.setTaskConfigMutex.Lock()
 	fake.setTaskConfigArgsForCall = append(fake.setTaskConfigArgsForCall, struct {
@@ -547,6 +588,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.policyCheckFailedMutex.RLock()
+	defer fake.policyCheckFailedMutex.RUnlock()
 	fake.setTaskConfigMutex.RLock()
 	defer fake.setTaskConfigMutex.RUnlock()
 	fake.startingMutex.RLock()
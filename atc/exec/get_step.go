@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
@@ -39,6 +40,7 @@ func (e ErrResourceNotFound) Error() string {
 type GetDelegate interface {
 	ImageVersionDetermined(db.UsedResourceCache) error
 	RedactImageSource(source atc.Source) (atc.Source, error)
+	PolicyCheckFailed(lager.Logger, string, policy.PolicyCheckOutput)
 
 	Stdout() io.Writer
 	Stderr() io.Writer
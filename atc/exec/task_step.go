@@ -7,6 +7,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -15,6 +16,8 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/idtoken"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
@@ -55,6 +58,7 @@ func (err TaskImageSourceParametersError) Error() string {
 type TaskDelegate interface {
 	ImageVersionDetermined(db.UsedResourceCache) error
 	RedactImageSource(source atc.Source) (atc.Source, error)
+	PolicyCheckFailed(lager.Logger, string, policy.PolicyCheckOutput)
 
 	Stdout() io.Writer
 	Stderr() io.Writer
@@ -69,6 +73,14 @@ type TaskDelegate interface {
 	Errored(lager.Logger, string)
 }
 
+//go:generate counterfeiter . IDTokenIssuer
+
+// IDTokenIssuer issues the identity tokens requested by tasks with an
+// `id_token:` config.
+type IDTokenIssuer interface {
+	IssueToken(idtoken.BuildClaims, []string, time.Duration) (string, error)
+}
+
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
 // artifact.Repository and outputs will be added to the artifact.Repository.
 type TaskStep struct {
@@ -81,6 +93,7 @@ type TaskStep struct {
 	workerClient      worker.Client
 	delegate          TaskDelegate
 	lockFactory       lock.LockFactory
+	idTokenIssuer     IDTokenIssuer
 	succeeded         bool
 }
 
@@ -94,6 +107,7 @@ func NewTaskStep(
 	workerClient worker.Client,
 	delegate TaskDelegate,
 	lockFactory lock.LockFactory,
+	idTokenIssuer IDTokenIssuer,
 ) Step {
 	return &TaskStep{
 		planID:            planID,
@@ -105,6 +119,7 @@ func NewTaskStep(
 		workerClient:      workerClient,
 		delegate:          delegate,
 		lockFactory:       lockFactory,
+		idTokenIssuer:     idTokenIssuer,
 	}
 }
 
@@ -398,9 +413,61 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 		containerSpec.Outputs[output.Name] = path
 	}
 
+	if step.plan.IDToken != nil {
+		err = step.provideIDToken(&containerSpec, metadata)
+		if err != nil {
+			return worker.ContainerSpec{}, err
+		}
+	}
+
 	return containerSpec, nil
 }
 
+// provideIDToken issues an identity token for the build and exposes it to the
+// task through an environment variable, a file, or both.
+func (step *TaskStep) provideIDToken(containerSpec *worker.ContainerSpec, metadata db.ContainerMetadata) error {
+	config := step.plan.IDToken
+
+	var expiry time.Duration
+	if config.Expiry != "" {
+		var err error
+		expiry, err = time.ParseDuration(config.Expiry)
+		if err != nil {
+			return fmt.Errorf("invalid id token expiry: %w", err)
+		}
+	}
+
+	token, err := step.idTokenIssuer.IssueToken(idtoken.BuildClaims{
+		TeamName:     step.metadata.TeamName,
+		PipelineName: step.metadata.PipelineName,
+		JobName:      step.metadata.JobName,
+		BuildID:      step.metadata.BuildID,
+		BuildName:    step.metadata.BuildName,
+	}, config.Audience, expiry)
+	if err != nil {
+		return fmt.Errorf("issue id token: %w", err)
+	}
+
+	env := config.Env
+	if env == "" && config.File == "" {
+		env = atc.DefaultIDTokenEnv
+	}
+
+	if env != "" {
+		containerSpec.Env = append(containerSpec.Env, env+"="+token)
+	}
+
+	if config.File != "" {
+		if containerSpec.Files == nil {
+			containerSpec.Files = map[string][]byte{}
+		}
+
+		containerSpec.Files[filepath.Join(metadata.WorkingDirectory, config.File)] = []byte(token)
+	}
+
+	return nil
+}
+
 func (step *TaskStep) workerSpec(logger lager.Logger, resourceTypes atc.VersionedResourceTypes, repository *build.Repository, config atc.TaskConfig) (worker.WorkerSpec, error) {
 	workerSpec := worker.WorkerSpec{
 		Platform:      config.Platform,
@@ -3,6 +3,7 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -11,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/idtoken"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
@@ -37,6 +39,7 @@ var _ = Describe("TaskStep", func() {
 		fakeStrategy *workerfakes.FakeContainerPlacementStrategy
 
 		fakeLockFactory *lockfakes.FakeLockFactory
+		fakeIssuer      *execfakes.FakeIDTokenIssuer
 
 		fakeDelegate *execfakes.FakeTaskDelegate
 		taskPlan     *atc.TaskPlan
@@ -76,6 +79,7 @@ var _ = Describe("TaskStep", func() {
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
 
 		fakeLockFactory = new(lockfakes.FakeLockFactory)
+		fakeIssuer = new(execfakes.FakeIDTokenIssuer)
 
 		credVars := vars.StaticVariables{"source-param": "super-secret-source"}
 		credVarsTracker = vars.NewCredVarsTracker(credVars, true)
@@ -138,6 +142,7 @@ var _ = Describe("TaskStep", func() {
 			fakeClient,
 			fakeDelegate,
 			fakeLockFactory,
+			fakeIssuer,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -246,6 +251,102 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		It("does not issue an id token", func() {
+			Expect(fakeIssuer.IssueTokenCallCount()).To(BeZero())
+		})
+
+		Context("when the plan requests an id token", func() {
+			BeforeEach(func() {
+				stepMetadata.TeamName = "some-team"
+				stepMetadata.PipelineName = "some-pipeline"
+				stepMetadata.JobName = "some-job"
+				stepMetadata.BuildName = "42"
+
+				taskPlan.IDToken = &atc.IDTokenConfig{
+					Audience: []string{"sts.amazonaws.com"},
+					Expiry:   "5m",
+				}
+
+				fakeIssuer.IssueTokenReturns("some-token", nil)
+			})
+
+			AfterEach(func() {
+				stepMetadata.TeamName = ""
+				stepMetadata.PipelineName = ""
+				stepMetadata.JobName = ""
+				stepMetadata.BuildName = ""
+			})
+
+			It("issues a token identifying the build", func() {
+				Expect(fakeIssuer.IssueTokenCallCount()).To(Equal(1))
+				claims, audience, expiry := fakeIssuer.IssueTokenArgsForCall(0)
+				Expect(claims).To(Equal(idtoken.BuildClaims{
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					BuildID:      1234,
+					BuildName:    "42",
+				}))
+				Expect(audience).To(Equal([]string{"sts.amazonaws.com"}))
+				Expect(expiry).To(Equal(5 * time.Minute))
+			})
+
+			It("provides the token through the default env var", func() {
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Env).To(ContainElement("CONCOURSE_ID_TOKEN=some-token"))
+				Expect(containerSpec.Files).To(BeEmpty())
+			})
+
+			Context("when an env var is configured", func() {
+				BeforeEach(func() {
+					taskPlan.IDToken.Env = "AWS_WEB_IDENTITY_TOKEN"
+				})
+
+				It("provides the token through the env var", func() {
+					_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					Expect(containerSpec.Env).To(ContainElement("AWS_WEB_IDENTITY_TOKEN=some-token"))
+					Expect(containerSpec.Env).ToNot(ContainElement("CONCOURSE_ID_TOKEN=some-token"))
+				})
+			})
+
+			Context("when a file is configured", func() {
+				BeforeEach(func() {
+					taskPlan.IDToken.File = "creds/token"
+				})
+
+				It("writes the token to the file in the working directory", func() {
+					_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+					Expect(containerSpec.Files).To(Equal(map[string][]byte{
+						"some-artifact-root/creds/token": []byte("some-token"),
+					}))
+					Expect(containerSpec.Env).ToNot(ContainElement("CONCOURSE_ID_TOKEN=some-token"))
+				})
+			})
+
+			Context("when the expiry is invalid", func() {
+				BeforeEach(func() {
+					taskPlan.IDToken.Expiry = "bogus"
+				})
+
+				It("errors without running the task", func() {
+					Expect(stepErr).To(HaveOccurred())
+					Expect(fakeIssuer.IssueTokenCallCount()).To(BeZero())
+					Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when issuing the token fails", func() {
+				BeforeEach(func() {
+					fakeIssuer.IssueTokenReturns("", errors.New("nope"))
+				})
+
+				It("errors without running the task", func() {
+					Expect(stepErr).To(MatchError(ContainSubstring("nope")))
+					Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		It("secrets are tracked", func() {
 			mapit := vars.NewMapCredVarsTrackerIterator()
 			credVarsTracker.IterateInterpolatedCreds(mapit)
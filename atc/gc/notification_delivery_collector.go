@@ -0,0 +1,36 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type notificationDeliveryCollector struct {
+	notificationDeliveryLifecycle db.NotificationDeliveryLifecycle
+	retention                     time.Duration
+}
+
+func NewNotificationDeliveryCollector(notificationDeliveryLifecycle db.NotificationDeliveryLifecycle, retention time.Duration) *notificationDeliveryCollector {
+	return &notificationDeliveryCollector{
+		notificationDeliveryLifecycle: notificationDeliveryLifecycle,
+		retention:                     retention,
+	}
+}
+
+func (c *notificationDeliveryCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("notification-delivery-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	_, err := c.notificationDeliveryLifecycle.RemoveExpiredNotificationDeliveries(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-notification-deliveries", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationDeliveryCollector", func() {
+	var collector GcCollector
+	var fakeNotificationDeliveryLifecycle *dbfakes.FakeNotificationDeliveryLifecycle
+
+	BeforeEach(func() {
+		fakeNotificationDeliveryLifecycle = new(dbfakes.FakeNotificationDeliveryLifecycle)
+
+		collector = gc.NewNotificationDeliveryCollector(fakeNotificationDeliveryLifecycle, time.Hour*24*7)
+	})
+
+	Describe("Run", func() {
+		It("tells the notification delivery lifecycle to remove finished deliveries older than the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeNotificationDeliveryLifecycle.RemoveExpiredNotificationDeliveriesCallCount()).To(Equal(1))
+			retention := fakeNotificationDeliveryLifecycle.RemoveExpiredNotificationDeliveriesArgsForCall(0)
+			Expect(retention).To(Equal(time.Hour * 24 * 7))
+		})
+
+		Context("when removing the deliveries fails", func() {
+			BeforeEach(func() {
+				fakeNotificationDeliveryLifecycle.RemoveExpiredNotificationDeliveriesReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type pipelineEventCollector struct {
+	pipelineEventLifecycle db.PipelineEventLifecycle
+	retention              time.Duration
+}
+
+func NewPipelineEventCollector(pipelineEventLifecycle db.PipelineEventLifecycle, retention time.Duration) *pipelineEventCollector {
+	return &pipelineEventCollector{
+		pipelineEventLifecycle: pipelineEventLifecycle,
+		retention:              retention,
+	}
+}
+
+func (c *pipelineEventCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("pipeline-event-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	deleted, err := c.pipelineEventLifecycle.RemoveExpiredPipelineEvents(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-pipeline-events", err)
+		return err
+	}
+
+	metric.PipelineEventsDeleted.IncDelta(deleted)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineEventCollector", func() {
+	var collector GcCollector
+	var fakePipelineEventLifecycle *dbfakes.FakePipelineEventLifecycle
+
+	BeforeEach(func() {
+		fakePipelineEventLifecycle = new(dbfakes.FakePipelineEventLifecycle)
+
+		collector = gc.NewPipelineEventCollector(fakePipelineEventLifecycle, time.Hour*24)
+	})
+
+	Describe("Run", func() {
+		It("tells the pipeline event lifecycle to remove events older than the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePipelineEventLifecycle.RemoveExpiredPipelineEventsCallCount()).To(Equal(1))
+			retention := fakePipelineEventLifecycle.RemoveExpiredPipelineEventsArgsForCall(0)
+			Expect(retention).To(Equal(time.Hour * 24))
+		})
+
+		Context("when removing the events fails", func() {
+			BeforeEach(func() {
+				fakePipelineEventLifecycle.RemoveExpiredPipelineEventsReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
@@ -0,0 +1,38 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type sessionCollector struct {
+	sessionFactory db.SessionFactory
+}
+
+func NewSessionCollector(sessionFactory db.SessionFactory) *sessionCollector {
+	return &sessionCollector{
+		sessionFactory: sessionFactory,
+	}
+}
+
+func (c *sessionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("session-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.sessionFactory.RemoveExpiredSessions()
+	if err != nil {
+		logger.Error("failed-to-remove-expired-sessions", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-expired-sessions", lager.Data{"count": removed})
+	}
+
+	return nil
+}
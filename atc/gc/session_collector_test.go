@@ -0,0 +1,42 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SessionCollector", func() {
+	var collector GcCollector
+	var fakeSessionFactory *dbfakes.FakeSessionFactory
+
+	BeforeEach(func() {
+		fakeSessionFactory = new(dbfakes.FakeSessionFactory)
+
+		collector = gc.NewSessionCollector(fakeSessionFactory)
+	})
+
+	Describe("Run", func() {
+		It("tells the session factory to remove expired sessions", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeSessionFactory.RemoveExpiredSessionsCallCount()).To(Equal(1))
+		})
+
+		Context("when removing the sessions fails", func() {
+			BeforeEach(func() {
+				fakeSessionFactory.RemoveExpiredSessionsReturns(0, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
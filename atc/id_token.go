@@ -0,0 +1,21 @@
+package atc
+
+// DefaultIDTokenEnv is the env var a task's identity token is provided in when
+// neither an env var nor a file is configured.
+const DefaultIDTokenEnv = "CONCOURSE_ID_TOKEN"
+
+// IDTokenConfig requests an OIDC identity token for a task. The token is
+// signed by the ATC and identifies the build's team, pipeline and job to the
+// given audience, e.g. a cloud provider trusting the ATC as an OIDC issuer.
+type IDTokenConfig struct {
+	Audience []string `json:"audience"`
+
+	// How long the token is valid for, e.g. "15m".
+	Expiry string `json:"expiry,omitempty"`
+
+	// The env var to provide the token in.
+	Env string `json:"env,omitempty"`
+
+	// The file to write the token to, relative to the task's working directory.
+	File string `json:"file,omitempty"`
+}
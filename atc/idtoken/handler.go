@@ -0,0 +1,55 @@
+package idtoken
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"gopkg.in/square/go-jose.v2"
+)
+
+type discovery struct {
+	Issuer                           string   `json:"issuer"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	ResponseTypesSupported           []string `json:"response_types_supported"`
+	SubjectTypesSupported            []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported                  []string `json:"claims_supported"`
+}
+
+// NewHandler serves the OIDC discovery document and the key set that third
+// parties use to verify the tokens of the issuer.
+func NewHandler(issuer *Issuer) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(DiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, discovery{
+			Issuer:                           issuer.url,
+			JWKSURI:                          issuer.url + KeySetPath,
+			ResponseTypesSupported:           []string{"id_token"},
+			SubjectTypesSupported:            []string{"public"},
+			IDTokenSigningAlgValuesSupported: []string{string(jose.RS256)},
+			ClaimsSupported: []string{
+				"iss", "sub", "aud", "iat", "nbf", "exp",
+				"team", "pipeline", "job", "build_id", "build_name",
+			},
+		})
+	})
+
+	mux.HandleFunc(KeySetPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{issuer.key.Public()},
+		})
+	})
+
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	err := json.NewEncoder(w).Encode(payload)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
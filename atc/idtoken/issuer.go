@@ -0,0 +1,150 @@
+package idtoken
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	DiscoveryPath = "/.well-known/openid-configuration"
+	KeySetPath    = "/.well-known/jwks.json"
+
+	DefaultExpiry = 15 * time.Minute
+	MaxExpiry     = time.Hour
+)
+
+var (
+	ErrAudienceEmpty = errors.New("id token must have an audience")
+	ErrExpiryTooLong = fmt.Errorf("id token cannot be valid for more than %s", MaxExpiry)
+)
+
+// BuildClaims identify the build a token is issued to.
+type BuildClaims struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	BuildID      int
+	BuildName    string
+}
+
+// Subject joins the team, pipeline and job into the token's subject, e.g.
+// "main/deploy/prod". One-off builds only have a team. Each name is path
+// escaped, so that a "/" in a name cannot make the subject of one job look
+// like the subject of another.
+func (claims BuildClaims) Subject() string {
+	parts := []string{url.PathEscape(claims.TeamName)}
+	if claims.PipelineName != "" {
+		parts = append(parts, url.PathEscape(claims.PipelineName))
+	}
+	if claims.JobName != "" {
+		parts = append(parts, url.PathEscape(claims.JobName))
+	}
+	return strings.Join(parts, "/")
+}
+
+// Issuer issues short-lived OIDC identity tokens to builds, so that they can
+// authenticate against third parties trusting the ATC as an OIDC provider.
+type Issuer struct {
+	url               string
+	key               jose.JSONWebKey
+	signer            jose.Signer
+	reservedAudiences []string
+	now               func() time.Time
+}
+
+// NewIssuer returns an issuer signing tokens with the given key. Tokens may
+// not be issued for any of the reserved audiences, which are the clients
+// whose tokens grant access to the ATC itself.
+func NewIssuer(url string, signingKey *rsa.PrivateKey, reservedAudiences []string) (*Issuer, error) {
+	key := jose.JSONWebKey{
+		Key:       signingKey,
+		Algorithm: string(jose.RS256),
+		Use:       "sig",
+	}
+
+	publicKey := key.Public()
+
+	thumbprint, err := publicKey.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	key.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Issuer{
+		url:               strings.TrimSuffix(url, "/"),
+		key:               key,
+		signer:            signer,
+		reservedAudiences: reservedAudiences,
+		now:               time.Now,
+	}, nil
+}
+
+type tokenClaims struct {
+	jwt.Claims
+
+	Team      string `json:"team"`
+	Pipeline  string `json:"pipeline,omitempty"`
+	Job       string `json:"job,omitempty"`
+	BuildID   int    `json:"build_id"`
+	BuildName string `json:"build_name,omitempty"`
+}
+
+// IssueToken signs a token identifying the build for the given audience. The
+// token expires after DefaultExpiry unless another expiry is given.
+func (issuer *Issuer) IssueToken(claims BuildClaims, audience []string, expiry time.Duration) (string, error) {
+	if len(audience) == 0 {
+		return "", ErrAudienceEmpty
+	}
+
+	for _, aud := range audience {
+		for _, reserved := range issuer.reservedAudiences {
+			if aud == reserved {
+				return "", fmt.Errorf("id token cannot be issued for audience '%s'", aud)
+			}
+		}
+	}
+
+	if expiry == 0 {
+		expiry = DefaultExpiry
+	}
+
+	if expiry > MaxExpiry {
+		return "", ErrExpiryTooLong
+	}
+
+	now := issuer.now()
+
+	return jwt.Signed(issuer.signer).Claims(tokenClaims{
+		Claims: jwt.Claims{
+			Issuer:    issuer.url,
+			Subject:   claims.Subject(),
+			Audience:  jwt.Audience(audience),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(now.Add(expiry)),
+		},
+		Team:      claims.TeamName,
+		Pipeline:  claims.PipelineName,
+		Job:       claims.JobName,
+		BuildID:   claims.BuildID,
+		BuildName: claims.BuildName,
+	}).CompactSerialize()
+}
@@ -0,0 +1,169 @@
+package idtoken_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/concourse/concourse/atc/idtoken"
+)
+
+var _ = Describe("Issuer", func() {
+	var (
+		server *httptest.Server
+		issuer *idtoken.Issuer
+
+		claims idtoken.BuildClaims
+	)
+
+	BeforeEach(func() {
+		signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		var handler http.Handler
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.ServeHTTP(w, r)
+		}))
+
+		issuer, err = idtoken.NewIssuer(server.URL+"/", signingKey, []string{"fly"})
+		Expect(err).NotTo(HaveOccurred())
+
+		handler = idtoken.NewHandler(issuer)
+
+		claims = idtoken.BuildClaims{
+			TeamName:     "some-team",
+			PipelineName: "some-pipeline",
+			JobName:      "some-job",
+			BuildID:      42,
+			BuildName:    "7",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	fetch := func(url string, dest interface{}) {
+		response, err := http.Get(url)
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(json.NewDecoder(response.Body).Decode(dest)).To(Succeed())
+	}
+
+	It("publishes a discovery document", func() {
+		var discovery map[string]interface{}
+		fetch(server.URL+idtoken.DiscoveryPath, &discovery)
+
+		Expect(discovery["issuer"]).To(Equal(server.URL))
+		Expect(discovery["jwks_uri"]).To(Equal(server.URL + idtoken.KeySetPath))
+		Expect(discovery["id_token_signing_alg_values_supported"]).To(ConsistOf("RS256"))
+	})
+
+	It("issues tokens that verify against the published key set", func() {
+		raw, err := issuer.IssueToken(claims, []string{"sts.amazonaws.com"}, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		fetch(server.URL+idtoken.DiscoveryPath, &discovery)
+
+		var keySet jose.JSONWebKeySet
+		fetch(discovery.JWKSURI, &keySet)
+		Expect(keySet.Keys).To(HaveLen(1))
+		Expect(keySet.Keys[0].IsPublic()).To(BeTrue())
+
+		token, err := jwt.ParseSigned(raw)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token.Headers[0].KeyID).To(Equal(keySet.Keys[0].KeyID))
+
+		var standardClaims jwt.Claims
+		var customClaims map[string]interface{}
+		err = token.Claims(&keySet, &standardClaims, &customClaims)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = standardClaims.Validate(jwt.Expected{
+			Issuer:   server.URL,
+			Subject:  "some-team/some-pipeline/some-job",
+			Audience: jwt.Audience{"sts.amazonaws.com"},
+			Time:     time.Now(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(standardClaims.Expiry.Time()).To(BeTemporally("~", time.Now().Add(idtoken.DefaultExpiry), time.Minute))
+
+		Expect(customClaims).To(HaveKeyWithValue("team", "some-team"))
+		Expect(customClaims).To(HaveKeyWithValue("pipeline", "some-pipeline"))
+		Expect(customClaims).To(HaveKeyWithValue("job", "some-job"))
+		Expect(customClaims).To(HaveKeyWithValue("build_id", BeNumerically("==", 42)))
+		Expect(customClaims).To(HaveKeyWithValue("build_name", "7"))
+	})
+
+	Context("when the build is a one-off build", func() {
+		BeforeEach(func() {
+			claims = idtoken.BuildClaims{TeamName: "some-team", BuildID: 42}
+		})
+
+		It("identifies the team only", func() {
+			raw, err := issuer.IssueToken(claims, []string{"some-audience"}, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			token, err := jwt.ParseSigned(raw)
+			Expect(err).NotTo(HaveOccurred())
+
+			var standardClaims jwt.Claims
+			Expect(token.UnsafeClaimsWithoutVerification(&standardClaims)).To(Succeed())
+			Expect(standardClaims.Subject).To(Equal("some-team"))
+			Expect(standardClaims.Expiry.Time()).To(BeTemporally("~", time.Now().Add(time.Minute), 5*time.Second))
+		})
+	})
+
+	Context("when a name contains a slash", func() {
+		BeforeEach(func() {
+			claims = idtoken.BuildClaims{
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline/some-job",
+				JobName:      "other-job",
+				BuildID:      42,
+			}
+		})
+
+		It("escapes it in the subject", func() {
+			raw, err := issuer.IssueToken(claims, []string{"some-audience"}, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			token, err := jwt.ParseSigned(raw)
+			Expect(err).NotTo(HaveOccurred())
+
+			var standardClaims jwt.Claims
+			Expect(token.UnsafeClaimsWithoutVerification(&standardClaims)).To(Succeed())
+			Expect(standardClaims.Subject).To(Equal("some-team/some-pipeline%2Fsome-job/other-job"))
+		})
+	})
+
+	It("requires an audience", func() {
+		_, err := issuer.IssueToken(claims, nil, 0)
+		Expect(err).To(Equal(idtoken.ErrAudienceEmpty))
+	})
+
+	It("does not issue tokens for the ATC's own clients", func() {
+		_, err := issuer.IssueToken(claims, []string{"some-audience", "fly"}, 0)
+		Expect(err).To(MatchError("id token cannot be issued for audience 'fly'"))
+	})
+
+	It("does not issue long-lived tokens", func() {
+		_, err := issuer.IssueToken(claims, []string{"some-audience"}, 2*time.Hour)
+		Expect(err).To(Equal(idtoken.ErrExpiryTooLong))
+	})
+})
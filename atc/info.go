@@ -5,4 +5,10 @@ type Info struct {
 	WorkerVersion string `json:"worker_version"`
 	ExternalURL   string `json:"external_url,omitempty"`
 	ClusterName   string `json:"cluster_name,omitempty"`
+
+	Wall []WallMessage `json:"wall,omitempty"`
+
+	// Maintenance is set while maintenance mode is enabled. It does not
+	// include the build counts, which are only reported by GetMaintenance.
+	Maintenance *Maintenance `json:"maintenance,omitempty"`
 }
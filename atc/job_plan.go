@@ -0,0 +1,22 @@
+package atc
+
+// JobPlanRequest asks for a job in a pipeline config to be compiled into a
+// plan which can be run as a one-off build.
+type JobPlanRequest struct {
+	Config Config `json:"config"`
+	Job    string `json:"job"`
+
+	// Artifacts maps get step names to uploaded artifacts to use in their
+	// place.
+	Artifacts map[string]int `json:"artifacts,omitempty"`
+
+	// Versions maps the names of the remaining get steps to the version they
+	// should fetch.
+	Versions map[string]Version `json:"versions,omitempty"`
+
+	// ResourceTypes overrides the pipeline's resource types, e.g. to pin them
+	// to the versions used by an existing pipeline.
+	ResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
+
+	SkipPuts bool `json:"skip_puts,omitempty"`
+}
@@ -0,0 +1,39 @@
+package atc
+
+const (
+	JobStatsQuerySince = "since"
+	JobStatsQueryUntil = "until"
+)
+
+// JobStats summarizes the completed builds of a job which were created within
+// a time window. Durations are in seconds, and are zero when no build in the
+// window ran to completion.
+type JobStats struct {
+	JobName string `json:"job_name"`
+
+	Builds    int `json:"builds"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Errored   int `json:"errored"`
+	Aborted   int `json:"aborted"`
+
+	// SuccessRate is the fraction of builds which succeeded, not counting
+	// aborted builds.
+	SuccessRate float64 `json:"success_rate"`
+
+	DurationP50 float64 `json:"duration_p50"`
+	DurationP95 float64 `json:"duration_p95"`
+
+	// QueueP50 and QueueP95 measure the time between a build being created
+	// and it starting.
+	QueueP50 float64 `json:"queue_p50"`
+	QueueP95 float64 `json:"queue_p95"`
+
+	// FlakyRetries counts builds which succeeded directly after a failed
+	// build with the same inputs.
+	FlakyRetries int `json:"flaky_retries"`
+
+	// Flakiness is the fraction of succeeded and failed builds which were
+	// flaky retries.
+	Flakiness float64 `json:"flakiness"`
+}
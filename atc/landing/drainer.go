@@ -0,0 +1,226 @@
+package landing
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// Drainer moves work off of landing workers.
+//
+// Builds which are still running on a landing worker once its land deadline
+// has passed are aborted, and then builds of jobs are rerun so that they get
+// scheduled onto another worker. Until then, resource caches which only
+// exist on a landing worker are copied to a compatible running worker so that
+// they are still warm once the worker is gone.
+type Drainer struct {
+	buildFactory     db.BuildFactory
+	workerFactory    db.WorkerFactory
+	volumeRepository db.VolumeRepository
+	workerProvider   worker.WorkerProvider
+	compression      compression.Compression
+	cachesPerRun     int
+}
+
+func NewDrainer(
+	buildFactory db.BuildFactory,
+	workerFactory db.WorkerFactory,
+	volumeRepository db.VolumeRepository,
+	workerProvider worker.WorkerProvider,
+	compression compression.Compression,
+	cachesPerRun int,
+) *Drainer {
+	return &Drainer{
+		buildFactory:     buildFactory,
+		workerFactory:    workerFactory,
+		volumeRepository: volumeRepository,
+		workerProvider:   workerProvider,
+		compression:      compression,
+		cachesPerRun:     cachesPerRun,
+	}
+}
+
+func (d *Drainer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("landing-drainer")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := d.interruptOverdueBuilds(logger)
+	if err != nil {
+		return err
+	}
+
+	return d.copyResourceCaches(ctx, logger)
+}
+
+func (d *Drainer) interruptOverdueBuilds(logger lager.Logger) error {
+	builds, err := d.buildFactory.GetBuildsOnOverdueLandingWorkers()
+	if err != nil {
+		logger.Error("failed-to-get-builds-on-overdue-landing-workers", err)
+		return err
+	}
+
+	for _, build := range builds {
+		blog := logger.WithData(lager.Data{"build": build.ID()})
+
+		// aborted builds are no longer returned, so aborting first makes sure
+		// that a build is rerun at most once even if the rerun fails
+		err := build.MarkAsAborted()
+		if err != nil {
+			blog.Error("failed-to-abort-build", err)
+			continue
+		}
+
+		blog.Info("interrupted-build")
+
+		if build.JobID() != 0 && build.InputsReady() {
+			err := d.rerun(build)
+			if err != nil {
+				blog.Error("failed-to-rerun-build", err)
+				continue
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *Drainer) rerun(build db.Build) error {
+	pipeline, found, err := build.Pipeline()
+	if err != nil || !found {
+		return err
+	}
+
+	job, found, err := pipeline.Job(build.JobName())
+	if err != nil || !found {
+		return err
+	}
+
+	_, err = job.RerunBuild(build)
+	return err
+}
+
+func (d *Drainer) copyResourceCaches(ctx context.Context, logger lager.Logger) error {
+	if d.cachesPerRun <= 0 {
+		return nil
+	}
+
+	dbWorkers, err := d.workerFactory.Workers()
+	if err != nil {
+		logger.Error("failed-to-get-workers", err)
+		return err
+	}
+
+	var landingWorkers []db.Worker
+	for _, dbWorker := range dbWorkers {
+		if dbWorker.State() == db.WorkerStateLanding {
+			landingWorkers = append(landingWorkers, dbWorker)
+		}
+	}
+
+	if len(landingWorkers) == 0 {
+		return nil
+	}
+
+	runningWorkers, err := d.workerProvider.RunningWorkers(logger)
+	if err != nil {
+		logger.Error("failed-to-get-running-workers", err)
+		return err
+	}
+
+	remaining := d.cachesPerRun
+	for _, dbWorker := range landingWorkers {
+		if remaining == 0 {
+			break
+		}
+
+		wlog := logger.Session("copy-caches", lager.Data{"worker": dbWorker.Name()})
+
+		destination, found := leastBusyWorker(wlog, runningWorkers, worker.WorkerSpec{
+			Platform: dbWorker.Platform(),
+			Tags:     dbWorker.Tags(),
+			TeamID:   dbWorker.TeamID(),
+		})
+		if !found {
+			wlog.Debug("no-compatible-worker")
+			continue
+		}
+
+		copied, err := d.copyWorkerCaches(ctx, wlog, dbWorker, destination, remaining)
+		if err != nil {
+			return err
+		}
+
+		remaining -= copied
+	}
+
+	return nil
+}
+
+func (d *Drainer) copyWorkerCaches(
+	ctx context.Context,
+	logger lager.Logger,
+	dbWorker db.Worker,
+	destination worker.Worker,
+	limit int,
+) (int, error) {
+	volumes, err := d.volumeRepository.GetUnreplicatedResourceCacheVolumes(dbWorker.Name(), limit)
+	if err != nil {
+		logger.Error("failed-to-get-resource-cache-volumes", err)
+		return 0, err
+	}
+
+	source := d.workerProvider.NewGardenWorker(logger, dbWorker, 0)
+
+	copied := 0
+	for _, dbVolume := range volumes {
+		vlog := logger.WithData(lager.Data{
+			"volume":      dbVolume.Handle(),
+			"destination": destination.Name(),
+		})
+
+		volume, found, err := source.LookupVolume(vlog, dbVolume.Handle())
+		if err != nil || !found {
+			vlog.Info("source-volume-not-found", lager.Data{"error": err})
+			continue
+		}
+
+		resourceCache, found, err := source.FindResourceCacheForVolume(volume)
+		if err != nil || !found {
+			vlog.Info("resource-cache-not-found", lager.Data{"error": err})
+			continue
+		}
+
+		_, err = destination.ImportVolumeForResourceCache(ctx, vlog, resourceCache, volume, d.compression.Encoding())
+		if err != nil {
+			vlog.Error("failed-to-copy-resource-cache", err)
+			continue
+		}
+
+		vlog.Info("copied-resource-cache")
+
+		copied++
+	}
+
+	return copied, nil
+}
+
+func leastBusyWorker(logger lager.Logger, workers []worker.Worker, spec worker.WorkerSpec) (worker.Worker, bool) {
+	var chosen worker.Worker
+	for _, w := range workers {
+		if !w.Satisfies(logger, spec) {
+			continue
+		}
+
+		if chosen == nil || w.BuildContainers() < chosen.BuildContainers() {
+			chosen = w
+		}
+	}
+
+	return chosen, chosen != nil
+}
@@ -0,0 +1,248 @@
+package landing_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/compression/compressionfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/landing"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drainer", func() {
+	var (
+		fakeBuildFactory     *dbfakes.FakeBuildFactory
+		fakeWorkerFactory    *dbfakes.FakeWorkerFactory
+		fakeVolumeRepository *dbfakes.FakeVolumeRepository
+		fakeWorkerProvider   *workerfakes.FakeWorkerProvider
+		fakeCompression      *compressionfakes.FakeCompression
+
+		drainer *landing.Drainer
+		runErr  error
+	)
+
+	BeforeEach(func() {
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeWorkerFactory = new(dbfakes.FakeWorkerFactory)
+		fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
+		fakeWorkerProvider = new(workerfakes.FakeWorkerProvider)
+		fakeCompression = new(compressionfakes.FakeCompression)
+		fakeCompression.EncodingReturns(baggageclaim.GzipEncoding)
+
+		drainer = landing.NewDrainer(
+			fakeBuildFactory,
+			fakeWorkerFactory,
+			fakeVolumeRepository,
+			fakeWorkerProvider,
+			fakeCompression,
+			10,
+		)
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = drainer.Run(ctx)
+	})
+
+	Describe("builds on overdue landing workers", func() {
+		var (
+			fakeJobBuild    *dbfakes.FakeBuild
+			fakeOneOffBuild *dbfakes.FakeBuild
+			fakePipeline    *dbfakes.FakePipeline
+			fakeJob         *dbfakes.FakeJob
+		)
+
+		BeforeEach(func() {
+			fakeJob = new(dbfakes.FakeJob)
+
+			fakePipeline = new(dbfakes.FakePipeline)
+			fakePipeline.JobReturns(fakeJob, true, nil)
+
+			fakeJobBuild = new(dbfakes.FakeBuild)
+			fakeJobBuild.IDReturns(1)
+			fakeJobBuild.JobIDReturns(2)
+			fakeJobBuild.JobNameReturns("some-job")
+			fakeJobBuild.InputsReadyReturns(true)
+			fakeJobBuild.PipelineReturns(fakePipeline, true, nil)
+
+			fakeOneOffBuild = new(dbfakes.FakeBuild)
+			fakeOneOffBuild.IDReturns(3)
+
+			fakeBuildFactory.GetBuildsOnOverdueLandingWorkersReturns([]db.Build{fakeJobBuild, fakeOneOffBuild}, nil)
+		})
+
+		It("reruns builds of jobs", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+			Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+			Expect(fakeJob.RerunBuildArgsForCall(0)).To(Equal(fakeJobBuild))
+		})
+
+		It("aborts every build", func() {
+			Expect(fakeJobBuild.MarkAsAbortedCallCount()).To(Equal(1))
+			Expect(fakeOneOffBuild.MarkAsAbortedCallCount()).To(Equal(1))
+		})
+
+		Context("when aborting a build fails", func() {
+			BeforeEach(func() {
+				fakeJobBuild.MarkAsAbortedReturns(errors.New("nope"))
+			})
+
+			It("does not rerun it", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeJob.RerunBuildCallCount()).To(BeZero())
+				Expect(fakeOneOffBuild.MarkAsAbortedCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when rerunning a build fails", func() {
+			BeforeEach(func() {
+				fakeJob.RerunBuildReturns(nil, errors.New("nope"))
+			})
+
+			It("still aborts every build", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeJobBuild.MarkAsAbortedCallCount()).To(Equal(1))
+				Expect(fakeOneOffBuild.MarkAsAbortedCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the builds cannot be found", func() {
+			BeforeEach(func() {
+				fakeBuildFactory.GetBuildsOnOverdueLandingWorkersReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("resource caches on landing workers", func() {
+		var (
+			fakeLandingDBWorker *dbfakes.FakeWorker
+			fakeSourceWorker    *workerfakes.FakeWorker
+			fakeBusyWorker      *workerfakes.FakeWorker
+			fakeIdleWorker      *workerfakes.FakeWorker
+			fakeOtherWorker     *workerfakes.FakeWorker
+			fakeDBVolume        *dbfakes.FakeCreatedVolume
+			fakeSourceVolume    *workerfakes.FakeVolume
+			fakeResourceCache   *dbfakes.FakeUsedResourceCache
+		)
+
+		BeforeEach(func() {
+			fakeLandingDBWorker = new(dbfakes.FakeWorker)
+			fakeLandingDBWorker.NameReturns("landing-worker")
+			fakeLandingDBWorker.StateReturns(db.WorkerStateLanding)
+			fakeLandingDBWorker.PlatformReturns("linux")
+
+			fakeRunningDBWorker := new(dbfakes.FakeWorker)
+			fakeRunningDBWorker.StateReturns(db.WorkerStateRunning)
+
+			fakeWorkerFactory.WorkersReturns([]db.Worker{fakeRunningDBWorker, fakeLandingDBWorker}, nil)
+
+			fakeBusyWorker = new(workerfakes.FakeWorker)
+			fakeBusyWorker.SatisfiesReturns(true)
+			fakeBusyWorker.BuildContainersReturns(5)
+
+			fakeIdleWorker = new(workerfakes.FakeWorker)
+			fakeIdleWorker.SatisfiesReturns(true)
+			fakeIdleWorker.BuildContainersReturns(1)
+
+			fakeOtherWorker = new(workerfakes.FakeWorker)
+			fakeOtherWorker.SatisfiesReturns(false)
+
+			fakeWorkerProvider.RunningWorkersReturns([]worker.Worker{fakeBusyWorker, fakeOtherWorker, fakeIdleWorker}, nil)
+
+			fakeDBVolume = new(dbfakes.FakeCreatedVolume)
+			fakeDBVolume.HandleReturns("some-handle")
+			fakeVolumeRepository.GetUnreplicatedResourceCacheVolumesReturns([]db.CreatedVolume{fakeDBVolume}, nil)
+
+			fakeSourceVolume = new(workerfakes.FakeVolume)
+			fakeResourceCache = new(dbfakes.FakeUsedResourceCache)
+
+			fakeSourceWorker = new(workerfakes.FakeWorker)
+			fakeSourceWorker.LookupVolumeReturns(fakeSourceVolume, true, nil)
+			fakeSourceWorker.FindResourceCacheForVolumeReturns(fakeResourceCache, true, nil)
+			fakeWorkerProvider.NewGardenWorkerReturns(fakeSourceWorker)
+		})
+
+		It("looks up the unreplicated caches on the landing worker", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeVolumeRepository.GetUnreplicatedResourceCacheVolumesCallCount()).To(Equal(1))
+
+			workerName, limit := fakeVolumeRepository.GetUnreplicatedResourceCacheVolumesArgsForCall(0)
+			Expect(workerName).To(Equal("landing-worker"))
+			Expect(limit).To(Equal(10))
+
+			_, dbWorker, _ := fakeWorkerProvider.NewGardenWorkerArgsForCall(0)
+			Expect(dbWorker).To(Equal(fakeLandingDBWorker))
+			Expect(fakeSourceWorker.LookupVolumeCallCount()).To(Equal(1))
+			_, handle := fakeSourceWorker.LookupVolumeArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+		})
+
+		It("copies them to the least busy compatible worker", func() {
+			_, spec := fakeIdleWorker.SatisfiesArgsForCall(0)
+			Expect(spec).To(Equal(worker.WorkerSpec{Platform: "linux"}))
+
+			Expect(fakeBusyWorker.ImportVolumeForResourceCacheCallCount()).To(BeZero())
+			Expect(fakeOtherWorker.ImportVolumeForResourceCacheCallCount()).To(BeZero())
+			Expect(fakeIdleWorker.ImportVolumeForResourceCacheCallCount()).To(Equal(1))
+
+			_, _, cache, source, encoding := fakeIdleWorker.ImportVolumeForResourceCacheArgsForCall(0)
+			Expect(cache).To(Equal(fakeResourceCache))
+			Expect(source).To(Equal(fakeSourceVolume))
+			Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+		})
+
+		Context("when no running worker is compatible", func() {
+			BeforeEach(func() {
+				fakeWorkerProvider.RunningWorkersReturns([]worker.Worker{fakeOtherWorker}, nil)
+			})
+
+			It("does not look for caches to copy", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeVolumeRepository.GetUnreplicatedResourceCacheVolumesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the source volume is gone", func() {
+			BeforeEach(func() {
+				fakeSourceWorker.LookupVolumeReturns(nil, false, nil)
+			})
+
+			It("skips it", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeIdleWorker.ImportVolumeForResourceCacheCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when copying is disabled", func() {
+			BeforeEach(func() {
+				drainer = landing.NewDrainer(
+					fakeBuildFactory,
+					fakeWorkerFactory,
+					fakeVolumeRepository,
+					fakeWorkerProvider,
+					fakeCompression,
+					0,
+				)
+			})
+
+			It("does not copy anything", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeWorkerFactory.WorkersCallCount()).To(BeZero())
+				Expect(fakeIdleWorker.ImportVolumeForResourceCacheCallCount()).To(BeZero())
+			})
+		})
+	})
+})
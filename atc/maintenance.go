@@ -0,0 +1,15 @@
+package atc
+
+// Maintenance describes the maintenance mode of the cluster. While it is
+// enabled no new builds are started, builds that are already running are left
+// to finish, and resource checks are paused if PauseChecks is set. The
+// cluster is safe to upgrade once RunningBuilds drops to zero.
+type Maintenance struct {
+	Enabled     bool   `json:"enabled"`
+	Reason      string `json:"reason,omitempty"`
+	PauseChecks bool   `json:"pause_checks,omitempty"`
+	EnabledAt   int64  `json:"enabled_at,omitempty"`
+
+	RunningBuilds int `json:"running_builds,omitempty"`
+	PendingBuilds int `json:"pending_builds,omitempty"`
+}
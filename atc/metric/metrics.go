@@ -23,6 +23,7 @@ var FailedVolumes = &Counter{}
 var ContainersDeleted = &Counter{}
 var VolumesDeleted = &Counter{}
 var ChecksDeleted = &Counter{}
+var PipelineEventsDeleted = &Counter{}
 
 var JobsScheduled = &Counter{}
 var JobsScheduling = &Gauge{}
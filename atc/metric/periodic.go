@@ -75,6 +75,14 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	emit(
+		logger.Session("pipeline-events-deleted"),
+		Event{
+			Name:  "pipeline events deleted",
+			Value: PipelineEventsDeleted.Delta(),
+		},
+	)
+
 	emit(
 		logger.Session("containers-created"),
 		Event{
@@ -0,0 +1,116 @@
+package atc
+
+import (
+	"path"
+)
+
+// NotificationConfig is a rule for POSTing pipeline events to a URL from the
+// ATC itself. The URL, headers, and secret may contain ((vars)), which are
+// resolved through the pipeline's credential managers on each delivery.
+type NotificationConfig struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+
+	// Events limits the rule to the given event types. All events match if
+	// none are given.
+	Events []PipelineEventType `json:"events,omitempty"`
+
+	// Jobs limits the rule to events about jobs matching one of the given
+	// glob patterns.
+	Jobs []string `json:"jobs,omitempty"`
+
+	// Statuses limits build-status events to builds with one of the given
+	// statuses.
+	Statuses []BuildStatus `json:"statuses,omitempty"`
+
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Template is a Go text/template rendered with the event to form the
+	// payload. The event is sent as JSON if it is empty.
+	Template string `json:"template,omitempty"`
+
+	// Secret is used to sign payloads with HMAC-SHA256.
+	Secret string `json:"secret,omitempty"`
+}
+
+type NotificationConfigs []NotificationConfig
+
+func (configs NotificationConfigs) Lookup(name string) (NotificationConfig, bool) {
+	for _, config := range configs {
+		if config.Name == name {
+			return config, true
+		}
+	}
+
+	return NotificationConfig{}, false
+}
+
+// Matches returns whether the event should be sent by the rule.
+func (config NotificationConfig) Matches(ev PipelineEvent) bool {
+	if len(config.Events) > 0 && !containsEventType(config.Events, ev.Type) {
+		return false
+	}
+
+	if len(config.Jobs) > 0 {
+		if ev.JobName == "" {
+			return false
+		}
+
+		matched := false
+		for _, pattern := range config.Jobs {
+			if ok, _ := path.Match(pattern, ev.JobName); ok {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	if len(config.Statuses) > 0 && ev.Type == PipelineEventBuildStatus {
+		if ev.Build == nil {
+			return false
+		}
+
+		for _, status := range config.Statuses {
+			if status == ev.Build.Status {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func containsEventType(types []PipelineEventType, t PipelineEventType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	NotificationDeliveryPending   = "pending"
+	NotificationDeliverySucceeded = "succeeded"
+	NotificationDeliveryFailed    = "failed"
+)
+
+type NotificationDelivery struct {
+	ID               int               `json:"id"`
+	NotificationName string            `json:"notification_name"`
+	EventID          int               `json:"event_id"`
+	EventType        PipelineEventType `json:"event_type"`
+	Status           string            `json:"status"`
+	Attempts         int               `json:"attempts"`
+	ResponseCode     int               `json:"response_code,omitempty"`
+	Error            string            `json:"error,omitempty"`
+	CreatedAt        int64             `json:"created_at"`
+	UpdatedAt        int64             `json:"updated_at"`
+}
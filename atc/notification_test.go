@@ -0,0 +1,65 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationConfig", func() {
+	Describe("Matches", func() {
+		var (
+			config atc.NotificationConfig
+			ev     atc.PipelineEvent
+		)
+
+		BeforeEach(func() {
+			config = atc.NotificationConfig{Name: "some-notification"}
+
+			ev = atc.PipelineEvent{
+				Type:    atc.PipelineEventBuildStatus,
+				JobName: "deploy-prod",
+				Build:   &atc.PipelineEventBuild{ID: 1, Name: "1", Status: atc.StatusFailed},
+			}
+		})
+
+		It("matches every event when no filters are given", func() {
+			Expect(config.Matches(ev)).To(BeTrue())
+			Expect(config.Matches(atc.PipelineEvent{Type: atc.PipelineEventPipelinePaused})).To(BeTrue())
+		})
+
+		It("filters by event type", func() {
+			config.Events = []atc.PipelineEventType{atc.PipelineEventPipelinePaused}
+			Expect(config.Matches(ev)).To(BeFalse())
+
+			config.Events = append(config.Events, atc.PipelineEventBuildStatus)
+			Expect(config.Matches(ev)).To(BeTrue())
+		})
+
+		It("filters by job name glob", func() {
+			config.Jobs = []string{"deploy-*"}
+			Expect(config.Matches(ev)).To(BeTrue())
+
+			config.Jobs = []string{"unit"}
+			Expect(config.Matches(ev)).To(BeFalse())
+		})
+
+		It("does not match events without a job when filtering by job", func() {
+			config.Jobs = []string{"*"}
+			Expect(config.Matches(atc.PipelineEvent{Type: atc.PipelineEventPipelinePaused})).To(BeFalse())
+		})
+
+		It("filters build status events by status", func() {
+			config.Statuses = []atc.BuildStatus{atc.StatusSucceeded}
+			Expect(config.Matches(ev)).To(BeFalse())
+
+			config.Statuses = []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored}
+			Expect(config.Matches(ev)).To(BeTrue())
+		})
+
+		It("does not filter other events by status", func() {
+			config.Statuses = []atc.BuildStatus{atc.StatusFailed}
+			Expect(config.Matches(atc.PipelineEvent{Type: atc.PipelineEventJobPaused, JobName: "deploy-prod"})).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,149 @@
+package notifier
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrDestinationNotAllowed is returned when a notification would be sent to
+// a host or address which the operator has not allowed.
+var ErrDestinationNotAllowed = errors.New("destination not allowed")
+
+// privateNetworks are the loopback, private, link-local, and other reserved
+// ranges which notifications are not sent to unless the operator allows it,
+// so that pipeline authors cannot use the ATC to reach internal services such
+// as cloud metadata endpoints.
+var privateNetworks = mustParseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+
+		networks[i] = network
+	}
+
+	return networks
+}
+
+// Destinations decides where notifications may be sent.
+type Destinations struct {
+	// AllowedHosts lists the hosts notifications may be sent to. An entry of
+	// the form *.example.com matches any subdomain of example.com. When empty,
+	// any host is allowed.
+	AllowedHosts []string
+
+	// AllowPrivateNetworks allows notifications to be sent to loopback,
+	// private, and link-local addresses.
+	AllowPrivateNetworks bool
+}
+
+// CheckHost returns ErrDestinationNotAllowed if the host is not in
+// AllowedHosts.
+func (d Destinations) CheckHost(host string) error {
+	if len(d.AllowedHosts) == 0 {
+		return nil
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	for _, allowed := range d.AllowedHosts {
+		allowed = strings.ToLower(allowed)
+
+		if strings.HasPrefix(allowed, "*.") {
+			if strings.HasSuffix(host, allowed[1:]) {
+				return nil
+			}
+		} else if host == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: host %s is not allowed", ErrDestinationNotAllowed, host)
+}
+
+// CheckIP returns ErrDestinationNotAllowed if the address is in a private
+// network and those are not allowed.
+func (d Destinations) CheckIP(ip net.IP) error {
+	if d.AllowPrivateNetworks {
+		return nil
+	}
+
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return fmt.Errorf("%w: %s is in a private network", ErrDestinationNotAllowed, ip)
+		}
+	}
+
+	return nil
+}
+
+// NewHTTPClient returns a client which only connects to the allowed
+// destinations. Addresses are checked as they are dialed, after DNS
+// resolution, so that a host cannot resolve to a private address; redirects
+// are checked in the same way as the original request.
+//
+// Proxies from the environment are not used, as they would be dialed in
+// place of the destination.
+func NewHTTPClient(destinations Destinations, timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("%w: cannot parse address %s", ErrDestinationNotAllowed, host)
+			}
+
+			return destinations.CheckIP(ip)
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+
+			return destinations.CheckHost(req.URL.Hostname())
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package notifier_test
+
+import (
+	"errors"
+	"net"
+
+	"github.com/concourse/concourse/atc/notifier"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Destinations", func() {
+	Describe("CheckHost", func() {
+		It("allows any host when none are configured", func() {
+			Expect(notifier.Destinations{}.CheckHost("hooks.example.com")).To(Succeed())
+		})
+
+		It("matches hosts exactly or by wildcard subdomain", func() {
+			destinations := notifier.Destinations{
+				AllowedHosts: []string{"hooks.example.com", "*.slack.com"},
+			}
+
+			Expect(destinations.CheckHost("HOOKS.example.com")).To(Succeed())
+			Expect(destinations.CheckHost("hooks.slack.com")).To(Succeed())
+
+			err := destinations.CheckHost("slack.com")
+			Expect(errors.Is(err, notifier.ErrDestinationNotAllowed)).To(BeTrue())
+
+			err = destinations.CheckHost("evil-hooks.example.com")
+			Expect(errors.Is(err, notifier.ErrDestinationNotAllowed)).To(BeTrue())
+		})
+	})
+
+	Describe("CheckIP", func() {
+		It("rejects loopback, private, and link-local addresses", func() {
+			for _, ip := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "::1", "fe80::1", "fd00::1", "::ffff:10.0.0.1"} {
+				err := notifier.Destinations{}.CheckIP(net.ParseIP(ip))
+				Expect(errors.Is(err, notifier.ErrDestinationNotAllowed)).To(BeTrue(), ip)
+			}
+		})
+
+		It("allows public addresses", func() {
+			Expect(notifier.Destinations{}.CheckIP(net.ParseIP("93.184.216.34"))).To(Succeed())
+			Expect(notifier.Destinations{}.CheckIP(net.ParseIP("2606:2800:220:1::1"))).To(Succeed())
+		})
+
+		It("allows private addresses when configured to", func() {
+			Expect(notifier.Destinations{AllowPrivateNetworks: true}.CheckIP(net.ParseIP("169.254.169.254"))).To(Succeed())
+		})
+	})
+})
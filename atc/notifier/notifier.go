@@ -0,0 +1,291 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"text/template"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const (
+	// MaxAttempts is the number of times a delivery is attempted before it is
+	// given up on.
+	MaxAttempts = 5
+
+	// RetryBackoff is the delay before the first retry. It doubles with each
+	// subsequent attempt.
+	RetryBackoff = 10 * time.Second
+
+	batchSize = 100
+)
+
+type notifier struct {
+	logger              lager.Logger
+	notificationFactory db.NotificationFactory
+	secrets             creds.Secrets
+	varSourcePool       creds.VarSourcePool
+	httpClient          *http.Client
+	destinations        Destinations
+	maxInFlightPerHost  int
+}
+
+// NewNotifier returns a notifier which sends deliveries concurrently, with at
+// most maxInFlightPerHost requests in flight to any one host. The client
+// should come from NewHTTPClient with the same destinations.
+func NewNotifier(
+	logger lager.Logger,
+	notificationFactory db.NotificationFactory,
+	secrets creds.Secrets,
+	varSourcePool creds.VarSourcePool,
+	httpClient *http.Client,
+	destinations Destinations,
+	maxInFlightPerHost int,
+) *notifier {
+	return &notifier{
+		logger:              logger,
+		notificationFactory: notificationFactory,
+		secrets:             secrets,
+		varSourcePool:       varSourcePool,
+		httpClient:          httpClient,
+		destinations:        destinations,
+		maxInFlightPerHost:  maxInFlightPerHost,
+	}
+}
+
+func (n *notifier) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("notifier")
+
+	_, err := n.notificationFactory.DispatchPipelineEvents(batchSize)
+	if err != nil {
+		logger.Error("failed-to-dispatch-pipeline-events", err)
+		return err
+	}
+
+	deliveries, err := n.notificationFactory.DueNotificationDeliveries(batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-due-notification-deliveries", err)
+		return err
+	}
+
+	limiter := newHostLimiter(n.maxInFlightPerHost)
+
+	wg := new(sync.WaitGroup)
+	for _, delivery := range deliveries {
+		wg.Add(1)
+
+		go func(delivery db.NotificationDelivery) {
+			defer wg.Done()
+
+			err := n.deliver(ctx, logger, limiter, delivery)
+			if err != nil {
+				logger.Error("failed-to-record-notification-delivery", err, lager.Data{
+					"delivery": delivery.ID(),
+				})
+			}
+		}(delivery)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// deliver attempts a single delivery and records its outcome. Errors are only
+// returned when the outcome could not be recorded.
+func (n *notifier) deliver(ctx context.Context, logger lager.Logger, limiter *hostLimiter, delivery db.NotificationDelivery) error {
+	logger = logger.Session("deliver", lager.Data{
+		"delivery":     delivery.ID(),
+		"notification": delivery.NotificationName(),
+	})
+
+	pipeline, found, err := delivery.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return delivery.Fail(0, "pipeline not found")
+	}
+
+	notifications, err := pipeline.Notifications()
+	if err != nil {
+		return err
+	}
+
+	notification, found := notifications.Lookup(delivery.NotificationName())
+	if !found {
+		return delivery.Fail(0, "notification no longer configured")
+	}
+
+	variables, err := pipeline.Variables(n.logger, n.secrets, n.varSourcePool)
+	if err != nil {
+		return n.retry(delivery, 0, "failed to resolve variables: "+err.Error())
+	}
+
+	notification, err = creds.NewNotificationConfig(variables, notification).Evaluate()
+	if err != nil {
+		return n.retry(delivery, 0, "failed to resolve variables: "+err.Error())
+	}
+
+	payload, err := renderPayload(notification.Template, delivery)
+	if err != nil {
+		return delivery.Fail(0, "failed to render template: "+err.Error())
+	}
+
+	req, err := http.NewRequest("POST", notification.URL, bytes.NewReader(payload))
+	if err != nil {
+		return delivery.Fail(0, "invalid url")
+	}
+
+	err = n.destinations.CheckHost(req.URL.Hostname())
+	if err != nil {
+		logger.Info("destination-not-allowed", lager.Data{"error": err.Error()})
+		return delivery.Fail(0, err.Error())
+	}
+
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range notification.Headers {
+		req.Header.Set(name, value)
+	}
+
+	req.Header.Set("X-Concourse-Event", string(delivery.Event().Type))
+	req.Header.Set("X-Concourse-Delivery", strconv.Itoa(delivery.ID()))
+
+	if notification.Secret != "" {
+		req.Header.Set("X-Concourse-Signature", Sign(notification.Secret, payload))
+	}
+
+	release, ok := limiter.acquire(ctx, req.URL.Host)
+	if !ok {
+		// shutting down; the delivery is still due and will be sent next time
+		return nil
+	}
+
+	defer release()
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		// the URL may contain credentials, so it is left out of the recorded
+		// error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+
+		logger.Info("request-failed", lager.Data{"error": err.Error()})
+
+		if errors.Is(err, ErrDestinationNotAllowed) {
+			return delivery.Fail(0, err.Error())
+		}
+
+		return n.retry(delivery, 0, err.Error())
+	}
+
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		logger.Info("unexpected-response", lager.Data{"status": resp.StatusCode})
+
+		return n.retry(delivery, resp.StatusCode, fmt.Sprintf("unexpected response: %s", resp.Status))
+	}
+
+	return delivery.Succeed(resp.StatusCode)
+}
+
+// retry schedules another attempt with exponential backoff, or fails the
+// delivery once it has been attempted MaxAttempts times.
+func (n *notifier) retry(delivery db.NotificationDelivery, responseCode int, reason string) error {
+	attempts := delivery.Attempts() + 1
+	if attempts >= MaxAttempts {
+		return delivery.Fail(responseCode, reason)
+	}
+
+	backoff := RetryBackoff * time.Duration(1<<uint(attempts-1))
+
+	return delivery.Retry(responseCode, reason, time.Now().Add(backoff))
+}
+
+func renderPayload(tmpl string, delivery db.NotificationDelivery) ([]byte, error) {
+	if tmpl == "" {
+		return json.Marshal(delivery.Event())
+	}
+
+	t, err := template.New("payload").Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	err = t.Execute(buf, delivery.Event())
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Sign returns the value of the signature header for the payload, which
+// receivers can use to verify that it was sent by Concourse.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// hostLimiter bounds the number of requests in flight to each host.
+type hostLimiter struct {
+	limit int
+
+	lock  sync.Mutex
+	hosts map[string]chan struct{}
+}
+
+func newHostLimiter(limit int) *hostLimiter {
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &hostLimiter{
+		limit: limit,
+		hosts: map[string]chan struct{}{},
+	}
+}
+
+// acquire waits for a slot for the host, returning a func to release it. It
+// returns false if the context is done first.
+func (l *hostLimiter) acquire(ctx context.Context, host string) (func(), bool) {
+	l.lock.Lock()
+	slots, found := l.hosts[host]
+	if !found {
+		slots = make(chan struct{}, l.limit)
+		l.hosts[host] = slots
+	}
+	l.lock.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
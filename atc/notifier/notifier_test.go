@@ -0,0 +1,355 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/notifier"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+type runnable interface {
+	Run(context.Context) error
+}
+
+var _ = Describe("Notifier", func() {
+	var (
+		server                  *ghttp.Server
+		fakeNotificationFactory *dbfakes.FakeNotificationFactory
+		fakeDelivery            *dbfakes.FakeNotificationDelivery
+		fakePipeline            *dbfakes.FakePipeline
+		event                   atc.PipelineEvent
+		notification            atc.NotificationConfig
+		destinations            notifier.Destinations
+		maxInFlightPerHost      int
+
+		n      runnable
+		runErr error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeNotificationFactory = new(dbfakes.FakeNotificationFactory)
+		fakeDelivery = new(dbfakes.FakeNotificationDelivery)
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		event = atc.PipelineEvent{
+			ID:           42,
+			Type:         atc.PipelineEventBuildStatus,
+			Time:         1234,
+			TeamName:     "some-team",
+			PipelineName: "some-pipeline",
+			JobName:      "some-job",
+			Build: &atc.PipelineEventBuild{
+				ID:     1,
+				Name:   "1",
+				Status: atc.StatusFailed,
+			},
+		}
+
+		notification = atc.NotificationConfig{
+			Name: "some-notification",
+			URL:  server.URL() + "/hooks/((hook-id))",
+		}
+
+		fakeDelivery.IDReturns(7)
+		fakeDelivery.NotificationNameReturns("some-notification")
+		fakeDelivery.EventReturns(event)
+		fakeDelivery.PipelineReturns(fakePipeline, true, nil)
+		fakeNotificationFactory.DueNotificationDeliveriesReturns([]db.NotificationDelivery{fakeDelivery}, nil)
+
+		fakePipeline.VariablesReturns(vars.StaticVariables{
+			"hook-id": "abc",
+			"secret":  "shh",
+		}, nil)
+
+		// the test server listens on loopback
+		destinations = notifier.Destinations{AllowPrivateNetworks: true}
+		maxInFlightPerHost = 4
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		fakePipeline.NotificationsReturns(atc.NotificationConfigs{notification}, nil)
+
+		n = notifier.NewNotifier(
+			lagertest.NewTestLogger("test"),
+			fakeNotificationFactory,
+			nil,
+			nil,
+			notifier.NewHTTPClient(destinations, time.Minute),
+			destinations,
+			maxInFlightPerHost,
+		)
+
+		runErr = n.Run(context.TODO())
+	})
+
+	Context("when there are no due deliveries", func() {
+		BeforeEach(func() {
+			fakeNotificationFactory.DueNotificationDeliveriesReturns(nil, nil)
+		})
+
+		It("dispatches pipeline events before looking for deliveries", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeNotificationFactory.DispatchPipelineEventsCallCount()).To(Equal(1))
+			Expect(fakeNotificationFactory.DueNotificationDeliveriesCallCount()).To(Equal(1))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("when dispatching fails", func() {
+		BeforeEach(func() {
+			fakeNotificationFactory.DispatchPipelineEventsReturns(0, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(HaveOccurred())
+			Expect(fakeNotificationFactory.DueNotificationDeliveriesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the receiver responds successfully", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/hooks/abc"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+					ghttp.VerifyHeaderKV("X-Concourse-Event", "build-status"),
+					ghttp.VerifyHeaderKV("X-Concourse-Delivery", "7"),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(r.Header.Get("X-Concourse-Signature")).To(BeEmpty())
+
+						var received atc.PipelineEvent
+						err := json.NewDecoder(r.Body).Decode(&received)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(received).To(Equal(event))
+					},
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("posts the event as JSON to the interpolated url", func() {
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("records the delivery as succeeded", func() {
+			Expect(fakeDelivery.SucceedCallCount()).To(Equal(1))
+			Expect(fakeDelivery.SucceedArgsForCall(0)).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Context("when the notification has headers, a template, and a secret", func() {
+		BeforeEach(func() {
+			notification.Headers = map[string]string{
+				"Content-Type":  "text/plain",
+				"Authorization": "Bearer ((secret))",
+			}
+			notification.Template = `{{.JobName}} {{.Build.Status}} (((not-a-var)))`
+			notification.Secret = "((secret))"
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("Content-Type", "text/plain"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer shh"),
+					ghttp.VerifyHeaderKV("X-Concourse-Signature", notifier.Sign("shh", []byte("some-job failed (((not-a-var)))"))),
+					func(w http.ResponseWriter, r *http.Request) {
+						body, err := ioutil.ReadAll(r.Body)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(string(body)).To(Equal("some-job failed (((not-a-var)))"))
+					},
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("renders the template and signs the payload", func() {
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+			Expect(fakeDelivery.SucceedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the receiver responds with an error", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadGateway, nil))
+			fakeDelivery.AttemptsReturns(1)
+		})
+
+		It("schedules a retry with backoff", func() {
+			Expect(fakeDelivery.RetryCallCount()).To(Equal(1))
+
+			code, reason, at := fakeDelivery.RetryArgsForCall(0)
+			Expect(code).To(Equal(http.StatusBadGateway))
+			Expect(reason).To(ContainSubstring("502"))
+			Expect(at).To(BeTemporally("~", time.Now().Add(2*notifier.RetryBackoff), time.Second))
+		})
+
+		Context("when the delivery has run out of attempts", func() {
+			BeforeEach(func() {
+				fakeDelivery.AttemptsReturns(notifier.MaxAttempts - 1)
+			})
+
+			It("fails the delivery", func() {
+				Expect(fakeDelivery.RetryCallCount()).To(Equal(0))
+				Expect(fakeDelivery.FailCallCount()).To(Equal(1))
+
+				code, _ := fakeDelivery.FailArgsForCall(0)
+				Expect(code).To(Equal(http.StatusBadGateway))
+			})
+		})
+	})
+
+	Context("when the request cannot be made", func() {
+		BeforeEach(func() {
+			server.Close()
+		})
+
+		It("retries without recording the url", func() {
+			Expect(fakeDelivery.RetryCallCount()).To(Equal(1))
+
+			code, reason, _ := fakeDelivery.RetryArgsForCall(0)
+			Expect(code).To(Equal(0))
+			Expect(reason).ToNot(BeEmpty())
+			Expect(reason).ToNot(ContainSubstring("abc"))
+		})
+	})
+
+	Context("when the url resolves to a private network", func() {
+		BeforeEach(func() {
+			destinations.AllowPrivateNetworks = false
+		})
+
+		It("fails the delivery without sending it", func() {
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeDelivery.RetryCallCount()).To(Equal(0))
+			Expect(fakeDelivery.FailCallCount()).To(Equal(1))
+
+			_, reason := fakeDelivery.FailArgsForCall(0)
+			Expect(reason).To(ContainSubstring("127.0.0.1 is in a private network"))
+		})
+	})
+
+	Context("when allowed hosts are configured", func() {
+		Context("when the url's host is allowed", func() {
+			BeforeEach(func() {
+				destinations.AllowedHosts = []string{"127.0.0.1"}
+				server.AppendHandlers(ghttp.RespondWith(http.StatusOK, nil))
+			})
+
+			It("sends the delivery", func() {
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+				Expect(fakeDelivery.SucceedCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the url's host is not allowed", func() {
+			BeforeEach(func() {
+				destinations.AllowedHosts = []string{"hooks.example.com"}
+			})
+
+			It("fails the delivery without sending it", func() {
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+				Expect(fakeDelivery.FailCallCount()).To(Equal(1))
+
+				_, reason := fakeDelivery.FailArgsForCall(0)
+				Expect(reason).To(ContainSubstring("host 127.0.0.1 is not allowed"))
+			})
+		})
+	})
+
+	Context("when there are many deliveries to the same host", func() {
+		var (
+			lock        sync.Mutex
+			inFlight    int
+			maxInFlight int
+			deliveries  []*dbfakes.FakeNotificationDelivery
+		)
+
+		BeforeEach(func() {
+			maxInFlightPerHost = 2
+			inFlight, maxInFlight = 0, 0
+
+			server.RouteToHandler("POST", "/hooks/abc", func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				lock.Unlock()
+
+				time.Sleep(100 * time.Millisecond)
+
+				lock.Lock()
+				inFlight--
+				lock.Unlock()
+			})
+
+			deliveries = nil
+			var due []db.NotificationDelivery
+			for i := 0; i < 5; i++ {
+				delivery := new(dbfakes.FakeNotificationDelivery)
+				delivery.IDReturns(i)
+				delivery.NotificationNameReturns("some-notification")
+				delivery.EventReturns(event)
+				delivery.PipelineReturns(fakePipeline, true, nil)
+
+				deliveries = append(deliveries, delivery)
+				due = append(due, delivery)
+			}
+
+			fakeNotificationFactory.DueNotificationDeliveriesReturns(due, nil)
+		})
+
+		It("sends them concurrently, up to the limit for the host", func() {
+			Expect(server.ReceivedRequests()).To(HaveLen(5))
+			Expect(maxInFlight).To(Equal(2))
+
+			for _, delivery := range deliveries {
+				Expect(delivery.SucceedCallCount()).To(Equal(1))
+			}
+		})
+	})
+
+	Context("when the notification is no longer configured", func() {
+		BeforeEach(func() {
+			notification.Name = "some-other-notification"
+		})
+
+		It("fails the delivery without sending it", func() {
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeDelivery.FailCallCount()).To(Equal(1))
+
+			_, reason := fakeDelivery.FailArgsForCall(0)
+			Expect(reason).To(Equal("notification no longer configured"))
+		})
+	})
+
+	Context("when the pipeline no longer exists", func() {
+		BeforeEach(func() {
+			fakeDelivery.PipelineReturns(nil, false, nil)
+		})
+
+		It("fails the delivery", func() {
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeDelivery.FailCallCount()).To(Equal(1))
+		})
+	})
+})
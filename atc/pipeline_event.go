@@ -0,0 +1,60 @@
+package atc
+
+type PipelineEventType string
+
+const (
+	// PipelineEventBuildStatus is emitted when a job's build is created,
+	// started, or finished.
+	PipelineEventBuildStatus PipelineEventType = "build-status"
+
+	// PipelineEventResourceVersion is emitted when a check finds a new
+	// version of a resource.
+	PipelineEventResourceVersion PipelineEventType = "resource-version"
+
+	// PipelineEventConfig is emitted when a pipeline's config is set.
+	PipelineEventConfig PipelineEventType = "pipeline-config"
+
+	PipelineEventPipelinePaused   PipelineEventType = "pipeline-paused"
+	PipelineEventPipelineUnpaused PipelineEventType = "pipeline-unpaused"
+	PipelineEventJobPaused        PipelineEventType = "job-paused"
+	PipelineEventJobUnpaused      PipelineEventType = "job-unpaused"
+	PipelineEventResourcePinned   PipelineEventType = "resource-pinned"
+	PipelineEventResourceUnpinned PipelineEventType = "resource-unpinned"
+)
+
+var PipelineEventTypes = []PipelineEventType{
+	PipelineEventBuildStatus,
+	PipelineEventResourceVersion,
+	PipelineEventConfig,
+	PipelineEventPipelinePaused,
+	PipelineEventPipelineUnpaused,
+	PipelineEventJobPaused,
+	PipelineEventJobUnpaused,
+	PipelineEventResourcePinned,
+	PipelineEventResourceUnpinned,
+}
+
+// PipelineEvent is something which happened to a pipeline. IDs increase
+// across all pipelines, so the ID of the last event seen can be used to
+// resume a stream of events.
+//
+// Which of the optional fields are set depends on the event's type.
+type PipelineEvent struct {
+	ID           int               `json:"id"`
+	Type         PipelineEventType `json:"type"`
+	Time         int64             `json:"time"`
+	TeamName     string            `json:"team_name"`
+	PipelineName string            `json:"pipeline_name"`
+
+	JobName       string              `json:"job_name,omitempty"`
+	ResourceName  string              `json:"resource_name,omitempty"`
+	Build         *PipelineEventBuild `json:"build,omitempty"`
+	Version       Version             `json:"version,omitempty"`
+	ConfigVersion int                 `json:"config_version,omitempty"`
+}
+
+type PipelineEventBuild struct {
+	ID     int         `json:"id"`
+	Name   string      `json:"name"`
+	Status BuildStatus `json:"status"`
+}
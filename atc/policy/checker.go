@@ -10,10 +10,16 @@ import (
 
 const ActionUseImage = "UseImage"
 
-type PolicyCheckNotPass struct{}
+type PolicyCheckNotPass struct {
+	Reasons []string
+}
 
 func (e PolicyCheckNotPass) Error() string {
-	return "policy check rejected"
+	if len(e.Reasons) == 0 {
+		return "policy check rejected"
+	}
+
+	return fmt.Sprintf("policy check rejected: %s", strings.Join(e.Reasons, "; "))
 }
 
 type Filter struct {
@@ -34,13 +40,63 @@ type PolicyCheckInput struct {
 	Data           interface{} `json:"data,omitempty"`
 }
 
+// Severity decides what happens when a policy check does not pass.
+type Severity string
+
+const (
+	// SeverityBlock rejects the action. It is the default.
+	SeverityBlock Severity = "block"
+
+	// SeverityWarn allows the action, but the reasons are shown to the user.
+	SeverityWarn Severity = "warn"
+
+	// SeverityAudit allows the action and only logs the reasons.
+	SeverityAudit Severity = "audit"
+)
+
+var severityOrder = map[Severity]int{
+	SeverityAudit: 1,
+	SeverityWarn:  2,
+	SeverityBlock: 3,
+}
+
+type PolicyCheckOutput struct {
+	Allowed  bool     `json:"allowed"`
+	Reasons  []string `json:"reasons,omitempty"`
+	Severity Severity `json:"severity,omitempty"`
+}
+
+// PassedPolicyCheck returns an output for inputs that pass, or that do not go
+// through policy check at all.
+func PassedPolicyCheck() PolicyCheckOutput {
+	return PolicyCheckOutput{Allowed: true}
+}
+
+// ShouldBlock returns true if the action must be rejected.
+func (o PolicyCheckOutput) ShouldBlock() bool {
+	return !o.Allowed && (o.Severity == "" || o.Severity == SeverityBlock)
+}
+
+// ShouldWarn returns true if the action is allowed, but the user should be
+// told why it would have been rejected.
+func (o PolicyCheckOutput) ShouldWarn() bool {
+	return !o.Allowed && o.Severity == SeverityWarn
+}
+
 //go:generate counterfeiter . Agent
 
 // Agent should be implemented by policy agents.
 type Agent interface {
-	// Check returns true if passes policy check. If not goes through policy
-	// check, just return true.
-	Check(PolicyCheckInput) (bool, error)
+	// Check returns whether the input passes policy check, and if not, why
+	// and how severe it is. If the input does not go through policy check,
+	// just return an allowed output.
+	Check(PolicyCheckInput) (PolicyCheckOutput, error)
+}
+
+// Closer is implemented by agents which must release resources, such as
+// background goroutines, when the ATC shuts down.
+type Closer interface {
+	Close()
 }
 
 //go:generate counterfeiter . AgentFactory
@@ -51,6 +107,18 @@ type AgentFactory interface {
 	NewAgent(lager.Logger) (Agent, error)
 }
 
+// CombineMode decides how the results of multiple configured agents are
+// combined.
+type CombineMode string
+
+const (
+	// CombineAll requires every agent to pass.
+	CombineAll CombineMode = "all"
+
+	// CombineAny requires at least one agent to pass.
+	CombineAny CombineMode = "any"
+)
+
 var agentFactories []AgentFactory
 
 func RegisterAgent(factory AgentFactory) {
@@ -71,22 +139,21 @@ var (
 	clusterVersion string
 )
 
-func Initialize(logger lager.Logger, cluster string, version string, filter Filter) (*Checker, error) {
+func Initialize(logger lager.Logger, cluster string, version string, filter Filter, combine CombineMode) (*Checker, error) {
 	logger.Debug("policy-checker-initialize")
 
 	clusterName = cluster
 	clusterVersion = version
 
-	var checkerDescriptions []string
-	for _, factory := range agentFactories {
-		if factory.IsConfigured() {
-			checkerDescriptions = append(checkerDescriptions, factory.Description())
-		}
-	}
-	if len(checkerDescriptions) > 1 {
-		return nil, fmt.Errorf("Multiple policy checker configured: %s", strings.Join(checkerDescriptions, ", "))
+	switch combine {
+	case "":
+		combine = CombineAll
+	case CombineAll, CombineAny:
+	default:
+		return nil, fmt.Errorf("unknown policy check combine mode: %s", combine)
 	}
 
+	var agents []Agent
 	for _, factory := range agentFactories {
 		if factory.IsConfigured() {
 			agent, err := factory.NewAgent(logger.Session("policy-checker"))
@@ -94,23 +161,42 @@ func Initialize(logger lager.Logger, cluster string, version string, filter Filt
 				return nil, err
 			}
 
-			logger.Info("warning-experiment-policy-check",
-				lager.Data{"rfc": "https://github.com/concourse/rfcs/pull/41"})
+			logger.Info("policy-checker-configured", lager.Data{"agent": factory.Description()})
 
-			return &Checker{
-				filter: filter,
-				agent:  agent,
-			}, nil
+			agents = append(agents, agent)
 		}
 	}
 
 	// No policy checker configured.
-	return nil, nil
+	if len(agents) == 0 {
+		return nil, nil
+	}
+
+	logger.Info("warning-experiment-policy-check",
+		lager.Data{"rfc": "https://github.com/concourse/rfcs/pull/41"})
+
+	return &Checker{
+		logger:  logger.Session("policy-checker"),
+		filter:  filter,
+		agents:  agents,
+		combine: combine,
+	}, nil
 }
 
 type Checker struct {
-	filter Filter
-	agent  Agent
+	logger  lager.Logger
+	filter  Filter
+	agents  []Agent
+	combine CombineMode
+}
+
+// Close closes every agent which implements Closer.
+func (c *Checker) Close() {
+	for _, agent := range c.agents {
+		if closer, ok := agent.(Closer); ok {
+			closer.Close()
+		}
+	}
 }
 
 func (c *Checker) ShouldCheckHttpMethod(method string) bool {
@@ -136,9 +222,116 @@ func inArray(array []string, target string) bool {
 	return found
 }
 
-func (c *Checker) Check(input PolicyCheckInput) (bool, error) {
+func (c *Checker) Check(input PolicyCheckInput) (PolicyCheckOutput, error) {
 	input.Service = "concourse"
 	input.ClusterName = clusterName
 	input.ClusterVersion = clusterVersion
-	return c.agent.Check(input)
+
+	var (
+		output PolicyCheckOutput
+		err    error
+	)
+	if c.combine == CombineAny {
+		output, err = c.checkAny(input)
+	} else {
+		output, err = c.checkAll(input)
+	}
+	if err != nil {
+		return PolicyCheckOutput{}, err
+	}
+
+	if !output.Allowed {
+		c.logger.Info("policy-check-not-pass", lager.Data{
+			"action":   input.Action,
+			"team":     input.Team,
+			"pipeline": input.Pipeline,
+			"user":     input.User,
+			"severity": output.Severity,
+			"reasons":  output.Reasons,
+		})
+	}
+
+	return output, nil
+}
+
+// checkAll fails as soon as one agent errors. Otherwise the reasons of every
+// agent that did not pass are combined.
+func (c *Checker) checkAll(input PolicyCheckInput) (PolicyCheckOutput, error) {
+	output := PassedPolicyCheck()
+	for _, agent := range c.agents {
+		agentOutput, err := c.checkAgent(agent, input)
+		if err != nil {
+			return PolicyCheckOutput{}, err
+		}
+
+		if !agentOutput.Allowed {
+			output = combineNotPass(output, agentOutput)
+		}
+	}
+
+	return output, nil
+}
+
+// checkAny passes as soon as one agent passes the input. If no agent passes,
+// the first error, if any, is returned.
+func (c *Checker) checkAny(input PolicyCheckInput) (PolicyCheckOutput, error) {
+	var (
+		checkErr error
+		output   = PassedPolicyCheck()
+	)
+	for _, agent := range c.agents {
+		agentOutput, err := c.checkAgent(agent, input)
+		if err != nil {
+			if checkErr == nil {
+				checkErr = err
+			}
+			continue
+		}
+
+		if agentOutput.Allowed {
+			return PassedPolicyCheck(), nil
+		}
+
+		output = combineNotPass(output, agentOutput)
+	}
+
+	if checkErr != nil {
+		return PolicyCheckOutput{}, checkErr
+	}
+
+	return output, nil
+}
+
+func (c *Checker) checkAgent(agent Agent, input PolicyCheckInput) (PolicyCheckOutput, error) {
+	output, err := agent.Check(input)
+	if err != nil {
+		return PolicyCheckOutput{}, err
+	}
+
+	if output.Allowed {
+		return PassedPolicyCheck(), nil
+	}
+
+	if output.Severity == "" {
+		output.Severity = SeverityBlock
+	}
+
+	if _, known := severityOrder[output.Severity]; !known {
+		return PolicyCheckOutput{}, fmt.Errorf("unknown policy check severity: %s", output.Severity)
+	}
+
+	return output, nil
+}
+
+// combineNotPass adds the reasons of an agent that did not pass to the
+// output. The most severe result wins.
+func combineNotPass(output PolicyCheckOutput, agentOutput PolicyCheckOutput) PolicyCheckOutput {
+	if output.Allowed || severityOrder[agentOutput.Severity] > severityOrder[output.Severity] {
+		output.Severity = agentOutput.Severity
+	}
+
+	output.Allowed = false
+	output.Reasons = append(output.Reasons, agentOutput.Reasons...)
+
+	return output
 }
@@ -15,6 +15,7 @@ var _ = Describe("Policy checker", func() {
 	var (
 		checker *policy.Checker
 		filter  policy.Filter
+		combine policy.CombineMode
 		err     error
 	)
 
@@ -25,12 +26,18 @@ var _ = Describe("Policy checker", func() {
 			ActionsToSkip: []string{"skip_1", "skip_2"},
 		}
 
+		combine = policy.CombineAll
+
 		fakeAgent = new(policyfakes.FakeAgent)
 		fakeAgentFactory.NewAgentReturns(fakeAgent, nil)
+
+		anotherFakeAgent = new(policyfakes.FakeAgent)
+		anotherFakeAgentFactory.NewAgentReturns(anotherFakeAgent, nil)
+		anotherFakeAgentFactory.IsConfiguredReturns(false)
 	})
 
 	JustBeforeEach(func() {
-		checker, err = policy.Initialize(testLogger, "some-cluster", "some-version", filter)
+		checker, err = policy.Initialize(testLogger, "some-cluster", "some-version", filter, combine)
 	})
 
 	// fakeAgent is configured in BeforeSuite.
@@ -44,6 +51,21 @@ var _ = Describe("Policy checker", func() {
 			Expect(checker).ToNot(BeNil())
 		})
 
+		Context("when an agent must be closed", func() {
+			var agent *closableAgent
+
+			BeforeEach(func() {
+				agent = &closableAgent{FakeAgent: fakeAgent}
+				fakeAgentFactory.NewAgentReturns(agent, nil)
+			})
+
+			It("should close it with the checker", func() {
+				Expect(agent.closed).To(BeFalse())
+				checker.Close()
+				Expect(agent.closed).To(BeTrue())
+			})
+		})
+
 		Context("Checker", func() {
 			Context("ShouldCheckHttpMethod", func() {
 				It("should return correct result", func() {
@@ -75,14 +97,14 @@ var _ = Describe("Policy checker", func() {
 			Context("Check", func() {
 				var (
 					input    policy.PolicyCheckInput
-					pass     bool
+					output   policy.PolicyCheckOutput
 					checkErr error
 				)
 				BeforeEach(func() {
 					input = policy.PolicyCheckInput{}
 				})
 				JustBeforeEach(func() {
-					pass, checkErr = checker.Check(input)
+					output, checkErr = checker.Check(input)
 				})
 
 				It("agent should be called", func() {
@@ -99,38 +121,238 @@ var _ = Describe("Policy checker", func() {
 
 				Context("when agent says pass", func() {
 					BeforeEach(func() {
-						fakeAgent.CheckReturns(true, nil)
+						fakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
 					})
 
 					It("it should pass", func() {
 						Expect(checkErr).ToNot(HaveOccurred())
-						Expect(pass).To(BeTrue())
+						Expect(output.Allowed).To(BeTrue())
 					})
 				})
 
 				Context("when agent says not-pass", func() {
 					BeforeEach(func() {
-						fakeAgent.CheckReturns(false, nil)
+						fakeAgent.CheckReturns(policy.PolicyCheckOutput{Reasons: []string{"some-reason"}}, nil)
 					})
 
 					It("should not pass", func() {
 						Expect(checkErr).ToNot(HaveOccurred())
-						Expect(pass).To(BeFalse())
+						Expect(output.Allowed).To(BeFalse())
+					})
+
+					It("should return the reasons and block by default", func() {
+						Expect(output.Reasons).To(Equal([]string{"some-reason"}))
+						Expect(output.Severity).To(Equal(policy.SeverityBlock))
+						Expect(output.ShouldBlock()).To(BeTrue())
+						Expect(output.ShouldWarn()).To(BeFalse())
+					})
+				})
+
+				Context("when agent says not-pass with warn severity", func() {
+					BeforeEach(func() {
+						fakeAgent.CheckReturns(policy.PolicyCheckOutput{
+							Reasons:  []string{"some-reason"},
+							Severity: policy.SeverityWarn,
+						}, nil)
+					})
+
+					It("should warn rather than block", func() {
+						Expect(checkErr).ToNot(HaveOccurred())
+						Expect(output.Allowed).To(BeFalse())
+						Expect(output.ShouldBlock()).To(BeFalse())
+						Expect(output.ShouldWarn()).To(BeTrue())
+					})
+				})
+
+				Context("when agent says not-pass with audit severity", func() {
+					BeforeEach(func() {
+						fakeAgent.CheckReturns(policy.PolicyCheckOutput{
+							Reasons:  []string{"some-reason"},
+							Severity: policy.SeverityAudit,
+						}, nil)
+					})
+
+					It("should neither block nor warn", func() {
+						Expect(checkErr).ToNot(HaveOccurred())
+						Expect(output.ShouldBlock()).To(BeFalse())
+						Expect(output.ShouldWarn()).To(BeFalse())
+					})
+
+					It("should log the reasons", func() {
+						Expect(testLogger.LogMessages()).To(ContainElement("test.policy-checker.policy-check-not-pass"))
+					})
+				})
+
+				Context("when agent says not-pass with an unknown severity", func() {
+					BeforeEach(func() {
+						fakeAgent.CheckReturns(policy.PolicyCheckOutput{Severity: "some-severity"}, nil)
+					})
+
+					It("should return an error", func() {
+						Expect(checkErr).To(MatchError("unknown policy check severity: some-severity"))
 					})
 				})
 
 				Context("when agent says error", func() {
 					BeforeEach(func() {
-						fakeAgent.CheckReturns(false, errors.New("some-error"))
+						fakeAgent.CheckReturns(policy.PolicyCheckOutput{}, errors.New("some-error"))
 					})
 
 					It("should not pass", func() {
 						Expect(checkErr).To(HaveOccurred())
 						Expect(checkErr.Error()).To(Equal("some-error"))
-						Expect(pass).To(BeFalse())
+						Expect(output.Allowed).To(BeFalse())
 					})
 				})
 			})
 		})
 	})
+
+	Context("when the combine mode is unknown", func() {
+		BeforeEach(func() {
+			combine = "some-mode"
+		})
+
+		It("should return an error", func() {
+			Expect(err).To(MatchError("unknown policy check combine mode: some-mode"))
+			Expect(checker).To(BeNil())
+		})
+	})
+
+	Context("when multiple agents are configured", func() {
+		var (
+			output   policy.PolicyCheckOutput
+			checkErr error
+		)
+
+		BeforeEach(func() {
+			anotherFakeAgentFactory.IsConfiguredReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			Expect(err).ToNot(HaveOccurred())
+			output, checkErr = checker.Check(policy.PolicyCheckInput{})
+		})
+
+		Context("when combining with all", func() {
+			Context("when every agent passes", func() {
+				BeforeEach(func() {
+					fakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+					anotherFakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+				})
+
+				It("should pass", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(output.Allowed).To(BeTrue())
+				})
+
+				It("should check with every agent", func() {
+					Expect(fakeAgent.CheckCallCount()).To(Equal(1))
+					Expect(anotherFakeAgent.CheckCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when one agent does not pass", func() {
+				BeforeEach(func() {
+					fakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+					anotherFakeAgent.CheckReturns(policy.PolicyCheckOutput{}, nil)
+				})
+
+				It("should not pass", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(output.Allowed).To(BeFalse())
+				})
+			})
+
+			Context("when multiple agents do not pass", func() {
+				BeforeEach(func() {
+					fakeAgent.CheckReturns(policy.PolicyCheckOutput{
+						Reasons:  []string{"some-reason"},
+						Severity: policy.SeverityWarn,
+					}, nil)
+					anotherFakeAgent.CheckReturns(policy.PolicyCheckOutput{
+						Reasons:  []string{"another-reason"},
+						Severity: policy.SeverityBlock,
+					}, nil)
+				})
+
+				It("should combine the reasons with the most severe severity", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(output).To(Equal(policy.PolicyCheckOutput{
+						Allowed:  false,
+						Reasons:  []string{"some-reason", "another-reason"},
+						Severity: policy.SeverityBlock,
+					}))
+				})
+			})
+
+			Context("when one agent errors", func() {
+				BeforeEach(func() {
+					fakeAgent.CheckReturns(policy.PolicyCheckOutput{}, errors.New("some-error"))
+					anotherFakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+				})
+
+				It("should not pass and return the error", func() {
+					Expect(checkErr).To(MatchError("some-error"))
+					Expect(output.Allowed).To(BeFalse())
+				})
+
+				It("should not ask the remaining agents", func() {
+					Expect(anotherFakeAgent.CheckCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when combining with any", func() {
+			BeforeEach(func() {
+				combine = policy.CombineAny
+			})
+
+			Context("when one agent passes", func() {
+				BeforeEach(func() {
+					fakeAgent.CheckReturns(policy.PolicyCheckOutput{}, errors.New("some-error"))
+					anotherFakeAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+				})
+
+				It("should pass", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(output.Allowed).To(BeTrue())
+				})
+			})
+
+			Context("when no agent passes", func() {
+				BeforeEach(func() {
+					fakeAgent.CheckReturns(policy.PolicyCheckOutput{}, nil)
+					anotherFakeAgent.CheckReturns(policy.PolicyCheckOutput{}, nil)
+				})
+
+				It("should not pass", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(output.Allowed).To(BeFalse())
+				})
+			})
+
+			Context("when no agent passes and one errors", func() {
+				BeforeEach(func() {
+					fakeAgent.CheckReturns(policy.PolicyCheckOutput{}, nil)
+					anotherFakeAgent.CheckReturns(policy.PolicyCheckOutput{}, errors.New("some-error"))
+				})
+
+				It("should return the error", func() {
+					Expect(checkErr).To(MatchError("some-error"))
+					Expect(output.Allowed).To(BeFalse())
+				})
+			})
+		})
+	})
 })
+
+type closableAgent struct {
+	*policyfakes.FakeAgent
+
+	closed bool
+}
+
+func (agent *closableAgent) Close() {
+	agent.closed = true
+}
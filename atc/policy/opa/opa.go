@@ -34,7 +34,15 @@ type opaInput struct {
 }
 
 type opaResult struct {
-	Result *bool `json:"result,omitempty"`
+	Result *json.RawMessage `json:"result,omitempty"`
+}
+
+// opaDecision is the structured form of a policy decision. A policy may also
+// just return a boolean, which is the same as only setting Allowed.
+type opaDecision struct {
+	Allowed  *bool           `json:"allowed"`
+	Reasons  []string        `json:"reasons"`
+	Severity policy.Severity `json:"severity"`
 }
 
 type opa struct {
@@ -42,18 +50,18 @@ type opa struct {
 	logger lager.Logger
 }
 
-func (c opa) Check(input policy.PolicyCheckInput) (bool, error) {
+func (c opa) Check(input policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
 	data := opaInput{input}
 	jsonBytes, err := json.Marshal(data)
 	if err != nil {
-		return false, err
+		return policy.PolicyCheckOutput{}, err
 	}
 
 	c.logger.Debug("opa-check", lager.Data{"input": string(jsonBytes)})
 
 	req, err := http.NewRequest("POST", c.config.URL, bytes.NewBuffer(jsonBytes))
 	if err != nil {
-		return false, err
+		return policy.PolicyCheckOutput{}, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 
@@ -61,31 +69,51 @@ func (c opa) Check(input policy.PolicyCheckInput) (bool, error) {
 	client.Timeout = c.config.Timeout
 	resp, err := client.Do(req)
 	if err != nil {
-		return false, err
+		return policy.PolicyCheckOutput{}, err
 	}
 	defer resp.Body.Close()
 
 	statusCode := resp.StatusCode
 	if statusCode != http.StatusOK {
-		return false, fmt.Errorf("opa returned status: %d", statusCode)
+		return policy.PolicyCheckOutput{}, fmt.Errorf("opa returned status: %d", statusCode)
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return false, fmt.Errorf("opa returned no response: %s", err.Error())
+		return policy.PolicyCheckOutput{}, fmt.Errorf("opa returned no response: %s", err.Error())
 	}
 
 	result := &opaResult{}
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return false, fmt.Errorf("opa returned bad response: %s", err.Error())
+		return policy.PolicyCheckOutput{}, fmt.Errorf("opa returned bad response: %s", err.Error())
 	}
 
 	// If no result returned, meaning that the requested policy decision is
 	// undefined OPA, then consider as pass.
 	if result.Result == nil {
-		return true, nil
+		return policy.PassedPolicyCheck(), nil
+	}
+
+	var allowed bool
+	err = json.Unmarshal(*result.Result, &allowed)
+	if err == nil {
+		return policy.PolicyCheckOutput{Allowed: allowed}, nil
+	}
+
+	decision := opaDecision{}
+	err = json.Unmarshal(*result.Result, &decision)
+	if err != nil {
+		return policy.PolicyCheckOutput{}, fmt.Errorf("opa returned bad result: %s", err.Error())
+	}
+
+	if decision.Allowed == nil {
+		return policy.PolicyCheckOutput{}, fmt.Errorf("opa returned result without allowed")
 	}
 
-	return *result.Result, nil
+	return policy.PolicyCheckOutput{
+		Allowed:  *decision.Allowed,
+		Reasons:  decision.Reasons,
+		Severity: decision.Severity,
+	}, nil
 }
@@ -43,9 +43,9 @@ var _ = Describe("Policy checker", func() {
 		})
 
 		It("should pass", func() {
-			pass, err := agent.Check(policy.PolicyCheckInput{})
+			output, err := agent.Check(policy.PolicyCheckInput{})
 			Expect(err).ToNot(HaveOccurred())
-			Expect(pass).To(BeTrue())
+			Expect(output.Allowed).To(BeTrue())
 		})
 	})
 
@@ -57,9 +57,9 @@ var _ = Describe("Policy checker", func() {
 		})
 
 		It("should pass", func() {
-			pass, err := agent.Check(policy.PolicyCheckInput{})
+			output, err := agent.Check(policy.PolicyCheckInput{})
 			Expect(err).ToNot(HaveOccurred())
-			Expect(pass).To(BeTrue())
+			Expect(output.Allowed).To(BeTrue())
 		})
 	})
 
@@ -71,9 +71,40 @@ var _ = Describe("Policy checker", func() {
 		})
 
 		It("should not pass", func() {
-			pass, err := agent.Check(policy.PolicyCheckInput{})
+			output, err := agent.Check(policy.PolicyCheckInput{})
 			Expect(err).ToNot(HaveOccurred())
-			Expect(pass).To(BeFalse())
+			Expect(output.Allowed).To(BeFalse())
+		})
+	})
+
+	Context("when OPA returns a structured decision", func() {
+		BeforeEach(func() {
+			fakeOpa = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"result": {"allowed": false, "reasons": ["a reason", "another reason"], "severity": "warn"}}`)
+			}))
+		})
+
+		It("should return the reasons and severity", func() {
+			output, err := agent.Check(policy.PolicyCheckInput{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(Equal(policy.PolicyCheckOutput{
+				Allowed:  false,
+				Reasons:  []string{"a reason", "another reason"},
+				Severity: policy.SeverityWarn,
+			}))
+		})
+	})
+
+	Context("when OPA returns a structured decision without allowed", func() {
+		BeforeEach(func() {
+			fakeOpa = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"result": {"reasons": ["a reason"]}}`)
+			}))
+		})
+
+		It("should return error", func() {
+			_, err := agent.Check(policy.PolicyCheckInput{})
+			Expect(err).To(MatchError("opa returned result without allowed"))
 		})
 	})
 
@@ -88,10 +119,10 @@ var _ = Describe("Policy checker", func() {
 		})
 
 		It("should return error", func() {
-			pass, err := agent.Check(policy.PolicyCheckInput{})
+			output, err := agent.Check(policy.PolicyCheckInput{})
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(MatchRegexp("connection refused"))
-			Expect(pass).To(BeFalse())
+			Expect(output.Allowed).To(BeFalse())
 		})
 	})
 
@@ -101,10 +132,10 @@ var _ = Describe("Policy checker", func() {
 		})
 
 		It("should return error", func() {
-			pass, err := agent.Check(policy.PolicyCheckInput{})
+			output, err := agent.Check(policy.PolicyCheckInput{})
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(Equal("opa returned status: 404"))
-			Expect(pass).To(BeFalse())
+			Expect(output.Allowed).To(BeFalse())
 		})
 	})
 
@@ -116,10 +147,10 @@ var _ = Describe("Policy checker", func() {
 		})
 
 		It("should return error", func() {
-			pass, err := agent.Check(policy.PolicyCheckInput{})
+			output, err := agent.Check(policy.PolicyCheckInput{})
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(Equal("opa returned bad response: invalid character 'h' looking for beginning of value"))
-			Expect(pass).To(BeFalse())
+			Expect(output.Allowed).To(BeFalse())
 		})
 	})
 })
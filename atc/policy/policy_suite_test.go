@@ -20,6 +20,9 @@ var (
 
 	fakeAgent        *policyfakes.FakeAgent
 	fakeAgentFactory *policyfakes.FakeAgentFactory
+
+	anotherFakeAgent        *policyfakes.FakeAgent
+	anotherFakeAgentFactory *policyfakes.FakeAgentFactory
 )
 
 var _ = BeforeSuite(func() {
@@ -27,4 +30,9 @@ var _ = BeforeSuite(func() {
 	fakeAgentFactory.IsConfiguredReturns(true)
 	fakeAgentFactory.DescriptionReturns("fakeAgent")
 	policy.RegisterAgent(fakeAgentFactory)
+
+	// not configured unless a test says otherwise
+	anotherFakeAgentFactory = new(policyfakes.FakeAgentFactory)
+	anotherFakeAgentFactory.DescriptionReturns("anotherFakeAgent")
+	policy.RegisterAgent(anotherFakeAgentFactory)
 })
@@ -0,0 +1,115 @@
+package rego
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	oparego "github.com/open-policy-agent/opa/rego"
+)
+
+// policyFiles returns every file under the given paths which OPA would load:
+// policies, data documents and bundle tarballs. A path may be a single file
+// or a directory, which is walked recursively.
+func policyFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !info.IsDir() && isPolicyFile(file) {
+				files = append(files, file)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+func isPolicyFile(file string) bool {
+	switch filepath.Ext(file) {
+	case ".rego", ".json", ".yaml", ".yml":
+		return true
+	default:
+		return isBundle(file)
+	}
+}
+
+func isBundle(file string) bool {
+	return strings.HasSuffix(file, ".tar.gz")
+}
+
+// fingerprint identifies the current state of the policy files, so that they
+// are only loaded again when something changed.
+func fingerprint(files []string) (string, error) {
+	hash := sha256.New()
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(hash, "%s:%d:%d\n", file, info.Size(), info.ModTime().UnixNano())
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// prepareQuery loads the policies and compiles the query against them.
+// Bundle tarballs are loaded as bundles, and anything else is loaded the
+// same as 'opa run' loads its paths, with data files placed under data by
+// their directory.
+func prepareQuery(query string, paths []string, files []string) (oparego.PreparedEvalQuery, error) {
+	policies := 0
+	for _, file := range files {
+		if filepath.Ext(file) == ".rego" || isBundle(file) {
+			policies++
+		}
+	}
+
+	if policies == 0 {
+		return oparego.PreparedEvalQuery{}, fmt.Errorf("no .rego files or bundles found")
+	}
+
+	options := []func(*oparego.Rego){
+		oparego.Query(query),
+	}
+
+	var loadPaths []string
+	for _, path := range paths {
+		if isBundle(path) {
+			options = append(options, oparego.LoadBundle(path))
+		} else {
+			loadPaths = append(loadPaths, path)
+		}
+	}
+
+	if len(loadPaths) > 0 {
+		options = append(options, oparego.Load(loadPaths, nil))
+	}
+
+	return oparego.New(options...).PrepareForEval(context.Background())
+}
@@ -0,0 +1,199 @@
+// Package rego is a policy agent which evaluates Rego policies from local
+// files with an embedded OPA, so that policy checks do not need an OPA
+// server.
+package rego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/open-policy-agent/opa/ast"
+	oparego "github.com/open-policy-agent/opa/rego"
+
+	"github.com/concourse/concourse/atc/policy"
+)
+
+type RegoConfig struct {
+	Paths          []string      `long:"rego-policy-path" description:"Path to a .rego or data file, a directory of them, or a .tar.gz bundle to evaluate policy checks against. Can be specified multiple times."`
+	Query          string        `long:"rego-query" default:"data.concourse.allow" description:"Rego query whose result decides the policy check."`
+	ReloadInterval time.Duration `long:"rego-reload-interval" default:"10s" description:"Interval on which to reload policy files that have changed. Set to 0 to disable."`
+}
+
+func init() {
+	policy.RegisterAgent(&RegoConfig{})
+}
+
+func (c *RegoConfig) Description() string { return "Embedded Rego" }
+func (c *RegoConfig) IsConfigured() bool  { return len(c.Paths) > 0 }
+
+func (c *RegoConfig) NewAgent(logger lager.Logger) (policy.Agent, error) {
+	_, err := ast.ParseBody(c.Query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rego query: %s", err.Error())
+	}
+
+	agent := &rego{
+		config: *c,
+		logger: logger,
+	}
+
+	_, err = agent.reload()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rego policies: %s", err.Error())
+	}
+
+	if c.ReloadInterval > 0 {
+		agent.stop = make(chan struct{})
+		go agent.reloadPeriodically()
+	}
+
+	return agent, nil
+}
+
+type rego struct {
+	config RegoConfig
+	logger lager.Logger
+
+	lock        sync.RWMutex
+	query       oparego.PreparedEvalQuery
+	fingerprint string
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// regoDecision is the structured form of a policy decision, the same as
+// accepted from an OPA server.
+type regoDecision struct {
+	Allowed  *bool           `json:"allowed"`
+	Reasons  []string        `json:"reasons"`
+	Severity policy.Severity `json:"severity"`
+}
+
+// Close stops reloading the policy files.
+func (r *rego) Close() {
+	r.closeOnce.Do(func() {
+		if r.stop != nil {
+			close(r.stop)
+		}
+	})
+}
+
+func (r *rego) Check(input policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+	r.lock.RLock()
+	query := r.query
+	r.lock.RUnlock()
+
+	results, err := query.Eval(context.Background(), oparego.EvalInput(input))
+	if err != nil {
+		return policy.PolicyCheckOutput{}, err
+	}
+
+	// Same as OPA, an undefined decision is considered as pass.
+	if len(results) == 0 || len(results[0].Expressions) == 0 {
+		return policy.PassedPolicyCheck(), nil
+	}
+
+	output, err := decision(results[0].Expressions[0].Value)
+	if err != nil {
+		return policy.PolicyCheckOutput{}, fmt.Errorf("rego query %s %s", r.config.Query, err.Error())
+	}
+
+	return output, nil
+}
+
+// decision converts the result of the query, which is either a boolean or
+// an object with allowed, reasons and severity, into an output.
+func decision(result interface{}) (policy.PolicyCheckOutput, error) {
+	switch value := result.(type) {
+	case bool:
+		return policy.PolicyCheckOutput{Allowed: value}, nil
+
+	case map[string]interface{}:
+		payload, err := json.Marshal(value)
+		if err != nil {
+			return policy.PolicyCheckOutput{}, err
+		}
+
+		decision := regoDecision{}
+		err = json.Unmarshal(payload, &decision)
+		if err != nil {
+			return policy.PolicyCheckOutput{}, fmt.Errorf("returned invalid object: %s", err.Error())
+		}
+
+		if decision.Allowed == nil {
+			return policy.PolicyCheckOutput{}, fmt.Errorf("returned object without boolean allowed")
+		}
+
+		return policy.PolicyCheckOutput{
+			Allowed:  *decision.Allowed,
+			Reasons:  decision.Reasons,
+			Severity: decision.Severity,
+		}, nil
+
+	default:
+		return policy.PolicyCheckOutput{}, fmt.Errorf("returned %T instead of boolean or object", result)
+	}
+}
+
+// reload loads the policy files again if any of them changed since the last
+// load. It returns true if the policies were replaced.
+func (r *rego) reload() (bool, error) {
+	files, err := policyFiles(r.config.Paths)
+	if err != nil {
+		return false, err
+	}
+
+	current, err := fingerprint(files)
+	if err != nil {
+		return false, err
+	}
+
+	r.lock.RLock()
+	unchanged := current == r.fingerprint
+	r.lock.RUnlock()
+
+	if unchanged {
+		return false, nil
+	}
+
+	query, err := prepareQuery(r.config.Query, r.config.Paths, files)
+	if err != nil {
+		return false, err
+	}
+
+	r.lock.Lock()
+	r.query = query
+	r.fingerprint = current
+	r.lock.Unlock()
+
+	return true, nil
+}
+
+func (r *rego) reloadPeriodically() {
+	ticker := time.NewTicker(r.config.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+
+		reloaded, err := r.reload()
+		if err != nil {
+			// keep evaluating against the last good policies
+			r.logger.Error("failed-to-reload-policies", err)
+			continue
+		}
+
+		if reloaded {
+			r.logger.Info("reloaded-policies")
+		}
+	}
+}
@@ -0,0 +1,445 @@
+package rego_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/rego"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rego policy agent", func() {
+	var (
+		logger = lagertest.NewTestLogger("rego-test")
+
+		policyDir string
+		config    rego.RegoConfig
+		agent     policy.Agent
+		err       error
+	)
+
+	writePolicy := func(name string, source string) {
+		path := filepath.Join(policyDir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(source), 0644)).To(Succeed())
+	}
+
+	check := func(input policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+		Expect(err).ToNot(HaveOccurred())
+		return agent.Check(input)
+	}
+
+	BeforeEach(func() {
+		policyDir, err = ioutil.TempDir("", "rego-policies")
+		Expect(err).ToNot(HaveOccurred())
+
+		config = rego.RegoConfig{
+			Paths: []string{policyDir},
+			Query: "data.concourse.allow",
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(policyDir)
+	})
+
+	JustBeforeEach(func() {
+		agent, err = config.NewAgent(logger)
+	})
+
+	It("is configured when paths are given", func() {
+		Expect(config.IsConfigured()).To(BeTrue())
+		Expect((&rego.RegoConfig{}).IsConfigured()).To(BeFalse())
+	})
+
+	Context("when the policies deny based on the input", func() {
+		BeforeEach(func() {
+			writePolicy("images.rego", `
+package concourse
+
+default allow = false
+
+allow {
+	count(deny) == 0
+}
+
+deny[msg] {
+	input.action == "UseImage"
+	image := input.data.image
+	not startswith(image, "registry.example.com/")
+	msg := sprintf("image %s is not allowed", [image])
+}
+`)
+		})
+
+		It("passes allowed input", func() {
+			output, err := check(policy.PolicyCheckInput{
+				Action: "UseImage",
+				Data:   map[string]interface{}{"image": "registry.example.com/busybox"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+
+		It("rejects denied input", func() {
+			output, err := check(policy.PolicyCheckInput{
+				Action: "UseImage",
+				Data:   map[string]interface{}{"image": "docker.io/busybox"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeFalse())
+		})
+
+		It("passes other actions", func() {
+			output, err := check(policy.PolicyCheckInput{Action: "SaveConfig"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when the policies are split across packages and directories", func() {
+		BeforeEach(func() {
+			writePolicy("main.rego", `
+package concourse
+
+import data.concourse.teams
+
+allow {
+	teams.allowed[input.team]
+}
+`)
+			writePolicy("nested/teams.rego", `
+package concourse.teams
+
+allowed := {t | t := ["main", "ops"][_]}
+`)
+			writePolicy("nested/README.md", "not a policy")
+		})
+
+		It("evaluates rules across packages", func() {
+			output, err := check(policy.PolicyCheckInput{Team: "ops"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+
+			output, err = check(policy.PolicyCheckInput{Team: "dev"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue(), "undefined decision is a pass")
+		})
+	})
+
+	Context("when the policies use rules with several bodies, functions, else and with", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", `
+package concourse
+
+allow {
+	input.team == "main"
+}
+
+allow {
+	trusted(input.data.image)
+}
+
+trusted(image) {
+	startswith(image, "registry.example.com/")
+}
+
+tier = "prod" {
+	input.pipeline == "deploy"
+} else = "dev" {
+	true
+}
+
+test_tier {
+	tier == "prod" with input as {"pipeline": "deploy"}
+}
+`)
+		})
+
+		It("evaluates them as OPA does", func() {
+			output, err := check(policy.PolicyCheckInput{Team: "main"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+
+			output, err = check(policy.PolicyCheckInput{Team: "other", Data: map[string]interface{}{"image": "registry.example.com/busybox"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+
+			config.Query = "data.concourse.test_tier"
+			agent, err = config.NewAgent(logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			output, err = agent.Check(policy.PolicyCheckInput{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when the policies read data documents", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", `
+package concourse
+
+allow {
+	data.teams.allowed[_] == input.team
+}
+`)
+			writePolicy("teams/data.json", `{"allowed": ["main"]}`)
+		})
+
+		It("places the documents under data by their directory", func() {
+			output, err := check(policy.PolicyCheckInput{Team: "main"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when the path is a bundle tarball", func() {
+		BeforeEach(func() {
+			bundlePath := filepath.Join(policyDir, "bundle.tar.gz")
+
+			file, err := os.Create(bundlePath)
+			Expect(err).ToNot(HaveOccurred())
+
+			gz := gzip.NewWriter(file)
+			tw := tar.NewWriter(gz)
+
+			for name, content := range map[string]string{
+				"/policy.rego": "package concourse\n\nallow = false { input.team == data.blocked }\n",
+				"/data.json":   `{"blocked": "dev"}`,
+			} {
+				Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))})).To(Succeed())
+				_, err = tw.Write([]byte(content))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(tw.Close()).To(Succeed())
+			Expect(gz.Close()).To(Succeed())
+			Expect(file.Close()).To(Succeed())
+
+			config.Paths = []string{bundlePath}
+		})
+
+		It("loads the bundle", func() {
+			output, err := check(policy.PolicyCheckInput{Team: "dev"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeFalse())
+
+			output, err = check(policy.PolicyCheckInput{Team: "main"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when the query is undefined", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", `
+package concourse
+
+allow {
+	input.team == "main"
+}
+`)
+		})
+
+		It("passes", func() {
+			output, err := check(policy.PolicyCheckInput{Team: "other"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when the query does not return a boolean", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", `
+package concourse
+
+allow = "yes"
+`)
+		})
+
+		It("errors", func() {
+			_, err := check(policy.PolicyCheckInput{})
+			Expect(err).To(MatchError("rego query data.concourse.allow returned string instead of boolean or object"))
+		})
+	})
+
+	Context("when the query returns a structured decision", func() {
+		BeforeEach(func() {
+			config.Query = "data.concourse.decision"
+
+			writePolicy("policy.rego", `
+package concourse
+
+decision = {
+	"allowed": count(deny) == 0,
+	"reasons": deny,
+	"severity": "warn",
+}
+
+deny[msg] {
+	input.data.privileged
+	msg := "privileged images are discouraged"
+}
+`)
+		})
+
+		It("returns the reasons and severity", func() {
+			output, err := check(policy.PolicyCheckInput{Data: map[string]interface{}{"privileged": true}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(Equal(policy.PolicyCheckOutput{
+				Allowed:  false,
+				Reasons:  []string{"privileged images are discouraged"},
+				Severity: policy.SeverityWarn,
+			}))
+
+			output, err = check(policy.PolicyCheckInput{Data: map[string]interface{}{"privileged": false}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when a custom query is configured", func() {
+		BeforeEach(func() {
+			config.Query = "data.custom.decision.ok"
+
+			writePolicy("policy.rego", `
+package custom.decision
+
+ok {
+	some i
+	input.data.labels[i] == "approved"
+}
+
+ok = false {
+	count(input.data.labels) == 0
+}
+`)
+		})
+
+		It("evaluates the configured query", func() {
+			output, err := check(policy.PolicyCheckInput{Data: map[string]interface{}{"labels": []string{"x", "approved"}}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+
+			output, err = check(policy.PolicyCheckInput{Data: map[string]interface{}{"labels": []string{}}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeFalse())
+		})
+	})
+
+	Context("when a rule has conflicting values", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", `
+package concourse
+
+allow = true { input.team == "main" }
+allow = false { input.pipeline == "prod" }
+`)
+		})
+
+		It("errors", func() {
+			_, err := check(policy.PolicyCheckInput{Team: "main", Pipeline: "prod"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when a policy file is invalid", func() {
+		BeforeEach(func() {
+			writePolicy("policy.rego", `
+package concourse
+
+allow {
+`)
+		})
+
+		It("fails to create the agent", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to load rego policies"))
+		})
+	})
+
+	Context("when no policy files exist", func() {
+		It("fails to create the agent", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when the query is invalid", func() {
+		BeforeEach(func() {
+			config.Query = "data..allow"
+			writePolicy("policy.rego", "package concourse\n")
+		})
+
+		It("fails to create the agent", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid rego query"))
+		})
+	})
+
+	Context("when the policy files change", func() {
+		BeforeEach(func() {
+			config.ReloadInterval = 10 * time.Millisecond
+
+			writePolicy("policy.rego", `
+package concourse
+
+allow = false
+`)
+		})
+
+		It("reloads them", func() {
+			output, err := check(policy.PolicyCheckInput{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeFalse())
+
+			writePolicy("policy.rego", `
+package concourse
+
+allow = true
+`)
+
+			Eventually(func() bool {
+				output, _ := agent.Check(policy.PolicyCheckInput{})
+				return output.Allowed
+			}).Should(BeTrue())
+		})
+
+		It("stops reloading them once closed", func() {
+			agent.(policy.Closer).Close()
+
+			writePolicy("policy.rego", `
+package concourse
+
+allow = true
+`)
+
+			Consistently(func() bool {
+				output, _ := agent.Check(policy.PolicyCheckInput{})
+				return output.Allowed
+			}, 100*time.Millisecond).Should(BeFalse())
+		})
+
+		It("keeps the last good policies when the new ones are invalid", func() {
+			writePolicy("policy.rego", `
+package concourse
+
+allow =
+`)
+
+			Eventually(logger.LogMessages).Should(ContainElement("rego-test.failed-to-reload-policies"))
+
+			output, err := check(policy.PolicyCheckInput{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,18 @@
+package atc
+
+// PolicyCheckHeader is set on API responses for requests that did not pass
+// policy check, either because they were rejected or because the policy only
+// warns about them. Its value is a JSON encoded PolicyCheckResult.
+const PolicyCheckHeader = "X-Concourse-Policy-Check"
+
+const (
+	PolicyCheckSeverityBlock = "block"
+	PolicyCheckSeverityWarn  = "warn"
+	PolicyCheckSeverityAudit = "audit"
+)
+
+type PolicyCheckResult struct {
+	Allowed  bool     `json:"allowed"`
+	Reasons  []string `json:"reasons,omitempty"`
+	Severity string   `json:"severity,omitempty"`
+}
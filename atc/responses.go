@@ -12,3 +12,9 @@ type SaveConfigResponse struct {
 type ConfigResponse struct {
 	Config Config `json:"config"`
 }
+
+type CheckCredsResponse struct {
+	Vars     []VarCheck      `json:"vars"`
+	Errors   []string        `json:"errors,omitempty"`
+	Warnings []ConfigWarning `json:"warnings,omitempty"`
+}
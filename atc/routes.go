@@ -3,12 +3,14 @@ package atc
 import "github.com/tedsuo/rata"
 
 const (
-	SaveConfig = "SaveConfig"
-	GetConfig  = "GetConfig"
+	SaveConfig       = "SaveConfig"
+	GetConfig        = "GetConfig"
+	CheckConfigCreds = "CheckConfigCreds"
 
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
 	CreateBuild         = "CreateBuild"
+	CreateJobPlan       = "CreateJobPlan"
 	ListBuilds          = "ListBuilds"
 	BuildEvents         = "BuildEvents"
 	BuildResources      = "BuildResources"
@@ -24,6 +26,7 @@ const (
 	ListJobs       = "ListJobs"
 	ListJobBuilds  = "ListJobBuilds"
 	ListJobInputs  = "ListJobInputs"
+	ListJobStats   = "ListJobStats"
 	GetJobBuild    = "GetJobBuild"
 	PauseJob       = "PauseJob"
 	UnpauseJob     = "UnpauseJob"
@@ -69,6 +72,9 @@ const (
 	ListPipelineBuilds  = "ListPipelineBuilds"
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
+	PipelineEvents      = "PipelineEvents"
+
+	ListNotificationDeliveries = "ListNotificationDeliveries"
 
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
@@ -88,6 +94,8 @@ const (
 	ListContainers           = "ListContainers"
 	GetContainer             = "GetContainer"
 	HijackContainer          = "HijackContainer"
+	StreamInContainer        = "StreamInContainer"
+	StreamOutContainer       = "StreamOutContainer"
 	ListDestroyingContainers = "ListDestroyingContainers"
 	ReportWorkerContainers   = "ReportWorkerContainers"
 
@@ -101,6 +109,11 @@ const (
 	RenameTeam     = "RenameTeam"
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
+	TeamEvents     = "TeamEvents"
+
+	ExplainTeamAuth = "ExplainTeamAuth"
+	ExportTeam      = "ExportTeam"
+	ImportTeam      = "ImportTeam"
 
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
@@ -112,6 +125,23 @@ const (
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
+
+	ListWallMessages  = "ListWallMessages"
+	CreateWallMessage = "CreateWallMessage"
+	DeleteWallMessage = "DeleteWallMessage"
+
+	GetMaintenance     = "GetMaintenance"
+	EnableMaintenance  = "EnableMaintenance"
+	DisableMaintenance = "DisableMaintenance"
+
+	ListAPITokens  = "ListAPITokens"
+	CreateAPIToken = "CreateAPIToken"
+	DeleteAPIToken = "DeleteAPIToken"
+
+	ListSessions         = "ListSessions"
+	RevokeSession        = "RevokeSession"
+	RevokeCurrentSession = "RevokeCurrentSession"
+	RevokeUserSessions   = "RevokeUserSessions"
 )
 
 const (
@@ -122,8 +152,10 @@ const (
 var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "PUT", Name: SaveConfig},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "GET", Name: GetConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/check-creds", Method: "POST", Name: CheckConfigCreds},
 
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
+	{Path: "/api/v1/teams/:team_name/job-plan", Method: "POST", Name: CreateJobPlan},
 
 	{Path: "/api/v1/builds", Method: "GET", Name: ListBuilds},
 	{Path: "/api/v1/builds/:build_id", Method: "GET", Name: GetBuild},
@@ -139,6 +171,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/jobs", Method: "GET", Name: ListAllJobs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs", Method: "GET", Name: ListJobs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name", Method: "GET", Name: GetJob},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/job-stats", Method: "GET", Name: ListJobStats},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "GET", Name: ListJobBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "POST", Name: RerunJobBuild},
@@ -167,6 +200,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/events", Method: "GET", Name: PipelineEvents},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/notification-deliveries", Method: "GET", Name: ListNotificationDeliveries},
 
 	{Path: "/api/v1/resources", Method: "GET", Name: ListAllResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources", Method: "GET", Name: ListResources},
@@ -206,12 +241,19 @@ var Routes = rata.Routes([]rata.Route{
 
 	{Path: "/api/v1/user", Method: "GET", Name: GetUser},
 	{Path: "/api/v1/users", Method: "GET", Name: ListActiveUsersSince},
+	{Path: "/api/v1/users/:connector/:user_sub/sessions", Method: "DELETE", Name: RevokeUserSessions},
+
+	{Path: "/api/v1/sessions", Method: "GET", Name: ListSessions},
+	{Path: "/api/v1/sessions/:session_id", Method: "DELETE", Name: RevokeSession},
+	{Path: "/api/v1/session", Method: "DELETE", Name: RevokeCurrentSession},
 
 	{Path: "/api/v1/containers/destroying", Method: "GET", Name: ListDestroyingContainers},
 	{Path: "/api/v1/containers/report", Method: "PUT", Name: ReportWorkerContainers},
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
 	{Path: "/api/v1/teams/:team_name/containers/:id", Method: "GET", Name: GetContainer},
 	{Path: "/api/v1/teams/:team_name/containers/:id/hijack", Method: "GET", Name: HijackContainer},
+	{Path: "/api/v1/teams/:team_name/containers/:id/files", Method: "PUT", Name: StreamInContainer},
+	{Path: "/api/v1/teams/:team_name/containers/:id/files", Method: "GET", Name: StreamOutContainer},
 
 	{Path: "/api/v1/teams/:team_name/volumes", Method: "GET", Name: ListVolumes},
 	{Path: "/api/v1/volumes/destroying", Method: "GET", Name: ListDestroyingVolumes},
@@ -223,6 +265,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/events", Method: "GET", Name: TeamEvents},
+	{Path: "/api/v1/teams/:team_name/auth/explain", Method: "POST", Name: ExplainTeamAuth},
+	{Path: "/api/v1/teams/:team_name/export", Method: "GET", Name: ExportTeam},
+	{Path: "/api/v1/teams/:team_name/import", Method: "PUT", Name: ImportTeam},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
@@ -230,4 +276,15 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/wall", Method: "GET", Name: GetWall},
 	{Path: "/api/v1/wall", Method: "PUT", Name: SetWall},
 	{Path: "/api/v1/wall", Method: "DELETE", Name: ClearWall},
+	{Path: "/api/v1/wall/messages", Method: "GET", Name: ListWallMessages},
+	{Path: "/api/v1/wall/messages", Method: "POST", Name: CreateWallMessage},
+	{Path: "/api/v1/wall/messages/:message_id", Method: "DELETE", Name: DeleteWallMessage},
+
+	{Path: "/api/v1/maintenance", Method: "GET", Name: GetMaintenance},
+	{Path: "/api/v1/maintenance", Method: "PUT", Name: EnableMaintenance},
+	{Path: "/api/v1/maintenance", Method: "DELETE", Name: DisableMaintenance},
+
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "GET", Name: ListAPITokens},
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "POST", Name: CreateAPIToken},
+	{Path: "/api/v1/teams/:team_name/tokens/:token_name", Method: "DELETE", Name: DeleteAPIToken},
 })
@@ -36,16 +36,19 @@ type Build interface {
 func NewBuildStarter(
 	planner BuildPlanner,
 	algorithm Algorithm,
+	maintenance db.Maintenance,
 ) BuildStarter {
 	return &buildStarter{
-		planner:   planner,
-		algorithm: algorithm,
+		planner:     planner,
+		algorithm:   algorithm,
+		maintenance: maintenance,
 	}
 }
 
 type buildStarter struct {
-	planner   BuildPlanner
-	algorithm Algorithm
+	planner     BuildPlanner
+	algorithm   Algorithm
+	maintenance db.Maintenance
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -53,6 +56,18 @@ func (s *buildStarter) TryStartPendingBuildsForJob(
 	job db.SchedulerJob,
 	jobInputs db.InputConfigs,
 ) (bool, error) {
+	mode, err := s.maintenance.Mode()
+	if err != nil {
+		return false, fmt.Errorf("get maintenance mode: %w", err)
+	}
+
+	if mode.Enabled {
+		// Leave the pending builds be and retry until maintenance mode is
+		// disabled
+		logger.Debug("maintenance-mode-enabled")
+		return true, nil
+	}
+
 	nextPendingBuilds, err := job.GetPendingBuilds()
 	if err != nil {
 		return false, fmt.Errorf("get pending builds: %w", err)
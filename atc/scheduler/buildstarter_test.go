@@ -22,6 +22,8 @@ var _ = Describe("BuildStarter", func() {
 		pendingBuilds []db.Build
 		fakeAlgorithm *schedulerfakes.FakeAlgorithm
 
+		fakeMaintenance *dbfakes.FakeMaintenance
+
 		buildStarter scheduler.BuildStarter
 
 		jobInputs db.InputConfigs
@@ -34,7 +36,9 @@ var _ = Describe("BuildStarter", func() {
 		fakePlanner = new(schedulerfakes.FakeBuildPlanner)
 		fakeAlgorithm = new(schedulerfakes.FakeAlgorithm)
 
-		buildStarter = scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm)
+		fakeMaintenance = new(dbfakes.FakeMaintenance)
+
+		buildStarter = scheduler.NewBuildStarter(fakePlanner, fakeAlgorithm, fakeMaintenance)
 
 		disaster = errors.New("bad thing")
 	})
@@ -102,6 +106,49 @@ var _ = Describe("BuildStarter", func() {
 				}
 			})
 
+			Context("when maintenance mode is enabled", func() {
+				BeforeEach(func() {
+					fakeMaintenance.ModeReturns(atc.Maintenance{Enabled: true, Reason: "upgrading"}, nil)
+				})
+
+				JustBeforeEach(func() {
+					needsReschedule, tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+						lagertest.NewTestLogger("test"),
+						db.SchedulerJob{
+							Job:           job,
+							Resources:     resources,
+							ResourceTypes: versionedResourceTypes,
+						},
+						jobInputs,
+					)
+				})
+
+				It("does not start any pending build", func() {
+					Expect(tryStartErr).NotTo(HaveOccurred())
+					Expect(job.GetPendingBuildsCallCount()).To(BeZero())
+					Expect(job.ScheduleBuildCallCount()).To(BeZero())
+				})
+
+				It("needs to be rescheduled", func() {
+					Expect(needsReschedule).To(BeTrue())
+				})
+			})
+
+			Context("when getting the maintenance mode fails", func() {
+				BeforeEach(func() {
+					fakeMaintenance.ModeReturns(atc.Maintenance{}, disaster)
+				})
+
+				It("returns an error", func() {
+					_, err := buildStarter.TryStartPendingBuildsForJob(
+						lagertest.NewTestLogger("test"),
+						db.SchedulerJob{Job: job},
+						jobInputs,
+					)
+					Expect(err).To(Equal(fmt.Errorf("get maintenance mode: %w", disaster)))
+				})
+			})
+
 			Context("when one pending build is aborted before start", func() {
 				var abortedBuild *dbfakes.FakeBuild
 
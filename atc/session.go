@@ -0,0 +1,21 @@
+package atc
+
+// Session is a token issued by skymarshal which has been used against the
+// API. Sessions can be revoked before the token expires.
+type Session struct {
+	ID         int    `json:"id"`
+	Sub        string `json:"sub"`
+	UserName   string `json:"user_name"`
+	Connector  string `json:"connector"`
+	CreatedAt  int64  `json:"created_at"`
+	LastUsedAt int64  `json:"last_used_at"`
+	ExpiresAt  int64  `json:"expires_at"`
+
+	// Current is set on the session used to make the request.
+	Current bool `json:"current,omitempty"`
+}
+
+type RevokedSessions struct {
+	Count     int `json:"count"`
+	APITokens int `json:"api_tokens"`
+}
@@ -78,6 +78,26 @@ func (validator *StepValidator) VisitTask(plan *TaskStep) error {
 		validator.popContext()
 	}
 
+	if plan.IDToken != nil {
+		validator.pushContext(".id_token")
+
+		if len(plan.IDToken.Audience) == 0 {
+			validator.recordError("must specify an `audience:`")
+		}
+
+		if plan.IDToken.Expiry != "" {
+			if _, err := time.ParseDuration(plan.IDToken.Expiry); err != nil {
+				validator.recordError("invalid expiry '%s'", plan.IDToken.Expiry)
+			}
+		}
+
+		if strings.HasPrefix(plan.IDToken.File, "/") || strings.Contains(plan.IDToken.File, "..") {
+			validator.recordError("file '%s' must be relative to the task's working directory", plan.IDToken.File)
+		}
+
+		validator.popContext()
+	}
+
 	return nil
 }
 
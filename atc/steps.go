@@ -319,6 +319,7 @@ type TaskStep struct {
 	InputMapping      map[string]string `json:"input_mapping,omitempty"`
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
+	IDToken           *IDTokenConfig    `json:"id_token,omitempty"`
 }
 
 func (step *TaskStep) ParseJSON(data []byte) error {
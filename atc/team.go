@@ -1,24 +1,156 @@
 package atc
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"path"
 )
 
 var (
 	ErrAuthConfigEmpty   = errors.New("auth config for the team must not be empty")
 	ErrAuthConfigInvalid = errors.New("auth config for the team does not have users and groups configured")
+
+	ErrCredentialManagerTypeEmpty = errors.New("credential manager for the team must have a type")
+
+	ErrCustomRoleNameEmpty        = errors.New("custom role for the team must have a name")
+	ErrCustomRolePermissionsEmpty = errors.New("custom role for the team must extend a role or grant actions")
 )
 
 type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	CredentialManager *TeamCredentialManager `json:"credential_manager,omitempty"`
+	CustomRoles       []TeamRole             `json:"custom_roles,omitempty"`
+
+	// RemoveCredentialManager is sent as an explicit null credential_manager.
+	// Clients which omit the field, such as older versions of fly, leave the
+	// team's credential manager unchanged.
+	RemoveCredentialManager bool `json:"-"`
+}
+
+// teamJSON is Team without its JSON methods.
+type teamJSON Team
+
+func (team Team) MarshalJSON() ([]byte, error) {
+	if !team.RemoveCredentialManager || team.CredentialManager != nil {
+		return json.Marshal(teamJSON(team))
+	}
+
+	return json.Marshal(struct {
+		teamJSON
+		CredentialManager *TeamCredentialManager `json:"credential_manager"`
+	}{teamJSON: teamJSON(team)})
+}
+
+func (team *Team) UnmarshalJSON(payload []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(payload, &fields)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(payload, (*teamJSON)(team))
+	if err != nil {
+		return err
+	}
+
+	credentialManager, found := fields["credential_manager"]
+	team.RemoveCredentialManager = found && string(credentialManager) == "null"
+
+	return nil
 }
 
 func (team Team) Validate() error {
-	return team.Auth.Validate()
+	if team.CredentialManager != nil && team.CredentialManager.Type == "" {
+		return ErrCredentialManagerTypeEmpty
+	}
+
+	seen := map[string]bool{}
+	for _, role := range team.CustomRoles {
+		if err := role.Validate(); err != nil {
+			return err
+		}
+
+		if seen[role.Name] {
+			return fmt.Errorf("custom role '%s' is defined more than once", role.Name)
+		}
+
+		seen[role.Name] = true
+	}
+
+	err := team.Auth.Validate()
+	if err != nil {
+		return err
+	}
+
+	for _, role := range team.CustomRoles {
+		if len(team.Auth[role.Name]["pipelines"]) > 0 && !role.canScopeToPipelines() {
+			return pipelineScopeError(role.Name)
+		}
+	}
+
+	return nil
+}
+
+// TeamCredentialManager configures a credential manager owned by a team. It
+// takes the same config as a pipeline's var_sources and is consulted for the
+// team's ((vars)) before the cluster-wide credential manager.
+type TeamCredentialManager struct {
+	Type   string                 `json:"type"`
+	Config map[string]interface{} `json:"config"`
 }
 
+// TeamRole is a role defined by a team on top of the built-in roles. It grants
+// everything granted by the role it extends, plus the listed actions.
+type TeamRole struct {
+	Name        string               `json:"name"`
+	Extends     string               `json:"extends,omitempty"`
+	Permissions []TeamRolePermission `json:"permissions,omitempty"`
+}
+
+func (role TeamRole) Validate() error {
+	if role.Name == "" {
+		return ErrCustomRoleNameEmpty
+	}
+
+	if role.Extends == "" && len(role.Permissions) == 0 {
+		return ErrCustomRolePermissionsEmpty
+	}
+
+	for _, permission := range role.Permissions {
+		if permission.Action == "" {
+			return fmt.Errorf("custom role '%s' has a permission without an action", role.Name)
+		}
+	}
+
+	return nil
+}
+
+// canScopeToPipelines returns whether the custom role may be granted on only
+// some of the team's pipelines, which is the same as for the role it extends.
+func (role TeamRole) canScopeToPipelines() bool {
+	for _, permission := range role.Permissions {
+		if permission.Action == SaveConfig {
+			return false
+		}
+	}
+
+	return role.Extends == "" || role.Extends == "viewer" || role.Extends == "pipeline-operator"
+}
+
+// TeamRolePermission grants a single API action, optionally only on the
+// given pipelines.
+type TeamRolePermission struct {
+	Action    string   `json:"action"`
+	Pipelines []string `json:"pipelines,omitempty"`
+}
+
+// TeamAuth maps each role to the "users" and "groups" it is granted to. The
+// viewer and pipeline-operator roles may be restricted to some of the team's
+// pipelines by listing their names or glob patterns under "pipelines".
 type TeamAuth map[string]map[string][]string
 
 func (auth TeamAuth) Validate() error {
@@ -26,14 +158,31 @@ func (auth TeamAuth) Validate() error {
 		return ErrAuthConfigEmpty
 	}
 
-	for _, config := range auth {
+	for role, config := range auth {
 		users := config["users"]
 		groups := config["groups"]
 
 		if len(users) == 0 && len(groups) == 0 {
 			return ErrAuthConfigInvalid
 		}
+
+		if len(config["pipelines"]) > 0 && (role == "owner" || role == "member") {
+			return pipelineScopeError(role)
+		}
+
+		for _, pipeline := range config["pipelines"] {
+			if _, err := path.Match(pipeline, ""); err != nil {
+				return fmt.Errorf("role '%s' has an invalid pipeline pattern '%s'", role, pipeline)
+			}
+		}
 	}
 
 	return nil
 }
+
+// pipelineScopeError is returned for roles which can save pipeline configs
+// but are restricted to some pipelines. A config can set other pipelines and
+// read any of the team's credentials, which would escape the restriction.
+func pipelineScopeError(role string) error {
+	return fmt.Errorf("role '%s' cannot be restricted to pipelines; only viewer and pipeline-operator roles can be", role)
+}
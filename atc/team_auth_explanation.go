@@ -0,0 +1,46 @@
+package atc
+
+const (
+	TeamAuthRuleUser  = "user"
+	TeamAuthRuleGroup = "group"
+
+	// TeamAuthRuleAll is the type of the rules of roles configured with
+	// neither users nor groups, which are granted to every user.
+	TeamAuthRuleAll = "all"
+)
+
+// AuthSubject identifies a user the way the team auth rules see them.
+// Groups are given without the connector prefix, as they are named by the
+// connector.
+type AuthSubject struct {
+	Connector string   `json:"connector,omitempty"`
+	UserID    string   `json:"user_id,omitempty"`
+	UserName  string   `json:"user_name,omitempty"`
+	Groups    []string `json:"groups,omitempty"`
+}
+
+// TeamAuthRule is a single entry of a TeamAuth config that grants a role.
+type TeamAuthRule struct {
+	Role      string   `json:"role"`
+	Type      string   `json:"type"`
+	Value     string   `json:"value,omitempty"`
+	Pipelines []string `json:"pipelines,omitempty"`
+}
+
+// ExplainTeamAuthRequest asks for the roles a subject is granted on a team.
+// When Subject is not set the requesting user is explained. When Auth is set
+// it is evaluated in place of the team's current auth config.
+type ExplainTeamAuthRequest struct {
+	Subject *AuthSubject `json:"subject,omitempty"`
+	Auth    TeamAuth     `json:"auth,omitempty"`
+}
+
+// TeamAuthExplanation lists the rules of a team's auth config that match a
+// subject, and the roles they grant on the whole team. Roles granted on only
+// some pipelines are listed by their rules.
+type TeamAuthExplanation struct {
+	Team    string         `json:"team"`
+	Subject AuthSubject    `json:"subject"`
+	Roles   []string       `json:"roles"`
+	Rules   []TeamAuthRule `json:"rules"`
+}
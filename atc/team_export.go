@@ -0,0 +1,43 @@
+package atc
+
+// TeamExportVersion is the version of the TeamExport format written by this
+// version of Concourse. Imports of any other version are rejected.
+const TeamExportVersion = 1
+
+// TeamExport is a snapshot of a team's configuration which can be imported
+// into another cluster, or back into the same one.
+type TeamExport struct {
+	Version   int              `json:"version"`
+	Team      Team             `json:"team"`
+	Pipelines []PipelineExport `json:"pipelines"`
+}
+
+// PipelineExport is a pipeline's config along with the state that is set
+// outside of it. Pipelines are listed in the team's order.
+type PipelineExport struct {
+	Name      string           `json:"name"`
+	Paused    bool             `json:"paused"`
+	Public    bool             `json:"public"`
+	Archived  bool             `json:"archived"`
+	Config    Config           `json:"config"`
+	Resources []ResourceExport `json:"resources,omitempty"`
+}
+
+// ResourceExport is the version state of a resource which is set through the
+// API rather than the pipeline config.
+type ResourceExport struct {
+	Name             string    `json:"name"`
+	PinnedVersion    Version   `json:"pinned_version,omitempty"`
+	PinComment       string    `json:"pin_comment,omitempty"`
+	DisabledVersions []Version `json:"disabled_versions,omitempty"`
+}
+
+// TeamImportResponse reports what was applied from the export, anything
+// which could not be restored exactly, or why the export was rejected.
+type TeamImportResponse struct {
+	Team        string   `json:"team"`
+	TeamCreated bool     `json:"team_created,omitempty"`
+	Pipelines   []string `json:"pipelines,omitempty"`
+	Errors      []string `json:"errors,omitempty"`
+	Warnings    []string `json:"warnings,omitempty"`
+}
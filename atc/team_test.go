@@ -0,0 +1,126 @@
+package atc_test
+
+import (
+	"encoding/json"
+
+	. "github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Team", func() {
+	Describe("credential manager", func() {
+		It("omits the credential manager when it is not set", func() {
+			payload, err := json.Marshal(Team{Name: "some-team"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(payload).To(MatchJSON(`{"name":"some-team"}`))
+		})
+
+		It("sends an explicit null to remove the credential manager", func() {
+			payload, err := json.Marshal(Team{Name: "some-team", RemoveCredentialManager: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(payload).To(MatchJSON(`{"name":"some-team","credential_manager":null}`))
+		})
+
+		It("sends the credential manager when both are set", func() {
+			payload, err := json.Marshal(Team{
+				Name:                    "some-team",
+				CredentialManager:       &TeamCredentialManager{Type: "vault"},
+				RemoveCredentialManager: true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(payload).To(MatchJSON(`{"name":"some-team","credential_manager":{"type":"vault","config":null}}`))
+		})
+
+		It("distinguishes an explicit null from an omitted credential manager", func() {
+			var team Team
+			err := json.Unmarshal([]byte(`{"name":"some-team"}`), &team)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(team.Name).To(Equal("some-team"))
+			Expect(team.RemoveCredentialManager).To(BeFalse())
+
+			err = json.Unmarshal([]byte(`{"name":"some-team","credential_manager":null}`), &team)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(team.CredentialManager).To(BeNil())
+			Expect(team.RemoveCredentialManager).To(BeTrue())
+		})
+	})
+
+	Describe("Validate", func() {
+		var team Team
+
+		BeforeEach(func() {
+			team = Team{
+				Name: "some-team",
+				Auth: TeamAuth{
+					"owner": {"users": {"local:some-owner"}},
+				},
+			}
+		})
+
+		It("allows viewer and pipeline-operator to be restricted to pipelines", func() {
+			team.Auth["viewer"] = map[string][]string{
+				"users":     {"local:some-viewer"},
+				"pipelines": {"some-pipeline"},
+			}
+			team.Auth["pipeline-operator"] = map[string][]string{
+				"users":     {"local:some-operator"},
+				"pipelines": {"deploy-*"},
+			}
+
+			Expect(team.Validate()).To(Succeed())
+		})
+
+		It("rejects member restricted to pipelines", func() {
+			team.Auth["member"] = map[string][]string{
+				"users":     {"local:some-member"},
+				"pipelines": {"some-pipeline"},
+			}
+
+			Expect(team.Validate()).To(MatchError(ContainSubstring("role 'member' cannot be restricted to pipelines")))
+		})
+
+		It("rejects owner restricted to pipelines", func() {
+			team.Auth["owner"]["pipelines"] = []string{"some-pipeline"}
+
+			Expect(team.Validate()).To(MatchError(ContainSubstring("role 'owner' cannot be restricted to pipelines")))
+		})
+
+		Context("with custom roles", func() {
+			BeforeEach(func() {
+				team.CustomRoles = []TeamRole{
+					{Name: "deployer", Extends: "pipeline-operator", Permissions: []TeamRolePermission{{Action: ExposePipeline}}},
+					{Name: "committer", Extends: "member"},
+					{Name: "configurer", Extends: "viewer", Permissions: []TeamRolePermission{{Action: SaveConfig}}},
+				}
+			})
+
+			It("allows roles extending pipeline-operator to be restricted to pipelines", func() {
+				team.Auth["deployer"] = map[string][]string{
+					"users":     {"local:some-deployer"},
+					"pipelines": {"deploy-*"},
+				}
+
+				Expect(team.Validate()).To(Succeed())
+			})
+
+			It("rejects roles extending member restricted to pipelines", func() {
+				team.Auth["committer"] = map[string][]string{
+					"users":     {"local:some-committer"},
+					"pipelines": {"some-pipeline"},
+				}
+
+				Expect(team.Validate()).To(MatchError(ContainSubstring("role 'committer' cannot be restricted to pipelines")))
+			})
+
+			It("rejects roles which can save configs restricted to pipelines", func() {
+				team.Auth["configurer"] = map[string][]string{
+					"users":     {"local:some-configurer"},
+					"pipelines": {"some-pipeline"},
+				}
+
+				Expect(team.Validate()).To(MatchError(ContainSubstring("role 'configurer' cannot be restricted to pipelines")))
+			})
+		})
+	})
+})
@@ -0,0 +1,20 @@
+package atc
+
+type VarCheckStatus string
+
+const (
+	VarCheckFound        VarCheckStatus = "found"
+	VarCheckNotFound     VarCheckStatus = "not-found"
+	VarCheckAccessDenied VarCheckStatus = "access-denied"
+	VarCheckErrored      VarCheckStatus = "errored"
+)
+
+// VarCheck is the result of resolving a single ((var)) against a credential
+// manager without using its value.
+type VarCheck struct {
+	Name   string         `json:"name"`
+	Source string         `json:"source,omitempty"`
+	Status VarCheckStatus `json:"status"`
+	Paths  []string       `json:"paths,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
@@ -1,8 +1,51 @@
 package atc
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 type Wall struct {
 	Message string        `json:"message,omitempty"`
 	TTL     time.Duration `json:"TTL,omitempty"`
 }
+
+const (
+	WallSeverityInfo     = "info"
+	WallSeverityWarning  = "warning"
+	WallSeverityCritical = "critical"
+)
+
+var ErrWallMessageEmpty = errors.New("wall message must not be empty")
+var ErrWallMessageWindowInvalid = errors.New("wall message must end after it starts")
+
+// WallMessage is an announcement shown to the users of the cluster. When
+// StartsAt or EndsAt are set it is only shown within that window, and when
+// Teams are set it is only shown to the members of those teams.
+type WallMessage struct {
+	ID       int      `json:"id,omitempty"`
+	Message  string   `json:"message"`
+	Severity string   `json:"severity"`
+	StartsAt int64    `json:"starts_at,omitempty"`
+	EndsAt   int64    `json:"ends_at,omitempty"`
+	Teams    []string `json:"teams,omitempty"`
+}
+
+func (message WallMessage) Validate() error {
+	if message.Message == "" {
+		return ErrWallMessageEmpty
+	}
+
+	switch message.Severity {
+	case WallSeverityInfo, WallSeverityWarning, WallSeverityCritical:
+	default:
+		return fmt.Errorf("unknown wall message severity '%s'", message.Severity)
+	}
+
+	if message.StartsAt != 0 && message.EndsAt != 0 && message.EndsAt <= message.StartsAt {
+		return ErrWallMessageWindowInvalid
+	}
+
+	return nil
+}
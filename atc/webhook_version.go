@@ -0,0 +1,96 @@
+package atc
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// DefaultWebhookSignatureHeader is the header carrying the payload signature
+// when none is configured. It matches the one sent by GitHub.
+const DefaultWebhookSignatureHeader = "X-Hub-Signature-256"
+
+// WebhookVersionConfig lets a resource's webhook save a version taken from
+// the webhook's JSON payload, rather than triggering a check.
+type WebhookVersionConfig struct {
+	// Version maps each version field to a Go text/template rendered with the
+	// decoded payload, e.g. ref: "{{.after}}".
+	Version map[string]string `json:"version"`
+
+	// Secret is used to verify the HMAC-SHA256 signature of the payload.
+	Secret string `json:"secret"`
+
+	// SignatureHeader is the header carrying the signature, as hex optionally
+	// prefixed with "sha256=".
+	SignatureHeader string `json:"signature_header,omitempty"`
+}
+
+func (config WebhookVersionConfig) Header() string {
+	if config.SignatureHeader == "" {
+		return DefaultWebhookSignatureHeader
+	}
+
+	return config.SignatureHeader
+}
+
+// ExtractVersion renders the version from the payload. Fields which are
+// missing from the payload or render empty are errors.
+func (config WebhookVersionConfig) ExtractVersion(payload []byte) (Version, error) {
+	var data interface{}
+	err := json.Unmarshal(payload, &data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload: %s", err)
+	}
+
+	fields := make([]string, 0, len(config.Version))
+	for field := range config.Version {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	version := Version{}
+	for _, field := range fields {
+		tmpl, err := template.New(field).Option("missingkey=error").Parse(config.Version[field])
+		if err != nil {
+			return nil, fmt.Errorf("invalid template for version field '%s': %s", field, err)
+		}
+
+		buf := new(bytes.Buffer)
+		err = tmpl.Execute(buf, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render version field '%s': %s", field, err)
+		}
+
+		value := strings.TrimSpace(buf.String())
+		if value == "" || value == "<no value>" {
+			return nil, fmt.Errorf("version field '%s' is empty", field)
+		}
+
+		version[field] = value
+	}
+
+	return version, nil
+}
+
+// VerifyWebhookSignature returns whether the signature is a valid
+// HMAC-SHA256 of the payload with the secret.
+func VerifyWebhookSignature(secret string, payload []byte, signature string) bool {
+	signature = strings.TrimPrefix(signature, "sha256=")
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,140 @@
+package atc_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebhookVersionConfig", func() {
+	var config atc.WebhookVersionConfig
+
+	BeforeEach(func() {
+		config = atc.WebhookVersionConfig{
+			Version: map[string]string{
+				"ref":    "{{.after}}",
+				"branch": "{{.ref}}",
+			},
+			Secret: "some-secret",
+		}
+	})
+
+	Describe("Header", func() {
+		It("defaults to GitHub's signature header", func() {
+			Expect(config.Header()).To(Equal("X-Hub-Signature-256"))
+		})
+
+		It("can be configured", func() {
+			config.SignatureHeader = "X-Signature"
+			Expect(config.Header()).To(Equal("X-Signature"))
+		})
+	})
+
+	Describe("ExtractVersion", func() {
+		var (
+			payload string
+			version atc.Version
+			err     error
+		)
+
+		BeforeEach(func() {
+			payload = `{"ref": "refs/heads/main", "after": "abc123", "head_commit": null}`
+		})
+
+		JustBeforeEach(func() {
+			version, err = config.ExtractVersion([]byte(payload))
+		})
+
+		It("renders each field with the payload", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal(atc.Version{
+				"ref":    "abc123",
+				"branch": "refs/heads/main",
+			}))
+		})
+
+		Context("when the payload is not JSON", func() {
+			BeforeEach(func() {
+				payload = "nope"
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError(ContainSubstring("invalid payload")))
+			})
+		})
+
+		Context("when a field is missing from the payload", func() {
+			BeforeEach(func() {
+				config.Version = map[string]string{"id": "{{.head_commit.id}}"}
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError(ContainSubstring("version field 'id'")))
+			})
+		})
+
+		Context("when a key is missing from the payload", func() {
+			BeforeEach(func() {
+				config.Version = map[string]string{"id": "{{.sha}}"}
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError(ContainSubstring("failed to render version field 'id'")))
+			})
+		})
+
+		Context("when a field renders empty", func() {
+			BeforeEach(func() {
+				payload = `{"after": ""}`
+				config.Version = map[string]string{"ref": "{{.after}}"}
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError("version field 'ref' is empty"))
+			})
+		})
+	})
+
+	Describe("VerifyWebhookSignature", func() {
+		var (
+			payload   []byte
+			signature string
+		)
+
+		BeforeEach(func() {
+			payload = []byte(`{"after": "abc123"}`)
+
+			mac := hmac.New(sha256.New, []byte("some-secret"))
+			mac.Write(payload)
+			signature = hex.EncodeToString(mac.Sum(nil))
+		})
+
+		It("accepts a valid signature", func() {
+			Expect(atc.VerifyWebhookSignature("some-secret", payload, signature)).To(BeTrue())
+		})
+
+		It("accepts a valid signature with an algorithm prefix", func() {
+			Expect(atc.VerifyWebhookSignature("some-secret", payload, "sha256="+signature)).To(BeTrue())
+		})
+
+		It("rejects a signature made with another secret", func() {
+			Expect(atc.VerifyWebhookSignature("other-secret", payload, signature)).To(BeFalse())
+		})
+
+		It("rejects a signature of another payload", func() {
+			Expect(atc.VerifyWebhookSignature("some-secret", []byte("{}"), signature)).To(BeFalse())
+		})
+
+		It("rejects a malformed signature", func() {
+			Expect(atc.VerifyWebhookSignature("some-secret", payload, "sha256=zzz")).To(BeFalse())
+		})
+
+		It("rejects a missing signature", func() {
+			Expect(atc.VerifyWebhookSignature("some-secret", payload, "")).To(BeFalse())
+		})
+	})
+})
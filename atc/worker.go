@@ -30,6 +30,8 @@ type Worker struct {
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
 	State     string   `json:"state"`
+
+	LandDeadline int64 `json:"land_deadline,omitempty"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -1,12 +1,15 @@
 package worker
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"fmt"
 	"io"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/garden"
@@ -330,6 +333,11 @@ func (client *client) RunTaskStep(
 	if err == nil {
 		logger.Info("already-running")
 	} else {
+		err = streamInFiles(container, containerSpec.Files)
+		if err != nil {
+			return TaskResult{}, err
+		}
+
 		eventDelegate.Starting(logger)
 		logger.Info("spawning")
 
@@ -765,3 +773,40 @@ func release(activeTasksLock lock.Lock, err error) {
 		err = multierror.Append(err, releaseErr)
 	}
 }
+
+// streamInFiles writes the given files into the container, creating their
+// parent directories as needed.
+func streamInFiles(container Container, files map[string][]byte) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	tarWriter := tar.NewWriter(buf)
+
+	for filePath, content := range files {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name: strings.TrimPrefix(filePath, "/"),
+			Mode: 0644,
+			Size: int64(len(content)),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tarWriter.Write(content)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return container.StreamIn(garden.StreamInSpec{
+		Path:      "/",
+		TarStream: buf,
+	})
+}
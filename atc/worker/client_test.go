@@ -1,10 +1,12 @@
 package worker_test
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"path"
 	"time"
 
@@ -1015,6 +1017,47 @@ var _ = Describe("Client", func() {
 					Expect(fakeEventDelegate.StartingCallCount()).Should((Equal(1)))
 				})
 
+				It("does not stream any files into the container", func() {
+					Expect(fakeContainer.StreamInCallCount()).To(BeZero())
+				})
+
+				Context("when the container spec has files", func() {
+					BeforeEach(func() {
+						fakeContainerSpec.Files = map[string][]byte{
+							"/tmp/build/some-dir/token": []byte("some-token"),
+						}
+					})
+
+					It("streams the files into the container before running the process", func() {
+						Expect(fakeContainer.StreamInCallCount()).To(Equal(1))
+
+						spec := fakeContainer.StreamInArgsForCall(0)
+						Expect(spec.Path).To(Equal("/"))
+
+						tarReader := tar.NewReader(spec.TarStream)
+						header, err := tarReader.Next()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(header.Name).To(Equal("tmp/build/some-dir/token"))
+
+						content, err := ioutil.ReadAll(tarReader)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(string(content)).To(Equal("some-token"))
+
+						Expect(fakeContainer.RunCallCount()).To(Equal(1))
+					})
+
+					Context("when streaming in fails", func() {
+						BeforeEach(func() {
+							fakeContainer.StreamInReturns(errors.New("nope"))
+						})
+
+						It("returns the error without running the process", func() {
+							Expect(err).To(MatchError("nope"))
+							Expect(fakeContainer.RunCallCount()).To(BeZero())
+						})
+					})
+				})
+
 				Context("when the process is interrupted", func() {
 					var stopped chan struct{}
 					BeforeEach(func() {
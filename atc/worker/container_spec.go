@@ -49,6 +49,10 @@ type ContainerSpec struct {
 
 	// Optional user to run processes as. Overwrites the one specified in the docker image.
 	User string
+
+	// Files to write into the container before running its process. The key
+	// is the absolute path of the file and the value is its content.
+	Files map[string][]byte
 }
 
 // The below methods cause ContainerSpec to fulfill the
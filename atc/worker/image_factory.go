@@ -8,6 +8,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/policy"
 )
 
 //go:generate counterfeiter . ImageFactory
@@ -49,6 +50,8 @@ type ImageFetchingDelegate interface {
 	ImageVersionDetermined(db.UsedResourceCache) error
 
 	RedactImageSource(source atc.Source) (atc.Source, error)
+
+	PolicyCheckFailed(lager.Logger, string, policy.PolicyCheckOutput)
 }
 
 type ImageMetadata struct {
@@ -61,6 +64,7 @@ type NoopImageFetchingDelegate struct{}
 func (NoopImageFetchingDelegate) Stdout() io.Writer                                 { return ioutil.Discard }
 func (NoopImageFetchingDelegate) Stderr() io.Writer                                 { return ioutil.Discard }
 func (NoopImageFetchingDelegate) ImageVersionDetermined(db.UsedResourceCache) error { return nil }
+func (NoopImageFetchingDelegate) PolicyCheckFailed(lager.Logger, string, policy.PolicyCheckOutput) {}
 func (NoopImageFetchingDelegate) RedactImageSource(source atc.Source) (atc.Source, error) {
 	// As this is noop, redaction can just return an empty source.
 	return atc.Source{}, nil
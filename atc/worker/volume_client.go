@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -50,6 +51,13 @@ type VolumeClient interface {
 		lager.Logger,
 		db.UsedResourceCache,
 	) (Volume, bool, error)
+	ImportVolumeForResourceCache(
+		context.Context,
+		lager.Logger,
+		db.UsedResourceCache,
+		Volume,
+		baggageclaim.Encoding,
+	) (Volume, error)
 	FindVolumeForTaskCache(
 		logger lager.Logger,
 		teamID int,
@@ -247,6 +255,84 @@ func (c *volumeClient) FindVolumeForResourceCache(
 	return NewVolume(bcVolume, dbVolume, c), true, nil
 }
 
+// ImportVolumeForResourceCache copies the contents of a resource cache volume
+// from another worker into a new volume on this worker and initializes it as
+// the cache. The volume stays in the creating state while the contents are
+// streamed so that it is not garbage collected half-way through.
+func (c *volumeClient) ImportVolumeForResourceCache(
+	ctx context.Context,
+	logger lager.Logger,
+	usedResourceCache db.UsedResourceCache,
+	source Volume,
+	encoding baggageclaim.Encoding,
+) (Volume, error) {
+	creatingVolume, err := c.dbVolumeRepository.CreateVolume(0, c.dbWorker.Name(), db.VolumeTypeResource)
+	if err != nil {
+		logger.Error("failed-to-create-volume-in-db", err)
+		return nil, err
+	}
+
+	logger = logger.WithData(lager.Data{
+		"volume":        creatingVolume.Handle(),
+		"source-volume": source.Handle(),
+		"source-worker": source.WorkerName(),
+	})
+
+	markFailed := func() {
+		_, failedErr := creatingVolume.Failed()
+		if failedErr != nil {
+			logger.Error("failed-to-mark-volume-as-failed", failedErr)
+		}
+
+		metric.FailedVolumes.Inc()
+	}
+
+	bcVolume, err := c.baggageclaimClient.CreateVolume(
+		logger.Session("create-volume"),
+		creatingVolume.Handle(),
+		baggageclaim.VolumeSpec{Strategy: baggageclaim.EmptyStrategy{}},
+	)
+	if err != nil {
+		logger.Error("failed-to-create-volume-in-baggageclaim", err)
+		markFailed()
+		return nil, err
+	}
+
+	metric.VolumesCreated.Inc()
+
+	out, err := source.StreamOut(ctx, ".", encoding)
+	if err != nil {
+		logger.Error("failed-to-stream-out-source-volume", err)
+		markFailed()
+		return nil, err
+	}
+
+	defer out.Close()
+
+	err = bcVolume.StreamIn(ctx, ".", encoding, out)
+	if err != nil {
+		logger.Error("failed-to-stream-in-volume", err)
+		markFailed()
+		return nil, err
+	}
+
+	createdVolume, err := creatingVolume.Created()
+	if err != nil {
+		logger.Error("failed-to-initialize-volume", err)
+		return nil, err
+	}
+
+	err = createdVolume.InitializeResourceCache(usedResourceCache)
+	if err != nil {
+		logger.Error("failed-to-initialize-resource-cache", err)
+		return nil, err
+	}
+
+	logger.Debug("imported")
+
+	return NewVolume(bcVolume, createdVolume, c), nil
+}
+
 func (c *volumeClient) CreateVolumeForTaskCache(
 	logger lager.Logger,
 	volumeSpec VolumeSpec,
@@ -1,7 +1,10 @@
 package worker_test
 
 import (
+	"context"
 	"errors"
+	"io/ioutil"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/clock/fakeclock"
@@ -644,6 +647,80 @@ var _ = Describe("VolumeClient", func() {
 		})
 	})
 
+	Describe("ImportVolumeForResourceCache", func() {
+		var (
+			fakeCreatingVolume     *dbfakes.FakeCreatingVolume
+			fakeCreatedVolume      *dbfakes.FakeCreatedVolume
+			fakeBaggageclaimVolume *baggageclaimfakes.FakeVolume
+			fakeSourceVolume       *workerfakes.FakeVolume
+			fakeResourceCache      *dbfakes.FakeUsedResourceCache
+
+			importErr error
+		)
+
+		BeforeEach(func() {
+			fakeCreatingVolume = new(dbfakes.FakeCreatingVolume)
+			fakeCreatingVolume.HandleReturns("some-handle")
+			fakeCreatedVolume = new(dbfakes.FakeCreatedVolume)
+			fakeCreatingVolume.CreatedReturns(fakeCreatedVolume, nil)
+			fakeDBVolumeRepository.CreateVolumeReturns(fakeCreatingVolume, nil)
+
+			fakeBaggageclaimVolume = new(baggageclaimfakes.FakeVolume)
+			fakeBaggageclaimClient.CreateVolumeReturns(fakeBaggageclaimVolume, nil)
+
+			fakeSourceVolume = new(workerfakes.FakeVolume)
+			fakeSourceVolume.StreamOutReturns(ioutil.NopCloser(strings.NewReader("some-contents")), nil)
+
+			fakeResourceCache = new(dbfakes.FakeUsedResourceCache)
+		})
+
+		JustBeforeEach(func() {
+			_, importErr = volumeClient.ImportVolumeForResourceCache(
+				context.TODO(),
+				testLogger,
+				fakeResourceCache,
+				fakeSourceVolume,
+				baggageclaim.GzipEncoding,
+			)
+		})
+
+		It("creates a global resource volume on the worker", func() {
+			Expect(importErr).ToNot(HaveOccurred())
+
+			teamID, workerName, volumeType := fakeDBVolumeRepository.CreateVolumeArgsForCall(0)
+			Expect(teamID).To(Equal(0))
+			Expect(workerName).To(Equal("some-worker"))
+			Expect(volumeType).To(Equal(db.VolumeTypeResource))
+
+			_, handle, spec := fakeBaggageclaimClient.CreateVolumeArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(spec.Strategy).To(Equal(baggageclaim.EmptyStrategy{}))
+		})
+
+		It("streams the source volume in before initializing the cache", func() {
+			_, path, encoding := fakeSourceVolume.StreamOutArgsForCall(0)
+			Expect(path).To(Equal("."))
+			Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+
+			Expect(fakeBaggageclaimVolume.StreamInCallCount()).To(Equal(1))
+			Expect(fakeCreatingVolume.CreatedCallCount()).To(Equal(1))
+			Expect(fakeCreatedVolume.InitializeResourceCacheCallCount()).To(Equal(1))
+			Expect(fakeCreatedVolume.InitializeResourceCacheArgsForCall(0)).To(Equal(fakeResourceCache))
+		})
+
+		Context("when streaming fails", func() {
+			BeforeEach(func() {
+				fakeBaggageclaimVolume.StreamInReturns(errors.New("nope"))
+			})
+
+			It("marks the volume as failed", func() {
+				Expect(importErr).To(HaveOccurred())
+				Expect(fakeCreatingVolume.FailedCallCount()).To(Equal(1))
+				Expect(fakeCreatingVolume.CreatedCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("CreateVolume", func() {
 		var err error
 		var workerVolume worker.Volume
@@ -60,6 +60,7 @@ type Worker interface {
 	) (Container, error)
 
 	FindVolumeForResourceCache(logger lager.Logger, resourceCache db.UsedResourceCache) (Volume, bool, error)
+	ImportVolumeForResourceCache(ctx context.Context, logger lager.Logger, resourceCache db.UsedResourceCache, source Volume, encoding baggageclaim.Encoding) (Volume, error)
 	FindResourceCacheForVolume(volume Volume) (db.UsedResourceCache, bool, error)
 	FindVolumeForTaskCache(lager.Logger, int, int, string, string) (Volume, bool, error)
 	Fetch(
@@ -187,6 +188,10 @@ func (worker *gardenWorker) FindVolumeForResourceCache(logger lager.Logger, reso
 	return worker.volumeClient.FindVolumeForResourceCache(logger, resourceCache)
 }
 
+func (worker *gardenWorker) ImportVolumeForResourceCache(ctx context.Context, logger lager.Logger, resourceCache db.UsedResourceCache, source Volume, encoding baggageclaim.Encoding) (Volume, error) {
+	return worker.volumeClient.ImportVolumeForResourceCache(ctx, logger, resourceCache, source, encoding)
+}
+
 func (worker *gardenWorker) FindResourceCacheForVolume(volume Volume) (db.UsedResourceCache, bool, error) {
 	if volume.GetResourceCacheID() != 0 {
 		return worker.resourceCacheFactory.FindResourceCacheByID(volume.GetResourceCacheID())
@@ -218,14 +223,14 @@ func (worker *gardenWorker) imagePolicyCheck(
 	metadata db.ContainerMetadata,
 	containerSpec ContainerSpec,
 	resourceTypes atc.VersionedResourceTypes,
-) (bool, error) {
+) (policy.PolicyCheckOutput, error) {
 	if worker.policyChecker == nil {
-		return true, nil
+		return policy.PassedPolicyCheck(), nil
 	}
 
 	// Actions in skip list will not go through policy check.
 	if !worker.policyChecker.ShouldCheckAction(policy.ActionUseImage) {
-		return true, nil
+		return policy.PassedPolicyCheck(), nil
 	}
 
 	imageSpec := containerSpec.ImageSpec
@@ -248,17 +253,17 @@ func (worker *gardenWorker) imagePolicyCheck(
 		// If resource type not found, then it should be a built-in resource
 		// type, and could skip policy check.
 		if _, ok := imageInfo["image_type"]; !ok {
-			return true, nil
+			return policy.PassedPolicyCheck(), nil
 		}
 	} else {
 		// Ignore other images as policy checker cannot do much on them.
-		return true, nil
+		return policy.PassedPolicyCheck(), nil
 	}
 
 	if originalSource, ok := imageInfo["image_source"].(atc.Source); ok {
 		redactedSource, err := delegate.RedactImageSource(originalSource)
 		if err != nil {
-			return false, err
+			return policy.PolicyCheckOutput{}, err
 		}
 		imageInfo["image_source"] = redactedSource
 	}
@@ -292,12 +297,15 @@ func (worker *gardenWorker) FindOrCreateContainer(
 		err               error
 	)
 
-	pass, err := worker.imagePolicyCheck(ctx, delegate, metadata, containerSpec, resourceTypes)
+	policyCheckOutput, err := worker.imagePolicyCheck(ctx, delegate, metadata, containerSpec, resourceTypes)
 	if err != nil {
 		return nil, err
 	}
-	if !pass {
-		return nil, policy.PolicyCheckNotPass{}
+	if policyCheckOutput.ShouldBlock() || policyCheckOutput.ShouldWarn() {
+		delegate.PolicyCheckFailed(logger, policy.ActionUseImage, policyCheckOutput)
+	}
+	if policyCheckOutput.ShouldBlock() {
+		return nil, policy.PolicyCheckNotPass{Reasons: policyCheckOutput.Reasons}
 	}
 
 	// ensure either creatingContainer or createdContainer exists
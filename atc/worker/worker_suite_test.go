@@ -1,6 +1,9 @@
 package worker_test
 
 import (
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -11,3 +14,13 @@ func TestWorker(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Worker Suite")
 }
+
+var fakePolicyAgentFactory *policyfakes.FakeAgentFactory
+
+var _ = BeforeSuite(func() {
+	fakePolicyAgentFactory = new(policyfakes.FakeAgentFactory)
+	fakePolicyAgentFactory.IsConfiguredReturns(true)
+	fakePolicyAgentFactory.DescriptionReturns("fakeAgent")
+
+	policy.RegisterAgent(fakePolicyAgentFactory)
+})
@@ -16,6 +16,8 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/gclient/gclientfakes"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
@@ -36,6 +38,7 @@ var _ = Describe("Worker", func() {
 		ephemeral                 bool
 		workerName                string
 		gardenWorker              Worker
+		policyChecker             *policy.Checker
 		workerVersion             string
 		fakeGardenClient          *gclientfakes.FakeClient
 		fakeImageFactory          *workerfakes.FakeImageFactory
@@ -78,6 +81,7 @@ var _ = Describe("Worker", func() {
 
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("test")
+		policyChecker = nil
 		fakeVolumeClient = new(workerfakes.FakeVolumeClient)
 		activeContainers = 42
 		resourceTypes = []atc.WorkerResourceType{
@@ -274,7 +278,7 @@ var _ = Describe("Worker", func() {
 			fakeDBWorker,
 			fakeResourceCacheFactory,
 			0,
-			nil,
+			policyChecker,
 		)
 	})
 
@@ -907,6 +911,98 @@ var _ = Describe("Worker", func() {
 		})
 		disasterErr := errors.New("disaster")
 
+		Context("when the image goes through policy check", func() {
+			var fakePolicyAgent *policyfakes.FakeAgent
+
+			BeforeEach(func() {
+				fakePolicyAgent = new(policyfakes.FakeAgent)
+				fakePolicyAgentFactory.NewAgentReturns(fakePolicyAgent, nil)
+
+				var err error
+				policyChecker, err = policy.Initialize(logger, "some-cluster", "some-version", policy.Filter{
+					Actions: []string{policy.ActionUseImage},
+				}, policy.CombineAll)
+				Expect(err).ToNot(HaveOccurred())
+
+				fakeDBWorker.FindContainerReturns(fakeCreatingContainer, nil, nil)
+				fakeGardenClient.LookupReturns(fakeGardenContainer, nil)
+			})
+
+			It("checks the image", func() {
+				Expect(fakePolicyAgent.CheckCallCount()).To(Equal(1))
+				input := fakePolicyAgent.CheckArgsForCall(0)
+				Expect(input.Action).To(Equal(policy.ActionUseImage))
+				Expect(input.Data).To(HaveKeyWithValue("image_type", "registry-image"))
+			})
+
+			Context("when the policy check passes", func() {
+				BeforeEach(func() {
+					fakePolicyAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+				})
+
+				It("returns the container without reporting the check", func() {
+					Expect(findOrCreateErr).ToNot(HaveOccurred())
+					Expect(findOrCreateContainer).ToNot(BeNil())
+					Expect(fakeImageFetchingDelegate.PolicyCheckFailedCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the policy check blocks", func() {
+				BeforeEach(func() {
+					fakePolicyAgent.CheckReturns(policy.PolicyCheckOutput{
+						Reasons:  []string{"some-reason"},
+						Severity: policy.SeverityBlock,
+					}, nil)
+				})
+
+				It("returns an error with the reasons", func() {
+					Expect(findOrCreateErr).To(Equal(policy.PolicyCheckNotPass{Reasons: []string{"some-reason"}}))
+					Expect(fakeDBWorker.FindContainerCallCount()).To(Equal(0))
+				})
+
+				It("reports the check to the delegate", func() {
+					Expect(fakeImageFetchingDelegate.PolicyCheckFailedCallCount()).To(Equal(1))
+					_, action, output := fakeImageFetchingDelegate.PolicyCheckFailedArgsForCall(0)
+					Expect(action).To(Equal(policy.ActionUseImage))
+					Expect(output.Reasons).To(Equal([]string{"some-reason"}))
+				})
+			})
+
+			Context("when the policy check only warns", func() {
+				BeforeEach(func() {
+					fakePolicyAgent.CheckReturns(policy.PolicyCheckOutput{
+						Reasons:  []string{"some-reason"},
+						Severity: policy.SeverityWarn,
+					}, nil)
+				})
+
+				It("returns the container", func() {
+					Expect(findOrCreateErr).ToNot(HaveOccurred())
+					Expect(findOrCreateContainer).ToNot(BeNil())
+				})
+
+				It("reports the check to the delegate", func() {
+					Expect(fakeImageFetchingDelegate.PolicyCheckFailedCallCount()).To(Equal(1))
+					_, _, output := fakeImageFetchingDelegate.PolicyCheckFailedArgsForCall(0)
+					Expect(output.Severity).To(Equal(policy.SeverityWarn))
+				})
+			})
+
+			Context("when the policy check is only audited", func() {
+				BeforeEach(func() {
+					fakePolicyAgent.CheckReturns(policy.PolicyCheckOutput{
+						Reasons:  []string{"some-reason"},
+						Severity: policy.SeverityAudit,
+					}, nil)
+				})
+
+				It("returns the container without reporting the check", func() {
+					Expect(findOrCreateErr).ToNot(HaveOccurred())
+					Expect(fakeImageFetchingDelegate.PolicyCheckFailedCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when container exists in database in creating state", func() {
 			BeforeEach(func() {
 				fakeDBWorker.FindContainerReturns(fakeCreatingContainer, nil, nil)
@@ -2,9 +2,11 @@
 package workerfakes
 
 import (
+	"context"
 	"sync"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -146,6 +148,23 @@ type FakeVolumeClient struct {
 		result2 bool
 		result3 error
 	}
+	ImportVolumeForResourceCacheStub        func(context.Context, lager.Logger, db.UsedResourceCache, worker.Volume, baggageclaim.Encoding) (worker.Volume, error)
+	importVolumeForResourceCacheMutex       sync.RWMutex
+	importVolumeForResourceCacheArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 db.UsedResourceCache
+		arg4 worker.Volume
+		arg5 baggageclaim.Encoding
+	}
+	importVolumeForResourceCacheReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	importVolumeForResourceCacheReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	LookupVolumeStub        func(lager.Logger, string) (worker.Volume, bool, error)
 	lookupVolumeMutex       sync.RWMutex
 	lookupVolumeArgsForCall []struct {
@@ -705,6 +724,73 @@ func (fake *FakeVolumeClient) FindVolumeForTaskCacheReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeVolumeClient) ImportVolumeForResourceCache(arg1 context.Context, arg2 lager.Logger, arg3 db.UsedResourceCache, arg4 worker.Volume, arg5 baggageclaim.Encoding) (worker.Volume, error) {
+	fake.importVolumeForResourceCacheMutex.Lock()
+	ret, specificReturn := fake.importVolumeForResourceCacheReturnsOnCall[len(fake.importVolumeForResourceCacheArgsForCall)]
+	fake.importVolumeForResourceCacheArgsForCall = append(fake.importVolumeForResourceCacheArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 db.UsedResourceCache
+		arg4 worker.Volume
+		arg5 baggageclaim.Encoding
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("ImportVolumeForResourceCache", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.importVolumeForResourceCacheMutex.Unlock()
+	if fake.ImportVolumeForResourceCacheStub != nil {
+		return fake.ImportVolumeForResourceCacheStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.importVolumeForResourceCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeClient) ImportVolumeForResourceCacheCallCount() int {
+	fake.importVolumeForResourceCacheMutex.RLock()
+	defer fake.importVolumeForResourceCacheMutex.RUnlock()
+	return len(fake.importVolumeForResourceCacheArgsForCall)
+}
+
+func (fake *FakeVolumeClient) ImportVolumeForResourceCacheCalls(stub func(context.Context, lager.Logger, db.UsedResourceCache, worker.Volume, baggageclaim.Encoding) (worker.Volume, error)) {
+	fake.importVolumeForResourceCacheMutex.Lock()
+	defer fake.importVolumeForResourceCacheMutex.Unlock()
+	fake.ImportVolumeForResourceCacheStub = stub
+}
+
+func (fake *FakeVolumeClient) ImportVolumeForResourceCacheArgsForCall(i int) (context.Context, lager.Logger, db.UsedResourceCache, worker.Volume, baggageclaim.Encoding) {
+	fake.importVolumeForResourceCacheMutex.RLock()
+	defer fake.importVolumeForResourceCacheMutex.RUnlock()
+	argsForCall := fake.importVolumeForResourceCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeVolumeClient) ImportVolumeForResourceCacheReturns(result1 worker.Volume, result2 error) {
+	fake.importVolumeForResourceCacheMutex.Lock()
+	defer fake.importVolumeForResourceCacheMutex.Unlock()
+	fake.ImportVolumeForResourceCacheStub = nil
+	fake.importVolumeForResourceCacheReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeClient) ImportVolumeForResourceCacheReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.importVolumeForResourceCacheMutex.Lock()
+	defer fake.importVolumeForResourceCacheMutex.Unlock()
+	fake.ImportVolumeForResourceCacheStub = nil
+	if fake.importVolumeForResourceCacheReturnsOnCall == nil {
+		fake.importVolumeForResourceCacheReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.importVolumeForResourceCacheReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeClient) LookupVolume(arg1 lager.Logger, arg2 string) (worker.Volume, bool, error) {
 	fake.lookupVolumeMutex.Lock()
 	ret, specificReturn := fake.lookupVolumeReturnsOnCall[len(fake.lookupVolumeArgsForCall)]
@@ -791,6 +877,8 @@ func (fake *FakeVolumeClient) Invocations() map[string][][]interface{} {
 	defer fake.findVolumeForResourceCacheMutex.RUnlock()
 	fake.findVolumeForTaskCacheMutex.RLock()
 	defer fake.findVolumeForTaskCacheMutex.RUnlock()
+	fake.importVolumeForResourceCacheMutex.RLock()
+	defer fake.importVolumeForResourceCacheMutex.RUnlock()
 	fake.lookupVolumeMutex.RLock()
 	defer fake.lookupVolumeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
@@ -221,6 +222,23 @@ type FakeWorker struct {
 	gardenClientReturnsOnCall map[int]struct {
 		result1 gclient.Client
 	}
+	ImportVolumeForResourceCacheStub        func(context.Context, lager.Logger, db.UsedResourceCache, worker.Volume, baggageclaim.Encoding) (worker.Volume, error)
+	importVolumeForResourceCacheMutex       sync.RWMutex
+	importVolumeForResourceCacheArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 db.UsedResourceCache
+		arg4 worker.Volume
+		arg5 baggageclaim.Encoding
+	}
+	importVolumeForResourceCacheReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	importVolumeForResourceCacheReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	IncreaseActiveTasksStub        func() error
 	increaseActiveTasksMutex       sync.RWMutex
 	increaseActiveTasksArgsForCall []struct {
@@ -1188,6 +1206,73 @@ func (fake *FakeWorker) GardenClientReturnsOnCall(i int, result1 gclient.Client)
 	}{result1}
 }
 
+func (fake *FakeWorker) ImportVolumeForResourceCache(arg1 context.Context, arg2 lager.Logger, arg3 db.UsedResourceCache, arg4 worker.Volume, arg5 baggageclaim.Encoding) (worker.Volume, error) {
+	fake.importVolumeForResourceCacheMutex.Lock()
+	ret, specificReturn := fake.importVolumeForResourceCacheReturnsOnCall[len(fake.importVolumeForResourceCacheArgsForCall)]
+	fake.importVolumeForResourceCacheArgsForCall = append(fake.importVolumeForResourceCacheArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 db.UsedResourceCache
+		arg4 worker.Volume
+		arg5 baggageclaim.Encoding
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("ImportVolumeForResourceCache", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.importVolumeForResourceCacheMutex.Unlock()
+	if fake.ImportVolumeForResourceCacheStub != nil {
+		return fake.ImportVolumeForResourceCacheStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.importVolumeForResourceCacheReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) ImportVolumeForResourceCacheCallCount() int {
+	fake.importVolumeForResourceCacheMutex.RLock()
+	defer fake.importVolumeForResourceCacheMutex.RUnlock()
+	return len(fake.importVolumeForResourceCacheArgsForCall)
+}
+
+func (fake *FakeWorker) ImportVolumeForResourceCacheCalls(stub func(context.Context, lager.Logger, db.UsedResourceCache, worker.Volume, baggageclaim.Encoding) (worker.Volume, error)) {
+	fake.importVolumeForResourceCacheMutex.Lock()
+	defer fake.importVolumeForResourceCacheMutex.Unlock()
+	fake.ImportVolumeForResourceCacheStub = stub
+}
+
+func (fake *FakeWorker) ImportVolumeForResourceCacheArgsForCall(i int) (context.Context, lager.Logger, db.UsedResourceCache, worker.Volume, baggageclaim.Encoding) {
+	fake.importVolumeForResourceCacheMutex.RLock()
+	defer fake.importVolumeForResourceCacheMutex.RUnlock()
+	argsForCall := fake.importVolumeForResourceCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeWorker) ImportVolumeForResourceCacheReturns(result1 worker.Volume, result2 error) {
+	fake.importVolumeForResourceCacheMutex.Lock()
+	defer fake.importVolumeForResourceCacheMutex.Unlock()
+	fake.ImportVolumeForResourceCacheStub = nil
+	fake.importVolumeForResourceCacheReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) ImportVolumeForResourceCacheReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.importVolumeForResourceCacheMutex.Lock()
+	defer fake.importVolumeForResourceCacheMutex.Unlock()
+	fake.ImportVolumeForResourceCacheStub = nil
+	if fake.importVolumeForResourceCacheReturnsOnCall == nil {
+		fake.importVolumeForResourceCacheReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.importVolumeForResourceCacheReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) IncreaseActiveTasks() error {
 	fake.increaseActiveTasksMutex.Lock()
 	ret, specificReturn := fake.increaseActiveTasksReturnsOnCall[len(fake.increaseActiveTasksArgsForCall)]
@@ -1720,6 +1805,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.findVolumeForTaskCacheMutex.RUnlock()
 	fake.gardenClientMutex.RLock()
 	defer fake.gardenClientMutex.RUnlock()
+	fake.importVolumeForResourceCacheMutex.RLock()
+	defer fake.importVolumeForResourceCacheMutex.RUnlock()
 	fake.increaseActiveTasksMutex.RLock()
 	defer fake.increaseActiveTasksMutex.RUnlock()
 	fake.isOwnedByTeamMutex.RLock()
@@ -68,6 +68,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.PipelineBadge,
 			atc.JobBadge,
 			atc.ListJobs,
+			atc.ListJobStats,
 			atc.GetJob,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
@@ -83,8 +84,11 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// authenticated
 		case atc.CreateBuild,
+			atc.CreateJobPlan,
 			atc.GetContainer,
 			atc.HijackContainer,
+			atc.StreamInContainer,
+			atc.StreamOutContainer,
 			atc.ListContainers,
 			atc.ListWorkers,
 			atc.RegisterWorker,
@@ -93,10 +97,17 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetTeam,
 			atc.SetTeam,
 			atc.ListTeamBuilds,
+			atc.ExplainTeamAuth,
+			atc.ExportTeam,
+			atc.ImportTeam,
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.ListVolumes,
-			atc.GetUser:
+			atc.GetUser,
+			atc.ListSessions,
+			atc.RevokeSession,
+			atc.RevokeCurrentSession,
+			atc.GetMaintenance:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
 
 		// unauthenticated / delegating to handler (validate token if provided)
@@ -111,7 +122,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListAllResources,
 			atc.ListBuilds,
 			atc.MainJobBadge,
-			atc.GetWall:
+			atc.GetWall,
+			atc.ListWallMessages:
 			newHandler = auth.CheckAuthenticationIfProvidedHandler(handler, rejector)
 
 		case atc.GetLogLevel,
@@ -119,7 +131,12 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
-			atc.ClearWall:
+			atc.ClearWall,
+			atc.CreateWallMessage,
+			atc.DeleteWallMessage,
+			atc.EnableMaintenance,
+			atc.DisableMaintenance,
+			atc.RevokeUserSessions:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team)
@@ -137,7 +154,10 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetConfig,
 			atc.GetCC,
 			atc.GetVersionsDB,
+			atc.ListNotificationDeliveries,
 			atc.ListJobInputs,
+			atc.TeamEvents,
+			atc.PipelineEvents,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.PausePipeline,
@@ -147,11 +167,15 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ExposePipeline,
 			atc.HidePipeline,
 			atc.SaveConfig,
+			atc.CheckConfigCreds,
 			atc.ArchivePipeline,
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.DeleteAPIToken:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
@@ -144,6 +144,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.PipelineBadge:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.PipelineBadge]),
 				atc.JobBadge:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.JobBadge]),
 				atc.ListJobs:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobs]),
+				atc.ListJobStats:                  openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobStats]),
 				atc.GetJob:                        openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJob]),
 				atc.ListJobBuilds:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobBuilds]),
 				atc.ListPipelineBuilds:            openForPublicPipelineOrAuthorized(inputHandlers[atc.ListPipelineBuilds]),
@@ -157,21 +158,32 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetResourceVersion:            openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceVersion]),
 
 				// authenticated
-				atc.CreateBuild:     authenticated(inputHandlers[atc.CreateBuild]),
-				atc.GetContainer:    authenticated(inputHandlers[atc.GetContainer]),
-				atc.HijackContainer: authenticated(inputHandlers[atc.HijackContainer]),
-				atc.ListContainers:  authenticated(inputHandlers[atc.ListContainers]),
-				atc.ListVolumes:     authenticated(inputHandlers[atc.ListVolumes]),
-				atc.ListTeamBuilds:  authenticated(inputHandlers[atc.ListTeamBuilds]),
-				atc.ListWorkers:     authenticated(inputHandlers[atc.ListWorkers]),
-				atc.RegisterWorker:  authenticated(inputHandlers[atc.RegisterWorker]),
-				atc.HeartbeatWorker: authenticated(inputHandlers[atc.HeartbeatWorker]),
-				atc.DeleteWorker:    authenticated(inputHandlers[atc.DeleteWorker]),
-				atc.GetTeam:         authenticated(inputHandlers[atc.GetTeam]),
-				atc.SetTeam:         authenticated(inputHandlers[atc.SetTeam]),
-				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
-				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
-				atc.GetUser:         authenticated(inputHandlers[atc.GetUser]),
+				atc.CreateBuild:        authenticated(inputHandlers[atc.CreateBuild]),
+				atc.CreateJobPlan:      authenticated(inputHandlers[atc.CreateJobPlan]),
+				atc.GetContainer:       authenticated(inputHandlers[atc.GetContainer]),
+				atc.HijackContainer:    authenticated(inputHandlers[atc.HijackContainer]),
+				atc.StreamInContainer:  authenticated(inputHandlers[atc.StreamInContainer]),
+				atc.StreamOutContainer: authenticated(inputHandlers[atc.StreamOutContainer]),
+				atc.ListContainers:     authenticated(inputHandlers[atc.ListContainers]),
+				atc.ListVolumes:        authenticated(inputHandlers[atc.ListVolumes]),
+				atc.ListTeamBuilds:     authenticated(inputHandlers[atc.ListTeamBuilds]),
+				atc.ExplainTeamAuth:    authenticated(inputHandlers[atc.ExplainTeamAuth]),
+				atc.ExportTeam:         authenticated(inputHandlers[atc.ExportTeam]),
+				atc.ImportTeam:         authenticated(inputHandlers[atc.ImportTeam]),
+				atc.ListWorkers:        authenticated(inputHandlers[atc.ListWorkers]),
+				atc.RegisterWorker:     authenticated(inputHandlers[atc.RegisterWorker]),
+				atc.HeartbeatWorker:    authenticated(inputHandlers[atc.HeartbeatWorker]),
+				atc.DeleteWorker:       authenticated(inputHandlers[atc.DeleteWorker]),
+				atc.GetTeam:            authenticated(inputHandlers[atc.GetTeam]),
+				atc.SetTeam:            authenticated(inputHandlers[atc.SetTeam]),
+				atc.RenameTeam:         authenticated(inputHandlers[atc.RenameTeam]),
+				atc.DestroyTeam:        authenticated(inputHandlers[atc.DestroyTeam]),
+				atc.GetUser:            authenticated(inputHandlers[atc.GetUser]),
+
+				atc.ListSessions:         authenticated(inputHandlers[atc.ListSessions]),
+				atc.RevokeSession:        authenticated(inputHandlers[atc.RevokeSession]),
+				atc.RevokeCurrentSession: authenticated(inputHandlers[atc.RevokeCurrentSession]),
+				atc.GetMaintenance:       authenticated(inputHandlers[atc.GetMaintenance]),
 
 				//authenticateIfTokenProvided / delegating to handler
 				atc.GetInfo:              authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
@@ -186,6 +198,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListTeams:            authenticateIfTokenProvided(inputHandlers[atc.ListTeams]),
 				atc.MainJobBadge:         authenticateIfTokenProvided(inputHandlers[atc.MainJobBadge]),
 				atc.GetWall:              authenticateIfTokenProvided(inputHandlers[atc.GetWall]),
+				atc.ListWallMessages:     authenticateIfTokenProvided(inputHandlers[atc.ListWallMessages]),
 
 				// authenticated and is admin
 				atc.GetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
@@ -194,6 +207,11 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListActiveUsersSince: authenticatedAndAdmin(inputHandlers[atc.ListActiveUsersSince]),
 				atc.SetWall:              authenticatedAndAdmin(inputHandlers[atc.SetWall]),
 				atc.ClearWall:            authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
+				atc.CreateWallMessage:    authenticatedAndAdmin(inputHandlers[atc.CreateWallMessage]),
+				atc.DeleteWallMessage:    authenticatedAndAdmin(inputHandlers[atc.DeleteWallMessage]),
+				atc.EnableMaintenance:    authenticatedAndAdmin(inputHandlers[atc.EnableMaintenance]),
+				atc.DisableMaintenance:   authenticatedAndAdmin(inputHandlers[atc.DisableMaintenance]),
+				atc.RevokeUserSessions:   authenticatedAndAdmin(inputHandlers[atc.RevokeUserSessions]),
 
 				// authorized (requested team matches resource team)
 				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
@@ -210,12 +228,15 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
 				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
 				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
+				atc.TeamEvents:              authorized(inputHandlers[atc.TeamEvents]),
+				atc.PipelineEvents:          authorized(inputHandlers[atc.PipelineEvents]),
 				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
 				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
 				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
 				atc.ArchivePipeline:         authorized(inputHandlers[atc.ArchivePipeline]),
 				atc.RenamePipeline:          authorized(inputHandlers[atc.RenamePipeline]),
 				atc.SaveConfig:              authorized(inputHandlers[atc.SaveConfig]),
+				atc.CheckConfigCreds:        authorized(inputHandlers[atc.CheckConfigCreds]),
 				atc.UnpauseJob:              authorized(inputHandlers[atc.UnpauseJob]),
 				atc.ScheduleJob:             authorized(inputHandlers[atc.ScheduleJob]),
 				atc.UnpausePipeline:         authorized(inputHandlers[atc.UnpausePipeline]),
@@ -225,6 +246,11 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
 				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
 				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.ListAPITokens:           authorized(inputHandlers[atc.ListAPITokens]),
+				atc.CreateAPIToken:          authorized(inputHandlers[atc.CreateAPIToken]),
+				atc.DeleteAPIToken:          authorized(inputHandlers[atc.DeleteAPIToken]),
+
+				atc.ListNotificationDeliveries: authorized(inputHandlers[atc.ListNotificationDeliveries]),
 			}
 		})
 
@@ -22,7 +22,7 @@ func (wrappa APIMetricsWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 	for name, handler := range handlers {
 		switch name {
-		case atc.BuildEvents, atc.DownloadCLI, atc.HijackContainer:
+		case atc.BuildEvents, atc.PipelineEvents, atc.TeamEvents, atc.DownloadCLI, atc.HijackContainer, atc.StreamInContainer, atc.StreamOutContainer:
 			wrapped[name] = handler
 		default:
 			wrapped[name] = metric.WrapHandler(wrappa.logger, name, handler)
@@ -23,7 +23,7 @@ func (wrappa CompressionWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 	for name, handler := range handlers {
 		switch name {
 		// always gzip for events
-		case atc.BuildEvents:
+		case atc.BuildEvents, atc.PipelineEvents, atc.TeamEvents:
 			gzipEnforcedHandler, err := gziphandler.GzipHandlerWithOpts(gziphandler.MinSize(0))
 			if err != nil {
 				wrappa.Logger.Error("failed-to-create-gzip-handler", err)
@@ -59,8 +59,10 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetPipeline,
 			atc.GetJobBuild,
 			atc.PipelineBadge,
+			atc.PipelineEvents,
 			atc.JobBadge,
 			atc.ListJobs,
+			atc.ListJobStats,
 			atc.GetJob,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
@@ -73,11 +75,15 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetResourceCausality,
 			atc.GetResourceVersion,
 			atc.CreateBuild,
+			atc.CreateJobPlan,
 			atc.GetContainer,
 			atc.HijackContainer,
+			atc.StreamInContainer,
+			atc.StreamOutContainer,
 			atc.ListContainers,
 			atc.ListVolumes,
 			atc.ListTeamBuilds,
+			atc.TeamEvents,
 			atc.ListWorkers,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
@@ -86,7 +92,14 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetTeam,
 			atc.RenameTeam,
 			atc.DestroyTeam,
+			atc.ExplainTeamAuth,
+			atc.ExportTeam,
+			atc.ImportTeam,
 			atc.GetUser,
+			atc.ListSessions,
+			atc.RevokeSession,
+			atc.RevokeCurrentSession,
+			atc.RevokeUserSessions,
 			atc.GetInfo,
 			atc.GetCheck,
 			atc.DownloadCLI,
@@ -105,22 +118,33 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListActiveUsersSince,
 			atc.SetWall,
 			atc.ClearWall,
+			atc.ListWallMessages,
+			atc.CreateWallMessage,
+			atc.DeleteWallMessage,
+			atc.GetMaintenance,
+			atc.EnableMaintenance,
+			atc.DisableMaintenance,
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
+			atc.ListNotificationDeliveries,
 			atc.ListJobInputs,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.ArchivePipeline,
 			atc.RenamePipeline,
 			atc.SaveConfig,
+			atc.CheckConfigCreds,
 			atc.UnpauseJob,
 			atc.ExposePipeline,
 			atc.HidePipeline,
 			atc.CreatePipelineBuild,
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.DeleteAPIToken:
 
 		default:
 			panic("how do archived pipelines affect your endpoint?")
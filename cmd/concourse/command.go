@@ -21,6 +21,8 @@ type ConcourseCommand struct {
 	RetireWorker retire.RetireWorkerCommand `command:"retire-worker" description:"Safely remove a worker from the cluster permanently."`
 
 	GenerateKey GenerateKeyCommand `command:"generate-key" description:"Generate RSA key for use with Concourse components."`
+
+	EncryptSecrets EncryptSecretsCommand `command:"encrypt-secrets" description:"Encrypt a secrets file for use with the encrypted file credential manager."`
 }
 
 func (cmd ConcourseCommand) LessenRequirements(parser *flags.Parser) {
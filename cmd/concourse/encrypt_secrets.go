@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/concourse/concourse/atc/creds/encryptedfile"
+	"sigs.k8s.io/yaml"
+)
+
+type EncryptSecretsCommand struct {
+	Key string `short:"k"  long:"key"  required:"true"  env:"CONCOURSE_ENCRYPTED_FILE_CREDS_KEY"  description:"A 16, 24 or 32 length key used to encrypt the secrets file. Must match --encrypted-file-creds-key on the web node."`
+
+	InputPath  string `short:"i"  long:"input"   required:"true"  description:"Path to a YAML or JSON file mapping secret paths such as 'main/my-pipeline/password' to their values."`
+	OutputPath string `short:"o"  long:"output"  required:"true"  description:"File path where the encrypted secrets file shall be created."`
+}
+
+func (cmd *EncryptSecretsCommand) Execute(args []string) error {
+	key, err := encryptedfile.NewKey(cmd.Key)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := ioutil.ReadFile(cmd.InputPath)
+	if err != nil {
+		return fmt.Errorf("failed to read secrets: %s", err)
+	}
+
+	var secrets map[string]interface{}
+	err = yaml.Unmarshal(plaintext, &secrets)
+	if err != nil {
+		return fmt.Errorf("failed to parse secrets: %s", err)
+	}
+
+	encrypted, err := encryptedfile.Encrypt(key, secrets)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secrets: %s", err)
+	}
+
+	err = ioutil.WriteFile(cmd.OutputPath, encrypted, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write encrypted secrets: %s", err)
+	}
+
+	fmt.Println("wrote encrypted secrets to", cmd.OutputPath)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type AddWallMessageCommand struct {
+	Message  string        `short:"m" long:"message" required:"true" description:"Message to show to users"`
+	Severity string        `short:"s" long:"severity" default:"info" choice:"info" choice:"warning" choice:"critical" description:"Severity of the message"`
+	StartsIn time.Duration `long:"starts-in" description:"Only show the message after this long from now, e.g. 2h"`
+	Duration time.Duration `short:"d" long:"duration" description:"Stop showing the message after it has been shown this long, e.g. 30m"`
+	Teams    []string      `long:"team" description:"Only show the message to members of this team (can be specified multiple times)"`
+}
+
+func (command *AddWallMessageCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	message := atc.WallMessage{
+		Message:  command.Message,
+		Severity: command.Severity,
+		Teams:    command.Teams,
+	}
+
+	startsAt := time.Now()
+	if command.StartsIn != 0 {
+		startsAt = startsAt.Add(command.StartsIn)
+		message.StartsAt = startsAt.Unix()
+	}
+
+	if command.Duration != 0 {
+		message.EndsAt = startsAt.Add(command.Duration).Unix()
+	}
+
+	created, err := target.Client().CreateWallMessage(message)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("added wall message %d\n", created.ID)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/go-archive/tarfs"
+)
+
+type CpCommand struct {
+	containerSelector
+
+	PositionalArgs struct {
+		Source      string `positional-arg-name:"SOURCE" required:"true" description:"File or directory to copy. Prefix with ':' for a path in the container"`
+		Destination string `positional-arg-name:"DEST"   required:"true" description:"Directory to copy into, created if missing. Prefix with ':' for a path in the container"`
+	} `positional-args:"yes"`
+}
+
+func (command *CpCommand) Execute([]string) error {
+	source := command.PositionalArgs.Source
+	destination := command.PositionalArgs.Destination
+
+	sourceInContainer := strings.HasPrefix(source, ":")
+	destinationInContainer := strings.HasPrefix(destination, ":")
+
+	if sourceInContainer == destinationInContainer {
+		return errors.New("exactly one of SOURCE or DEST must be a container path, prefixed with ':'")
+	}
+
+	_, team, container, err := command.selectContainer()
+	if err == io.EOF {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if sourceInContainer {
+		containerPath := resolveContainerPath(container.WorkingDirectory, source)
+
+		stream, err := team.StreamOutContainer(container.ID, containerPath, container.User)
+		if err != nil {
+			return err
+		}
+
+		defer stream.Close()
+
+		err = os.MkdirAll(destination, 0755)
+		if err != nil {
+			return err
+		}
+
+		err = tarfs.Extract(stream, destination)
+		if err != nil {
+			return fmt.Errorf("failed to extract %s: %s", containerPath, err)
+		}
+
+		fmt.Fprintf(ui.Stderr, "copied %s to %s\n", containerPath, destination)
+
+		return nil
+	}
+
+	_, err = os.Stat(source)
+	if err != nil {
+		return err
+	}
+
+	containerPath := resolveContainerPath(container.WorkingDirectory, destination)
+
+	archiveStream, archiveWriter := io.Pipe()
+
+	go func() {
+		archiveWriter.CloseWithError(tarfs.Compress(archiveWriter, filepath.Dir(source), filepath.Base(source)))
+	}()
+
+	err = team.StreamInContainer(container.ID, containerPath, container.User, archiveStream)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ui.Stderr, "copied %s to %s\n", source, containerPath)
+
+	return nil
+}
+
+// resolveContainerPath strips the ':' prefix from a container path argument and
+// resolves relative paths against the container's working directory.
+func resolveContainerPath(workingDirectory string, arg string) string {
+	p := strings.TrimPrefix(arg, ":")
+	if path.IsAbs(p) {
+		return p
+	}
+
+	return path.Join(workingDirectory, p)
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type CreateTokenCommand struct {
+	Name           string        `short:"n" long:"name" required:"true" description:"Name of the token"`
+	Role           string        `long:"role" default:"member" choice:"owner" choice:"member" choice:"pipeline-operator" choice:"viewer" description:"Maximum role granted to the token on the team"`
+	ExpiresIn      time.Duration `long:"expires-in" default:"720h" description:"How long the token is valid for"`
+	ServiceAccount bool          `long:"service-account" description:"Create a token owned by the team rather than by you (requires the owner role)"`
+	Team           string        `long:"team" description:"Name of the team the token grants access to, if different from the target default"`
+	Json           bool          `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *CreateTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	token, err := team.CreateAPIToken(atc.APITokenRequest{
+		Name:           command.Name,
+		Role:           command.Role,
+		ServiceAccount: command.ServiceAccount,
+		ExpiresIn:      command.ExpiresIn,
+	})
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(token)
+	}
+
+	fmt.Printf("created token '%s' with role '%s' on team '%s'\n", token.Name, token.Role, team.Name())
+	fmt.Printf("expires: %s\n\n", time.Unix(token.ExpiresAt, 0).Format(time.RFC1123))
+	fmt.Println(token.Token)
+	fmt.Println()
+	fmt.Println(ui.WarningColor("this is the only time the token will be shown; store it somewhere safe"))
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DeleteWallMessageCommand struct {
+	ID int `long:"id" required:"true" description:"ID of the wall message to delete"`
+}
+
+func (command *DeleteWallMessageCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Client().DeleteWallMessage(command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("wall message %d not found", command.ID)
+	}
+
+	fmt.Printf("deleted wall message %d\n", command.ID)
+
+	return nil
+}
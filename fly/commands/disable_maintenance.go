@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DisableMaintenanceCommand struct{}
+
+func (command *DisableMaintenanceCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = target.Client().DisableMaintenance()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("disabled maintenance mode")
+
+	return nil
+}
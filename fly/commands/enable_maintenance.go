@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type EnableMaintenanceCommand struct {
+	Reason      string `short:"r" long:"reason" description:"Why the cluster is in maintenance, shown to users"`
+	PauseChecks bool   `long:"pause-checks" description:"Also pause resource checking until maintenance mode is disabled"`
+}
+
+func (command *EnableMaintenanceCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = target.Client().EnableMaintenance(command.Reason, command.PauseChecks)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("enabled maintenance mode; no new builds will be started")
+	fmt.Println()
+	fmt.Println("run 'fly maintenance --wait' to wait for the running builds to finish")
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -21,10 +22,13 @@ import (
 	"github.com/concourse/concourse/fly/ui/progress"
 	"github.com/concourse/concourse/go-concourse/concourse"
 	"github.com/vbauerster/mpb/v4"
+	"sigs.k8s.io/yaml"
 )
 
 type ExecuteCommand struct {
-	TaskConfig     atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute"`
+	TaskConfig     atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute, or the pipeline config when running a job"`
+	Job            string                             `          long:"job"         value-name:"NAME"         description:"Run the named job from the pipeline config given by --config instead of a single task"`
+	SkipPuts       bool                               `          long:"skip-puts"                             description:"Skip the job's put steps (only with --job)"`
 	Privileged     bool                               `short:"p" long:"privileged"                            description:"Run the task with full privileges"`
 	IncludeIgnored bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
 	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
@@ -49,6 +53,14 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Job != "" {
+		return command.executeJob(target)
+	}
+
+	if command.SkipPuts {
+		return errors.New("--skip-puts can only be used with --job")
+	}
+
 	taskConfig, err := command.CreateTaskConfig(args)
 	if err != nil {
 		return err
@@ -99,6 +111,83 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		return err
 	}
 
+	return command.run(target, plan, outputs)
+}
+
+func (command *ExecuteCommand) executeJob(target rc.Target) error {
+	if len(command.Outputs) > 0 || len(command.InputMappings) > 0 || command.Image != "" || command.Privileged || len(command.Tags) > 0 {
+		return errors.New("--output, --input-mapping, --image, --privileged and --tag cannot be used with --job")
+	}
+
+	pipelineConfig, err := command.CreatePipelineConfig()
+	if err != nil {
+		return err
+	}
+
+	job, found := pipelineConfig.Jobs.Lookup(command.Job)
+	if !found {
+		return fmt.Errorf("unknown job `%s`", command.Job)
+	}
+
+	getNames := map[string]bool{}
+	_ = job.StepConfig().Visit(atc.StepRecursor{
+		OnGet: func(step *atc.GetStep) error {
+			getNames[step.Name] = true
+			return nil
+		},
+	})
+
+	for _, input := range command.Inputs {
+		if !getNames[input.Name] {
+			return fmt.Errorf("unknown input `%s`", input.Name)
+		}
+	}
+
+	err = executehelpers.CheckForInputType(command.Inputs)
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	request := atc.JobPlanRequest{
+		Config:    pipelineConfig,
+		Job:       command.Job,
+		Artifacts: map[string]int{},
+		SkipPuts:  command.SkipPuts,
+	}
+
+	if command.InputsFrom.PipelineName != "" {
+		request.Versions, request.ResourceTypes, err = executehelpers.FetchVersionsFromJob(team, command.InputsFrom)
+		if err != nil {
+			return err
+		}
+	}
+
+	localInputs, err := executehelpers.GenerateLocalInputs(
+		atc.NewPlanFactory(time.Now().Unix()),
+		team,
+		command.Inputs,
+		command.IncludeIgnored,
+		"",
+	)
+	if err != nil {
+		return err
+	}
+
+	for name, input := range localInputs {
+		request.Artifacts[name] = input.Plan.ArtifactInput.ArtifactID
+	}
+
+	plan, err := team.CreateJobPlan(request)
+	if err != nil {
+		return err
+	}
+
+	return command.run(target, plan, nil)
+}
+
+func (command *ExecuteCommand) run(target rc.Target, plan atc.Plan, outputs []executehelpers.Output) error {
 	client := target.Client()
 	clientURL, err := url.Parse(client.URL())
 	if err != nil {
@@ -181,6 +270,28 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	return nil
 }
 
+func (command *ExecuteCommand) CreatePipelineConfig() (atc.Config, error) {
+	pipelineTemplate := templatehelpers.NewYamlTemplateWithParams(
+		command.TaskConfig,
+		command.VarsFrom,
+		command.Var,
+		command.YAMLVar,
+	)
+
+	evaluated, err := pipelineTemplate.Evaluate(false, false)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	var pipelineConfig atc.Config
+	err = yaml.Unmarshal(evaluated, &pipelineConfig)
+	if err != nil {
+		return atc.Config{}, fmt.Errorf("failed to parse pipeline config: %s", err)
+	}
+
+	return pipelineConfig, nil
+}
+
 func (command *ExecuteCommand) CreateTaskConfig(args []string) (atc.TaskConfig, error) {
 
 	taskTemplate := templatehelpers.NewYamlTemplateWithParams(
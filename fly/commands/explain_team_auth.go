@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/skymarshal/skycmd"
+	"github.com/concourse/flag"
+	"github.com/fatih/color"
+)
+
+type ExplainTeamAuthCommand struct {
+	Team      flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to explain access to"`
+	Connector string               `long:"connector" description:"Connector of a hypothetical user to explain instead of yourself, e.g. 'github'"`
+	User      string               `long:"user" description:"User name or id of the hypothetical user"`
+	Groups    []string             `long:"group" description:"Group of the hypothetical user, as named by the connector, e.g. 'my-org:my-team'"`
+	Config    flag.File            `short:"c" long:"config" description:"Team configuration file to evaluate instead of the team's current configuration"`
+	JSON      bool                 `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ExplainTeamAuthCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	request := atc.ExplainTeamAuthRequest{}
+
+	if command.Connector != "" || command.User != "" || len(command.Groups) != 0 {
+		if command.Connector == "" {
+			return errors.New("--connector must be specified to explain a hypothetical user")
+		}
+
+		request.Subject = &atc.AuthSubject{
+			Connector: command.Connector,
+			UserID:    command.User,
+			UserName:  command.User,
+			Groups:    command.Groups,
+		}
+	}
+
+	if command.Config.Path() != "" {
+		authFlags := skycmd.AuthTeamFlags{Config: command.Config}
+
+		request.Auth, err = authFlags.Format()
+		if err != nil {
+			return err
+		}
+	}
+
+	explanation, err := target.Client().Team(command.Team.Name()).ExplainAuth(request)
+	if err != nil {
+		return err
+	}
+
+	if command.JSON {
+		return displayhelpers.JsonPrint(explanation)
+	}
+
+	fmt.Printf("team: %s\n", ui.Embolden("%s", explanation.Team))
+	fmt.Printf("user: %s\n", describeAuthSubject(explanation.Subject))
+
+	if len(explanation.Roles) == 0 {
+		fmt.Println("roles: none")
+	} else {
+		fmt.Printf("roles: %s\n", strings.Join(explanation.Roles, ", "))
+	}
+
+	fmt.Println()
+
+	if len(explanation.Rules) == 0 {
+		fmt.Println("no auth rules of the team match this user")
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "role", Color: color.New(color.Bold)},
+			{Contents: "matched", Color: color.New(color.Bold)},
+			{Contents: "pipelines", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, rule := range explanation.Rules {
+		matched := ui.TableCell{Contents: rule.Type + " " + rule.Value}
+		if rule.Type == atc.TeamAuthRuleAll {
+			matched = ui.TableCell{Contents: "all users", Color: color.New(color.Faint)}
+		}
+
+		pipelines := ui.TableCell{Contents: "all", Color: color.New(color.Faint)}
+		if len(rule.Pipelines) != 0 {
+			pipelines = ui.TableCell{Contents: strings.Join(rule.Pipelines, ",")}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: rule.Role},
+			matched,
+			pipelines,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func describeAuthSubject(subject atc.AuthSubject) string {
+	user := subject.UserName
+	if user == "" {
+		user = subject.UserID
+	}
+
+	description := subject.Connector + ":" + user
+	if len(subject.Groups) != 0 {
+		description += " (groups: " + strings.Join(subject.Groups, ", ") + ")"
+	}
+
+	return description
+}
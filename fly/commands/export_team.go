@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+type ExportTeamCommand struct {
+	Team   flaghelpers.TeamFlag `short:"n" long:"team-name" description:"Team to export (default: the target's team)"`
+	Output string               `short:"o" long:"output"    description:"File to write the export to (default: stdout)"`
+}
+
+func (command *ExportTeamCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	team := target.Team()
+	if command.Team != "" {
+		team = target.Client().Team(command.Team.Name())
+	}
+
+	export, err := team.Export()
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	payload = append(payload, '\n')
+
+	if command.Output == "" {
+		_, err = os.Stdout.Write(payload)
+		return err
+	}
+
+	err = ioutil.WriteFile(command.Output, payload, 0600)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ui.Stderr, "exported team %s with %d pipelines to %s\n", team.Name(), len(export.Pipelines), command.Output)
+
+	return nil
+}
+
+type ImportTeamCommand struct {
+	Team  flaghelpers.TeamFlag `short:"n" long:"team-name"                 description:"Team to import into (default: the team named in the export)"`
+	Input string               `short:"i" long:"input"     required:"true" description:"Export file to import, or - for stdin"`
+
+	CheckCredentials bool `long:"check-creds" description:"Validate the pipelines' credential variables against the credential manager before importing"`
+}
+
+func (command *ImportTeamCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	var input io.Reader = os.Stdin
+	if command.Input != "-" {
+		file, err := os.Open(command.Input)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		input = file
+	}
+
+	var export atc.TeamExport
+	err = json.NewDecoder(input).Decode(&export)
+	if err != nil {
+		return fmt.Errorf("failed to parse export: %s", err)
+	}
+
+	teamName := export.Team.Name
+	if command.Team != "" {
+		teamName = command.Team.Name()
+	}
+
+	if teamName == "" {
+		return errors.New("the export does not name a team; specify one with --team-name")
+	}
+
+	response, err := target.Client().Team(teamName).Import(export, command.CheckCredentials)
+	if err != nil {
+		if len(response.Pipelines) > 0 {
+			fmt.Fprintf(ui.Stderr, "pipelines imported before the failure: %s\n", strings.Join(response.Pipelines, ", "))
+		}
+
+		return err
+	}
+
+	for _, warning := range response.Warnings {
+		fmt.Fprintln(ui.Stderr, ui.WarningColor("WARNING: %s", warning))
+	}
+
+	if response.TeamCreated {
+		fmt.Printf("created team %s\n", teamName)
+	} else {
+		fmt.Printf("updated team %s\n", teamName)
+	}
+
+	for _, pipeline := range response.Pipelines {
+		fmt.Printf("  imported pipeline %s\n", pipeline)
+	}
+
+	fmt.Printf("imported %d pipelines into team %s\n", len(response.Pipelines), teamName)
+
+	return nil
+}
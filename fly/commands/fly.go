@@ -26,12 +26,28 @@ type FlyCommand struct {
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
 
+	Tokens      TokensCommand      `command:"tokens"       alias:"tks" description:"List the API tokens of a team"`
+	CreateToken CreateTokenCommand `command:"create-token" alias:"ctk" description:"Create an API token"`
+	RevokeToken RevokeTokenCommand `command:"revoke-token" alias:"rtk" description:"Revoke an API token"`
+
+	Sessions      SessionsCommand      `command:"sessions"       alias:"ss"  description:"List active login sessions"`
+	RevokeSession RevokeSessionCommand `command:"revoke-session" alias:"rss" description:"Revoke a login session, or every session of a user"`
+
+	WallMessages      WallMessagesCommand      `command:"wall-messages"       alias:"wms" description:"List the messages shown on the wall"`
+	AddWallMessage    AddWallMessageCommand    `command:"add-wall-message"    alias:"awm" description:"Show a message on the wall, optionally for a time window or to some teams (requires admin)"`
+	DeleteWallMessage DeleteWallMessageCommand `command:"delete-wall-message" alias:"dwm" description:"Take a message off the wall (requires admin)"`
+
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	GetTeam     GetTeamCommand     `command:"get-team"  alias:"gt" description:"Show team configuration"`
 	SetTeam     SetTeamCommand     `command:"set-team"  alias:"st" description:"Create or modify a team to have the given credentials"`
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	ExplainTeamAuth ExplainTeamAuthCommand `command:"explain-team-auth" alias:"eta" description:"Explain which auth rules of a team grant a user their roles"`
+
+	ExportTeam ExportTeamCommand `command:"export-team" description:"Export a team's auth, pipelines, and resource state to a file"`
+	ImportTeam ImportTeamCommand `command:"import-team" description:"Create or update a team from a file written by export-team"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -39,8 +55,10 @@ type FlyCommand struct {
 
 	Containers ContainersCommand `command:"containers" alias:"cs" description:"Print the active containers"`
 	Hijack     HijackCommand     `command:"hijack"     alias:"intercept" alias:"i" description:"Execute a command in a container"`
+	Cp         CpCommand         `command:"cp"                                     description:"Copy files into or out of a container"`
 
 	Jobs        JobsCommand        `command:"jobs"      alias:"js" description:"List the jobs in the pipelines"`
+	JobStats    JobStatsCommand    `command:"job-stats" description:"Show success rates, durations, and flakiness of the jobs in a pipeline"`
 	PauseJob    PauseJobCommand    `command:"pause-job" alias:"pj" description:"Pause a job"`
 	UnpauseJob  UnpauseJobCommand  `command:"unpause-job" alias:"uj" description:"Unpause a job"`
 	ScheduleJob ScheduleJobCommand `command:"schedule-job" alias:"sj" description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
@@ -59,6 +77,8 @@ type FlyCommand struct {
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
 
+	NotificationDeliveries NotificationDeliveriesCommand `command:"notification-deliveries" alias:"nds" description:"List the recent notification deliveries of a pipeline"`
+
 	Resources              ResourcesCommand              `command:"resources"                  alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"          alias:"rvs"  description:"List the versions of a resource"`
 	CheckResource          CheckResourceCommand          `command:"check-resource"             alias:"cr"   description:"Check a resource"`
@@ -83,6 +103,10 @@ type FlyCommand struct {
 	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
 	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
 
+	Maintenance        MaintenanceCommand        `command:"maintenance"         alias:"mt"  description:"Show the maintenance mode of the cluster and how many builds are still running"`
+	EnableMaintenance  EnableMaintenanceCommand  `command:"enable-maintenance"  alias:"emt" description:"Stop starting new builds, e.g. before an upgrade (requires admin)"`
+	DisableMaintenance DisableMaintenanceCommand `command:"disable-maintenance" alias:"dmt" description:"Resume starting builds (requires admin)"`
+
 	Curl CurlCommand `command:"curl" alias:"c" description:"curl the api"`
 
 	Completion CompletionCommand `command:"completion" description:"generate shell completion code"`
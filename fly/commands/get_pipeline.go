@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/pipelinegraph"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/mattn/go-isatty"
@@ -19,9 +20,14 @@ import (
 type GetPipelineCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get configuration of this pipeline"`
 	JSON     bool                     `short:"j" long:"json"                     description:"Print config as json instead of yaml"`
+	Graph    string                   `long:"graph" choice:"dot" choice:"mermaid" description:"Print the pipeline's job and resource graph in this format instead of its config"`
 }
 
 func (command *GetPipelineCommand) Validate() error {
+	if command.JSON && command.Graph != "" {
+		return errors.New("--json and --graph cannot be used together")
+	}
+
 	return command.Pipeline.Validate()
 }
 
@@ -53,6 +59,10 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 		return errors.New("pipeline not found")
 	}
 
+	if command.Graph != "" {
+		return pipelinegraph.New(pipelineName, config).Render(os.Stdout, command.Graph)
+	}
+
 	return dump(config, asJSON)
 }
 
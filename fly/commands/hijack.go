@@ -1,8 +1,10 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/url"
 	"os"
 	"sort"
@@ -16,27 +18,155 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/hijackhelpers"
 	"github.com/concourse/concourse/fly/pty"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
 	"github.com/tedsuo/rata"
 	"github.com/vito/go-interact/interact"
 )
 
 type HijackCommand struct {
-	Job            flaghelpers.JobFlag      `short:"j" long:"job"   value-name:"PIPELINE/JOB"   description:"Name of a job to hijack"`
-	Handle         string                   `          long:"handle"                            description:"Handle id of a job to hijack"`
-	Check          flaghelpers.ResourceFlag `short:"c" long:"check" value-name:"PIPELINE/CHECK" description:"Name of a resource's checking container to hijack"`
-	Url            string                   `short:"u" long:"url"                               description:"URL for the build, job, or check container to hijack"`
-	Build          string                   `short:"b" long:"build"                             description:"Build number within the job, or global build ID"`
-	StepName       string                   `short:"s" long:"step"                              description:"Name of step to hijack (e.g. build, unit, resource name)"`
-	StepType       string                   `          long:"step-type"                         description:"Type of step to hijack (e.g. get, put, task)"`
-	Attempt        string                   `short:"a" long:"attempt" value-name:"N[,N,...]"    description:"Attempt number of step to hijack."`
+	containerSelector
+
+	PortForwards []flaghelpers.PortForwardFlag `short:"L" long:"port-forward" value-name:"[LOCAL_PORT:]CONTAINER_PORT" description:"Forward a local port to a port in the container instead of running a command (can be specified multiple times)"`
+
 	PositionalArgs struct {
 		Command []string `positional-arg-name:"command" description:"The command to run in the container (default: bash)"`
 	} `positional-args:"yes"`
-	Team string `long:"team" description:"Name of the team to which the container belongs, if different from the target default"`
+}
+
+// containerSelector holds the flags used to pick a single container, shared
+// by the commands which operate on one.
+type containerSelector struct {
+	Job      flaghelpers.JobFlag      `short:"j" long:"job"   value-name:"PIPELINE/JOB"   description:"Name of a job to hijack"`
+	Handle   string                   `          long:"handle"                            description:"Handle id of a job to hijack"`
+	Check    flaghelpers.ResourceFlag `short:"c" long:"check" value-name:"PIPELINE/CHECK" description:"Name of a resource's checking container to hijack"`
+	Url      string                   `short:"u" long:"url"                               description:"URL for the build, job, or check container to hijack"`
+	Build    string                   `short:"b" long:"build"                             description:"Build number within the job, or global build ID"`
+	StepName string                   `short:"s" long:"step"                              description:"Name of step to hijack (e.g. build, unit, resource name)"`
+	StepType string                   `          long:"step-type"                         description:"Type of step to hijack (e.g. get, put, task)"`
+	Attempt  string                   `short:"a" long:"attempt" value-name:"N[,N,...]"    description:"Attempt number of step to hijack."`
+	Team     string                   `long:"team" description:"Name of the team to which the container belongs, if different from the target default"`
 }
 
 func (command *HijackCommand) Execute([]string) error {
+	if len(command.PortForwards) > 0 && len(command.PositionalArgs.Command) > 0 {
+		return errors.New("a command cannot be given when forwarding ports")
+	}
+
+	target, team, chosenContainer, err := command.selectContainer()
+	if err == io.EOF {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(command.PortForwards) > 0 {
+		return command.forwardPorts(target, team, chosenContainer)
+	}
+
+	privileged := true
+
+	reqGenerator := rata.NewRequestGenerator(target.URL(), atc.Routes)
+
+	var ttySpec *atc.HijackTTYSpec
+	rows, cols, err := pty.Getsize(os.Stdout)
+	if err == nil {
+		ttySpec = &atc.HijackTTYSpec{
+			WindowSize: atc.HijackWindowSize{
+				Columns: cols,
+				Rows:    rows,
+			},
+		}
+	}
+
+	path, args := remoteCommand(command.PositionalArgs.Command)
+
+	spec := atc.HijackProcessSpec{
+		Path: path,
+		Args: args,
+		Env:  []string{"TERM=" + os.Getenv("TERM")},
+		User: chosenContainer.User,
+		Dir:  chosenContainer.WorkingDirectory,
+
+		Privileged: privileged,
+		TTY:        ttySpec,
+	}
+
+	result, err := func() (int, error) { // so the term.Restore() can run before the os.Exit()
+		var in io.Reader
+
+		if pty.IsTerminal() {
+			term, err := pty.OpenRawTerm()
+			if err != nil {
+				return -1, err
+			}
+
+			defer func() {
+				_ = term.Restore()
+			}()
+
+			in = term
+		} else {
+			in = os.Stdin
+		}
+
+		io := hijacker.ProcessIO{
+			In:  in,
+			Out: os.Stdout,
+			Err: os.Stderr,
+		}
+
+		h := hijacker.New(target.TLSConfig(), reqGenerator, target.Token())
+
+		return h.Hijack(team.Name(), chosenContainer.ID, spec, io)
+	}()
+
+	if err != nil {
+		return err
+	}
+
+	os.Exit(result)
+
+	return nil
+}
+
+// forwardPorts listens on each local port and relays its connections to the
+// container until fly is interrupted or a listener fails.
+func (command *HijackCommand) forwardPorts(target rc.Target, team concourse.Team, container atc.Container) error {
+	reqGenerator := rata.NewRequestGenerator(target.URL(), atc.Routes)
+	h := hijacker.New(target.TLSConfig(), reqGenerator, target.Token())
+
+	spec := atc.HijackProcessSpec{
+		User: container.User,
+		Dir:  container.WorkingDirectory,
+	}
+
+	errs := make(chan error, len(command.PortForwards))
+
+	for _, forward := range command.PortForwards {
+		listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", forward.LocalPort))
+		if err != nil {
+			return err
+		}
+
+		defer listener.Close()
+
+		fmt.Printf("forwarding 127.0.0.1:%d to port %d in the container\n", forward.LocalPort, forward.ContainerPort)
+
+		go func(listener net.Listener, port int) {
+			errs <- h.Forward(listener, team.Name(), container.ID, spec, port, ui.Stderr)
+		}(listener, forward.ContainerPort)
+	}
+
+	return <-errs
+}
+
+// selectContainer loads the target and finds the container matching the
+// flags, asking the user to choose if there is more than one. io.EOF is
+// returned if the user did not choose one.
+func (command *containerSelector) selectContainer() (rc.Target, concourse.Team, atc.Container, error) {
 	var (
 		chosenContainer atc.Container
 		err             error
@@ -47,30 +177,30 @@ func (command *HijackCommand) Execute([]string) error {
 	if Fly.Target == "" && command.Url != "" {
 		u, err := url.Parse(command.Url)
 		if err != nil {
-			return err
+			return nil, nil, atc.Container{}, err
 		}
 		urlMap := parseUrlPath(u.Path)
 		target, name, err = rc.LoadTargetFromURL(fmt.Sprintf("%s://%s", u.Scheme, u.Host), urlMap["teams"], Fly.Verbose)
 		if err != nil {
-			return err
+			return nil, nil, atc.Container{}, err
 		}
 		Fly.Target = name
 	} else {
 		target, err = rc.LoadTarget(Fly.Target, Fly.Verbose)
 		if err != nil {
-			return err
+			return nil, nil, atc.Container{}, err
 		}
 	}
 
 	err = target.Validate()
 	if err != nil {
-		return err
+		return nil, nil, atc.Container{}, err
 	}
 
 	if command.Team != "" {
 		team, err = target.FindTeam(command.Team)
 		if err != nil {
-			return err
+			return nil, nil, atc.Container{}, err
 		}
 	} else {
 		team = target.Team()
@@ -85,12 +215,12 @@ func (command *HijackCommand) Execute([]string) error {
 	} else {
 		fingerprint, err := command.getContainerFingerprint(target, team)
 		if err != nil {
-			return err
+			return nil, nil, atc.Container{}, err
 		}
 
 		containers, err := command.getContainerIDs(target, fingerprint, team)
 		if err != nil {
-			return err
+			return nil, nil, atc.Container{}, err
 		}
 
 		hijackableContainers := make([]atc.Container, 0)
@@ -141,82 +271,15 @@ func (command *HijackCommand) Execute([]string) error {
 			}
 
 			err = interact.NewInteraction("choose a container", choices...).Resolve(&chosenContainer)
-			if err == io.EOF {
-				return nil
-			}
-
 			if err != nil {
-				return err
+				return nil, nil, atc.Container{}, err
 			}
 		} else {
 			chosenContainer = hijackableContainers[0]
 		}
 	}
 
-	privileged := true
-
-	reqGenerator := rata.NewRequestGenerator(target.URL(), atc.Routes)
-
-	var ttySpec *atc.HijackTTYSpec
-	rows, cols, err := pty.Getsize(os.Stdout)
-	if err == nil {
-		ttySpec = &atc.HijackTTYSpec{
-			WindowSize: atc.HijackWindowSize{
-				Columns: cols,
-				Rows:    rows,
-			},
-		}
-	}
-
-	path, args := remoteCommand(command.PositionalArgs.Command)
-
-	spec := atc.HijackProcessSpec{
-		Path: path,
-		Args: args,
-		Env:  []string{"TERM=" + os.Getenv("TERM")},
-		User: chosenContainer.User,
-		Dir:  chosenContainer.WorkingDirectory,
-
-		Privileged: privileged,
-		TTY:        ttySpec,
-	}
-
-	result, err := func() (int, error) { // so the term.Restore() can run before the os.Exit()
-		var in io.Reader
-
-		if pty.IsTerminal() {
-			term, err := pty.OpenRawTerm()
-			if err != nil {
-				return -1, err
-			}
-
-			defer func() {
-				_ = term.Restore()
-			}()
-
-			in = term
-		} else {
-			in = os.Stdin
-		}
-
-		io := hijacker.ProcessIO{
-			In:  in,
-			Out: os.Stdout,
-			Err: os.Stderr,
-		}
-
-		h := hijacker.New(target.TLSConfig(), reqGenerator, target.Token())
-
-		return h.Hijack(team.Name(), chosenContainer.ID, spec, io)
-	}()
-
-	if err != nil {
-		return err
-	}
-
-	os.Exit(result)
-
-	return nil
+	return target, team, chosenContainer, nil
 }
 
 func parseUrlPath(urlPath string) map[string]string {
@@ -233,7 +296,7 @@ func parseUrlPath(urlPath string) map[string]string {
 	return urlMap
 }
 
-func (command *HijackCommand) getContainerFingerprintFromUrl(target rc.Target, urlParam string, team concourse.Team) (*containerFingerprint, error) {
+func (command *containerSelector) getContainerFingerprintFromUrl(target rc.Target, urlParam string, team concourse.Team) (*containerFingerprint, error) {
 	u, err := url.Parse(urlParam)
 	if err != nil {
 		return nil, err
@@ -269,7 +332,7 @@ func (command *HijackCommand) getContainerFingerprintFromUrl(target rc.Target, u
 	return fingerprint, nil
 }
 
-func (command *HijackCommand) getContainerFingerprint(target rc.Target, team concourse.Team) (*containerFingerprint, error) {
+func (command *containerSelector) getContainerFingerprint(target rc.Target, team concourse.Team) (*containerFingerprint, error) {
 	var err error
 	fingerprint := &containerFingerprint{}
 
@@ -305,7 +368,7 @@ func (command *HijackCommand) getContainerFingerprint(target rc.Target, team con
 	return fingerprint, nil
 }
 
-func (command *HijackCommand) getContainerIDs(target rc.Target, fingerprint *containerFingerprint, team concourse.Team) ([]atc.Container, error) {
+func (command *containerSelector) getContainerIDs(target rc.Target, fingerprint *containerFingerprint, team concourse.Team) ([]atc.Container, error) {
 	reqValues, err := locateContainer(target.Client(), fingerprint)
 	if err != nil {
 		return nil, err
@@ -31,15 +31,42 @@ func ShowErrors(errorHeader string, errorMessages []string) {
 	fmt.Fprintln(ui.Stderr, "")
 }
 
-func ShowWarnings(warnings []concourse.ConfigWarning) {
-	fmt.Fprintln(ui.Stderr, "")
-	PrintDeprecationWarningHeader()
+func PrintPolicyCheckWarningHeader() {
+	printColorFunc := ui.ErroredColor.SprintFunc()
+	fmt.Fprintf(ui.Stderr, "%s\n", printColorFunc("POLICY CHECK WARNING:"))
+}
 
+func ShowWarnings(warnings []concourse.ConfigWarning) {
+	var deprecations, policyWarnings []concourse.ConfigWarning
 	for _, warning := range warnings {
-		fmt.Fprintf(ui.Stderr, "  - %s\n", warning.Message)
+		if warning.Type == "policy" {
+			policyWarnings = append(policyWarnings, warning)
+		} else {
+			deprecations = append(deprecations, warning)
+		}
 	}
 
-	fmt.Fprintln(ui.Stderr, "")
+	if len(deprecations) > 0 {
+		fmt.Fprintln(ui.Stderr, "")
+		PrintDeprecationWarningHeader()
+
+		for _, warning := range deprecations {
+			fmt.Fprintf(ui.Stderr, "  - %s\n", warning.Message)
+		}
+
+		fmt.Fprintln(ui.Stderr, "")
+	}
+
+	if len(policyWarnings) > 0 {
+		fmt.Fprintln(ui.Stderr, "")
+		PrintPolicyCheckWarningHeader()
+
+		for _, warning := range policyWarnings {
+			fmt.Fprintf(ui.Stderr, "  - %s\n", warning.Message)
+		}
+
+		fmt.Fprintln(ui.Stderr, "")
+	}
 }
 
 func Failf(message string, args ...interface{}) {
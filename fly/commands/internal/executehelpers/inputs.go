@@ -242,6 +242,35 @@ func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom fl
 	return kvMap, imageResource, nil
 }
 
+// FetchVersionsFromJob returns the versions the given job would currently use
+// for each of its inputs, along with the pipeline's resource types.
+func FetchVersionsFromJob(team concourse.Team, inputsFrom flaghelpers.JobFlag) (map[string]atc.Version, atc.VersionedResourceTypes, error) {
+	buildInputs, found, err := team.BuildInputsForJob(inputsFrom.PipelineName, inputsFrom.JobName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !found {
+		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", inputsFrom.PipelineName, inputsFrom.JobName)
+	}
+
+	versionedResourceTypes, found, err := team.VersionedResourceTypes(inputsFrom.PipelineName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !found {
+		return nil, nil, fmt.Errorf("versioned resource types of %s not found", inputsFrom.PipelineName)
+	}
+
+	versions := map[string]atc.Version{}
+	for _, buildInput := range buildInputs {
+		versions[buildInput.Name] = buildInput.Version
+	}
+
+	return versions, versionedResourceTypes, nil
+}
+
 func FetchImageResourceFromJobInputs(inputs []atc.BuildInput, imageName string) (*atc.ImageResource, bool, error) {
 
 	for _, input := range inputs {
@@ -0,0 +1,43 @@
+package flaghelpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type PortForwardFlag struct {
+	LocalPort     int
+	ContainerPort int
+}
+
+func (flag *PortForwardFlag) UnmarshalFlag(value string) error {
+	ports := strings.SplitN(value, ":", 2)
+	if len(ports) == 1 {
+		ports = append(ports, ports[0])
+	}
+
+	local, err := parsePort(ports[0])
+	if err != nil {
+		return fmt.Errorf("invalid port forward '%s': %s", value, err)
+	}
+
+	container, err := parsePort(ports[1])
+	if err != nil {
+		return fmt.Errorf("invalid port forward '%s': %s", value, err)
+	}
+
+	flag.LocalPort = local
+	flag.ContainerPort = container
+
+	return nil
+}
+
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("'%s' is not a valid port", value)
+	}
+
+	return port, nil
+}
@@ -0,0 +1,31 @@
+package flaghelpers_test
+
+import (
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PortForwardFlag", func() {
+	var flag *PortForwardFlag
+
+	BeforeEach(func() {
+		flag = &PortForwardFlag{}
+	})
+
+	It("parses a local and container port", func() {
+		Expect(flag.UnmarshalFlag("15432:5432")).To(Succeed())
+		Expect(*flag).To(Equal(PortForwardFlag{LocalPort: 15432, ContainerPort: 5432}))
+	})
+
+	It("uses the same port on both sides when only one is given", func() {
+		Expect(flag.UnmarshalFlag("8080")).To(Succeed())
+		Expect(*flag).To(Equal(PortForwardFlag{LocalPort: 8080, ContainerPort: 8080}))
+	})
+
+	It("rejects ports that are not numbers in range", func() {
+		Expect(flag.UnmarshalFlag("80:http")).To(MatchError("invalid port forward '80:http': 'http' is not a valid port"))
+		Expect(flag.UnmarshalFlag("0:80")).To(MatchError("invalid port forward '0:80': '0' is not a valid port"))
+	})
+})
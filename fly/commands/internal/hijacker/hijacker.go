@@ -2,6 +2,7 @@ package hijacker
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -68,10 +69,17 @@ func (h *Hijacker) Hijack(teamName, handle string, spec atc.HijackProcessSpec, p
 	inputs := make(chan atc.HijackInput, 1)
 	finished := make(chan struct{}, 1)
 
-	go h.monitorTTYSize(inputs, finished)
+	if spec.TTY != nil {
+		go h.monitorTTYSize(inputs, finished)
+	}
+
 	go func() {
-		io.Copy(&stdinWriter{inputs}, pio.In)
-		inputs <- atc.HijackInput{Closed: true}
+		stdin := &stdinWriter{inputs: inputs, finished: finished}
+
+		_, err := io.Copy(stdin, pio.In)
+		if err != errFinished {
+			_, _ = stdin.send(atc.HijackInput{Closed: true})
+		}
 	}()
 	go h.handleInput(conn, inputs, finished)
 
@@ -181,16 +189,26 @@ func (h *Hijacker) monitorTTYSize(inputs chan<- atc.HijackInput, finished chan s
 	}
 }
 
+var errFinished = errors.New("hijack session finished")
+
 type stdinWriter struct {
-	inputs chan<- atc.HijackInput
+	inputs   chan<- atc.HijackInput
+	finished <-chan struct{}
 }
 
 func (w *stdinWriter) Write(d []byte) (int, error) {
-	w.inputs <- atc.HijackInput{
+	return w.send(atc.HijackInput{
 		Stdin: d,
-	}
+	})
+}
 
-	return len(d), nil
+func (w *stdinWriter) send(input atc.HijackInput) (int, error) {
+	select {
+	case w.inputs <- input:
+		return len(input.Stdin), nil
+	case <-w.finished:
+		return 0, errFinished
+	}
 }
 
 var websocketSchemeMap = map[string]string{
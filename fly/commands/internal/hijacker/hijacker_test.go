@@ -3,6 +3,8 @@ package hijacker_test
 import (
 	"crypto/tls"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"time"
 
@@ -103,4 +105,97 @@ var _ = Describe("Hijacker", func() {
 			Eventually(didGetPing).Should(BeClosed())
 		})
 	})
+
+	Describe("forwarding a port", func() {
+		var (
+			listener  net.Listener
+			spec      chan atc.HijackProcessSpec
+			forwarded chan error
+		)
+
+		BeforeEach(func() {
+			spec = make(chan atc.HijackProcessSpec, 1)
+
+			server.RouteToHandler("GET", "/api/v1/teams/some-team/containers/some-handle/hijack", func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				conn, err := upgrader.Upgrade(w, r, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				defer conn.Close()
+
+				var processSpec atc.HijackProcessSpec
+				err = conn.ReadJSON(&processSpec)
+				Expect(err).NotTo(HaveOccurred())
+
+				spec <- processSpec
+
+				for {
+					var input atc.HijackInput
+					err := conn.ReadJSON(&input)
+					if err != nil {
+						return
+					}
+
+					if input.Closed {
+						exitStatus := 0
+						_ = conn.WriteJSON(atc.HijackOutput{ExitStatus: &exitStatus})
+						return
+					}
+
+					_ = conn.WriteJSON(atc.HijackOutput{Stdout: input.Stdin})
+				}
+			})
+
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+
+			reqGenerator := rata.NewRequestGenerator(server.URL(), atc.Routes)
+			h := hijacker.New(&tls.Config{}, reqGenerator, nil)
+
+			forwarded = make(chan error, 1)
+			go func() {
+				forwarded <- h.Forward(listener, "some-team", "some-handle", atc.HijackProcessSpec{
+					User: "root",
+					Dir:  "/tmp/build/some-guid",
+				}, 5432, GinkgoWriter)
+			}()
+		})
+
+		AfterEach(func() {
+			listener.Close()
+		})
+
+		It("relays each connection through a hijacked relay process", func() {
+			conn, err := net.Dial("tcp", listener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+
+			defer conn.Close()
+
+			_, err = conn.Write([]byte("ping"))
+			Expect(err).NotTo(HaveOccurred())
+
+			buf := make([]byte, 4)
+			_, err = io.ReadFull(conn, buf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(buf)).To(Equal("ping"))
+
+			var processSpec atc.HijackProcessSpec
+			Expect(spec).To(Receive(&processSpec))
+
+			path, args := hijacker.RelayCommand(5432)
+			Expect(processSpec.Path).To(Equal(path))
+			Expect(processSpec.Args).To(Equal(args))
+			Expect(processSpec.Args[len(args)-1]).To(Equal("5432"))
+			Expect(processSpec.User).To(Equal("root"))
+			Expect(processSpec.Dir).To(Equal("/tmp/build/some-guid"))
+			Expect(processSpec.TTY).To(BeNil())
+		})
+
+		It("stops when the listener is closed", func() {
+			listener.Close()
+			Eventually(forwarded).Should(Receive(HaveOccurred()))
+		})
+	})
 })
@@ -0,0 +1,58 @@
+package hijacker
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// relayScript pipes stdin and stdout to a TCP port on the container's
+// loopback interface using whichever tool the image happens to have.
+const relayScript = `port="$1"
+if command -v nc >/dev/null 2>&1; then exec nc 127.0.0.1 "$port"; fi
+if command -v socat >/dev/null 2>&1; then exec socat - "TCP:127.0.0.1:$port"; fi
+if command -v bash >/dev/null 2>&1; then exec bash -c 'exec 3<>"/dev/tcp/127.0.0.1/$0" || exit 1; cat <&3 & r=$!; exec 4<&0; cat <&4 >&3 & w=$!; wait -n; kill $r $w 2>/dev/null' "$port"; fi
+echo "port forwarding requires nc, socat, or bash in the container" >&2
+exit 1`
+
+// RelayCommand returns the path and args of a process which relays its stdin
+// and stdout to the given port in the container.
+func RelayCommand(port int) (string, []string) {
+	return "sh", []string{"-c", relayScript, "relay", strconv.Itoa(port)}
+}
+
+// Forward accepts connections on the listener until it is closed, relaying
+// each one to the given port in the container over its own hijack session.
+// The spec determines the user and working directory of the relay process.
+func (h *Hijacker) Forward(listener net.Listener, teamName, handle string, spec atc.HijackProcessSpec, port int, stderr io.Writer) error {
+	spec.Path, spec.Args = RelayCommand(port)
+	spec.TTY = nil
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			defer conn.Close()
+
+			exitStatus, err := h.Hijack(teamName, handle, spec, ProcessIO{
+				In:  conn,
+				Out: conn,
+				Err: stderr,
+			})
+			if err != nil {
+				fmt.Fprintf(stderr, "failed to forward %s: %s\n", conn.RemoteAddr(), err)
+				return
+			}
+
+			if exitStatus != 0 {
+				fmt.Fprintf(stderr, "relay to port %d exited with status %d\n", port, exitStatus)
+			}
+		}()
+	}
+}
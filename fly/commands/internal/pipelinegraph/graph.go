@@ -0,0 +1,220 @@
+package pipelinegraph
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type NodeKind string
+
+const (
+	JobNode      NodeKind = "job"
+	ResourceNode NodeKind = "resource"
+)
+
+type Node struct {
+	ID   string
+	Kind NodeKind
+	Name string
+}
+
+type EdgeKind string
+
+const (
+	// GetEdge goes from a resource to a job which gets it without passed
+	// constraints.
+	GetEdge EdgeKind = "get"
+
+	// PassedEdge goes from a job named in a get step's passed constraints to
+	// the job with the get step. It is labelled with the resource.
+	PassedEdge EdgeKind = "passed"
+
+	// PutEdge goes from a job to a resource it puts to.
+	PutEdge EdgeKind = "put"
+)
+
+type Edge struct {
+	From    string
+	To      string
+	Kind    EdgeKind
+	Label   string
+	Trigger bool
+}
+
+type Group struct {
+	Name  string
+	Nodes []string
+}
+
+// Graph is the job and resource dependency graph of a pipeline. Every node
+// belongs to at most one group; nodes in no group are listed in Ungrouped.
+type Graph struct {
+	Name      string
+	Nodes     []Node
+	Edges     []Edge
+	Groups    []Group
+	Ungrouped []string
+}
+
+// New builds the graph of the pipeline config. Jobs are placed in the first
+// group which lists them. Resources are placed in the first group which lists
+// them, or else in the group of the jobs using them if they all share one.
+func New(name string, config atc.Config) Graph {
+	graph := Graph{Name: name}
+
+	nodes := map[string]bool{}
+	addNode := func(kind NodeKind, name string) string {
+		id := string(kind) + ":" + name
+		if !nodes[id] {
+			nodes[id] = true
+			graph.Nodes = append(graph.Nodes, Node{ID: id, Kind: kind, Name: name})
+		}
+
+		return id
+	}
+
+	for _, resource := range config.Resources {
+		addNode(ResourceNode, resource.Name)
+	}
+
+	edges := map[Edge]int{}
+	addEdge := func(edge Edge) {
+		trigger := edge.Trigger
+		edge.Trigger = false
+
+		if i, found := edges[edge]; found {
+			graph.Edges[i].Trigger = graph.Edges[i].Trigger || trigger
+			return
+		}
+
+		edges[edge] = len(graph.Edges)
+		edge.Trigger = trigger
+		graph.Edges = append(graph.Edges, edge)
+	}
+
+	resourceJobs := map[string][]string{}
+
+	for _, job := range config.Jobs {
+		jobID := addNode(JobNode, job.Name)
+
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnGet: func(step *atc.GetStep) error {
+				resourceID := addNode(ResourceNode, step.ResourceName())
+				resourceJobs[resourceID] = append(resourceJobs[resourceID], jobID)
+
+				if len(step.Passed) == 0 {
+					addEdge(Edge{
+						From:    resourceID,
+						To:      jobID,
+						Kind:    GetEdge,
+						Trigger: step.Trigger,
+					})
+
+					return nil
+				}
+
+				for _, passed := range step.Passed {
+					addEdge(Edge{
+						From:    addNode(JobNode, passed),
+						To:      jobID,
+						Kind:    PassedEdge,
+						Label:   step.ResourceName(),
+						Trigger: step.Trigger,
+					})
+				}
+
+				return nil
+			},
+			OnPut: func(step *atc.PutStep) error {
+				resourceID := addNode(ResourceNode, step.ResourceName())
+				resourceJobs[resourceID] = append(resourceJobs[resourceID], jobID)
+
+				addEdge(Edge{
+					From: jobID,
+					To:   resourceID,
+					Kind: PutEdge,
+				})
+
+				return nil
+			},
+		})
+	}
+
+	nodeGroup := map[string]int{}
+	for i, group := range config.Groups {
+		graph.Groups = append(graph.Groups, Group{Name: group.Name})
+
+		for _, job := range group.Jobs {
+			id := string(JobNode) + ":" + job
+			if _, placed := nodeGroup[id]; !placed && nodes[id] {
+				nodeGroup[id] = i
+			}
+		}
+
+		for _, resource := range group.Resources {
+			id := string(ResourceNode) + ":" + resource
+			if _, placed := nodeGroup[id]; !placed && nodes[id] {
+				nodeGroup[id] = i
+			}
+		}
+	}
+
+	for _, node := range graph.Nodes {
+		if node.Kind != ResourceNode {
+			continue
+		}
+
+		if _, placed := nodeGroup[node.ID]; placed {
+			continue
+		}
+
+		group, shared := -1, true
+		for _, jobID := range resourceJobs[node.ID] {
+			jobGroup, found := nodeGroup[jobID]
+			if !found || (group != -1 && jobGroup != group) {
+				shared = false
+				break
+			}
+
+			group = jobGroup
+		}
+
+		if shared && group != -1 {
+			nodeGroup[node.ID] = group
+		}
+	}
+
+	for _, node := range graph.Nodes {
+		if i, found := nodeGroup[node.ID]; found {
+			graph.Groups[i].Nodes = append(graph.Groups[i].Nodes, node.ID)
+		} else {
+			graph.Ungrouped = append(graph.Ungrouped, node.ID)
+		}
+	}
+
+	return graph
+}
+
+// Render writes the graph in the given format, either "dot" or "mermaid".
+func (graph Graph) Render(w io.Writer, format string) error {
+	switch format {
+	case "dot":
+		return graph.DOT(w)
+	case "mermaid":
+		return graph.Mermaid(w)
+	default:
+		return fmt.Errorf("unknown graph format `%s`", format)
+	}
+}
+
+func (graph Graph) node(id string) Node {
+	for _, node := range graph.Nodes {
+		if node.ID == id {
+			return node
+		}
+	}
+
+	return Node{}
+}
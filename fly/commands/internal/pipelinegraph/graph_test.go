@@ -0,0 +1,186 @@
+package pipelinegraph_test
+
+import (
+	"bytes"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/pipelinegraph"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Graph", func() {
+	var (
+		config atc.Config
+		graph  pipelinegraph.Graph
+	)
+
+	BeforeEach(func() {
+		config = atc.Config{
+			Groups: atc.GroupConfigs{
+				{Name: "build", Jobs: []string{"unit", "package"}},
+				{Name: "ship", Jobs: []string{"deploy"}, Resources: []string{"prod"}},
+			},
+			Resources: atc.ResourceConfigs{
+				{Name: "repo", Type: "git"},
+				{Name: "tarball", Type: "s3"},
+				{Name: "prod", Type: "cf"},
+			},
+			Jobs: atc.JobConfigs{
+				{
+					Name: "unit",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Trigger: true}},
+					},
+				},
+				{
+					Name: "package",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Passed: []string{"unit"}, Trigger: true}},
+						{Config: &atc.PutStep{Name: "tarball"}},
+					},
+				},
+				{
+					Name: "deploy",
+					PlanSequence: []atc.Step{
+						{
+							Config: &atc.InParallelStep{
+								Config: atc.InParallelConfig{
+									Steps: []atc.Step{
+										{Config: &atc.GetStep{Name: "tarball", Passed: []string{"package"}}},
+										{Config: &atc.GetStep{Name: "source", Resource: "repo", Passed: []string{"package"}}},
+									},
+								},
+							},
+						},
+						{Config: &atc.PutStep{Name: "prod"}},
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		graph = pipelinegraph.New("some-pipeline", config)
+	})
+
+	It("has an edge for each get, passed constraint, and put", func() {
+		Expect(graph.Edges).To(Equal([]pipelinegraph.Edge{
+			{From: "resource:repo", To: "job:unit", Kind: pipelinegraph.GetEdge, Trigger: true},
+			{From: "job:unit", To: "job:package", Kind: pipelinegraph.PassedEdge, Label: "repo", Trigger: true},
+			{From: "job:package", To: "resource:tarball", Kind: pipelinegraph.PutEdge},
+			{From: "job:package", To: "job:deploy", Kind: pipelinegraph.PassedEdge, Label: "tarball"},
+			{From: "job:package", To: "job:deploy", Kind: pipelinegraph.PassedEdge, Label: "repo"},
+			{From: "job:deploy", To: "resource:prod", Kind: pipelinegraph.PutEdge},
+		}))
+	})
+
+	It("places nodes in groups", func() {
+		Expect(graph.Groups).To(Equal([]pipelinegraph.Group{
+			{Name: "build", Nodes: []string{"job:unit", "job:package"}},
+			{Name: "ship", Nodes: []string{"resource:prod", "job:deploy"}},
+		}))
+	})
+
+	It("leaves resources shared between groups ungrouped", func() {
+		Expect(graph.Ungrouped).To(ConsistOf("resource:repo", "resource:tarball"))
+	})
+
+	Context("when a resource is only used within one group", func() {
+		BeforeEach(func() {
+			config.Jobs[2].PlanSequence = []atc.Step{
+				{Config: &atc.PutStep{Name: "prod"}},
+			}
+			config.Groups[1].Resources = nil
+		})
+
+		It("is placed in that group", func() {
+			Expect(graph.Groups[0].Nodes).To(ContainElement("resource:repo"))
+			Expect(graph.Groups[0].Nodes).To(ContainElement("resource:tarball"))
+			Expect(graph.Groups[1].Nodes).To(ContainElement("resource:prod"))
+			Expect(graph.Ungrouped).To(BeEmpty())
+		})
+	})
+
+	Context("when the same dependency is declared twice", func() {
+		BeforeEach(func() {
+			config.Jobs[0].PlanSequence = append(config.Jobs[0].PlanSequence, atc.Step{
+				Config: &atc.GetStep{Name: "repo"},
+			})
+		})
+
+		It("has one edge which triggers if either does", func() {
+			Expect(graph.Edges[0]).To(Equal(pipelinegraph.Edge{
+				From: "resource:repo", To: "job:unit", Kind: pipelinegraph.GetEdge, Trigger: true,
+			}))
+			Expect(graph.Edges[1].From).To(Equal("job:unit"))
+		})
+	})
+
+	Describe("rendering", func() {
+		BeforeEach(func() {
+			config = atc.Config{
+				Groups: atc.GroupConfigs{
+					{Name: `"main"`, Jobs: []string{"unit"}},
+				},
+				Resources: atc.ResourceConfigs{
+					{Name: "repo", Type: "git"},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "unit",
+						PlanSequence: []atc.Step{
+							{Config: &atc.GetStep{Name: "repo", Trigger: true}},
+						},
+					},
+					{
+						Name: "bump",
+						PlanSequence: []atc.Step{
+							{Config: &atc.GetStep{Name: "repo", Passed: []string{"unit"}}},
+							{Config: &atc.PutStep{Name: "repo"}},
+						},
+					},
+				},
+			}
+		})
+
+		It("renders DOT", func() {
+			buf := new(bytes.Buffer)
+			Expect(graph.Render(buf, "dot")).To(Succeed())
+			Expect(buf.String()).To(Equal(`digraph "some-pipeline" {
+  rankdir=LR;
+  subgraph "cluster_0" {
+    label="\"main\"";
+    "job:unit" [label="unit", shape=box];
+  }
+  "resource:repo" [label="repo", shape=ellipse];
+  "job:bump" [label="bump", shape=box];
+  "resource:repo" -> "job:unit" [style=bold, color="#11c560"];
+  "job:unit" -> "job:bump" [label="repo", style=dashed];
+  "job:bump" -> "resource:repo";
+}
+`))
+		})
+
+		It("renders Mermaid", func() {
+			buf := new(bytes.Buffer)
+			Expect(graph.Render(buf, "mermaid")).To(Succeed())
+			Expect(buf.String()).To(Equal(`flowchart LR
+  subgraph group0 ["#quot;main#quot;"]
+    job1["unit"]
+  end
+  resource0(["repo"])
+  job2["bump"]
+  resource0 ==> job1
+  job1 -.->|"repo"| job2
+  job2 --> resource0
+  linkStyle 0 stroke:#11c560
+`))
+		})
+
+		It("rejects unknown formats", func() {
+			Expect(graph.Render(new(bytes.Buffer), "svg")).To(MatchError("unknown graph format `svg`"))
+		})
+	})
+})
@@ -0,0 +1,149 @@
+package pipelinegraph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// triggerColor is used for edges which trigger the downstream job.
+const triggerColor = "#11c560"
+
+// DOT writes the graph in Graphviz's DOT language. Groups are drawn as
+// clusters, trigger edges are bold and edges which do not trigger are dashed.
+func (graph Graph) DOT(w io.Writer) error {
+	out := bufio.NewWriter(w)
+
+	fmt.Fprintf(out, "digraph %s {\n", dotQuote(graph.Name))
+	fmt.Fprintln(out, "  rankdir=LR;")
+
+	for i, group := range graph.Groups {
+		if len(group.Nodes) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(out, "  subgraph %s {\n", dotQuote(fmt.Sprintf("cluster_%d", i)))
+		fmt.Fprintf(out, "    label=%s;\n", dotQuote(group.Name))
+
+		for _, id := range group.Nodes {
+			fmt.Fprintf(out, "    %s\n", graph.dotNode(id))
+		}
+
+		fmt.Fprintln(out, "  }")
+	}
+
+	for _, id := range graph.Ungrouped {
+		fmt.Fprintf(out, "  %s\n", graph.dotNode(id))
+	}
+
+	for _, edge := range graph.Edges {
+		var attrs []string
+		if edge.Label != "" {
+			attrs = append(attrs, "label="+dotQuote(edge.Label))
+		}
+
+		switch {
+		case edge.Trigger:
+			attrs = append(attrs, "style=bold", "color="+dotQuote(triggerColor))
+		case edge.Kind != PutEdge:
+			attrs = append(attrs, "style=dashed")
+		}
+
+		fmt.Fprintf(out, "  %s -> %s", dotQuote(edge.From), dotQuote(edge.To))
+		if len(attrs) > 0 {
+			fmt.Fprintf(out, " [%s]", strings.Join(attrs, ", "))
+		}
+		fmt.Fprintln(out, ";")
+	}
+
+	fmt.Fprintln(out, "}")
+
+	return out.Flush()
+}
+
+func (graph Graph) dotNode(id string) string {
+	node := graph.node(id)
+
+	shape := "box"
+	if node.Kind == ResourceNode {
+		shape = "ellipse"
+	}
+
+	return fmt.Sprintf("%s [label=%s, shape=%s];", dotQuote(id), dotQuote(node.Name), shape)
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// Mermaid writes the graph as a Mermaid flowchart. Groups are drawn as
+// subgraphs, trigger edges are thick and edges which do not trigger are
+// dotted.
+func (graph Graph) Mermaid(w io.Writer) error {
+	out := bufio.NewWriter(w)
+
+	ids := map[string]string{}
+	for i, node := range graph.Nodes {
+		ids[node.ID] = fmt.Sprintf("%s%d", node.Kind, i)
+	}
+
+	fmt.Fprintln(out, "flowchart LR")
+
+	for i, group := range graph.Groups {
+		if len(group.Nodes) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(out, "  subgraph group%d [%s]\n", i, mermaidQuote(group.Name))
+
+		for _, id := range group.Nodes {
+			fmt.Fprintf(out, "    %s\n", graph.mermaidNode(ids[id], id))
+		}
+
+		fmt.Fprintln(out, "  end")
+	}
+
+	for _, id := range graph.Ungrouped {
+		fmt.Fprintf(out, "  %s\n", graph.mermaidNode(ids[id], id))
+	}
+
+	var triggerLinks []string
+	for i, edge := range graph.Edges {
+		arrow := "-.->"
+		switch {
+		case edge.Trigger:
+			arrow = "==>"
+			triggerLinks = append(triggerLinks, strconv.Itoa(i))
+		case edge.Kind == PutEdge:
+			arrow = "-->"
+		}
+
+		if edge.Label != "" {
+			arrow += "|" + mermaidQuote(edge.Label) + "|"
+		}
+
+		fmt.Fprintf(out, "  %s %s %s\n", ids[edge.From], arrow, ids[edge.To])
+	}
+
+	if len(triggerLinks) > 0 {
+		fmt.Fprintf(out, "  linkStyle %s stroke:%s\n", strings.Join(triggerLinks, ","), triggerColor)
+	}
+
+	return out.Flush()
+}
+
+func (graph Graph) mermaidNode(mermaidID string, id string) string {
+	node := graph.node(id)
+
+	if node.Kind == ResourceNode {
+		return fmt.Sprintf("%s([%s])", mermaidID, mermaidQuote(node.Name))
+	}
+
+	return fmt.Sprintf("%s[%s]", mermaidID, mermaidQuote(node.Name))
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.Replace(s, `"`, "#quot;", -1) + `"`
+}
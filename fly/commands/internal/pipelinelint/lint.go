@@ -0,0 +1,302 @@
+package pipelinelint
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+// Finding is a problem found by a lint rule. Location is a dotted path to the
+// offending part of the config, e.g. "jobs.unit" or "resources.repo".
+type Finding struct {
+	RuleID   string `json:"rule"`
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+type Options struct {
+	// Ignore lists the IDs of rules to skip.
+	Ignore []string
+
+	// MinCheckEvery is the lowest check interval which is not reported by
+	// the low-check-every rule.
+	MinCheckEvery time.Duration
+}
+
+type Rule struct {
+	ID          string
+	Description string
+
+	check func(atc.Config, Options) []Finding
+}
+
+// DefaultMinCheckEvery is the default interval at which resources are checked.
+const DefaultMinCheckEvery = time.Minute
+
+// Rules lists every lint rule, in the order their findings are reported.
+// Unused resources and jobs missing from groups are not linted as validation
+// already rejects them.
+var Rules = []Rule{
+	{
+		ID:          "unused-resource-type",
+		Description: "Resource type is not used by any resource, resource type, or task image",
+		check:       checkUnusedResourceTypes,
+	},
+	{
+		ID:          "untriggered-get",
+		Description: "Get step neither triggers the job nor has passed constraints",
+		check:       checkUntriggeredGets,
+	},
+	{
+		ID:          "low-check-every",
+		Description: "Resource or resource type is checked more often than the minimum interval",
+		check:       checkLowCheckEvery,
+	},
+	{
+		ID:          "no-container-limits",
+		Description: "Task with an inline config does not set container_limits",
+		check:       checkContainerLimits,
+	},
+	{
+		ID:          "undeclared-var",
+		Description: "Var refers to a var source or local var which is not declared",
+		check:       checkUndeclaredVars,
+	},
+}
+
+// Lint runs every rule which is not ignored against the config.
+func Lint(config atc.Config, opts Options) ([]Finding, error) {
+	ignored := map[string]bool{}
+	for _, id := range opts.Ignore {
+		if _, found := LookupRule(id); !found {
+			return nil, fmt.Errorf("unknown lint rule `%s`", id)
+		}
+
+		ignored[id] = true
+	}
+
+	if opts.MinCheckEvery == 0 {
+		opts.MinCheckEvery = DefaultMinCheckEvery
+	}
+
+	findings := []Finding{}
+	for _, rule := range Rules {
+		if ignored[rule.ID] {
+			continue
+		}
+
+		findings = append(findings, rule.check(config, opts)...)
+	}
+
+	return findings, nil
+}
+
+func LookupRule(id string) (Rule, bool) {
+	for _, rule := range Rules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+func checkUnusedResourceTypes(config atc.Config, opts Options) []Finding {
+	used := map[string]bool{}
+	for _, resource := range config.Resources {
+		used[resource.Type] = true
+	}
+
+	for _, resourceType := range config.ResourceTypes {
+		used[resourceType.Type] = true
+	}
+
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnTask: func(step *atc.TaskStep) error {
+				if step.Config != nil && step.Config.ImageResource != nil {
+					used[step.Config.ImageResource.Type] = true
+				}
+				return nil
+			},
+		})
+	}
+
+	var findings []Finding
+	for _, resourceType := range config.ResourceTypes {
+		if !used[resourceType.Name] {
+			findings = append(findings, Finding{
+				RuleID:   "unused-resource-type",
+				Location: "resource_types." + resourceType.Name,
+				Message:  fmt.Sprintf("resource type `%s` is not used", resourceType.Name),
+			})
+		}
+	}
+
+	return findings
+}
+
+func checkUntriggeredGets(config atc.Config, opts Options) []Finding {
+	var findings []Finding
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnGet: func(step *atc.GetStep) error {
+				if !step.Trigger && len(step.Passed) == 0 {
+					findings = append(findings, Finding{
+						RuleID:   "untriggered-get",
+						Location: "jobs." + job.Name,
+						Message:  fmt.Sprintf("get `%s` does not trigger the job and has no passed constraints", step.Name),
+					})
+				}
+				return nil
+			},
+		})
+	}
+
+	return findings
+}
+
+func checkLowCheckEvery(config atc.Config, opts Options) []Finding {
+	var findings []Finding
+
+	check := func(location string, checkEvery string) {
+		if checkEvery == "" || checkEvery == "never" {
+			return
+		}
+
+		// unparseable intervals are reported by validation
+		interval, err := time.ParseDuration(checkEvery)
+		if err != nil || interval >= opts.MinCheckEvery {
+			return
+		}
+
+		findings = append(findings, Finding{
+			RuleID:   "low-check-every",
+			Location: location,
+			Message:  fmt.Sprintf("check_every of %s is less than %s", interval, opts.MinCheckEvery),
+		})
+	}
+
+	for _, resource := range config.Resources {
+		check("resources."+resource.Name, resource.CheckEvery)
+	}
+
+	for _, resourceType := range config.ResourceTypes {
+		check("resource_types."+resourceType.Name, resourceType.CheckEvery)
+	}
+
+	return findings
+}
+
+func checkContainerLimits(config atc.Config, opts Options) []Finding {
+	var findings []Finding
+	for _, job := range config.Jobs {
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnTask: func(step *atc.TaskStep) error {
+				// tasks loaded from files can only be checked at runtime
+				if step.Config != nil && step.Config.Limits == nil {
+					findings = append(findings, Finding{
+						RuleID:   "no-container-limits",
+						Location: "jobs." + job.Name,
+						Message:  fmt.Sprintf("task `%s` does not set container_limits", step.Name),
+					})
+				}
+				return nil
+			},
+		})
+	}
+
+	return findings
+}
+
+func checkUndeclaredVars(config atc.Config, opts Options) []Finding {
+	varSources := map[string]bool{}
+	for _, varSource := range config.VarSources {
+		varSources[varSource.Name] = true
+	}
+
+	var findings []Finding
+
+	check := func(location string, value interface{}, localVars map[string]bool) {
+		reported := map[string]bool{}
+		for _, ref := range varReferences(value) {
+			if reported[ref] {
+				continue
+			}
+
+			source, name := splitVarReference(ref)
+
+			var message string
+			switch {
+			case source == "":
+				continue
+			case source == ".":
+				if localVars[name] {
+					continue
+				}
+
+				message = fmt.Sprintf("local var `%s` is not loaded by any load_var step in the job", name)
+			case !varSources[source]:
+				message = fmt.Sprintf("var source `%s` is not declared in var_sources", source)
+			default:
+				continue
+			}
+
+			reported[ref] = true
+			findings = append(findings, Finding{
+				RuleID:   "undeclared-var",
+				Location: location,
+				Message:  fmt.Sprintf("((%s)): %s", ref, message),
+			})
+		}
+	}
+
+	for _, resource := range config.Resources {
+		check("resources."+resource.Name, resource, nil)
+	}
+
+	for _, resourceType := range config.ResourceTypes {
+		check("resource_types."+resourceType.Name, resourceType, nil)
+	}
+
+	for _, job := range config.Jobs {
+		localVars := map[string]bool{}
+		_ = job.StepConfig().Visit(atc.StepRecursor{
+			OnLoadVar: func(step *atc.LoadVarStep) error {
+				localVars[step.Name] = true
+				return nil
+			},
+		})
+
+		check("jobs."+job.Name, job, localVars)
+	}
+
+	return findings
+}
+
+// varReferences returns the name of every var referenced in the value, as
+// written between the (( and )).
+func varReferences(value interface{}) []string {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	return vars.NewTemplate(payload).ExtraVarNames()
+}
+
+// splitVarReference splits a reference such as "source:name.field" into its
+// var source, which is "." for local vars and empty for the team's credential
+// manager, and var name.
+func splitVarReference(ref string) (string, string) {
+	var source string
+	if i := strings.Index(ref, ":"); i > 0 {
+		source, ref = ref[:i], ref[i+1:]
+	}
+
+	return source, strings.SplitN(ref, ".", 2)[0]
+}
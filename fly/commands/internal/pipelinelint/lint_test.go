@@ -0,0 +1,192 @@
+package pipelinelint_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/pipelinelint"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lint", func() {
+	var (
+		config   atc.Config
+		opts     pipelinelint.Options
+		findings []pipelinelint.Finding
+		lintErr  error
+	)
+
+	BeforeEach(func() {
+		config = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "repo", Type: "git"},
+			},
+			Jobs: atc.JobConfigs{
+				{
+					Name: "unit",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Trigger: true}},
+					},
+				},
+			},
+		}
+
+		opts = pipelinelint.Options{}
+	})
+
+	JustBeforeEach(func() {
+		findings, lintErr = pipelinelint.Lint(config, opts)
+	})
+
+	It("finds nothing in a tidy pipeline", func() {
+		Expect(lintErr).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	Context("when an ignored rule does not exist", func() {
+		BeforeEach(func() {
+			opts.Ignore = []string{"bogus"}
+		})
+
+		It("errors", func() {
+			Expect(lintErr).To(MatchError("unknown lint rule `bogus`"))
+		})
+	})
+
+	Context("when resource types are defined", func() {
+		BeforeEach(func() {
+			config.ResourceTypes = atc.ResourceTypes{
+				{Name: "git", Type: "registry-image"},
+				{Name: "base", Type: "registry-image"},
+				{Name: "image", Type: "base"},
+				{Name: "unused", Type: "registry-image"},
+			}
+			config.Jobs[0].PlanSequence = append(config.Jobs[0].PlanSequence, atc.Step{
+				Config: &atc.TaskStep{
+					Name: "test",
+					Config: &atc.TaskConfig{
+						ImageResource: &atc.ImageResource{Type: "image"},
+						Limits:        &atc.ContainerLimits{},
+					},
+				},
+			})
+		})
+
+		It("reports the ones used by nothing", func() {
+			Expect(findings).To(ConsistOf(pipelinelint.Finding{
+				RuleID:   "unused-resource-type",
+				Location: "resource_types.unused",
+				Message:  "resource type `unused` is not used",
+			}))
+		})
+
+		Context("when the rule is ignored", func() {
+			BeforeEach(func() {
+				opts.Ignore = []string{"unused-resource-type"}
+			})
+
+			It("reports nothing", func() {
+				Expect(findings).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("when a get neither triggers nor has passed constraints", func() {
+		BeforeEach(func() {
+			config.Resources = append(config.Resources, atc.ResourceConfig{Name: "version", Type: "semver"})
+			config.Jobs[0].PlanSequence = append(config.Jobs[0].PlanSequence,
+				atc.Step{Config: &atc.GetStep{Name: "version"}},
+				atc.Step{Config: &atc.GetStep{Name: "upstream", Resource: "repo", Passed: []string{"unit"}}},
+			)
+		})
+
+		It("reports untriggered-get", func() {
+			Expect(findings).To(ConsistOf(pipelinelint.Finding{
+				RuleID:   "untriggered-get",
+				Location: "jobs.unit",
+				Message:  "get `version` does not trigger the job and has no passed constraints",
+			}))
+		})
+	})
+
+	Context("when check_every is too low", func() {
+		BeforeEach(func() {
+			config.Resources[0].CheckEvery = "10s"
+			config.ResourceTypes = atc.ResourceTypes{
+				{Name: "git", Type: "registry-image", CheckEvery: "never"},
+			}
+		})
+
+		It("reports low-check-every", func() {
+			Expect(findings).To(ConsistOf(pipelinelint.Finding{
+				RuleID:   "low-check-every",
+				Location: "resources.repo",
+				Message:  "check_every of 10s is less than 1m0s",
+			}))
+		})
+
+		Context("when the minimum is lowered", func() {
+			BeforeEach(func() {
+				opts.MinCheckEvery = 5 * time.Second
+			})
+
+			It("reports nothing", func() {
+				Expect(findings).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("when a task has no container limits", func() {
+		BeforeEach(func() {
+			config.Jobs[0].PlanSequence = append(config.Jobs[0].PlanSequence,
+				atc.Step{Config: &atc.TaskStep{Name: "inline", Config: &atc.TaskConfig{}}},
+				atc.Step{Config: &atc.TaskStep{Name: "from-file", ConfigPath: "repo/task.yml"}},
+			)
+		})
+
+		It("reports inline configs only", func() {
+			Expect(findings).To(ConsistOf(pipelinelint.Finding{
+				RuleID:   "no-container-limits",
+				Location: "jobs.unit",
+				Message:  "task `inline` does not set container_limits",
+			}))
+		})
+	})
+
+	Context("when vars are referenced", func() {
+		BeforeEach(func() {
+			config.VarSources = atc.VarSourceConfigs{
+				{Name: "vault", Type: "vault"},
+			}
+			config.Resources[0].Source = atc.Source{
+				"uri":         "((uri))",
+				"private_key": "((vault:deploy-key))",
+				"username":    "((nowhere:user))",
+			}
+			config.Jobs[0].PlanSequence = append(config.Jobs[0].PlanSequence,
+				atc.Step{Config: &atc.LoadVarStep{Name: "version", File: "repo/version"}},
+				atc.Step{Config: &atc.PutStep{Name: "repo", Params: atc.Params{
+					"tag":    "((.:version))",
+					"branch": "((.:branch.name))",
+				}}},
+			)
+		})
+
+		It("reports undeclared var sources and local vars", func() {
+			Expect(findings).To(ConsistOf(
+				pipelinelint.Finding{
+					RuleID:   "undeclared-var",
+					Location: "resources.repo",
+					Message:  "((nowhere:user)): var source `nowhere` is not declared in var_sources",
+				},
+				pipelinelint.Finding{
+					RuleID:   "undeclared-var",
+					Location: "jobs.unit",
+					Message:  "((.:branch.name)): local var `branch` is not loaded by any load_var step in the job",
+				},
+			))
+		})
+	})
+})
@@ -0,0 +1,135 @@
+package pipelinelint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse"
+)
+
+// WriteText writes one line per finding, prefixed with its rule ID.
+func WriteText(w io.Writer, findings []Finding) error {
+	for _, finding := range findings {
+		_, err := fmt.Fprintf(w, "%s: [%s] %s\n", finding.Location, finding.RuleID, finding.Message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON writes the findings as a JSON array.
+func WriteJSON(w io.Writer, findings []Finding) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(findings)
+}
+
+// WriteSARIF writes the findings as a SARIF 2.1.0 log for the config file at
+// the given path. Findings have no line numbers, so each result is located
+// by its config path as a logical location.
+func WriteSARIF(w io.Writer, configPath string, findings []Finding) error {
+	rules := []sarifRule{}
+	for _, rule := range Rules {
+		rules = append(rules, sarifRule{
+			ID:               rule.ID,
+			ShortDescription: sarifMessage{Text: rule.Description},
+		})
+	}
+
+	results := []sarifResult{}
+	for _, finding := range findings {
+		results = append(results, sarifResult{
+			RuleID:  finding.RuleID,
+			Level:   "warning",
+			Message: sarifMessage{Text: finding.Message},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: configPath},
+					},
+					LogicalLocations: []sarifLogicalLocation{
+						{FullyQualifiedName: finding.Location},
+					},
+				},
+			},
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "fly",
+						Version:        concourse.Version,
+						InformationURI: "https://concourse-ci.org",
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	})
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
@@ -0,0 +1,73 @@
+package pipelinelint_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/concourse/concourse/fly/commands/internal/pipelinelint"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reports", func() {
+	var findings []pipelinelint.Finding
+
+	BeforeEach(func() {
+		findings = []pipelinelint.Finding{
+			{RuleID: "unused-resource-type", Location: "resource_types.stale", Message: "resource type `stale` is not used"},
+		}
+	})
+
+	It("writes text", func() {
+		buf := new(bytes.Buffer)
+		Expect(pipelinelint.WriteText(buf, findings)).To(Succeed())
+		Expect(buf.String()).To(Equal("resource_types.stale: [unused-resource-type] resource type `stale` is not used\n"))
+	})
+
+	It("writes JSON", func() {
+		buf := new(bytes.Buffer)
+		Expect(pipelinelint.WriteJSON(buf, findings)).To(Succeed())
+		Expect(buf.String()).To(MatchJSON(`[{
+			"rule": "unused-resource-type",
+			"location": "resource_types.stale",
+			"message": "resource type ` + "`stale`" + ` is not used"
+		}]`))
+	})
+
+	It("writes SARIF with every rule and a result per finding", func() {
+		buf := new(bytes.Buffer)
+		Expect(pipelinelint.WriteSARIF(buf, "ci/pipeline.yml", findings)).To(Succeed())
+
+		var log struct {
+			Version string `json:"version"`
+			Runs    []struct {
+				Tool struct {
+					Driver struct {
+						Name  string `json:"name"`
+						Rules []struct {
+							ID string `json:"id"`
+						} `json:"rules"`
+					} `json:"driver"`
+				} `json:"tool"`
+				Results []json.RawMessage `json:"results"`
+			} `json:"runs"`
+		}
+		Expect(json.Unmarshal(buf.Bytes(), &log)).To(Succeed())
+
+		Expect(log.Version).To(Equal("2.1.0"))
+		Expect(log.Runs).To(HaveLen(1))
+		Expect(log.Runs[0].Tool.Driver.Name).To(Equal("fly"))
+		Expect(log.Runs[0].Tool.Driver.Rules).To(HaveLen(len(pipelinelint.Rules)))
+		Expect(log.Runs[0].Results).To(HaveLen(1))
+		Expect(log.Runs[0].Results[0]).To(MatchJSON(`{
+			"ruleId": "unused-resource-type",
+			"level": "warning",
+			"message": {"text": "resource type ` + "`stale`" + ` is not used"},
+			"locations": [{
+				"physicalLocation": {"artifactLocation": {"uri": "ci/pipeline.yml"}},
+				"logicalLocations": [{"fullyQualifiedName": "resource_types.stale"}]
+			}]
+		}`))
+	})
+})
@@ -1,9 +1,13 @@
 package setpipelinehelpers
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
+	"strings"
+
+	"github.com/fatih/color"
 	"sigs.k8s.io/yaml"
 
 	"github.com/vito/go-interact/interact"
@@ -23,6 +27,7 @@ type ATCConfig struct {
 	Target           string
 	SkipInteraction  bool
 	CheckCredentials bool
+	DryRun           bool
 }
 
 func (atcConfig ATCConfig) ApplyConfigInteraction() bool {
@@ -58,6 +63,10 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 
 	diffExists := diff(existingConfig, newConfig)
 
+	if atcConfig.DryRun {
+		return atcConfig.checkCredentials(evaluatedTemplate)
+	}
+
 	if !diffExists {
 		fmt.Println("no changes to apply")
 		return nil
@@ -86,6 +95,85 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	return nil
 }
 
+func (atcConfig ATCConfig) checkCredentials(evaluatedTemplate []byte) error {
+	response, err := atcConfig.Team.CheckPipelineConfigCreds(atcConfig.PipelineName, evaluatedTemplate)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Warnings) > 0 {
+		warnings := []concourse.ConfigWarning{}
+		for _, warning := range response.Warnings {
+			warnings = append(warnings, concourse.ConfigWarning{
+				Type:    warning.Type,
+				Message: warning.Message,
+			})
+		}
+
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "source", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "paths", Color: color.New(color.Bold)},
+		},
+	}
+
+	failed := 0
+	for _, check := range response.Vars {
+		sourceCell := ui.TableCell{Contents: check.Source}
+		if check.Source == "" {
+			sourceCell = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		statusCell := ui.TableCell{Contents: string(check.Status)}
+		switch check.Status {
+		case atc.VarCheckFound:
+			statusCell.Color = ui.SucceededColor
+		case atc.VarCheckNotFound, atc.VarCheckAccessDenied:
+			statusCell.Color = ui.FailedColor
+			failed++
+		default:
+			statusCell.Color = ui.ErroredColor
+			failed++
+		}
+
+		pathsCell := ui.TableCell{Contents: strings.Join(check.Paths, ",")}
+		if check.Error != "" {
+			pathsCell.Contents = strings.TrimSpace(fmt.Sprintf("%s (%s)", pathsCell.Contents, check.Error))
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: check.Name},
+			sourceCell,
+			statusCell,
+			pathsCell,
+		})
+	}
+
+	err = table.Render(os.Stdout, true)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Errors) > 0 {
+		displayhelpers.ShowErrors("credential manager errors", response.Errors)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d credential variables could not be resolved", failed, len(response.Vars))
+	}
+
+	if len(response.Errors) > 0 {
+		return errors.New("credential managers could not be configured")
+	}
+
+	return nil
+}
+
 func (atcConfig ATCConfig) UnpausePipelineCommand() string {
 	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineName)
 }
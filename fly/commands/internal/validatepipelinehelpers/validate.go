@@ -12,21 +12,39 @@ import (
 )
 
 func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool, output bool) error {
-	evaluatedTemplate, err := yamlTemplate.Evaluate(true, strict)
+	evaluatedTemplate, _, err := ValidateConfig(yamlTemplate, strict)
 	if err != nil {
 		return err
 	}
 
+	if output {
+		fmt.Println(string(evaluatedTemplate))
+	} else {
+		fmt.Println("looks good")
+	}
+
+	return nil
+}
+
+// ValidateConfig evaluates and validates the template, showing any warnings
+// and failing if it is invalid. It returns the evaluated template along with
+// the config it unmarshals to.
+func ValidateConfig(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool) ([]byte, atc.Config, error) {
+	evaluatedTemplate, err := yamlTemplate.Evaluate(true, strict)
+	if err != nil {
+		return nil, atc.Config{}, err
+	}
+
 	var unmarshalledTemplate atc.Config
 	if strict {
 		// UnmarshalStrict will pick up fields in structs that have the wrong names, as well as any duplicate keys in maps
 		// we should consider always using this everywhere in a later release...
 		if err := yaml.UnmarshalStrict([]byte(evaluatedTemplate), &unmarshalledTemplate); err != nil {
-			return err
+			return nil, atc.Config{}, err
 		}
 	} else {
 		if err := yaml.Unmarshal([]byte(evaluatedTemplate), &unmarshalledTemplate); err != nil {
-			return err
+			return nil, atc.Config{}, err
 		}
 	}
 
@@ -48,11 +66,5 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 		displayhelpers.Failf("configuration invalid")
 	}
 
-	if output {
-		fmt.Println(string(evaluatedTemplate))
-	} else {
-		fmt.Println("looks good")
-	}
-
-	return nil
+	return evaluatedTemplate, unmarshalledTemplate, nil
 }
@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type JobStatsCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get job stats for this pipeline"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+	Last     time.Duration            `long:"last" description:"Only count builds created within this duration, e.g. 24h (default: 168h)"`
+	Since    string                   `long:"since" description:"Start of the range of build creation times to count"`
+	Until    string                   `long:"until" description:"End of the range of build creation times to count"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *JobStatsCommand) Execute([]string) error {
+	err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	since, until, err := command.window()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	stats, found, err := team.JobStats(string(command.Pipeline), since, until)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found", command.Pipeline)
+	}
+
+	sortJobStats(stats)
+
+	if command.Json {
+		return displayhelpers.JsonPrint(stats)
+	}
+
+	headers := []string{"name", "builds", "success", "flaky", "p50", "p95", "queue p50", "queue p95"}
+	table := ui.Table{Headers: ui.TableRow{}}
+	for _, h := range headers {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	for _, s := range stats {
+		row := ui.TableRow{
+			{Contents: s.JobName},
+			{Contents: fmt.Sprintf("%d", s.Builds)},
+		}
+
+		if s.Builds == 0 {
+			for len(row) < len(headers) {
+				row = append(row, ui.TableCell{Contents: "n/a"})
+			}
+
+			table.Data = append(table.Data, row)
+			continue
+		}
+
+		successCell := ui.TableCell{Contents: fmt.Sprintf("%.0f%%", s.SuccessRate*100)}
+		switch {
+		case s.SuccessRate < 0.5:
+			successCell.Color = ui.FailedColor
+		case s.SuccessRate < 0.9:
+			successCell.Color = ui.StartedColor
+		}
+
+		flakyCell := ui.TableCell{Contents: fmt.Sprintf("%d (%.0f%%)", s.FlakyRetries, s.Flakiness*100)}
+		if s.FlakyRetries > 0 {
+			flakyCell.Color = ui.StartedColor
+		}
+
+		row = append(row,
+			successCell,
+			flakyCell,
+			ui.TableCell{Contents: formatStatSeconds(s.DurationP50)},
+			ui.TableCell{Contents: formatStatSeconds(s.DurationP95)},
+			ui.TableCell{Contents: formatStatSeconds(s.QueueP50)},
+			ui.TableCell{Contents: formatStatSeconds(s.QueueP95)},
+		)
+
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+// window returns the range of build creation times to count. Zero times are
+// left for the server to default.
+func (command *JobStatsCommand) window() (time.Time, time.Time, error) {
+	var since, until time.Time
+
+	if command.Last != 0 && (command.Since != "" || command.Until != "") {
+		return since, until, errors.New("Cannot specify both --last and --since or --until")
+	}
+
+	if command.Last < 0 {
+		return since, until, errors.New("--last must be positive")
+	}
+
+	if command.Last != 0 {
+		until = time.Now()
+		return until.Add(-command.Last), until, nil
+	}
+
+	var err error
+	if command.Since != "" {
+		since, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return since, until, errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		until, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return since, until, errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+		return since, until, errors.New("Cannot have --since after --until")
+	}
+
+	return since, until, nil
+}
+
+// sortJobStats orders jobs worst first: by success rate, then by flakiness.
+// Jobs without builds go last.
+func sortJobStats(stats []atc.JobStats) {
+	sort.SliceStable(stats, func(i, j int) bool {
+		a, b := stats[i], stats[j]
+
+		if (a.Builds == 0) != (b.Builds == 0) {
+			return b.Builds == 0
+		}
+
+		if a.SuccessRate != b.SuccessRate {
+			return a.SuccessRate < b.SuccessRate
+		}
+
+		return a.Flakiness > b.Flakiness
+	})
+}
+
+func formatStatSeconds(seconds float64) string {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Second).String()
+}
@@ -2,13 +2,15 @@ package commands
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 )
 
 type LandWorkerCommand struct {
-	Worker flaghelpers.WorkerFlag `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+	Worker   flaghelpers.WorkerFlag `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+	Deadline time.Duration          `long:"deadline" description:"Interrupt and rerun elsewhere any builds still running on the worker after this long"`
 }
 
 func (command *LandWorkerCommand) Execute(args []string) error {
@@ -24,12 +26,20 @@ func (command *LandWorkerCommand) Execute(args []string) error {
 		return err
 	}
 
-	err = target.Client().LandWorker(workerName)
+	if command.Deadline != 0 {
+		err = target.Client().LandWorkerBy(workerName, command.Deadline)
+	} else {
+		err = target.Client().LandWorker(workerName)
+	}
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("landed '%s'\n", workerName)
+	if command.Deadline != 0 {
+		fmt.Printf("landed '%s' with a deadline of %s\n", workerName, command.Deadline)
+	} else {
+		fmt.Printf("landed '%s'\n", workerName)
+	}
 
 	return nil
 }
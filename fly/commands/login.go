@@ -31,6 +31,7 @@ type LoginCommand struct {
 	TeamName    string       `short:"n" long:"team-name" description:"Team to authenticate with"`
 	CACert      atc.PathFlag `long:"ca-cert" description:"Path to Concourse PEM-encoded CA certificate file."`
 	OpenBrowser bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
+	APIToken    string       `long:"api-token" description:"API token to authenticate with, as created by create-token"`
 
 	BrowserOnly bool
 }
@@ -115,7 +116,7 @@ func (command *LoginCommand) Execute(args []string) error {
 		return err
 	}
 
-	isRawMode := pty.IsTerminal() && !command.BrowserOnly
+	isRawMode := pty.IsTerminal() && !command.BrowserOnly && command.APIToken == ""
 	if isRawMode {
 		state, err := terminal.MakeRaw(int(os.Stdin.Fd()))
 		if err != nil {
@@ -128,7 +129,9 @@ func (command *LoginCommand) Execute(args []string) error {
 		}
 	}
 
-	if semver.Compare(legacySemver) <= 0 && semver.Compare(devSemver) != 0 {
+	if command.APIToken != "" {
+		tokenType, tokenValue = "Bearer", command.APIToken
+	} else if semver.Compare(legacySemver) <= 0 && semver.Compare(devSemver) != 0 {
 		// Legacy Auth Support
 		tokenType, tokenValue, err = command.legacyAuth(target, command.BrowserOnly, isRawMode)
 	} else {
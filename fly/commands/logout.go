@@ -3,8 +3,13 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	jwt "github.com/dgrijalva/jwt-go"
 )
 
 type LogoutCommand struct {
@@ -14,6 +19,8 @@ type LogoutCommand struct {
 func (command *LogoutCommand) Execute(args []string) error {
 
 	if Fly.Target != "" && !command.All {
+		revokeSession(Fly.Target)
+
 		if err := rc.LogoutTarget(Fly.Target); err != nil {
 			return err
 		}
@@ -27,6 +34,8 @@ func (command *LogoutCommand) Execute(args []string) error {
 		}
 
 		for targetName := range flyYAML.Targets {
+			revokeSession(targetName)
+
 			if err := rc.LogoutTarget(targetName); err != nil {
 				return err
 			}
@@ -39,3 +48,33 @@ func (command *LogoutCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// revokeSession revokes the target's token on the server so that it stops
+// working even where copies of it remain. Failing to do so does not prevent
+// logging out locally.
+func revokeSession(targetName rc.TargetName) {
+	target, err := rc.LoadTarget(targetName, Fly.Verbose)
+	if err != nil {
+		return
+	}
+
+	token := target.Token()
+	if token == nil || token.Value == "" || strings.HasPrefix(token.Value, atc.APITokenPrefix) {
+		return
+	}
+
+	_, err = jwt.Parse(token.Value, func(token *jwt.Token) (interface{}, error) {
+		return nil, token.Claims.Valid()
+	})
+
+	// anything other than a key error means the token is malformed or has
+	// already expired, so there is nothing left to revoke
+	if err == nil || err.Error() != jwt.ErrInvalidKeyType.Error() {
+		return
+	}
+
+	err = target.Client().RevokeCurrentSession()
+	if err != nil && err != concourse.ErrUnauthorized {
+		fmt.Fprintf(ui.Stderr, "warning: failed to revoke session: %s\n", err)
+	}
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+type MaintenanceCommand struct {
+	Wait         bool          `long:"wait" description:"Wait until no builds are running, i.e. the cluster is safe to upgrade"`
+	PollInterval time.Duration `long:"poll-interval" default:"5s" description:"How often to check the running builds while waiting"`
+	Json         bool          `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *MaintenanceCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	mode, err := target.Client().GetMaintenance()
+	if err != nil {
+		return err
+	}
+
+	if command.Wait {
+		if !mode.Enabled {
+			return errors.New("maintenance mode is not enabled, so builds may keep starting")
+		}
+
+		for mode.RunningBuilds > 0 {
+			fmt.Fprintf(ui.Stderr, "waiting for %d running build(s) to finish...\n", mode.RunningBuilds)
+
+			time.Sleep(command.PollInterval)
+
+			mode, err = target.Client().GetMaintenance()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(mode)
+	}
+
+	printMaintenance(mode)
+
+	return nil
+}
+
+func printMaintenance(mode atc.Maintenance) {
+	if !mode.Enabled {
+		fmt.Println("maintenance mode: disabled")
+		return
+	}
+
+	fmt.Printf("maintenance mode: %s (since %s)\n",
+		ui.Embolden("enabled"),
+		time.Unix(mode.EnabledAt, 0).Format(time.RFC1123),
+	)
+
+	if mode.Reason != "" {
+		fmt.Printf("reason: %s\n", mode.Reason)
+	}
+
+	if mode.PauseChecks {
+		fmt.Println("resource checks: paused")
+	} else {
+		fmt.Println("resource checks: running")
+	}
+
+	fmt.Printf("running builds: %d\n", mode.RunningBuilds)
+	fmt.Printf("pending builds: %d\n", mode.PendingBuilds)
+
+	if mode.RunningBuilds == 0 {
+		fmt.Println()
+		fmt.Println("no builds are running; the cluster is safe to upgrade")
+	}
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type NotificationDeliveriesCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get notification deliveries for this pipeline"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of deliveries you want to limit the return to"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *NotificationDeliveriesCommand) Execute([]string) error {
+	err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	deliveries, found, err := team.NotificationDeliveries(string(command.Pipeline), command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found", command.Pipeline)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(deliveries)
+	}
+
+	headers := []string{"id", "notification", "event", "status", "attempts", "response", "updated", "error"}
+	table := ui.Table{Headers: ui.TableRow{}}
+	for _, h := range headers {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	for _, d := range deliveries {
+		responseCell := ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		if d.ResponseCode != 0 {
+			responseCell = ui.TableCell{Contents: fmt.Sprintf("%d", d.ResponseCode)}
+		}
+
+		errorCell := ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		if d.Error != "" {
+			errorCell = ui.TableCell{Contents: d.Error}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: fmt.Sprintf("%d", d.ID)},
+			{Contents: d.NotificationName},
+			{Contents: fmt.Sprintf("%s #%d", d.EventType, d.EventID)},
+			notificationDeliveryStatusCell(d.Status),
+			{Contents: fmt.Sprintf("%d", d.Attempts)},
+			responseCell,
+			{Contents: time.Unix(d.UpdatedAt, 0).Format(time.RFC1123)},
+			errorCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func notificationDeliveryStatusCell(status string) ui.TableCell {
+	cell := ui.TableCell{Contents: status}
+
+	switch status {
+	case atc.NotificationDeliveryPending:
+		cell.Color = ui.StartedColor
+	case atc.NotificationDeliverySucceeded:
+		cell.Color = ui.SucceededColor
+	case atc.NotificationDeliveryFailed:
+		cell.Color = ui.FailedColor
+	}
+
+	return cell
+}
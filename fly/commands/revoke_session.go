@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type RevokeSessionCommand struct {
+	ID   int    `long:"id" description:"ID of the session to revoke"`
+	User string `short:"u" long:"user" value-name:"CONNECTOR:USERNAME" description:"Revoke every session and personal API token of the given user, e.g. github:some-user (requires admin)"`
+}
+
+func (command *RevokeSessionCommand) Execute([]string) error {
+	if (command.ID == 0) == (command.User == "") {
+		return errors.New("must specify either --id or --user")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.User != "" {
+		return command.revokeUserSessions(target.Client())
+	}
+
+	found, err := target.Client().RevokeSession(command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("session %d not found", command.ID)
+	}
+
+	fmt.Printf("revoked session %d\n", command.ID)
+
+	return nil
+}
+
+// revokeUserSessions revokes the sessions of every user with the given
+// connector and user name. User names are only unique per connector, and a
+// connector may have several users with the same name, so they are resolved
+// to subs from the active sessions.
+func (command *RevokeSessionCommand) revokeUserSessions(client concourse.Client) error {
+	parts := strings.SplitN(command.User, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return errors.New("--user must be given as CONNECTOR:USERNAME")
+	}
+
+	connector, userName := parts[0], parts[1]
+
+	sessions, err := client.ListSessions(true)
+	if err != nil {
+		return err
+	}
+
+	subs := map[string]bool{}
+	for _, session := range sessions {
+		if session.Connector == connector && session.UserName == userName {
+			subs[session.Sub] = true
+		}
+	}
+
+	var sessionCount, tokenCount int
+	for sub := range subs {
+		revoked, err := client.RevokeUserSessions(connector, sub)
+		if err != nil {
+			return err
+		}
+
+		sessionCount += revoked.Count
+		tokenCount += revoked.APITokens
+	}
+
+	fmt.Printf("revoked %d session(s) and %d api token(s) of '%s'\n", sessionCount, tokenCount, command.User)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type SessionsCommand struct {
+	All  bool `short:"a" long:"all" description:"List the sessions of every user (requires admin)"`
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *SessionsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	sessions, err := target.Client().ListSessions(command.All)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(sessions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "user", Color: color.New(color.Bold)},
+		{Contents: "connector", Color: color.New(color.Bold)},
+		{Contents: "created", Color: color.New(color.Bold)},
+		{Contents: "last used", Color: color.New(color.Bold)},
+		{Contents: "expires", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, session := range sessions {
+		idCell := ui.TableCell{Contents: strconv.Itoa(session.ID)}
+		if session.Current {
+			idCell.Contents += " (current)"
+			idCell.Color = color.New(color.Bold)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			idCell,
+			{Contents: session.UserName},
+			{Contents: session.Connector},
+			{Contents: time.Unix(session.CreatedAt, 0).Format(time.RFC1123)},
+			{Contents: time.Unix(session.LastUsedAt, 0).Format(time.RFC1123)},
+			{Contents: time.Unix(session.ExpiresAt, 0).Format(time.RFC1123)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
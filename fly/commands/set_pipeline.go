@@ -14,6 +14,7 @@ type SetPipelineCommand struct {
 	DisableAnsiColor bool `long:"no-color"               description:"Disable color output"`
 
 	CheckCredentials bool `long:"check-creds"  description:"Validate credential variables against credential manager"`
+	DryRun           bool `long:"dry-run"      description:"Show the diff and look up every credential variable against the credential manager and var_sources without saving the pipeline"`
 
 	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to configure"`
 	Config   atc.PathFlag             `short:"c"  long:"config"    required:"true"  description:"Pipeline configuration file"`
@@ -56,6 +57,7 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
+		DryRun:           command.DryRun,
 	}
 
 	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar)
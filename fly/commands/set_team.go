@@ -2,8 +2,10 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -13,6 +15,7 @@ import (
 	"github.com/concourse/concourse/skymarshal/skycmd"
 	"github.com/jessevdk/go-flags"
 	"github.com/vito/go-interact/interact"
+	"sigs.k8s.io/yaml"
 )
 
 func WireTeamConnectors(command *flags.Command) {
@@ -47,10 +50,24 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	config, err := command.teamConfig()
+	if err != nil {
+		fmt.Fprintln(ui.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
+	credentialManager := config.CredentialManager
+	customRoles := config.customRoles()
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
 	}
+	for _, customRole := range customRoles {
+		if _, found := authRoles[customRole.Name]; !found {
+			roles = append(roles, customRole.Name)
+		}
+	}
 	sort.Strings(roles)
 
 	teamName := command.Team.Name()
@@ -62,6 +79,13 @@ func (command *SetTeamCommand) Execute([]string) error {
 
 		fmt.Println()
 		fmt.Printf("role %s:\n", ui.Embolden(role))
+
+		for _, customRole := range customRoles {
+			if customRole.Name == role {
+				printCustomRole(customRole)
+			}
+		}
+
 		fmt.Printf("  users:\n")
 		if len(authUsers) > 0 {
 			for _, user := range authUsers {
@@ -80,6 +104,21 @@ func (command *SetTeamCommand) Execute([]string) error {
 		} else {
 			fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
 		}
+
+		if authPipelines := authRoles[role]["pipelines"]; len(authPipelines) > 0 {
+			fmt.Println()
+			fmt.Printf("  pipelines:\n")
+			for _, pipeline := range authPipelines {
+				fmt.Printf("  - %s\n", pipeline)
+			}
+		}
+	}
+
+	fmt.Println()
+	if credentialManager != nil {
+		fmt.Printf("credential manager: %s\n", ui.Embolden("%s", credentialManager.Type))
+	} else {
+		fmt.Printf("credential manager: %s\n", ui.OffColor.Sprint("none"))
 	}
 
 	confirm := true
@@ -95,7 +134,13 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
+	team := atc.Team{
+		Auth:              authRoles,
+		CredentialManager: credentialManager,
+		CustomRoles:       customRoles,
+
+		RemoveCredentialManager: credentialManager == nil,
+	}
 
 	_, created, updated, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
@@ -110,3 +155,73 @@ func (command *SetTeamCommand) Execute([]string) error {
 
 	return nil
 }
+
+func printCustomRole(role atc.TeamRole) {
+	if role.Extends != "" {
+		fmt.Printf("  extends: %s\n", role.Extends)
+	}
+
+	if len(role.Permissions) > 0 {
+		fmt.Printf("  permissions:\n")
+		for _, permission := range role.Permissions {
+			if len(permission.Pipelines) > 0 {
+				fmt.Printf("  - %s (pipelines: %s)\n", permission.Action, strings.Join(permission.Pipelines, ", "))
+			} else {
+				fmt.Printf("  - %s\n", permission.Action)
+			}
+		}
+	}
+
+	fmt.Println()
+}
+
+type teamConfig struct {
+	CredentialManager *atc.TeamCredentialManager `json:"credential_manager"`
+	Roles             []atc.TeamRole             `json:"roles"`
+}
+
+// customRoles returns the roles of the config that are defined by the team,
+// i.e. the ones that extend another role or grant actions.
+func (config teamConfig) customRoles() []atc.TeamRole {
+	var roles []atc.TeamRole
+	for _, role := range config.Roles {
+		if role.Extends != "" || len(role.Permissions) > 0 {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// teamConfig reads the team's own credential manager from the
+// 'credential_manager' section of the team config file, and the custom roles
+// from the 'extends' and 'permissions' of its 'roles'.
+func (command *SetTeamCommand) teamConfig() (teamConfig, error) {
+	path := command.AuthFlags.Config.Path()
+	if path == "" {
+		return teamConfig{}, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return teamConfig{}, err
+	}
+
+	var config teamConfig
+	err = yaml.Unmarshal(content, &config)
+	if err != nil {
+		return teamConfig{}, err
+	}
+
+	if config.CredentialManager != nil && config.CredentialManager.Type == "" {
+		return teamConfig{}, atc.ErrCredentialManagerTypeEmpty
+	}
+
+	for _, role := range config.customRoles() {
+		err = role.Validate()
+		if err != nil {
+			return teamConfig{}, err
+		}
+	}
+
+	return config, nil
+}
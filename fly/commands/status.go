@@ -2,7 +2,9 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	jwt "github.com/dgrijalva/jwt-go"
@@ -24,13 +26,16 @@ func (c *StatusCommand) Execute([]string) error {
 	}
 
 	if tToken != nil {
-		_, err := jwt.Parse(tToken.Value, func(token *jwt.Token) (interface{}, error) {
-			return nil, token.Claims.Valid()
-		})
-
-		if err != nil && err.Error() != jwt.ErrInvalidKeyType.Error() {
-			displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
-			return nil
+		// api tokens are opaque, so only the server can validate them
+		if !strings.HasPrefix(tToken.Value, atc.APITokenPrefix) {
+			_, err := jwt.Parse(tToken.Value, func(token *jwt.Token) (interface{}, error) {
+				return nil, token.Claims.Valid()
+			})
+
+			if err != nil && err.Error() != jwt.ErrInvalidKeyType.Error() {
+				displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
+				return nil
+			}
 		}
 
 		_, err = target.Client().UserInfo()
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type TokensCommand struct {
+	Team string `long:"team" description:"Name of the team to list tokens for, if different from the target default"`
+	Json bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *TokensCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	tokens, err := team.ListAPITokens()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(tokens)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "name", Color: color.New(color.Bold)},
+		{Contents: "role", Color: color.New(color.Bold)},
+		{Contents: "service account", Color: color.New(color.Bold)},
+		{Contents: "created by", Color: color.New(color.Bold)},
+		{Contents: "expires", Color: color.New(color.Bold)},
+		{Contents: "last used", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, token := range tokens {
+		expiresCell := ui.TableCell{Contents: time.Unix(token.ExpiresAt, 0).Format(time.RFC1123)}
+		if time.Unix(token.ExpiresAt, 0).Before(time.Now()) {
+			expiresCell.Color = ui.FailedColor
+		}
+
+		lastUsedCell := ui.TableCell{Contents: "never", Color: color.New(color.Faint)}
+		if token.LastUsedAt != 0 {
+			lastUsedCell = ui.TableCell{Contents: time.Unix(token.LastUsedAt, 0).Format(time.RFC1123)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: token.Name},
+			{Contents: token.Role},
+			{Contents: strconv.FormatBool(token.ServiceAccount)},
+			{Contents: token.CreatedBy},
+			expiresCell,
+			lastUsedCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
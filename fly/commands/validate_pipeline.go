@@ -1,8 +1,18 @@
 package commands
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/pipelinegraph"
+	"github.com/concourse/concourse/fly/commands/internal/pipelinelint"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"github.com/concourse/concourse/fly/commands/internal/validatepipelinehelpers"
 
@@ -10,6 +20,8 @@ import (
 	_ "github.com/concourse/concourse/atc/creds/conjur"
 	_ "github.com/concourse/concourse/atc/creds/credhub"
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/encryptedfile"
+	_ "github.com/concourse/concourse/atc/creds/filesystem"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
@@ -20,6 +32,13 @@ type ValidatePipelineCommand struct {
 	Config atc.PathFlag `short:"c" long:"config" required:"true"        description:"Pipeline configuration file"`
 	Strict bool         `short:"s" long:"strict"                        description:"Fail on warnings"`
 	Output bool         `short:"o" long:"output"                        description:"Output templated pipeline to stdout"`
+	Graph  string       `long:"graph" choice:"dot" choice:"mermaid"     description:"Output the pipeline's job and resource graph in this format"`
+
+	Lint              bool          `long:"lint" description:"Check the pipeline against opinionated lint rules"`
+	LintStrict        bool          `long:"lint-strict" description:"Fail if --lint has any findings"`
+	LintFormat        string        `long:"lint-format" default:"text" choice:"text" choice:"json" choice:"sarif" description:"Format of lint findings"`
+	LintIgnore        []string      `long:"lint-ignore" value-name:"RULE" description:"Skip the lint rule with this ID (can be specified multiple times)"`
+	LintMinCheckEvery time.Duration `long:"lint-min-check-every" default:"1m" description:"Lowest check_every interval allowed by the low-check-every rule"`
 
 	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
@@ -29,5 +48,66 @@ type ValidatePipelineCommand struct {
 
 func (command *ValidatePipelineCommand) Execute(args []string) error {
 	yamlTemplate := templatehelpers.NewYamlTemplateWithParams(command.Config, command.VarsFrom, command.Var, command.YAMLVar)
-	return validatepipelinehelpers.Validate(yamlTemplate, command.Strict, command.Output)
+
+	if command.LintStrict && !command.Lint {
+		return errors.New("--lint-strict can only be used with --lint")
+	}
+
+	if command.Graph == "" && !command.Lint {
+		return validatepipelinehelpers.Validate(yamlTemplate, command.Strict, command.Output)
+	}
+
+	if command.Output {
+		return errors.New("--output cannot be used with --graph or --lint")
+	}
+
+	if command.Graph != "" && command.Lint {
+		return errors.New("--graph and --lint cannot be used together")
+	}
+
+	_, config, err := validatepipelinehelpers.ValidateConfig(yamlTemplate, command.Strict)
+	if err != nil {
+		return err
+	}
+
+	if command.Graph != "" {
+		name := strings.TrimSuffix(filepath.Base(string(command.Config)), filepath.Ext(string(command.Config)))
+
+		return pipelinegraph.New(name, config).Render(os.Stdout, command.Graph)
+	}
+
+	return command.lint(config)
+}
+
+func (command *ValidatePipelineCommand) lint(config atc.Config) error {
+	findings, err := pipelinelint.Lint(config, pipelinelint.Options{
+		Ignore:        command.LintIgnore,
+		MinCheckEvery: command.LintMinCheckEvery,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch command.LintFormat {
+	case "json":
+		err = pipelinelint.WriteJSON(os.Stdout, findings)
+	case "sarif":
+		err = pipelinelint.WriteSARIF(os.Stdout, string(command.Config), findings)
+	default:
+		if len(findings) == 0 {
+			fmt.Println("looks good")
+			return nil
+		}
+
+		err = pipelinelint.WriteText(os.Stdout, findings)
+	}
+	if err != nil {
+		return err
+	}
+
+	if command.LintStrict && len(findings) > 0 {
+		displayhelpers.Failf("configuration has %d lint findings", len(findings))
+	}
+
+	return nil
 }
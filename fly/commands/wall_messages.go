@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WallMessagesCommand struct {
+	All  bool `short:"a" long:"all" description:"List every message that has not ended, including scheduled ones and ones for other teams (requires admin)"`
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WallMessagesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	messages, err := target.Client().ListWallMessages(command.All)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(messages)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "severity", Color: color.New(color.Bold)},
+		{Contents: "message", Color: color.New(color.Bold)},
+		{Contents: "starts", Color: color.New(color.Bold)},
+		{Contents: "ends", Color: color.New(color.Bold)},
+		{Contents: "teams", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, message := range messages {
+		severityCell := ui.TableCell{Contents: message.Severity}
+		switch message.Severity {
+		case atc.WallSeverityCritical:
+			severityCell.Color = ui.ErroredColor
+		case atc.WallSeverityWarning:
+			severityCell.Color = ui.StartedColor
+		}
+
+		teamsCell := ui.TableCell{Contents: "all", Color: color.New(color.Faint)}
+		if len(message.Teams) != 0 {
+			teamsCell = ui.TableCell{Contents: strings.Join(message.Teams, ",")}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(message.ID)},
+			severityCell,
+			{Contents: message.Message},
+			wallTimeCell(message.StartsAt),
+			wallTimeCell(message.EndsAt),
+			teamsCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func wallTimeCell(timestamp int64) ui.TableCell {
+	if timestamp == 0 {
+		return ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+	}
+
+	return ui.TableCell{Contents: time.Unix(timestamp, 0).Format(time.RFC1123)}
+}
@@ -1,14 +1,20 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
 	"strconv"
+	"time"
 
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
 )
 
 type WatchCommand struct {
@@ -16,6 +22,9 @@ type WatchCommand struct {
 	Build     string              `short:"b" long:"build"                                  description:"Watches a specific build"`
 	Url       string              `short:"u" long:"url"                                    description:"URL for the build or job to watch"`
 	Timestamp bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Watches events of the given pipeline, such as builds, new versions, and pausing"`
+	Json     bool                     `long:"json"               description:"Print pipeline events as JSON"`
 }
 
 func getBuildIDFromURL(target rc.Target, urlParam string) (int, error) {
@@ -76,6 +85,14 @@ func (command *WatchCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Pipeline != "" {
+		if command.Job.JobName != "" || command.Build != "" || command.Url != "" {
+			return errors.New("Cannot specify --pipeline with --job, --build, or --url")
+		}
+
+		return command.watchPipeline(target)
+	}
+
 	var buildId int
 	client := target.Client()
 	if command.Job.JobName != "" || command.Build == "" && command.Url == "" {
@@ -113,3 +130,78 @@ func (command *WatchCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (command *WatchCommand) watchPipeline(target rc.Target) error {
+	err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	events, err := target.Team().PipelineEvents(string(command.Pipeline))
+	if err != nil {
+		return err
+	}
+
+	defer events.Close()
+
+	for {
+		ev, err := events.NextEvent()
+		if err != nil {
+			return err
+		}
+
+		if command.Json {
+			err = displayhelpers.JsonPrint(ev)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		fmt.Printf(
+			"%s  %-17s  %s\n",
+			time.Unix(ev.Time, 0).Format(timeDateLayout),
+			ev.Type,
+			describePipelineEvent(ev),
+		)
+	}
+}
+
+func describePipelineEvent(ev atc.PipelineEvent) string {
+	switch ev.Type {
+	case atc.PipelineEventBuildStatus:
+		if ev.Build == nil {
+			return ev.JobName
+		}
+
+		return fmt.Sprintf("%s #%s %s", ev.JobName, ev.Build.Name, buildStatusColor(ev.Build.Status).Sprint(ev.Build.Status))
+	case atc.PipelineEventResourceVersion, atc.PipelineEventResourcePinned:
+		return fmt.Sprintf("%s %s", ev.ResourceName, ui.PresentVersion(ev.Version))
+	case atc.PipelineEventResourceUnpinned:
+		return ev.ResourceName
+	case atc.PipelineEventJobPaused, atc.PipelineEventJobUnpaused:
+		return ev.JobName
+	case atc.PipelineEventConfig:
+		return fmt.Sprintf("%s version %d", ev.PipelineName, ev.ConfigVersion)
+	default:
+		return ev.PipelineName
+	}
+}
+
+func buildStatusColor(status atc.BuildStatus) *color.Color {
+	switch status {
+	case atc.StatusStarted:
+		return ui.StartedColor
+	case atc.StatusSucceeded:
+		return ui.SucceededColor
+	case atc.StatusFailed:
+		return ui.FailedColor
+	case atc.StatusErrored:
+		return ui.ErroredColor
+	case atc.StatusAborted:
+		return ui.AbortedColor
+	default:
+		return ui.PendingColor
+	}
+}
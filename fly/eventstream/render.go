@@ -56,6 +56,20 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			dstImpl.SetTimestamp(0)
 			fmt.Fprintf(dstImpl, "%s\n", errCol(e.Message))
 
+		case event.PolicyCheck:
+			errCol := ui.ErroredColor.SprintFunc()
+			dstImpl.SetTimestamp(e.Time)
+
+			header := "policy check failed"
+			if e.Severity == "warn" {
+				header = "policy check warning"
+			}
+
+			fmt.Fprintf(dstImpl, "%s\n", errCol(fmt.Sprintf("%s (%s):", header, e.Action)))
+			for _, reason := range e.Reasons {
+				fmt.Fprintf(dstImpl, "  - %s\n", reason)
+			}
+
 		case event.Status:
 			dstImpl.SetTimestamp(e.Time)
 			var printColor *color.Color
@@ -97,6 +97,34 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a PolicyCheck event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.PolicyCheck{
+				Action:   "UseImage",
+				Severity: "warn",
+				Reasons:  []string{"some-reason", "another-reason"},
+			}
+		})
+
+		It("prints the reasons under a header in bold red", func() {
+			Expect(out.Contents()).To(ContainSubstring(ui.ErroredColor.SprintFunc()("policy check warning (UseImage):") + "\n"))
+			Expect(out.Contents()).To(ContainSubstring("  - some-reason\n  - another-reason\n"))
+		})
+
+		Context("when the severity is block", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.PolicyCheck{
+					Action:   "UseImage",
+					Severity: "block",
+				}
+			})
+
+			It("prints that the check failed", func() {
+				Expect(out.Contents()).To(ContainSubstring(ui.ErroredColor.SprintFunc()("policy check failed (UseImage):") + "\n"))
+			})
+		})
+	})
+
 	Context("when an InitializeTask event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.InitializeTask{
@@ -0,0 +1,157 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("cp", func() {
+		var (
+			tmpdir string
+			cpArgs []string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-cp")
+			Expect(err).NotTo(HaveOccurred())
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers/container-id"),
+					ghttp.RespondWithJSONEncoded(200, atc.Container{
+						ID:               "container-id",
+						User:             "root",
+						WorkingDirectory: "/tmp/build/some-guid",
+					}),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		runCp := func() *gexec.Session {
+			args := append([]string{"-t", targetName, "cp", "--handle", "container-id"}, cpArgs...)
+
+			sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+
+			return sess
+		}
+
+		Context("when copying out of the container", func() {
+			BeforeEach(func() {
+				cpArgs = []string{":reports/junit.xml", filepath.Join(tmpdir, "out")}
+
+				tarBuffer := new(bytes.Buffer)
+				tw := tar.NewWriter(tarBuffer)
+				err := tw.WriteHeader(&tar.Header{
+					Name: "junit.xml",
+					Mode: 0644,
+					Size: int64(len("<testsuites/>")),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = tw.Write([]byte("<testsuites/>"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tw.Close()).To(Succeed())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers/container-id/files", "path=%2Ftmp%2Fbuild%2Fsome-guid%2Freports%2Fjunit.xml&user=root"),
+						ghttp.RespondWith(200, tarBuffer.Bytes()),
+					),
+				)
+			})
+
+			It("extracts the path relative to the working directory into the destination", func() {
+				sess := runCp()
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				contents, err := ioutil.ReadFile(filepath.Join(tmpdir, "out", "junit.xml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("<testsuites/>"))
+			})
+		})
+
+		Context("when copying into the container", func() {
+			var uploaded chan []string
+
+			BeforeEach(func() {
+				srcDir := filepath.Join(tmpdir, "fixtures")
+				Expect(os.Mkdir(srcDir, 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(srcDir, "data"), []byte("hello"), 0644)).To(Succeed())
+
+				cpArgs = []string{srcDir, ":/tmp/inbox"}
+
+				uploaded = make(chan []string, 1)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/containers/container-id/files", "path=%2Ftmp%2Finbox&user=root"),
+						func(w http.ResponseWriter, r *http.Request) {
+							var names []string
+
+							tr := tar.NewReader(r.Body)
+							for {
+								hdr, err := tr.Next()
+								if err == io.EOF {
+									break
+								}
+								Expect(err).NotTo(HaveOccurred())
+
+								names = append(names, hdr.Name)
+							}
+
+							uploaded <- names
+						},
+						ghttp.RespondWith(204, nil),
+					),
+				)
+			})
+
+			It("streams a tar of the source, rooted at its base name", func() {
+				sess := runCp()
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				var names []string
+				Expect(uploaded).To(Receive(&names))
+				Expect(names).To(ContainElement(MatchRegexp(`^(\./)?fixtures/data$`)))
+				Expect(sess.Err).To(gbytes.Say("copied .*fixtures to /tmp/inbox"))
+			})
+		})
+
+		Context("when neither path is in the container", func() {
+			BeforeEach(func() {
+				cpArgs = []string{"a", "b"}
+			})
+
+			It("errors before looking up the container", func() {
+				sess := runCp()
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("exactly one of SOURCE or DEST must be a container path"))
+
+				for _, req := range atcServer.ReceivedRequests() {
+					Expect(req.URL.Path).NotTo(ContainSubstring("containers"))
+				}
+			})
+		})
+	})
+})
@@ -0,0 +1,257 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --job", func() {
+		var (
+			tmpdir             string
+			repoDir            string
+			pipelineConfigPath string
+
+			jobPlan        atc.Plan
+			planRequest    chan atc.JobPlanRequest
+			uploadingBits  chan struct{}
+			streaming      chan struct{}
+			events         chan atc.Event
+			extraFlyArgs   []string
+			jobPlanHandler http.HandlerFunc
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-execute-job")
+			Expect(err).NotTo(HaveOccurred())
+
+			repoDir = filepath.Join(tmpdir, "repo")
+			err = os.Mkdir(repoDir, 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(repoDir, "README"), []byte("hello"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelineConfigPath = filepath.Join(tmpdir, "pipeline.yml")
+			err = ioutil.WriteFile(
+				pipelineConfigPath,
+				[]byte(`---
+resources:
+- name: repo
+  type: git
+  source: {uri: ((uri))}
+
+jobs:
+- name: unit
+  plan:
+  - get: repo
+  - task: test
+    config:
+      platform: linux
+      image_resource:
+        type: registry-image
+        source: {repository: busybox}
+      inputs:
+      - name: repo
+      run: {path: ls}
+  - put: repo
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			planFactory := atc.NewPlanFactory(0)
+			jobPlan = planFactory.NewPlan(atc.DoPlan{
+				planFactory.NewPlan(atc.ArtifactInputPlan{
+					ArtifactID: 125,
+					Name:       "repo",
+				}),
+			})
+
+			planRequest = make(chan atc.JobPlanRequest, 1)
+			uploadingBits = make(chan struct{})
+			streaming = make(chan struct{})
+			events = make(chan atc.Event)
+			extraFlyArgs = nil
+
+			jobPlanHandler = func(w http.ResponseWriter, r *http.Request) {
+				var request atc.JobPlanRequest
+				err := json.NewDecoder(r.Body).Decode(&request)
+				Expect(err).NotTo(HaveOccurred())
+
+				planRequest <- request
+
+				err = json.NewEncoder(w).Encode(jobPlan)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		JustBeforeEach(func() {
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, r *http.Request) {
+						close(uploadingBits)
+					},
+					ghttp.RespondWith(201, `{"id":125}`),
+				),
+			)
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/job-plan", jobPlanHandler)
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/builds",
+				ghttp.CombineHandlers(
+					VerifyPlan(jobPlan),
+					ghttp.RespondWith(201, `{"id":128}`),
+				),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/events",
+				func(w http.ResponseWriter, r *http.Request) {
+					flusher := w.(http.Flusher)
+
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.WriteHeader(http.StatusOK)
+					flusher.Flush()
+
+					close(streaming)
+
+					for e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						err = sse.Event{Name: "event", Data: payload}.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+
+						flusher.Flush()
+					}
+
+					err := sse.Event{Name: "end"}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/artifacts",
+				ghttp.RespondWithJSONEncoded(200, []atc.WorkerArtifact{}),
+			)
+		})
+
+		runFly := func() *gexec.Session {
+			args := append([]string{
+				"-t", targetName, "execute",
+				"-c", pipelineConfigPath,
+				"--job", "unit",
+				"-v", "uri=https://example.com/repo.git",
+				"-i", "repo=" + repoDir,
+			}, extraFlyArgs...)
+
+			sess, err := gexec.Start(exec.Command(flyPath, args...), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			return sess
+		}
+
+		It("uploads local inputs, plans the job, and runs the plan as a one-off build", func() {
+			sess := runFly()
+
+			var request atc.JobPlanRequest
+			Eventually(planRequest).Should(Receive(&request))
+			Expect(uploadingBits).To(BeClosed())
+
+			Expect(request.Job).To(Equal("unit"))
+			Expect(request.Artifacts).To(Equal(map[string]int{"repo": 125}))
+			Expect(request.SkipPuts).To(BeFalse())
+			Expect(request.Config.Resources[0].Source).To(Equal(atc.Source{
+				"uri": "https://example.com/repo.git",
+			}))
+
+			Eventually(streaming).Should(BeClosed())
+			Eventually(sess.Out).Should(gbytes.Say("executing build 128"))
+
+			events <- event.Log{Payload: "sup"}
+			Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+			close(events)
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+
+		Context("when --skip-puts is given", func() {
+			BeforeEach(func() {
+				extraFlyArgs = []string{"--skip-puts"}
+			})
+
+			It("asks for the puts to be left out of the plan", func() {
+				sess := runFly()
+
+				var request atc.JobPlanRequest
+				Eventually(planRequest).Should(Receive(&request))
+				Expect(request.SkipPuts).To(BeTrue())
+
+				Eventually(streaming).Should(BeClosed())
+				close(events)
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when an input does not match a get step in the job", func() {
+			BeforeEach(func() {
+				extraFlyArgs = []string{"-i", "bogus=" + repoDir}
+			})
+
+			It("errors without creating a build", func() {
+				sess := runFly()
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("unknown input `bogus`"))
+
+				Expect(planRequest).NotTo(Receive())
+			})
+		})
+
+		Context("when the job cannot be planned", func() {
+			BeforeEach(func() {
+				jobPlanHandler = func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write([]byte("failed to plan job unit: nope"))
+				}
+			})
+
+			It("prints the error", func() {
+				sess := runFly()
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("failed to plan job unit: nope"))
+			})
+		})
+
+		Context("when the job does not exist in the pipeline config", func() {
+			It("errors", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, "-t", targetName, "execute", "-c", pipelineConfigPath, "--job", "bogus", "-v", "uri=x"), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("unknown job `bogus`"))
+			})
+		})
+	})
+})
@@ -0,0 +1,173 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("explain-team-auth", func() {
+		var (
+			flyCmd      *exec.Cmd
+			explanation atc.TeamAuthExplanation
+		)
+
+		BeforeEach(func() {
+			explanation = atc.TeamAuthExplanation{
+				Team: "some-team",
+				Subject: atc.AuthSubject{
+					Connector: "github",
+					UserName:  "some-user",
+					Groups:    []string{"some-org"},
+				},
+				Roles: []string{"owner"},
+				Rules: []atc.TeamAuthRule{
+					{Role: "owner", Type: atc.TeamAuthRuleUser, Value: "github:some-user"},
+					{Role: "viewer", Type: atc.TeamAuthRuleGroup, Value: "github:some-org", Pipelines: []string{"some-pipeline"}},
+				},
+			}
+		})
+
+		Context("when explaining the current user", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "explain-team-auth", "-n", "some-team")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/auth/explain"),
+						ghttp.VerifyJSONRepresenting(atc.ExplainTeamAuthRequest{}),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, explanation),
+					),
+				)
+			})
+
+			It("prints the roles and the rules that granted them", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("team: some-team"))
+				Expect(sess.Out).To(gbytes.Say(`user: github:some-user \(groups: some-org\)`))
+				Expect(sess.Out).To(gbytes.Say("roles: owner"))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "role", Color: color.New(color.Bold)},
+						{Contents: "matched", Color: color.New(color.Bold)},
+						{Contents: "pipelines", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "owner"}, {Contents: "user github:some-user"}, {Contents: "all", Color: color.New(color.Faint)}},
+						{{Contents: "viewer"}, {Contents: "group github:some-org"}, {Contents: "some-pipeline"}},
+					},
+				}))
+			})
+		})
+
+		Context("when explaining a hypothetical user", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "explain-team-auth", "-n", "some-team",
+					"--connector", "github",
+					"--user", "other-user",
+					"--group", "some-org:some-team",
+				)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/auth/explain"),
+						ghttp.VerifyJSONRepresenting(atc.ExplainTeamAuthRequest{
+							Subject: &atc.AuthSubject{
+								Connector: "github",
+								UserID:    "other-user",
+								UserName:  "other-user",
+								Groups:    []string{"some-org:some-team"},
+							},
+						}),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.TeamAuthExplanation{
+							Team:    "some-team",
+							Subject: atc.AuthSubject{Connector: "github", UserName: "other-user"},
+							Roles:   []string{},
+							Rules:   []atc.TeamAuthRule{},
+						}),
+					),
+				)
+			})
+
+			It("says that no rule matches", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("roles: none"))
+				Expect(sess.Out).To(gbytes.Say("no auth rules of the team match this user"))
+			})
+		})
+
+		Context("when a hypothetical user has no connector", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "explain-team-auth", "-n", "some-team", "--user", "other-user")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--connector must be specified"))
+			})
+		})
+
+		Context("when evaluating a team config file", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "explain-team-auth", "-n", "some-team",
+					"-c", "fixtures/team_config_with_pipeline_roles.yml",
+					"--json",
+				)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/auth/explain"),
+						ghttp.VerifyJSONRepresenting(atc.ExplainTeamAuthRequest{
+							Auth: atc.TeamAuth{
+								"owner": map[string][]string{
+									"users":  {"local:some-owner"},
+									"groups": {},
+								},
+								"pipeline-operator": map[string][]string{
+									"users":     {"local:some-contractor"},
+									"groups":    {},
+									"pipelines": {"deploy-*", "release"},
+								},
+							},
+						}),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, explanation),
+					),
+				)
+			})
+
+			It("sends the config and prints the explanation as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`{
+					"team": "some-team",
+					"subject": {"connector": "github", "user_name": "some-user", "groups": ["some-org"]},
+					"roles": ["owner"],
+					"rules": [
+						{"role": "owner", "type": "user", "value": "github:some-user"},
+						{"role": "viewer", "type": "group", "value": "github:some-org", "pipelines": ["some-pipeline"]}
+					]
+				}`))
+			})
+		})
+	})
+})
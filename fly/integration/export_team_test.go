@@ -0,0 +1,203 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		tmpdir string
+		export atc.TeamExport
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "fly-export-team")
+		Expect(err).NotTo(HaveOccurred())
+
+		export = atc.TeamExport{
+			Version: atc.TeamExportVersion,
+			Team: atc.Team{
+				Name: "some-team",
+				Auth: atc.TeamAuth{"owner": {"users": {"local:username"}}},
+			},
+			Pipelines: []atc.PipelineExport{
+				{
+					Name:   "some-pipeline",
+					Paused: true,
+					Resources: []atc.ResourceExport{
+						{Name: "some-repo", PinnedVersion: atc.Version{"ref": "abc"}},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	Describe("export-team", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/export"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, export),
+				),
+			)
+		})
+
+		It("writes the export to stdout", func() {
+			sess, err := gexec.Start(exec.Command(flyPath, "-t", targetName, "export-team", "-n", "some-team"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			var written atc.TeamExport
+			Expect(json.Unmarshal(sess.Out.Contents(), &written)).To(Succeed())
+			Expect(written).To(Equal(export))
+		})
+
+		It("writes the export to a file with --output", func() {
+			outputPath := filepath.Join(tmpdir, "team.json")
+
+			sess, err := gexec.Start(exec.Command(flyPath, "-t", targetName, "export-team", "-n", "some-team", "-o", outputPath), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Err).To(gbytes.Say("exported team some-team with 1 pipelines to " + outputPath))
+
+			contents, err := ioutil.ReadFile(outputPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			var written atc.TeamExport
+			Expect(json.Unmarshal(contents, &written)).To(Succeed())
+			Expect(written).To(Equal(export))
+		})
+	})
+
+	Describe("import-team", func() {
+		var inputPath string
+
+		BeforeEach(func() {
+			inputPath = filepath.Join(tmpdir, "team.json")
+
+			payload, err := json.Marshal(export)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ioutil.WriteFile(inputPath, payload, 0600)).To(Succeed())
+		})
+
+		Context("when the import succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/other-team/import"),
+						ghttp.VerifyJSONRepresenting(export),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.TeamImportResponse{
+							Team:        "other-team",
+							TeamCreated: true,
+							Pipelines:   []string{"some-pipeline"},
+							Warnings:    []string{"pipeline some-pipeline: resource some-repo is pinned through config, skipping its pin"},
+						}),
+					),
+				)
+			})
+
+			It("imports into the named team and prints what was applied and any warnings", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, "-t", targetName, "import-team", "-n", "other-team", "-i", inputPath), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Err).To(gbytes.Say("WARNING: pipeline some-pipeline: resource some-repo is pinned through config"))
+				Expect(sess.Out).To(gbytes.Say("created team other-team"))
+				Expect(sess.Out).To(gbytes.Say("imported pipeline some-pipeline"))
+				Expect(sess.Out).To(gbytes.Say("imported 1 pipelines into team other-team"))
+			})
+		})
+
+		Context("when --check-creds is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import", "check_creds="),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.TeamImportResponse{
+							Team:      "some-team",
+							Pipelines: []string{"some-pipeline"},
+						}),
+					),
+				)
+			})
+
+			It("asks the server to check the credentials", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, "-t", targetName, "import-team", "--check-creds", "-i", inputPath), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("updated team some-team"))
+			})
+		})
+
+		Context("when the import fails part way through", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import"),
+						ghttp.RespondWithJSONEncoded(http.StatusInternalServerError, atc.TeamImportResponse{
+							Team:      "some-team",
+							Pipelines: []string{"some-pipeline"},
+							Errors:    []string{"failed to import pipeline other-pipeline: boom"},
+						}),
+					),
+				)
+			})
+
+			It("prints what was applied before failing", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, "-t", targetName, "import-team", "-i", inputPath), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("pipelines imported before the failure: some-pipeline"))
+			})
+		})
+
+		Context("when the export is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import"),
+						ghttp.RespondWithJSONEncoded(http.StatusBadRequest, atc.TeamImportResponse{
+							Errors: []string{"unsupported export version 2, expected 1"},
+						}),
+					),
+				)
+			})
+
+			It("imports into the team named in the export and prints the errors", func() {
+				sess, err := gexec.Start(exec.Command(flyPath, "-t", targetName, "import-team", "-i", inputPath), GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("invalid team export"))
+				Expect(sess.Err).To(gbytes.Say("unsupported export version 2, expected 1"))
+			})
+		})
+	})
+})
@@ -188,6 +188,21 @@ var _ = Describe("Fly CLI", func() {
 							Expect(printedConfig).To(Equal(config))
 						})
 					})
+
+					Context("when --graph is given", func() {
+						It("prints the job and resource graph", func() {
+							flyCmd := exec.Command(flyPath, "-t", targetName, "get-pipeline", "--pipeline", "some-pipeline", "--graph", "dot")
+
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(0))
+
+							Expect(sess.Out).To(gbytes.Say(`digraph "some-pipeline" \{`))
+							Expect(sess.Out).To(gbytes.Say(`"job:some-job" \[label="some-job", shape=box\];`))
+						})
+					})
 				})
 			})
 		})
@@ -2,6 +2,8 @@ package integration_test
 
 import (
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"os/exec"
 
@@ -1045,4 +1047,106 @@ var _ = Describe("Hijacking", func() {
 			Expect(sess.ExitCode()).ToNot(Equal(0))
 		})
 	})
+
+	Context("when forwarding a port", func() {
+		var (
+			localPort   int
+			relaySpecs  chan atc.HijackProcessSpec
+			flyArgs     []string
+			forwardSess *gexec.Session
+		)
+
+		BeforeEach(func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			localPort = listener.Addr().(*net.TCPAddr).Port
+			listener.Close()
+
+			relaySpecs = make(chan atc.HijackProcessSpec, 1)
+			flyArgs = []string{"hijack", "--handle", "container-id", "-L", fmt.Sprintf("%d:5432", localPort)}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers/container-id"),
+					ghttp.RespondWithJSONEncoded(200, atc.Container{
+						ID:               "container-id",
+						User:             "postgres",
+						WorkingDirectory: "/tmp/build/some-guid",
+					}),
+				),
+			)
+
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/containers/container-id/hijack", func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				conn, err := upgrader.Upgrade(w, r, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				defer conn.Close()
+
+				var processSpec atc.HijackProcessSpec
+				err = conn.ReadJSON(&processSpec)
+				Expect(err).NotTo(HaveOccurred())
+
+				relaySpecs <- processSpec
+
+				for {
+					var input atc.HijackInput
+					err := conn.ReadJSON(&input)
+					if err != nil || input.Closed {
+						return
+					}
+
+					_ = conn.WriteJSON(atc.HijackOutput{Stdout: append([]byte("pong: "), input.Stdin...)})
+				}
+			})
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			forwardSess, err = gexec.Start(exec.Command(flyPath, append([]string{"-t", targetName}, flyArgs...)...), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			forwardSess.Kill().Wait()
+		})
+
+		It("relays connections to the local port into the container", func() {
+			Eventually(forwardSess.Out).Should(gbytes.Say(fmt.Sprintf("forwarding 127.0.0.1:%d to port 5432 in the container", localPort)))
+
+			conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", localPort))
+			Expect(err).NotTo(HaveOccurred())
+
+			defer conn.Close()
+
+			_, err = conn.Write([]byte("ping"))
+			Expect(err).NotTo(HaveOccurred())
+
+			reply := make([]byte, len("pong: ping"))
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(reply)).To(Equal("pong: ping"))
+
+			var processSpec atc.HijackProcessSpec
+			Expect(relaySpecs).To(Receive(&processSpec))
+			Expect(processSpec.Path).To(Equal("sh"))
+			Expect(processSpec.Args[len(processSpec.Args)-1]).To(Equal("5432"))
+			Expect(processSpec.User).To(Equal("postgres"))
+			Expect(processSpec.Dir).To(Equal("/tmp/build/some-guid"))
+			Expect(processSpec.TTY).To(BeNil())
+		})
+
+		Context("when a command is also given", func() {
+			BeforeEach(func() {
+				flyArgs = append(flyArgs, "psql")
+			})
+
+			It("errors", func() {
+				<-forwardSess.Exited
+				Expect(forwardSess.ExitCode()).To(Equal(1))
+				Expect(forwardSess.Err).To(gbytes.Say("a command cannot be given when forwarding ports"))
+			})
+		})
+	})
 })
@@ -0,0 +1,166 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("job-stats", func() {
+		var (
+			flyCmd *exec.Cmd
+			stats  []atc.JobStats
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "job-stats", "-p", "pipeline")
+
+			stats = []atc.JobStats{
+				{
+					JobName:     "steady",
+					Builds:      10,
+					Succeeded:   10,
+					SuccessRate: 1,
+					DurationP50: 60,
+					DurationP95: 90,
+					QueueP50:    1,
+					QueueP95:    2.4,
+				},
+				{
+					JobName: "unused",
+				},
+				{
+					JobName:      "flaky",
+					Builds:       4,
+					Succeeded:    2,
+					Failed:       2,
+					SuccessRate:  0.5,
+					DurationP50:  300,
+					DurationP95:  600,
+					QueueP50:     5,
+					QueueP95:     30,
+					FlakyRetries: 1,
+					Flakiness:    0.25,
+				},
+			}
+		})
+
+		Context("when the stats are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/job-stats", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, stats),
+					),
+				)
+			})
+
+			It("shows the worst jobs first", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "builds", Color: color.New(color.Bold)},
+						{Contents: "success", Color: color.New(color.Bold)},
+						{Contents: "flaky", Color: color.New(color.Bold)},
+						{Contents: "p50", Color: color.New(color.Bold)},
+						{Contents: "p95", Color: color.New(color.Bold)},
+						{Contents: "queue p50", Color: color.New(color.Bold)},
+						{Contents: "queue p95", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "flaky"}, {Contents: "4"}, {Contents: "50%", Color: ui.StartedColor}, {Contents: "1 (25%)", Color: ui.StartedColor}, {Contents: "5m0s"}, {Contents: "10m0s"}, {Contents: "5s"}, {Contents: "30s"}},
+						{{Contents: "steady"}, {Contents: "10"}, {Contents: "100%"}, {Contents: "0 (0%)"}, {Contents: "1m0s"}, {Contents: "1m30s"}, {Contents: "1s"}, {Contents: "2s"}},
+						{{Contents: "unused"}, {Contents: "0"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the sorted stats as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{"job_name": "flaky", "builds": 4, "succeeded": 2, "failed": 2, "errored": 0, "aborted": 0, "success_rate": 0.5, "duration_p50": 300, "duration_p95": 600, "queue_p50": 5, "queue_p95": 30, "flaky_retries": 1, "flakiness": 0.25},
+						{"job_name": "steady", "builds": 10, "succeeded": 10, "failed": 0, "errored": 0, "aborted": 0, "success_rate": 1, "duration_p50": 60, "duration_p95": 90, "queue_p50": 1, "queue_p95": 2.4, "flaky_retries": 0, "flakiness": 0},
+						{"job_name": "unused", "builds": 0, "succeeded": 0, "failed": 0, "errored": 0, "aborted": 0, "success_rate": 0, "duration_p50": 0, "duration_p95": 0, "queue_p50": 0, "queue_p95": 0, "flaky_retries": 0, "flakiness": 0}
+					]`))
+				})
+			})
+		})
+
+		Context("when --since and --until are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--since", "2020-01-01 00:00:00", "--until", "2020-01-02 00:00:00")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/job-stats"),
+						func(w http.ResponseWriter, r *http.Request) {
+							Expect(r.URL.Query().Get("since")).NotTo(BeEmpty())
+							Expect(r.URL.Query().Get("until")).NotTo(BeEmpty())
+						},
+						ghttp.RespondWithJSONEncoded(http.StatusOK, stats),
+					),
+				)
+			})
+
+			It("asks for stats within that window", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when both --last and --since are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--last", "24h", "--since", "2020-01-01 00:00:00")
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("Cannot specify both --last and --since or --until"))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/job-stats"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("pipeline 'pipeline' not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,184 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("maintenance", func() {
+		Context("when maintenance mode is enabled", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+						ghttp.RespondWithJSONEncoded(200, atc.Maintenance{
+							Enabled:       true,
+							Reason:        "upgrading",
+							EnabledAt:     100,
+							RunningBuilds: 2,
+							PendingBuilds: 3,
+						}),
+					),
+				)
+			})
+
+			It("prints the mode and the build counts", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("maintenance mode: enabled"))
+				Expect(sess.Out).To(gbytes.Say("reason: upgrading"))
+				Expect(sess.Out).To(gbytes.Say("resource checks: running"))
+				Expect(sess.Out).To(gbytes.Say("running builds: 2"))
+				Expect(sess.Out).To(gbytes.Say("pending builds: 3"))
+				Expect(sess.Out).NotTo(gbytes.Say("safe to upgrade"))
+			})
+
+			Context("when waiting for the running builds", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+							ghttp.RespondWithJSONEncoded(200, atc.Maintenance{
+								Enabled:       true,
+								Reason:        "upgrading",
+								EnabledAt:     100,
+								PendingBuilds: 3,
+							}),
+						),
+					)
+				})
+
+				It("polls until no builds are running", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance", "--wait", "--poll-interval", "10ms")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Err).To(gbytes.Say("waiting for 2 running build"))
+					Expect(sess.Out).To(gbytes.Say("running builds: 0"))
+					Expect(sess.Out).To(gbytes.Say("safe to upgrade"))
+				})
+			})
+		})
+
+		Context("when maintenance mode is disabled", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+						ghttp.RespondWithJSONEncoded(200, atc.Maintenance{RunningBuilds: 4}),
+					),
+				)
+			})
+
+			It("says so", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("maintenance mode: disabled"))
+			})
+
+			It("refuses to wait", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance", "--wait")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("maintenance mode is not enabled"))
+			})
+		})
+	})
+
+	Describe("enable-maintenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/maintenance"),
+					ghttp.VerifyJSONRepresenting(atc.Maintenance{
+						Reason:      "upgrading",
+						PauseChecks: true,
+					}),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("enables maintenance mode", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "enable-maintenance", "-r", "upgrading", "--pause-checks")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("enabled maintenance mode"))
+		})
+	})
+
+	Describe("disable-maintenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/maintenance"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("disables maintenance mode", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "disable-maintenance")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("disabled maintenance mode"))
+		})
+	})
+
+	Describe("maintenance mode in the info of the target", func() {
+		BeforeEach(func() {
+			atcServer.SetHandler(3, ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/info"),
+				ghttp.RespondWithJSONEncoded(200, atc.Info{
+					Version:       atcVersion,
+					WorkerVersion: workerVersion,
+					Maintenance:   &atc.Maintenance{Enabled: true, Reason: "upgrading"},
+				}),
+			))
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/maintenance"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("tells the user why no builds are starting", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "disable-maintenance")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Err).To(gbytes.Say(`\[MAINTENANCE\] no new builds will be started: upgrading`))
+		})
+	})
+})
@@ -0,0 +1,162 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("notification-deliveries", func() {
+		var (
+			flyCmd     *exec.Cmd
+			deliveries []atc.NotificationDelivery
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "notification-deliveries", "-p", "pipeline")
+
+			deliveries = []atc.NotificationDelivery{
+				{
+					ID:               2,
+					NotificationName: "slack",
+					EventID:          43,
+					EventType:        atc.PipelineEventBuildStatus,
+					Status:           atc.NotificationDeliveryPending,
+					Attempts:         1,
+					ResponseCode:     502,
+					Error:            "unexpected response: 502 Bad Gateway",
+					CreatedAt:        100,
+					UpdatedAt:        200,
+				},
+				{
+					ID:               1,
+					NotificationName: "slack",
+					EventID:          42,
+					EventType:        atc.PipelineEventPipelinePaused,
+					Status:           atc.NotificationDeliverySucceeded,
+					Attempts:         1,
+					ResponseCode:     200,
+					CreatedAt:        100,
+					UpdatedAt:        100,
+				},
+			}
+		})
+
+		Context("when the deliveries are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/notification-deliveries", "limit=50"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, deliveries),
+					),
+				)
+			})
+
+			It("lists them", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "notification", Color: color.New(color.Bold)},
+						{Contents: "event", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "attempts", Color: color.New(color.Bold)},
+						{Contents: "response", Color: color.New(color.Bold)},
+						{Contents: "updated", Color: color.New(color.Bold)},
+						{Contents: "error", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "2"},
+							{Contents: "slack"},
+							{Contents: "build-status #43"},
+							{Contents: "pending", Color: ui.StartedColor},
+							{Contents: "1"},
+							{Contents: "502"},
+							{Contents: time.Unix(200, 0).Format(time.RFC1123)},
+							{Contents: "unexpected response: 502 Bad Gateway"},
+						},
+						{
+							{Contents: "1"},
+							{Contents: "slack"},
+							{Contents: "pipeline-paused #42"},
+							{Contents: "succeeded", Color: ui.SucceededColor},
+							{Contents: "1"},
+							{Contents: "200"},
+							{Contents: time.Unix(100, 0).Format(time.RFC1123)},
+							{Contents: "n/a", Color: color.New(color.Faint)},
+						},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the deliveries as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{"id": 2, "notification_name": "slack", "event_id": 43, "event_type": "build-status", "status": "pending", "attempts": 1, "response_code": 502, "error": "unexpected response: 502 Bad Gateway", "created_at": 100, "updated_at": 200},
+						{"id": 1, "notification_name": "slack", "event_id": 42, "event_type": "pipeline-paused", "status": "succeeded", "attempts": 1, "response_code": 200, "created_at": 100, "updated_at": 100}
+					]`))
+				})
+			})
+		})
+
+		Context("when --count is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--count", "5")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/notification-deliveries", "limit=5"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, deliveries),
+					),
+				)
+			})
+
+			It("asks for that many deliveries", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/notification-deliveries"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("pipeline 'pipeline' not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,240 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("sessions", func() {
+		var (
+			flyCmd  *exec.Cmd
+			created time.Time
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "sessions", "--all")
+
+			created = time.Now().Add(-time.Hour)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/sessions", "all=true"),
+					ghttp.RespondWithJSONEncoded(200, []atc.Session{
+						{
+							ID:         1,
+							UserName:   "some-user",
+							Connector:  "github",
+							CreatedAt:  created.Unix(),
+							LastUsedAt: created.Unix(),
+							ExpiresAt:  created.Add(24 * time.Hour).Unix(),
+							Current:    true,
+						},
+						{
+							ID:         2,
+							UserName:   "other-user",
+							Connector:  "local",
+							CreatedAt:  created.Unix(),
+							LastUsedAt: created.Unix(),
+							ExpiresAt:  created.Add(24 * time.Hour).Unix(),
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the sessions", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			createdAt := time.Unix(created.Unix(), 0).Format(time.RFC1123)
+			expiresAt := time.Unix(created.Add(24*time.Hour).Unix(), 0).Format(time.RFC1123)
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "user", Color: color.New(color.Bold)},
+					{Contents: "connector", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+					{Contents: "last used", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "1 (current)", Color: color.New(color.Bold)},
+						{Contents: "some-user"},
+						{Contents: "github"},
+						{Contents: createdAt},
+						{Contents: createdAt},
+						{Contents: expiresAt},
+					},
+					{
+						{Contents: "2"},
+						{Contents: "other-user"},
+						{Contents: "local"},
+						{Contents: createdAt},
+						{Contents: createdAt},
+						{Contents: expiresAt},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("revoke-session", func() {
+		Context("when neither --id nor --user is given", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-session")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("must specify either --id or --user"))
+			})
+		})
+
+		Context("when revoking a single session", func() {
+			var status int
+
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/sessions/42"),
+						ghttp.RespondWith(status, nil),
+					),
+				)
+			})
+
+			It("revokes the session", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-session", "--id", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("revoked session 42"))
+			})
+
+			Context("when the session does not exist", func() {
+				BeforeEach(func() {
+					status = http.StatusNotFound
+				})
+
+				It("fails", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-session", "--id", "42")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("session 42 not found"))
+				})
+			})
+		})
+
+		Context("when revoking every session of a user", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/sessions", "all=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Session{
+							{ID: 1, UserName: "some-user", Connector: "github", Sub: "some-sub"},
+							{ID: 2, UserName: "some-user", Connector: "github", Sub: "some-sub"},
+							{ID: 3, UserName: "some-user", Connector: "ldap", Sub: "other-sub"},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/users/github/some-sub/sessions"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.RevokedSessions{Count: 2, APITokens: 1}),
+					),
+				)
+			})
+
+			It("revokes the sessions of the user from that connector", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-session", "--user", "github:some-user")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("revoked 2 session\\(s\\) and 1 api token\\(s\\) of 'github:some-user'"))
+			})
+
+			It("requires the connector", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-session", "--user", "some-user")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--user must be given as CONNECTOR:USERNAME"))
+			})
+		})
+	})
+
+	Describe("logout", func() {
+		BeforeEach(func() {
+			idToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"sub": "some-sub",
+				"exp": time.Now().Add(time.Hour).Unix(),
+			}).SignedString([]byte("some-key"))
+			Expect(err).NotTo(HaveOccurred())
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/sky/issuer/token"),
+					ghttp.RespondWithJSONEncoded(200, map[string]string{
+						"token_type":   "Bearer",
+						"access_token": "some-access-token",
+						"id_token":     idToken,
+					}),
+				),
+				userInfoHandler(),
+			)
+
+			loginCmd := exec.Command(flyPath, "-t", targetName, "login", "-u", "user", "-p", "pass", "-c", atcServer.URL(), "-n", teamName)
+
+			sess, err := gexec.Start(loginCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/session"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer "+idToken),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("revokes the session on the server", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "logout")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("logged out of target: " + targetName))
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(7))
+		})
+	})
+})
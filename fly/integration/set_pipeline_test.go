@@ -690,6 +690,101 @@ this is super secure
 					})
 				})
 
+				Context("when the --dry-run option is used", func() {
+					var checkResponse atc.CheckCredsResponse
+
+					BeforeEach(func() {
+						checkResponse = atc.CheckCredsResponse{
+							Vars: []atc.VarCheck{
+								{
+									Name:   "some-var",
+									Status: atc.VarCheckFound,
+									Paths:  []string{"/concourse/main/some-var"},
+								},
+							},
+						}
+					})
+
+					JustBeforeEach(func() {
+						path, err := atc.Routes.CreatePathForRoute(atc.CheckConfigCreds, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+						Expect(err).NotTo(HaveOccurred())
+
+						atcServer.RouteToHandler("POST", path,
+							ghttp.CombineHandlers(
+								func(w http.ResponseWriter, r *http.Request) {
+									receivedConfig := atc.Config{}
+									err = yaml.Unmarshal(getConfig(r), &receivedConfig)
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(receivedConfig).To(Equal(config))
+								},
+								ghttp.RespondWithJSONEncoded(http.StatusOK, checkResponse),
+							),
+						)
+					})
+
+					runDryRun := func() *gexec.Session {
+						flyCmd := exec.Command(
+							flyPath, "-t", targetName,
+							"set-pipeline",
+							"--pipeline", "awesome-pipeline",
+							"-c", "fixtures/vars-pipeline.yml",
+							"-l", "fixtures/vars-pipeline-params-a.yml",
+							"-l", "fixtures/vars-pipeline-params-types.yml",
+							"--dry-run",
+						)
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						return sess
+					}
+
+					It("prints the result of each lookup without saving the pipeline", func() {
+						Expect(func() {
+							sess := runDryRun()
+							Expect(sess.ExitCode()).To(Equal(0))
+							Expect(sess.Out).To(gbytes.Say(`some-var\s+n/a\s+found\s+/concourse/main/some-var`))
+						}).To(Change(func() int {
+							return len(atcServer.ReceivedRequests())
+						}).By(3))
+					})
+
+					Context("when a variable cannot be resolved", func() {
+						BeforeEach(func() {
+							checkResponse.Vars = append(checkResponse.Vars, atc.VarCheck{
+								Name:   "other-var",
+								Source: "some-source",
+								Status: atc.VarCheckAccessDenied,
+								Paths:  []string{"/concourse/main/other-var"},
+								Error:  "permission denied",
+							})
+						})
+
+						It("prints the failure and exits non-zero", func() {
+							sess := runDryRun()
+							Expect(sess.Out).To(gbytes.Say(`other-var\s+some-source\s+access-denied\s+/concourse/main/other-var \(permission denied\)`))
+							Expect(sess.Err).To(gbytes.Say(`1 of 2 credential variables could not be resolved`))
+							Expect(sess.ExitCode()).NotTo(Equal(0))
+						})
+					})
+
+					Context("when a credential manager cannot be configured", func() {
+						BeforeEach(func() {
+							checkResponse.Errors = []string{"create var_source 'some-source' error: nope"}
+						})
+
+						It("prints the error and exits non-zero", func() {
+							sess := runDryRun()
+							Expect(sess.Err).To(gbytes.Say(`credential manager errors:`))
+							Expect(sess.Err).To(gbytes.Say(`create var_source 'some-source' error: nope`))
+							Expect(sess.Err).To(gbytes.Say(`credential managers could not be configured`))
+							Expect(sess.ExitCode()).NotTo(Equal(0))
+						})
+					})
+				})
+
 			})
 		})
 
@@ -1094,6 +1189,72 @@ this is super secure
 				})
 			})
 
+			Context("when the server returns a policy check warning", func() {
+				BeforeEach(func() {
+					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path, ghttp.CombineHandlers(
+						ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+						ghttp.RespondWith(http.StatusCreated, `{}`, http.Header{
+							atc.PolicyCheckHeader: {`{"allowed":false,"reasons":["reason-1","reason-2"],"severity":"warn"}`},
+						}),
+					))
+					config.Resources[0].Name = "updated-name"
+				})
+
+				It("succeeds and prints the policy check reasons", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name())
+
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess.Err).Should(gbytes.Say("POLICY CHECK WARNING:"))
+					Eventually(sess.Err).Should(gbytes.Say("  - reason-1"))
+					Eventually(sess.Err).Should(gbytes.Say("  - reason-2"))
+					Eventually(sess).Should(gbytes.Say("pipeline created!"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
+
+			Context("when the server rejects the config by policy check", func() {
+				BeforeEach(func() {
+					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path, ghttp.RespondWith(http.StatusForbidden, `policy check not pass: reason-1`, http.Header{
+						atc.PolicyCheckHeader: {`{"allowed":false,"reasons":["reason-1"],"severity":"block"}`},
+					}))
+					config.Resources[0].Name = "updated-name"
+				})
+
+				It("prints the policy check reasons and exits 1", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name())
+
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess.Err).Should(gbytes.Say("policy check failed: reason-1"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+
 			Context("when there are no pipeline changes", func() {
 				It("does not ask for user interaction to apply changes", func() {
 					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name())
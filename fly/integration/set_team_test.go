@@ -342,6 +342,7 @@ var _ = Describe("Fly CLI", func() {
 					ghttp.CombineHandlers(
 						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
 						ghttp.VerifyJSON(`{
+							"credential_manager": null,
 							"auth": {
 								"owner":{
 									"users": [
@@ -403,6 +404,200 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("credential manager", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_credential_manager.yml"}
+			})
+
+			It("shows the type of the team's credential manager", func() {
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("role owner:"))
+				Eventually(sess.Out).Should(gbytes.Say("credential manager: vault"))
+				Consistently(sess.Out).ShouldNot(gbytes.Say("some-token"))
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+
+			Context("when confirmed", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"auth": {
+									"owner":{
+										"users": ["local:some-owner"],
+										"groups": []
+									}
+								},
+								"credential_manager": {
+									"type": "vault",
+									"config": {
+										"url": "https://vault.example.com",
+										"path_prefix": "/venture",
+										"client_token": "some-token"
+									}
+								}
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("sends the credential manager with the team", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess.Out).Should(gbytes.Say("team updated"))
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
+
+		Describe("custom roles", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_custom_roles.yml"}
+			})
+
+			It("shows the definition of the team's custom roles", func() {
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("role owner:"))
+				Eventually(sess.Out).Should(gbytes.Say("role release-manager:"))
+				Eventually(sess.Out).Should(gbytes.Say("extends: pipeline-operator"))
+				Eventually(sess.Out).Should(gbytes.Say(`- SaveConfig \(pipelines: release\)`))
+				Eventually(sess.Out).Should(gbytes.Say("local:some-release-manager"))
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+
+			Context("when confirmed", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"credential_manager": null,
+								"auth": {
+									"owner":{
+										"users": ["local:some-owner"],
+										"groups": []
+									},
+									"release-manager":{
+										"users": ["local:some-release-manager"],
+										"groups": []
+									}
+								},
+								"custom_roles": [
+									{
+										"name": "release-manager",
+										"extends": "pipeline-operator",
+										"permissions": [
+											{"action": "SaveConfig", "pipelines": ["release"]}
+										]
+									}
+								]
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("sends the custom roles with the team", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess.Out).Should(gbytes.Say("team updated"))
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
+
+		Describe("pipeline-scoped roles", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_pipeline_roles.yml"}
+			})
+
+			It("shows the pipelines the role is restricted to", func() {
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("role pipeline-operator:"))
+				Eventually(sess.Out).Should(gbytes.Say("local:some-contractor"))
+				Eventually(sess.Out).Should(gbytes.Say("pipelines:"))
+				Eventually(sess.Out).Should(gbytes.Say(`- deploy-\*`))
+				Eventually(sess.Out).Should(gbytes.Say("- release"))
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+
+			Context("when confirmed", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"credential_manager": null,
+								"auth": {
+									"owner":{
+										"users": ["local:some-owner"],
+										"groups": []
+									},
+									"pipeline-operator":{
+										"users": ["local:some-contractor"],
+										"groups": [],
+										"pipelines": ["deploy-*", "release"]
+									}
+								}
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("sends the pipelines with the role", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess.Out).Should(gbytes.Say("team updated"))
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"-c", "fixtures/team_config_mixed.yml"}
@@ -414,6 +609,7 @@ var _ = Describe("Fly CLI", func() {
 						ghttp.CombineHandlers(
 							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
 							ghttp.VerifyJSON(`{
+							"credential_manager": null,
 							"auth": {
 								"owner":{
 									"users": [
@@ -666,6 +862,7 @@ var _ = Describe("Fly CLI", func() {
 					ghttp.CombineHandlers(
 						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
 						ghttp.VerifyJSON(`{
+							"credential_manager": null,
 							"auth": {
 								"owner":{
 									"users": [
@@ -728,6 +925,7 @@ var _ = Describe("Fly CLI", func() {
 						ghttp.CombineHandlers(
 							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
 							ghttp.VerifyJSON(`{
+							"credential_manager": null,
 							"auth": {
 								"owner":{
 									"users": [
@@ -0,0 +1,200 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("tokens", func() {
+		var (
+			flyCmd    *exec.Cmd
+			expiresAt time.Time
+			lastUsed  time.Time
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "tokens")
+
+			expiresAt = time.Now().Add(time.Hour)
+			lastUsed = time.Now().Add(-time.Hour)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/tokens"),
+					ghttp.RespondWithJSONEncoded(200, []atc.APIToken{
+						{
+							ID:         1,
+							Name:       "some-token",
+							TeamName:   "main",
+							Role:       "member",
+							CreatedBy:  "some-user",
+							ExpiresAt:  expiresAt.Unix(),
+							LastUsedAt: lastUsed.Unix(),
+						},
+						{
+							ID:             2,
+							Name:           "deploy-bot",
+							TeamName:       "main",
+							Role:           "pipeline-operator",
+							ServiceAccount: true,
+							CreatedBy:      "some-owner",
+							ExpiresAt:      expiresAt.Unix(),
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the tokens of the team", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "role", Color: color.New(color.Bold)},
+					{Contents: "service account", Color: color.New(color.Bold)},
+					{Contents: "created by", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+					{Contents: "last used", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "some-token"},
+						{Contents: "member"},
+						{Contents: "false"},
+						{Contents: "some-user"},
+						{Contents: time.Unix(expiresAt.Unix(), 0).Format(time.RFC1123)},
+						{Contents: time.Unix(lastUsed.Unix(), 0).Format(time.RFC1123)},
+					},
+					{
+						{Contents: "deploy-bot"},
+						{Contents: "pipeline-operator"},
+						{Contents: "true"},
+						{Contents: "some-owner"},
+						{Contents: time.Unix(expiresAt.Unix(), 0).Format(time.RFC1123)},
+						{Contents: "never", Color: color.New(color.Faint)},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("create-token", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "create-token", "-n", "some-token", "--role", "viewer", "--expires-in", "24h")
+		})
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+						ghttp.VerifyJSONRepresenting(atc.APITokenRequest{
+							Name:      "some-token",
+							Role:      "viewer",
+							ExpiresIn: 24 * time.Hour,
+						}),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+							ID:        1,
+							Name:      "some-token",
+							TeamName:  "main",
+							Role:      "viewer",
+							ExpiresAt: time.Now().Add(24 * time.Hour).Unix(),
+							Token:     "cpat_some-secret",
+						}),
+					),
+				)
+			})
+
+			It("prints the token", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("created token 'some-token' with role 'viewer' on team 'main'"))
+				Expect(sess.Out).To(gbytes.Say("cpat_some-secret"))
+				Expect(sess.Out).To(gbytes.Say("this is the only time the token will be shown"))
+			})
+		})
+
+		Context("when the role is not allowed", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("forbidden"))
+			})
+		})
+	})
+
+	Describe("revoke-token", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "revoke-token", "-n", "some-token")
+		})
+
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/tokens/some-token"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("revokes the token", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("revoked 'some-token'"))
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/tokens/some-token"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("token 'some-token' not found on team main"))
+			})
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"os/exec"
 
 	. "github.com/onsi/ginkgo"
@@ -47,6 +48,109 @@ var _ = Describe("Fly CLI", func() {
 			Expect(sess.ExitCode()).To(Equal(0))
 		})
 
+		It("prints the graph of a valid configuration with --graph", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigValid.yml",
+				"--graph", "mermaid",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(string(sess.Out.Contents())).To(Equal(`flowchart LR
+  resource0(["some-resource"])
+  job1["job"]
+  resource0 -.-> job1
+`))
+		})
+
+		It("fails to graph an invalid configuration", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigError.yml",
+				"--graph", "dot",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Out.Contents()).To(BeEmpty())
+		})
+
+		It("rejects --lint-strict without --lint", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/testConfigLint.yml",
+				"--lint-strict",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("--lint-strict can only be used with --lint"))
+		})
+
+		Context("when --lint is given", func() {
+			runLint := func(args ...string) *gexec.Session {
+				flyCmd := exec.Command(
+					flyPath,
+					append([]string{"validate-pipeline", "-c", "fixtures/testConfigLint.yml", "--lint"}, args...)...,
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				return sess
+			}
+
+			It("prints each finding with its rule ID", func() {
+				sess := runLint()
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say(`resource_types.stale: \[unused-resource-type\] resource type ` + "`stale`" + ` is not used`))
+				Expect(sess.Out).To(gbytes.Say(`resources.repo: \[low-check-every\] check_every of 10s is less than 1m0s`))
+			})
+
+			It("skips ignored rules", func() {
+				sess := runLint("--lint-ignore", "unused-resource-type", "--lint-min-check-every", "5s")
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("looks good"))
+			})
+
+			It("does not fail on findings with --strict", func() {
+				sess := runLint("--strict")
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+
+			It("fails with --lint-strict and prints SARIF for CI", func() {
+				sess := runLint("--lint-strict", "--lint-format", "sarif")
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("configuration has 2 lint findings"))
+
+				var log struct {
+					Version string `json:"version"`
+					Runs    []struct {
+						Results []struct {
+							RuleID string `json:"ruleId"`
+						} `json:"results"`
+					} `json:"runs"`
+				}
+				Expect(json.Unmarshal(sess.Out.Contents(), &log)).To(Succeed())
+				Expect(log.Version).To(Equal("2.1.0"))
+				Expect(log.Runs[0].Results).To(HaveLen(2))
+			})
+		})
+
 		It("returns valid on templated configuration with variables", func() {
 			flyCmd := exec.Command(
 				flyPath,
@@ -0,0 +1,203 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("wall-messages", func() {
+		var (
+			flyCmd *exec.Cmd
+			ends   time.Time
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "wall-messages", "--all")
+
+			ends = time.Now().Add(time.Hour)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/wall/messages", "all=true"),
+					ghttp.RespondWithJSONEncoded(200, []atc.WallMessage{
+						{
+							ID:       1,
+							Message:  "upgrading soon",
+							Severity: atc.WallSeverityWarning,
+							EndsAt:   ends.Unix(),
+						},
+						{
+							ID:       2,
+							Message:  "hello team",
+							Severity: atc.WallSeverityInfo,
+							Teams:    []string{"some-team", "other-team"},
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the messages", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "severity", Color: color.New(color.Bold)},
+					{Contents: "message", Color: color.New(color.Bold)},
+					{Contents: "starts", Color: color.New(color.Bold)},
+					{Contents: "ends", Color: color.New(color.Bold)},
+					{Contents: "teams", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "1"},
+						{Contents: "warning", Color: ui.StartedColor},
+						{Contents: "upgrading soon"},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: time.Unix(ends.Unix(), 0).Format(time.RFC1123)},
+						{Contents: "all", Color: color.New(color.Faint)},
+					},
+					{
+						{Contents: "2"},
+						{Contents: "info"},
+						{Contents: "hello team"},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: "some-team,other-team"},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("add-wall-message", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/wall/messages"),
+					func(w http.ResponseWriter, r *http.Request) {
+						defer GinkgoRecover()
+
+						var message atc.WallMessage
+						Expect(json.NewDecoder(r.Body).Decode(&message)).To(Succeed())
+						Expect(message.Message).To(Equal("upgrading soon"))
+						Expect(message.Severity).To(Equal(atc.WallSeverityCritical))
+						Expect(message.Teams).To(Equal([]string{"some-team"}))
+						Expect(message.StartsAt).To(BeNumerically("~", time.Now().Add(time.Hour).Unix(), 5))
+						Expect(message.EndsAt - message.StartsAt).To(Equal(int64(30 * 60)))
+					},
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.WallMessage{ID: 42}),
+				),
+			)
+		})
+
+		It("creates the message", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "add-wall-message",
+				"-m", "upgrading soon",
+				"-s", "critical",
+				"--starts-in", "1h",
+				"-d", "30m",
+				"--team", "some-team",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("added wall message 42"))
+		})
+	})
+
+	Describe("delete-wall-message", func() {
+		Context("when the message exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/wall/messages/42"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("deletes the message", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "delete-wall-message", "--id", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("deleted wall message 42"))
+			})
+		})
+
+		Context("when the message does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/wall/messages/42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "delete-wall-message", "--id", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("wall message 42 not found"))
+			})
+		})
+	})
+
+	Describe("wall messages in the info of the target", func() {
+		BeforeEach(func() {
+			atcServer.SetHandler(3, ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/info"),
+				ghttp.RespondWithJSONEncoded(200, atc.Info{
+					Version:       atcVersion,
+					WorkerVersion: workerVersion,
+					Wall: []atc.WallMessage{
+						{ID: 1, Message: "upgrading soon", Severity: atc.WallSeverityWarning},
+					},
+				}),
+			))
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/wall/messages"),
+					ghttp.RespondWithJSONEncoded(200, []atc.WallMessage{}),
+				),
+			)
+		})
+
+		It("prints them to stderr before running the command", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "wall-messages")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Err).To(gbytes.Say(`\[WARNING\] upgrading soon`))
+			Expect(sess.Out).NotTo(gbytes.Say("upgrading soon"))
+		})
+	})
+})
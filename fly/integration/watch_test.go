@@ -239,4 +239,103 @@ var _ = Describe("Watching", func() {
 			})
 		})
 	})
+
+	Context("with a pipeline", func() {
+		var pipelineEvents []atc.PipelineEvent
+
+		BeforeEach(func() {
+			pipelineEvents = []atc.PipelineEvent{
+				{
+					ID:           1,
+					Type:         atc.PipelineEventBuildStatus,
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					Build:        &atc.PipelineEventBuild{ID: 3, Name: "12", Status: atc.StatusSucceeded},
+				},
+				{
+					ID:           2,
+					Type:         atc.PipelineEventResourceVersion,
+					PipelineName: "some-pipeline",
+					ResourceName: "some-resource",
+					Version:      atc.Version{"ref": "abc"},
+				},
+				{
+					ID:           3,
+					Type:         atc.PipelineEventPipelinePaused,
+					PipelineName: "some-pipeline",
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/events"),
+					func(w http.ResponseWriter, r *http.Request) {
+						flusher := w.(http.Flusher)
+
+						w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+						w.WriteHeader(http.StatusOK)
+
+						for _, ev := range pipelineEvents {
+							payload, err := json.Marshal(ev)
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{
+								ID:   fmt.Sprintf("%d", ev.ID),
+								Name: "event",
+								Data: payload,
+							}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+
+							flusher.Flush()
+						}
+
+						<-r.Context().Done()
+					},
+				),
+			)
+		})
+
+		watchPipeline := func(args ...string) *gexec.Session {
+			flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "watch", "--pipeline", "some-pipeline"}, args...)...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			return sess
+		}
+
+		It("prints a line for each event until interrupted", func() {
+			sess := watchPipeline()
+
+			Eventually(sess.Out).Should(gbytes.Say(`build-status\s+some-job #12 succeeded`))
+			Eventually(sess.Out).Should(gbytes.Say(`resource-version\s+some-resource ref:abc`))
+			Eventually(sess.Out).Should(gbytes.Say(`pipeline-paused\s+some-pipeline`))
+
+			sess.Interrupt()
+			Eventually(sess).Should(gexec.Exit())
+		})
+
+		It("prints each event as JSON when --json is given", func() {
+			sess := watchPipeline("--json")
+
+			Eventually(sess.Out).Should(gbytes.Say(`"type": "build-status"`))
+			Eventually(sess.Out).Should(gbytes.Say(`"type": "resource-version"`))
+			Eventually(sess.Out).Should(gbytes.Say(`"type": "pipeline-paused"`))
+
+			sess.Interrupt()
+			Eventually(sess).Should(gexec.Exit())
+		})
+	})
+
+	Context("with a pipeline and a job", func() {
+		It("fails", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--pipeline", "some-pipeline", "--job", "some-pipeline/some-job")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("Cannot specify --pipeline with --job, --build, or --url"))
+		})
+	})
 })
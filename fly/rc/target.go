@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"strings"
 	"time"
 
 	conc "github.com/concourse/concourse"
@@ -367,6 +368,9 @@ func (t *target) validate(allowVersionMismatch bool) error {
 		return err
 	}
 
+	printMaintenance(info.Maintenance)
+	printWall(info.Wall)
+
 	if info.Version == conc.Version || version.IsDev(conc.Version) {
 		return nil
 	}
@@ -393,8 +397,50 @@ func (t *target) validate(allowVersionMismatch bool) error {
 	return nil
 }
 
+// printWall prints the wall messages of the target to stderr, so that they
+// head the output of commands without getting mixed into it.
+func printWall(messages []atc.WallMessage) {
+	for _, message := range messages {
+		severity := "[" + strings.ToUpper(message.Severity) + "]"
+
+		switch message.Severity {
+		case atc.WallSeverityCritical:
+			severity = ui.ErroredColor.Sprint(severity)
+		case atc.WallSeverityWarning:
+			severity = ui.StartedColor.Sprint(severity)
+		}
+
+		line := severity + " " + message.Message
+		if message.EndsAt != 0 {
+			line += fmt.Sprintf(" (until %s)", time.Unix(message.EndsAt, 0).Format(time.RFC1123))
+		}
+
+		fmt.Fprintln(ui.Stderr, line)
+	}
+
+	if len(messages) != 0 {
+		fmt.Fprintln(ui.Stderr)
+	}
+}
+
+// printMaintenance tells the user that no builds will be started while the
+// cluster is in maintenance mode.
+func printMaintenance(mode *atc.Maintenance) {
+	if mode == nil {
+		return
+	}
+
+	line := ui.StartedColor.Sprint("[MAINTENANCE]") + " no new builds will be started"
+	if mode.Reason != "" {
+		line += ": " + mode.Reason
+	}
+
+	fmt.Fprintln(ui.Stderr, line)
+	fmt.Fprintln(ui.Stderr)
+}
+
 func (t *target) getInfo() (atc.Info, error) {
-	if (t.info != atc.Info{}) {
+	if t.info.Version != "" {
 		return t.info, nil
 	}
 
@@ -0,0 +1,76 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListAPITokens() ([]atc.APIToken, error) {
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	var tokens []atc.APIToken
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListAPITokens,
+		Params:      params,
+	}, &internal.Response{
+		Result: &tokens,
+	})
+
+	return tokens, err
+}
+
+// CreateAPIToken creates a new API token. The returned token is the only
+// time its secret value is ever made available.
+func (team *team) CreateAPIToken(request atc.APITokenRequest) (atc.APIToken, error) {
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(request)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	var token atc.APIToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateAPIToken,
+		Params:      params,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &token,
+	})
+
+	return token, err
+}
+
+func (team *team) DeleteAPIToken(tokenName string) (bool, error) {
+	params := rata.Params{
+		"team_name":  team.Name(),
+		"token_name": tokenName,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DeleteAPIToken,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
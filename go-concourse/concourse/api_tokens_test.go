@@ -0,0 +1,125 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("API Tokens", func() {
+	Describe("ListAPITokens", func() {
+		var expectedTokens []atc.APIToken
+
+		BeforeEach(func() {
+			expectedTokens = []atc.APIToken{
+				{ID: 1, Name: "some-token", TeamName: "some-team", Role: "member"},
+				{ID: 2, Name: "other-token", TeamName: "some-team", Role: "viewer", ServiceAccount: true},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTokens),
+				),
+			)
+		})
+
+		It("returns the tokens", func() {
+			tokens, err := team.ListAPITokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal(expectedTokens))
+		})
+	})
+
+	Describe("CreateAPIToken", func() {
+		var request atc.APITokenRequest
+
+		BeforeEach(func() {
+			request = atc.APITokenRequest{
+				Name:      "some-token",
+				Role:      "member",
+				ExpiresIn: time.Hour,
+			}
+		})
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/tokens"),
+						ghttp.VerifyJSONRepresenting(request),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+							ID:    1,
+							Name:  "some-token",
+							Token: "cpat_some-secret",
+						}),
+					),
+				)
+			})
+
+			It("returns the token including its value", func() {
+				token, err := team.CreateAPIToken(request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(token.Name).To(Equal("some-token"))
+				Expect(token.Token).To(Equal("cpat_some-secret"))
+			})
+		})
+
+		Context("when the token already exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/tokens"),
+						ghttp.RespondWith(http.StatusConflict, "token 'some-token' already exists"),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.CreateAPIToken(request)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("already exists"))
+			})
+		})
+	})
+
+	Describe("DeleteAPIToken", func() {
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/tokens/some-token"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("deletes the token", func() {
+				found, err := team.DeleteAPIToken("some-token")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/tokens/some-token"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := team.DeleteAPIToken("some-token")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -35,6 +35,31 @@ func (team *team) CreateBuild(plan atc.Plan) (atc.Build, error) {
 	return build, err
 }
 
+func (team *team) CreateJobPlan(request atc.JobPlanRequest) (atc.Plan, error) {
+	var plan atc.Plan
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(request)
+	if err != nil {
+		return plan, fmt.Errorf("Unable to marshal job plan request: %s", err)
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateJobPlan,
+		Body:        buffer,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &plan,
+	})
+
+	return plan, err
+}
+
 func (team *team) CreateJobBuild(pipelineName string, jobName string) (atc.Build, error) {
 	params := rata.Params{
 		"job_name":      jobName,
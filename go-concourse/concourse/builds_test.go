@@ -62,6 +62,43 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("CreateJobPlan", func() {
+		var (
+			request      atc.JobPlanRequest
+			expectedPlan atc.Plan
+		)
+
+		BeforeEach(func() {
+			request = atc.JobPlanRequest{
+				Job:       "some-job",
+				Artifacts: map[string]int{"some-input": 42},
+				SkipPuts:  true,
+			}
+
+			expectedPlan = atc.Plan{
+				ID: "some-guid",
+				ArtifactInput: &atc.ArtifactInputPlan{
+					ArtifactID: 42,
+					Name:       "some-input",
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/job-plan"),
+					ghttp.VerifyJSONRepresenting(request),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedPlan),
+				),
+			)
+		})
+
+		It("returns the compiled plan", func() {
+			plan, err := team.CreateJobPlan(request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(expectedPlan))
+		})
+	})
+
 	Describe("CreateJobBuild", func() {
 		var (
 			pipelineName  string
@@ -25,6 +25,7 @@ type Client interface {
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	LandWorkerBy(workerName string, deadline time.Duration) error
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
@@ -34,6 +35,16 @@ type Client interface {
 	Team(teamName string) Team
 	UserInfo() (atc.UserInfo, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	ListSessions(all bool) ([]atc.Session, error)
+	RevokeSession(sessionID int) (bool, error)
+	RevokeCurrentSession() error
+	RevokeUserSessions(connector string, sub string) (atc.RevokedSessions, error)
+	ListWallMessages(all bool) ([]atc.WallMessage, error)
+	CreateWallMessage(atc.WallMessage) (atc.WallMessage, error)
+	DeleteWallMessage(id int) (bool, error)
+	GetMaintenance() (atc.Maintenance, error)
+	EnableMaintenance(reason string, pauseChecks bool) error
+	DisableMaintenance() error
 	Check(checkID string) (atc.Check, bool, error)
 }
 
@@ -111,6 +111,54 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	CreateWallMessageStub        func(atc.WallMessage) (atc.WallMessage, error)
+	createWallMessageMutex       sync.RWMutex
+	createWallMessageArgsForCall []struct {
+		arg1 atc.WallMessage
+	}
+	createWallMessageReturns struct {
+		result1 atc.WallMessage
+		result2 error
+	}
+	createWallMessageReturnsOnCall map[int]struct {
+		result1 atc.WallMessage
+		result2 error
+	}
+	DeleteWallMessageStub        func(int) (bool, error)
+	deleteWallMessageMutex       sync.RWMutex
+	deleteWallMessageArgsForCall []struct {
+		arg1 int
+	}
+	deleteWallMessageReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteWallMessageReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DisableMaintenanceStub        func() error
+	disableMaintenanceMutex       sync.RWMutex
+	disableMaintenanceArgsForCall []struct {
+	}
+	disableMaintenanceReturns struct {
+		result1 error
+	}
+	disableMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnableMaintenanceStub        func(string, bool) error
+	enableMaintenanceMutex       sync.RWMutex
+	enableMaintenanceArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	enableMaintenanceReturns struct {
+		result1 error
+	}
+	enableMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -152,6 +200,18 @@ type FakeClient struct {
 		result1 atc.Info
 		result2 error
 	}
+	GetMaintenanceStub        func() (atc.Maintenance, error)
+	getMaintenanceMutex       sync.RWMutex
+	getMaintenanceArgsForCall []struct {
+	}
+	getMaintenanceReturns struct {
+		result1 atc.Maintenance
+		result2 error
+	}
+	getMaintenanceReturnsOnCall map[int]struct {
+		result1 atc.Maintenance
+		result2 error
+	}
 	HTTPClientStub        func() *http.Client
 	hTTPClientMutex       sync.RWMutex
 	hTTPClientArgsForCall []struct {
@@ -173,6 +233,18 @@ type FakeClient struct {
 	landWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LandWorkerByStub        func(string, time.Duration) error
+	landWorkerByMutex       sync.RWMutex
+	landWorkerByArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	landWorkerByReturns struct {
+		result1 error
+	}
+	landWorkerByReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ListActiveUsersSinceStub        func(time.Time) ([]atc.User, error)
 	listActiveUsersSinceMutex       sync.RWMutex
 	listActiveUsersSinceArgsForCall []struct {
@@ -223,6 +295,19 @@ type FakeClient struct {
 		result1 []atc.Pipeline
 		result2 error
 	}
+	ListSessionsStub        func(bool) ([]atc.Session, error)
+	listSessionsMutex       sync.RWMutex
+	listSessionsArgsForCall []struct {
+		arg1 bool
+	}
+	listSessionsReturns struct {
+		result1 []atc.Session
+		result2 error
+	}
+	listSessionsReturnsOnCall map[int]struct {
+		result1 []atc.Session
+		result2 error
+	}
 	ListTeamsStub        func() ([]atc.Team, error)
 	listTeamsMutex       sync.RWMutex
 	listTeamsArgsForCall []struct {
@@ -235,6 +320,19 @@ type FakeClient struct {
 		result1 []atc.Team
 		result2 error
 	}
+	ListWallMessagesStub        func(bool) ([]atc.WallMessage, error)
+	listWallMessagesMutex       sync.RWMutex
+	listWallMessagesArgsForCall []struct {
+		arg1 bool
+	}
+	listWallMessagesReturns struct {
+		result1 []atc.WallMessage
+		result2 error
+	}
+	listWallMessagesReturnsOnCall map[int]struct {
+		result1 []atc.WallMessage
+		result2 error
+	}
 	ListWorkersStub        func() ([]atc.Worker, error)
 	listWorkersMutex       sync.RWMutex
 	listWorkersArgsForCall []struct {
@@ -258,6 +356,43 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RevokeCurrentSessionStub        func() error
+	revokeCurrentSessionMutex       sync.RWMutex
+	revokeCurrentSessionArgsForCall []struct {
+	}
+	revokeCurrentSessionReturns struct {
+		result1 error
+	}
+	revokeCurrentSessionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeSessionStub        func(int) (bool, error)
+	revokeSessionMutex       sync.RWMutex
+	revokeSessionArgsForCall []struct {
+		arg1 int
+	}
+	revokeSessionReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeSessionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeUserSessionsStub        func(string, string) (atc.RevokedSessions, error)
+	revokeUserSessionsMutex       sync.RWMutex
+	revokeUserSessionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	revokeUserSessionsReturns struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}
+	revokeUserSessionsReturnsOnCall map[int]struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}
 	SaveWorkerStub        func(atc.Worker, *time.Duration) (*atc.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -762,6 +897,245 @@ func (fake *FakeClient) CheckReturnsOnCall(i int, result1 atc.Check, result2 boo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) CreateWallMessage(arg1 atc.WallMessage) (atc.WallMessage, error) {
+	fake.createWallMessageMutex.Lock()
+	ret, specificReturn := fake.createWallMessageReturnsOnCall[len(fake.createWallMessageArgsForCall)]
+	fake.createWallMessageArgsForCall = append(fake.createWallMessageArgsForCall, struct {
+		arg1 atc.WallMessage
+	}{arg1})
+	fake.recordInvocation("CreateWallMessage", []interface{}{arg1})
+	fake.createWallMessageMutex.Unlock()
+	if fake.CreateWallMessageStub != nil {
+		return fake.CreateWallMessageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createWallMessageReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateWallMessageCallCount() int {
+	fake.createWallMessageMutex.RLock()
+	defer fake.createWallMessageMutex.RUnlock()
+	return len(fake.createWallMessageArgsForCall)
+}
+
+func (fake *FakeClient) CreateWallMessageCalls(stub func(atc.WallMessage) (atc.WallMessage, error)) {
+	fake.createWallMessageMutex.Lock()
+	defer fake.createWallMessageMutex.Unlock()
+	fake.CreateWallMessageStub = stub
+}
+
+func (fake *FakeClient) CreateWallMessageArgsForCall(i int) atc.WallMessage {
+	fake.createWallMessageMutex.RLock()
+	defer fake.createWallMessageMutex.RUnlock()
+	argsForCall := fake.createWallMessageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreateWallMessageReturns(result1 atc.WallMessage, result2 error) {
+	fake.createWallMessageMutex.Lock()
+	defer fake.createWallMessageMutex.Unlock()
+	fake.CreateWallMessageStub = nil
+	fake.createWallMessageReturns = struct {
+		result1 atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateWallMessageReturnsOnCall(i int, result1 atc.WallMessage, result2 error) {
+	fake.createWallMessageMutex.Lock()
+	defer fake.createWallMessageMutex.Unlock()
+	fake.CreateWallMessageStub = nil
+	if fake.createWallMessageReturnsOnCall == nil {
+		fake.createWallMessageReturnsOnCall = make(map[int]struct {
+			result1 atc.WallMessage
+			result2 error
+		})
+	}
+	fake.createWallMessageReturnsOnCall[i] = struct {
+		result1 atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteWallMessage(arg1 int) (bool, error) {
+	fake.deleteWallMessageMutex.Lock()
+	ret, specificReturn := fake.deleteWallMessageReturnsOnCall[len(fake.deleteWallMessageArgsForCall)]
+	fake.deleteWallMessageArgsForCall = append(fake.deleteWallMessageArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteWallMessage", []interface{}{arg1})
+	fake.deleteWallMessageMutex.Unlock()
+	if fake.DeleteWallMessageStub != nil {
+		return fake.DeleteWallMessageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWallMessageReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeleteWallMessageCallCount() int {
+	fake.deleteWallMessageMutex.RLock()
+	defer fake.deleteWallMessageMutex.RUnlock()
+	return len(fake.deleteWallMessageArgsForCall)
+}
+
+func (fake *FakeClient) DeleteWallMessageCalls(stub func(int) (bool, error)) {
+	fake.deleteWallMessageMutex.Lock()
+	defer fake.deleteWallMessageMutex.Unlock()
+	fake.DeleteWallMessageStub = stub
+}
+
+func (fake *FakeClient) DeleteWallMessageArgsForCall(i int) int {
+	fake.deleteWallMessageMutex.RLock()
+	defer fake.deleteWallMessageMutex.RUnlock()
+	argsForCall := fake.deleteWallMessageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DeleteWallMessageReturns(result1 bool, result2 error) {
+	fake.deleteWallMessageMutex.Lock()
+	defer fake.deleteWallMessageMutex.Unlock()
+	fake.DeleteWallMessageStub = nil
+	fake.deleteWallMessageReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteWallMessageReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteWallMessageMutex.Lock()
+	defer fake.deleteWallMessageMutex.Unlock()
+	fake.DeleteWallMessageStub = nil
+	if fake.deleteWallMessageReturnsOnCall == nil {
+		fake.deleteWallMessageReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteWallMessageReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DisableMaintenance() error {
+	fake.disableMaintenanceMutex.Lock()
+	ret, specificReturn := fake.disableMaintenanceReturnsOnCall[len(fake.disableMaintenanceArgsForCall)]
+	fake.disableMaintenanceArgsForCall = append(fake.disableMaintenanceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DisableMaintenance", []interface{}{})
+	fake.disableMaintenanceMutex.Unlock()
+	if fake.DisableMaintenanceStub != nil {
+		return fake.DisableMaintenanceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.disableMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DisableMaintenanceCallCount() int {
+	fake.disableMaintenanceMutex.RLock()
+	defer fake.disableMaintenanceMutex.RUnlock()
+	return len(fake.disableMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) DisableMaintenanceCalls(stub func() error) {
+	fake.disableMaintenanceMutex.Lock()
+	defer fake.disableMaintenanceMutex.Unlock()
+	fake.DisableMaintenanceStub = stub
+}
+
+func (fake *FakeClient) DisableMaintenanceReturns(result1 error) {
+	fake.disableMaintenanceMutex.Lock()
+	defer fake.disableMaintenanceMutex.Unlock()
+	fake.DisableMaintenanceStub = nil
+	fake.disableMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DisableMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.disableMaintenanceMutex.Lock()
+	defer fake.disableMaintenanceMutex.Unlock()
+	fake.DisableMaintenanceStub = nil
+	if fake.disableMaintenanceReturnsOnCall == nil {
+		fake.disableMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) EnableMaintenance(arg1 string, arg2 bool) error {
+	fake.enableMaintenanceMutex.Lock()
+	ret, specificReturn := fake.enableMaintenanceReturnsOnCall[len(fake.enableMaintenanceArgsForCall)]
+	fake.enableMaintenanceArgsForCall = append(fake.enableMaintenanceArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("EnableMaintenance", []interface{}{arg1, arg2})
+	fake.enableMaintenanceMutex.Unlock()
+	if fake.EnableMaintenanceStub != nil {
+		return fake.EnableMaintenanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.enableMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) EnableMaintenanceCallCount() int {
+	fake.enableMaintenanceMutex.RLock()
+	defer fake.enableMaintenanceMutex.RUnlock()
+	return len(fake.enableMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) EnableMaintenanceCalls(stub func(string, bool) error) {
+	fake.enableMaintenanceMutex.Lock()
+	defer fake.enableMaintenanceMutex.Unlock()
+	fake.EnableMaintenanceStub = stub
+}
+
+func (fake *FakeClient) EnableMaintenanceArgsForCall(i int) (string, bool) {
+	fake.enableMaintenanceMutex.RLock()
+	defer fake.enableMaintenanceMutex.RUnlock()
+	argsForCall := fake.enableMaintenanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) EnableMaintenanceReturns(result1 error) {
+	fake.enableMaintenanceMutex.Lock()
+	defer fake.enableMaintenanceMutex.Unlock()
+	fake.EnableMaintenanceStub = nil
+	fake.enableMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) EnableMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.enableMaintenanceMutex.Lock()
+	defer fake.enableMaintenanceMutex.Unlock()
+	fake.EnableMaintenanceStub = nil
+	if fake.enableMaintenanceReturnsOnCall == nil {
+		fake.enableMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enableMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -947,6 +1321,61 @@ func (fake *FakeClient) GetInfoReturnsOnCall(i int, result1 atc.Info, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeClient) GetMaintenance() (atc.Maintenance, error) {
+	fake.getMaintenanceMutex.Lock()
+	ret, specificReturn := fake.getMaintenanceReturnsOnCall[len(fake.getMaintenanceArgsForCall)]
+	fake.getMaintenanceArgsForCall = append(fake.getMaintenanceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetMaintenance", []interface{}{})
+	fake.getMaintenanceMutex.Unlock()
+	if fake.GetMaintenanceStub != nil {
+		return fake.GetMaintenanceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getMaintenanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetMaintenanceCallCount() int {
+	fake.getMaintenanceMutex.RLock()
+	defer fake.getMaintenanceMutex.RUnlock()
+	return len(fake.getMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) GetMaintenanceCalls(stub func() (atc.Maintenance, error)) {
+	fake.getMaintenanceMutex.Lock()
+	defer fake.getMaintenanceMutex.Unlock()
+	fake.GetMaintenanceStub = stub
+}
+
+func (fake *FakeClient) GetMaintenanceReturns(result1 atc.Maintenance, result2 error) {
+	fake.getMaintenanceMutex.Lock()
+	defer fake.getMaintenanceMutex.Unlock()
+	fake.GetMaintenanceStub = nil
+	fake.getMaintenanceReturns = struct {
+		result1 atc.Maintenance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetMaintenanceReturnsOnCall(i int, result1 atc.Maintenance, result2 error) {
+	fake.getMaintenanceMutex.Lock()
+	defer fake.getMaintenanceMutex.Unlock()
+	fake.GetMaintenanceStub = nil
+	if fake.getMaintenanceReturnsOnCall == nil {
+		fake.getMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 atc.Maintenance
+			result2 error
+		})
+	}
+	fake.getMaintenanceReturnsOnCall[i] = struct {
+		result1 atc.Maintenance
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) HTTPClient() *http.Client {
 	fake.hTTPClientMutex.Lock()
 	ret, specificReturn := fake.hTTPClientReturnsOnCall[len(fake.hTTPClientArgsForCall)]
@@ -1059,6 +1488,67 @@ func (fake *FakeClient) LandWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) LandWorkerBy(arg1 string, arg2 time.Duration) error {
+	fake.landWorkerByMutex.Lock()
+	ret, specificReturn := fake.landWorkerByReturnsOnCall[len(fake.landWorkerByArgsForCall)]
+	fake.landWorkerByArgsForCall = append(fake.landWorkerByArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("LandWorkerBy", []interface{}{arg1, arg2})
+	fake.landWorkerByMutex.Unlock()
+	if fake.LandWorkerByStub != nil {
+		return fake.LandWorkerByStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.landWorkerByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) LandWorkerByCallCount() int {
+	fake.landWorkerByMutex.RLock()
+	defer fake.landWorkerByMutex.RUnlock()
+	return len(fake.landWorkerByArgsForCall)
+}
+
+func (fake *FakeClient) LandWorkerByCalls(stub func(string, time.Duration) error) {
+	fake.landWorkerByMutex.Lock()
+	defer fake.landWorkerByMutex.Unlock()
+	fake.LandWorkerByStub = stub
+}
+
+func (fake *FakeClient) LandWorkerByArgsForCall(i int) (string, time.Duration) {
+	fake.landWorkerByMutex.RLock()
+	defer fake.landWorkerByMutex.RUnlock()
+	argsForCall := fake.landWorkerByArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) LandWorkerByReturns(result1 error) {
+	fake.landWorkerByMutex.Lock()
+	defer fake.landWorkerByMutex.Unlock()
+	fake.LandWorkerByStub = nil
+	fake.landWorkerByReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) LandWorkerByReturnsOnCall(i int, result1 error) {
+	fake.landWorkerByMutex.Lock()
+	defer fake.landWorkerByMutex.Unlock()
+	fake.LandWorkerByStub = nil
+	if fake.landWorkerByReturnsOnCall == nil {
+		fake.landWorkerByReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.landWorkerByReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) ListActiveUsersSince(arg1 time.Time) ([]atc.User, error) {
 	fake.listActiveUsersSinceMutex.Lock()
 	ret, specificReturn := fake.listActiveUsersSinceReturnsOnCall[len(fake.listActiveUsersSinceArgsForCall)]
@@ -1295,6 +1785,69 @@ func (fake *FakeClient) ListPipelinesReturnsOnCall(i int, result1 []atc.Pipeline
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListSessions(arg1 bool) ([]atc.Session, error) {
+	fake.listSessionsMutex.Lock()
+	ret, specificReturn := fake.listSessionsReturnsOnCall[len(fake.listSessionsArgsForCall)]
+	fake.listSessionsArgsForCall = append(fake.listSessionsArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("ListSessions", []interface{}{arg1})
+	fake.listSessionsMutex.Unlock()
+	if fake.ListSessionsStub != nil {
+		return fake.ListSessionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listSessionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListSessionsCallCount() int {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	return len(fake.listSessionsArgsForCall)
+}
+
+func (fake *FakeClient) ListSessionsCalls(stub func(bool) ([]atc.Session, error)) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = stub
+}
+
+func (fake *FakeClient) ListSessionsArgsForCall(i int) bool {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	argsForCall := fake.listSessionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListSessionsReturns(result1 []atc.Session, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	fake.listSessionsReturns = struct {
+		result1 []atc.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListSessionsReturnsOnCall(i int, result1 []atc.Session, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	if fake.listSessionsReturnsOnCall == nil {
+		fake.listSessionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Session
+			result2 error
+		})
+	}
+	fake.listSessionsReturnsOnCall[i] = struct {
+		result1 []atc.Session
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListTeams() ([]atc.Team, error) {
 	fake.listTeamsMutex.Lock()
 	ret, specificReturn := fake.listTeamsReturnsOnCall[len(fake.listTeamsArgsForCall)]
@@ -1350,6 +1903,69 @@ func (fake *FakeClient) ListTeamsReturnsOnCall(i int, result1 []atc.Team, result
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListWallMessages(arg1 bool) ([]atc.WallMessage, error) {
+	fake.listWallMessagesMutex.Lock()
+	ret, specificReturn := fake.listWallMessagesReturnsOnCall[len(fake.listWallMessagesArgsForCall)]
+	fake.listWallMessagesArgsForCall = append(fake.listWallMessagesArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("ListWallMessages", []interface{}{arg1})
+	fake.listWallMessagesMutex.Unlock()
+	if fake.ListWallMessagesStub != nil {
+		return fake.ListWallMessagesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listWallMessagesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListWallMessagesCallCount() int {
+	fake.listWallMessagesMutex.RLock()
+	defer fake.listWallMessagesMutex.RUnlock()
+	return len(fake.listWallMessagesArgsForCall)
+}
+
+func (fake *FakeClient) ListWallMessagesCalls(stub func(bool) ([]atc.WallMessage, error)) {
+	fake.listWallMessagesMutex.Lock()
+	defer fake.listWallMessagesMutex.Unlock()
+	fake.ListWallMessagesStub = stub
+}
+
+func (fake *FakeClient) ListWallMessagesArgsForCall(i int) bool {
+	fake.listWallMessagesMutex.RLock()
+	defer fake.listWallMessagesMutex.RUnlock()
+	argsForCall := fake.listWallMessagesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListWallMessagesReturns(result1 []atc.WallMessage, result2 error) {
+	fake.listWallMessagesMutex.Lock()
+	defer fake.listWallMessagesMutex.Unlock()
+	fake.ListWallMessagesStub = nil
+	fake.listWallMessagesReturns = struct {
+		result1 []atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListWallMessagesReturnsOnCall(i int, result1 []atc.WallMessage, result2 error) {
+	fake.listWallMessagesMutex.Lock()
+	defer fake.listWallMessagesMutex.Unlock()
+	fake.ListWallMessagesStub = nil
+	if fake.listWallMessagesReturnsOnCall == nil {
+		fake.listWallMessagesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WallMessage
+			result2 error
+		})
+	}
+	fake.listWallMessagesReturnsOnCall[i] = struct {
+		result1 []atc.WallMessage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListWorkers() ([]atc.Worker, error) {
 	fake.listWorkersMutex.Lock()
 	ret, specificReturn := fake.listWorkersReturnsOnCall[len(fake.listWorkersArgsForCall)]
@@ -1465,6 +2081,185 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) RevokeCurrentSession() error {
+	fake.revokeCurrentSessionMutex.Lock()
+	ret, specificReturn := fake.revokeCurrentSessionReturnsOnCall[len(fake.revokeCurrentSessionArgsForCall)]
+	fake.revokeCurrentSessionArgsForCall = append(fake.revokeCurrentSessionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RevokeCurrentSession", []interface{}{})
+	fake.revokeCurrentSessionMutex.Unlock()
+	if fake.RevokeCurrentSessionStub != nil {
+		return fake.RevokeCurrentSessionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.revokeCurrentSessionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RevokeCurrentSessionCallCount() int {
+	fake.revokeCurrentSessionMutex.RLock()
+	defer fake.revokeCurrentSessionMutex.RUnlock()
+	return len(fake.revokeCurrentSessionArgsForCall)
+}
+
+func (fake *FakeClient) RevokeCurrentSessionCalls(stub func() error) {
+	fake.revokeCurrentSessionMutex.Lock()
+	defer fake.revokeCurrentSessionMutex.Unlock()
+	fake.RevokeCurrentSessionStub = stub
+}
+
+func (fake *FakeClient) RevokeCurrentSessionReturns(result1 error) {
+	fake.revokeCurrentSessionMutex.Lock()
+	defer fake.revokeCurrentSessionMutex.Unlock()
+	fake.RevokeCurrentSessionStub = nil
+	fake.revokeCurrentSessionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RevokeCurrentSessionReturnsOnCall(i int, result1 error) {
+	fake.revokeCurrentSessionMutex.Lock()
+	defer fake.revokeCurrentSessionMutex.Unlock()
+	fake.RevokeCurrentSessionStub = nil
+	if fake.revokeCurrentSessionReturnsOnCall == nil {
+		fake.revokeCurrentSessionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeCurrentSessionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RevokeSession(arg1 int) (bool, error) {
+	fake.revokeSessionMutex.Lock()
+	ret, specificReturn := fake.revokeSessionReturnsOnCall[len(fake.revokeSessionArgsForCall)]
+	fake.revokeSessionArgsForCall = append(fake.revokeSessionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("RevokeSession", []interface{}{arg1})
+	fake.revokeSessionMutex.Unlock()
+	if fake.RevokeSessionStub != nil {
+		return fake.RevokeSessionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeSessionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeSessionCallCount() int {
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	return len(fake.revokeSessionArgsForCall)
+}
+
+func (fake *FakeClient) RevokeSessionCalls(stub func(int) (bool, error)) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = stub
+}
+
+func (fake *FakeClient) RevokeSessionArgsForCall(i int) int {
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	argsForCall := fake.revokeSessionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RevokeSessionReturns(result1 bool, result2 error) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = nil
+	fake.revokeSessionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeSessionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeSessionMutex.Lock()
+	defer fake.revokeSessionMutex.Unlock()
+	fake.RevokeSessionStub = nil
+	if fake.revokeSessionReturnsOnCall == nil {
+		fake.revokeSessionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeSessionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeUserSessions(arg1 string, arg2 string) (atc.RevokedSessions, error) {
+	fake.revokeUserSessionsMutex.Lock()
+	ret, specificReturn := fake.revokeUserSessionsReturnsOnCall[len(fake.revokeUserSessionsArgsForCall)]
+	fake.revokeUserSessionsArgsForCall = append(fake.revokeUserSessionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RevokeUserSessions", []interface{}{arg1, arg2})
+	fake.revokeUserSessionsMutex.Unlock()
+	if fake.RevokeUserSessionsStub != nil {
+		return fake.RevokeUserSessionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeUserSessionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeUserSessionsCallCount() int {
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
+	return len(fake.revokeUserSessionsArgsForCall)
+}
+
+func (fake *FakeClient) RevokeUserSessionsCalls(stub func(string, string) (atc.RevokedSessions, error)) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = stub
+}
+
+func (fake *FakeClient) RevokeUserSessionsArgsForCall(i int) (string, string) {
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
+	argsForCall := fake.revokeUserSessionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) RevokeUserSessionsReturns(result1 atc.RevokedSessions, result2 error) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = nil
+	fake.revokeUserSessionsReturns = struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeUserSessionsReturnsOnCall(i int, result1 atc.RevokedSessions, result2 error) {
+	fake.revokeUserSessionsMutex.Lock()
+	defer fake.revokeUserSessionsMutex.Unlock()
+	fake.RevokeUserSessionsStub = nil
+	if fake.revokeUserSessionsReturnsOnCall == nil {
+		fake.revokeUserSessionsReturnsOnCall = make(map[int]struct {
+			result1 atc.RevokedSessions
+			result2 error
+		})
+	}
+	fake.revokeUserSessionsReturnsOnCall[i] = struct {
+		result1 atc.RevokedSessions
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) SaveWorker(arg1 atc.Worker, arg2 *time.Duration) (*atc.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1713,16 +2508,28 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildsMutex.RUnlock()
 	fake.checkMutex.RLock()
 	defer fake.checkMutex.RUnlock()
+	fake.createWallMessageMutex.RLock()
+	defer fake.createWallMessageMutex.RUnlock()
+	fake.deleteWallMessageMutex.RLock()
+	defer fake.deleteWallMessageMutex.RUnlock()
+	fake.disableMaintenanceMutex.RLock()
+	defer fake.disableMaintenanceMutex.RUnlock()
+	fake.enableMaintenanceMutex.RLock()
+	defer fake.enableMaintenanceMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
 	defer fake.getInfoMutex.RUnlock()
+	fake.getMaintenanceMutex.RLock()
+	defer fake.getMaintenanceMutex.RUnlock()
 	fake.hTTPClientMutex.RLock()
 	defer fake.hTTPClientMutex.RUnlock()
 	fake.landWorkerMutex.RLock()
 	defer fake.landWorkerMutex.RUnlock()
+	fake.landWorkerByMutex.RLock()
+	defer fake.landWorkerByMutex.RUnlock()
 	fake.listActiveUsersSinceMutex.RLock()
 	defer fake.listActiveUsersSinceMutex.RUnlock()
 	fake.listAllJobsMutex.RLock()
@@ -1731,12 +2538,22 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listBuildArtifactsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
 	fake.listTeamsMutex.RLock()
 	defer fake.listTeamsMutex.RUnlock()
+	fake.listWallMessagesMutex.RLock()
+	defer fake.listWallMessagesMutex.RUnlock()
 	fake.listWorkersMutex.RLock()
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.revokeCurrentSessionMutex.RLock()
+	defer fake.revokeCurrentSessionMutex.RUnlock()
+	fake.revokeSessionMutex.RLock()
+	defer fake.revokeSessionMutex.RUnlock()
+	fake.revokeUserSessionsMutex.RLock()
+	defer fake.revokeUserSessionsMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.teamMutex.RLock()
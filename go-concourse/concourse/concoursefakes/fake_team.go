@@ -4,12 +4,25 @@ package concoursefakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type FakeTeam struct {
+	AllPipelineEventsStub        func() (concourse.PipelineEventStream, error)
+	allPipelineEventsMutex       sync.RWMutex
+	allPipelineEventsArgsForCall []struct {
+	}
+	allPipelineEventsReturns struct {
+		result1 concourse.PipelineEventStream
+		result2 error
+	}
+	allPipelineEventsReturnsOnCall map[int]struct {
+		result1 concourse.PipelineEventStream
+		result2 error
+	}
 	ArchivePipelineStub        func(string) (bool, error)
 	archivePipelineMutex       sync.RWMutex
 	archivePipelineArgsForCall []struct {
@@ -98,6 +111,20 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	CheckPipelineConfigCredsStub        func(string, []byte) (atc.CheckCredsResponse, error)
+	checkPipelineConfigCredsMutex       sync.RWMutex
+	checkPipelineConfigCredsArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+	}
+	checkPipelineConfigCredsReturns struct {
+		result1 atc.CheckCredsResponse
+		result2 error
+	}
+	checkPipelineConfigCredsReturnsOnCall map[int]struct {
+		result1 atc.CheckCredsResponse
+		result2 error
+	}
 	CheckResourceStub        func(string, string, atc.Version) (atc.Check, bool, error)
 	checkResourceMutex       sync.RWMutex
 	checkResourceArgsForCall []struct {
@@ -148,6 +175,19 @@ type FakeTeam struct {
 		result1 int64
 		result2 error
 	}
+	CreateAPITokenStub        func(atc.APITokenRequest) (atc.APIToken, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 atc.APITokenRequest
+	}
+	createAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
 	CreateArtifactStub        func(io.Reader, string) (atc.WorkerArtifact, error)
 	createArtifactMutex       sync.RWMutex
 	createArtifactArgsForCall []struct {
@@ -189,6 +229,19 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	CreateJobPlanStub        func(atc.JobPlanRequest) (atc.Plan, error)
+	createJobPlanMutex       sync.RWMutex
+	createJobPlanArgsForCall []struct {
+		arg1 atc.JobPlanRequest
+	}
+	createJobPlanReturns struct {
+		result1 atc.Plan
+		result2 error
+	}
+	createJobPlanReturnsOnCall map[int]struct {
+		result1 atc.Plan
+		result2 error
+	}
 	CreateOrUpdateStub        func(atc.Team) (atc.Team, bool, bool, error)
 	createOrUpdateMutex       sync.RWMutex
 	createOrUpdateArgsForCall []struct {
@@ -240,6 +293,19 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	DeleteAPITokenStub        func(string) (bool, error)
+	deleteAPITokenMutex       sync.RWMutex
+	deleteAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	deleteAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DeletePipelineStub        func(string) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
@@ -294,6 +360,31 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	ExplainAuthStub        func(atc.ExplainTeamAuthRequest) (atc.TeamAuthExplanation, error)
+	explainAuthMutex       sync.RWMutex
+	explainAuthArgsForCall []struct {
+		arg1 atc.ExplainTeamAuthRequest
+	}
+	explainAuthReturns struct {
+		result1 atc.TeamAuthExplanation
+		result2 error
+	}
+	explainAuthReturnsOnCall map[int]struct {
+		result1 atc.TeamAuthExplanation
+		result2 error
+	}
+	ExportStub        func() (atc.TeamExport, error)
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+	}
+	exportReturns struct {
+		result1 atc.TeamExport
+		result2 error
+	}
+	exportReturnsOnCall map[int]struct {
+		result1 atc.TeamExport
+		result2 error
+	}
 	ExposePipelineStub        func(string) (bool, error)
 	exposePipelineMutex       sync.RWMutex
 	exposePipelineArgsForCall []struct {
@@ -346,6 +437,20 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	ImportStub        func(atc.TeamExport, bool) (atc.TeamImportResponse, error)
+	importMutex       sync.RWMutex
+	importArgsForCall []struct {
+		arg1 atc.TeamExport
+		arg2 bool
+	}
+	importReturns struct {
+		result1 atc.TeamImportResponse
+		result2 error
+	}
+	importReturnsOnCall map[int]struct {
+		result1 atc.TeamImportResponse
+		result2 error
+	}
 	JobStub        func(string, string) (atc.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -398,6 +503,35 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	JobStatsStub        func(string, time.Time, time.Time) ([]atc.JobStats, bool, error)
+	jobStatsMutex       sync.RWMutex
+	jobStatsArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+		arg3 time.Time
+	}
+	jobStatsReturns struct {
+		result1 []atc.JobStats
+		result2 bool
+		result3 error
+	}
+	jobStatsReturnsOnCall map[int]struct {
+		result1 []atc.JobStats
+		result2 bool
+		result3 error
+	}
+	ListAPITokensStub        func() ([]atc.APIToken, error)
+	listAPITokensMutex       sync.RWMutex
+	listAPITokensArgsForCall []struct {
+	}
+	listAPITokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	listAPITokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
 	ListContainersStub        func(map[string]string) ([]atc.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
@@ -471,6 +605,22 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(string, int) ([]atc.NotificationDelivery, bool, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
 	OrderingPipelinesStub        func([]string) error
 	orderingPipelinesMutex       sync.RWMutex
 	orderingPipelinesArgsForCall []struct {
@@ -574,6 +724,19 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineEventsStub        func(string) (concourse.PipelineEventStream, error)
+	pipelineEventsMutex       sync.RWMutex
+	pipelineEventsArgsForCall []struct {
+		arg1 string
+	}
+	pipelineEventsReturns struct {
+		result1 concourse.PipelineEventStream
+		result2 error
+	}
+	pipelineEventsReturnsOnCall map[int]struct {
+		result1 concourse.PipelineEventStream
+		result2 error
+	}
 	RenamePipelineStub        func(string, string) (bool, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -682,6 +845,35 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	StreamInContainerStub        func(string, string, string, io.Reader) error
+	streamInContainerMutex       sync.RWMutex
+	streamInContainerArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 io.Reader
+	}
+	streamInContainerReturns struct {
+		result1 error
+	}
+	streamInContainerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StreamOutContainerStub        func(string, string, string) (io.ReadCloser, error)
+	streamOutContainerMutex       sync.RWMutex
+	streamOutContainerArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	streamOutContainerReturns struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	streamOutContainerReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 error
+	}
 	UnpauseJobStub        func(string, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -742,6 +934,61 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) AllPipelineEvents() (concourse.PipelineEventStream, error) {
+	fake.allPipelineEventsMutex.Lock()
+	ret, specificReturn := fake.allPipelineEventsReturnsOnCall[len(fake.allPipelineEventsArgsForCall)]
+	fake.allPipelineEventsArgsForCall = append(fake.allPipelineEventsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AllPipelineEvents", []interface{}{})
+	fake.allPipelineEventsMutex.Unlock()
+	if fake.AllPipelineEventsStub != nil {
+		return fake.AllPipelineEventsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.allPipelineEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) AllPipelineEventsCallCount() int {
+	fake.allPipelineEventsMutex.RLock()
+	defer fake.allPipelineEventsMutex.RUnlock()
+	return len(fake.allPipelineEventsArgsForCall)
+}
+
+func (fake *FakeTeam) AllPipelineEventsCalls(stub func() (concourse.PipelineEventStream, error)) {
+	fake.allPipelineEventsMutex.Lock()
+	defer fake.allPipelineEventsMutex.Unlock()
+	fake.AllPipelineEventsStub = stub
+}
+
+func (fake *FakeTeam) AllPipelineEventsReturns(result1 concourse.PipelineEventStream, result2 error) {
+	fake.allPipelineEventsMutex.Lock()
+	defer fake.allPipelineEventsMutex.Unlock()
+	fake.AllPipelineEventsStub = nil
+	fake.allPipelineEventsReturns = struct {
+		result1 concourse.PipelineEventStream
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) AllPipelineEventsReturnsOnCall(i int, result1 concourse.PipelineEventStream, result2 error) {
+	fake.allPipelineEventsMutex.Lock()
+	defer fake.allPipelineEventsMutex.Unlock()
+	fake.AllPipelineEventsStub = nil
+	if fake.allPipelineEventsReturnsOnCall == nil {
+		fake.allPipelineEventsReturnsOnCall = make(map[int]struct {
+			result1 concourse.PipelineEventStream
+			result2 error
+		})
+	}
+	fake.allPipelineEventsReturnsOnCall[i] = struct {
+		result1 concourse.PipelineEventStream
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ArchivePipeline(arg1 string) (bool, error) {
 	fake.archivePipelineMutex.Lock()
 	ret, specificReturn := fake.archivePipelineReturnsOnCall[len(fake.archivePipelineArgsForCall)]
@@ -1126,6 +1373,75 @@ func (fake *FakeTeam) BuildsWithVersionAsOutputReturnsOnCall(i int, result1 []at
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) CheckPipelineConfigCreds(arg1 string, arg2 []byte) (atc.CheckCredsResponse, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.checkPipelineConfigCredsMutex.Lock()
+	ret, specificReturn := fake.checkPipelineConfigCredsReturnsOnCall[len(fake.checkPipelineConfigCredsArgsForCall)]
+	fake.checkPipelineConfigCredsArgsForCall = append(fake.checkPipelineConfigCredsArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+	}{arg1, arg2Copy})
+	fake.recordInvocation("CheckPipelineConfigCreds", []interface{}{arg1, arg2Copy})
+	fake.checkPipelineConfigCredsMutex.Unlock()
+	if fake.CheckPipelineConfigCredsStub != nil {
+		return fake.CheckPipelineConfigCredsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkPipelineConfigCredsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CheckPipelineConfigCredsCallCount() int {
+	fake.checkPipelineConfigCredsMutex.RLock()
+	defer fake.checkPipelineConfigCredsMutex.RUnlock()
+	return len(fake.checkPipelineConfigCredsArgsForCall)
+}
+
+func (fake *FakeTeam) CheckPipelineConfigCredsCalls(stub func(string, []byte) (atc.CheckCredsResponse, error)) {
+	fake.checkPipelineConfigCredsMutex.Lock()
+	defer fake.checkPipelineConfigCredsMutex.Unlock()
+	fake.CheckPipelineConfigCredsStub = stub
+}
+
+func (fake *FakeTeam) CheckPipelineConfigCredsArgsForCall(i int) (string, []byte) {
+	fake.checkPipelineConfigCredsMutex.RLock()
+	defer fake.checkPipelineConfigCredsMutex.RUnlock()
+	argsForCall := fake.checkPipelineConfigCredsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) CheckPipelineConfigCredsReturns(result1 atc.CheckCredsResponse, result2 error) {
+	fake.checkPipelineConfigCredsMutex.Lock()
+	defer fake.checkPipelineConfigCredsMutex.Unlock()
+	fake.CheckPipelineConfigCredsStub = nil
+	fake.checkPipelineConfigCredsReturns = struct {
+		result1 atc.CheckCredsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CheckPipelineConfigCredsReturnsOnCall(i int, result1 atc.CheckCredsResponse, result2 error) {
+	fake.checkPipelineConfigCredsMutex.Lock()
+	defer fake.checkPipelineConfigCredsMutex.Unlock()
+	fake.CheckPipelineConfigCredsStub = nil
+	if fake.checkPipelineConfigCredsReturnsOnCall == nil {
+		fake.checkPipelineConfigCredsReturnsOnCall = make(map[int]struct {
+			result1 atc.CheckCredsResponse
+			result2 error
+		})
+	}
+	fake.checkPipelineConfigCredsReturnsOnCall[i] = struct {
+		result1 atc.CheckCredsResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CheckResource(arg1 string, arg2 string, arg3 atc.Version) (atc.Check, bool, error) {
 	fake.checkResourceMutex.Lock()
 	ret, specificReturn := fake.checkResourceReturnsOnCall[len(fake.checkResourceArgsForCall)]
@@ -1328,6 +1644,69 @@ func (fake *FakeTeam) ClearTaskCacheReturnsOnCall(i int, result1 int64, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateAPIToken(arg1 atc.APITokenRequest) (atc.APIToken, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 atc.APITokenRequest
+	}{arg1})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateAPITokenCalls(stub func(atc.APITokenRequest) (atc.APIToken, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeTeam) CreateAPITokenArgsForCall(i int) atc.APITokenRequest {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateAPITokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateArtifact(arg1 io.Reader, arg2 string) (atc.WorkerArtifact, error) {
 	fake.createArtifactMutex.Lock()
 	ret, specificReturn := fake.createArtifactReturnsOnCall[len(fake.createArtifactArgsForCall)]
@@ -1519,6 +1898,69 @@ func (fake *FakeTeam) CreateJobBuildReturnsOnCall(i int, result1 atc.Build, resu
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateJobPlan(arg1 atc.JobPlanRequest) (atc.Plan, error) {
+	fake.createJobPlanMutex.Lock()
+	ret, specificReturn := fake.createJobPlanReturnsOnCall[len(fake.createJobPlanArgsForCall)]
+	fake.createJobPlanArgsForCall = append(fake.createJobPlanArgsForCall, struct {
+		arg1 atc.JobPlanRequest
+	}{arg1})
+	fake.recordInvocation("CreateJobPlan", []interface{}{arg1})
+	fake.createJobPlanMutex.Unlock()
+	if fake.CreateJobPlanStub != nil {
+		return fake.CreateJobPlanStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createJobPlanReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateJobPlanCallCount() int {
+	fake.createJobPlanMutex.RLock()
+	defer fake.createJobPlanMutex.RUnlock()
+	return len(fake.createJobPlanArgsForCall)
+}
+
+func (fake *FakeTeam) CreateJobPlanCalls(stub func(atc.JobPlanRequest) (atc.Plan, error)) {
+	fake.createJobPlanMutex.Lock()
+	defer fake.createJobPlanMutex.Unlock()
+	fake.CreateJobPlanStub = stub
+}
+
+func (fake *FakeTeam) CreateJobPlanArgsForCall(i int) atc.JobPlanRequest {
+	fake.createJobPlanMutex.RLock()
+	defer fake.createJobPlanMutex.RUnlock()
+	argsForCall := fake.createJobPlanArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateJobPlanReturns(result1 atc.Plan, result2 error) {
+	fake.createJobPlanMutex.Lock()
+	defer fake.createJobPlanMutex.Unlock()
+	fake.CreateJobPlanStub = nil
+	fake.createJobPlanReturns = struct {
+		result1 atc.Plan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateJobPlanReturnsOnCall(i int, result1 atc.Plan, result2 error) {
+	fake.createJobPlanMutex.Lock()
+	defer fake.createJobPlanMutex.Unlock()
+	fake.CreateJobPlanStub = nil
+	if fake.createJobPlanReturnsOnCall == nil {
+		fake.createJobPlanReturnsOnCall = make(map[int]struct {
+			result1 atc.Plan
+			result2 error
+		})
+	}
+	fake.createJobPlanReturnsOnCall[i] = struct {
+		result1 atc.Plan
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateOrUpdate(arg1 atc.Team) (atc.Team, bool, bool, error) {
 	fake.createOrUpdateMutex.Lock()
 	ret, specificReturn := fake.createOrUpdateReturnsOnCall[len(fake.createOrUpdateArgsForCall)]
@@ -1729,6 +2171,69 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DeleteAPIToken(arg1 string) (bool, error) {
+	fake.deleteAPITokenMutex.Lock()
+	ret, specificReturn := fake.deleteAPITokenReturnsOnCall[len(fake.deleteAPITokenArgsForCall)]
+	fake.deleteAPITokenArgsForCall = append(fake.deleteAPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteAPIToken", []interface{}{arg1})
+	fake.deleteAPITokenMutex.Unlock()
+	if fake.DeleteAPITokenStub != nil {
+		return fake.DeleteAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteAPITokenCallCount() int {
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
+	return len(fake.deleteAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteAPITokenCalls(stub func(string) (bool, error)) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = stub
+}
+
+func (fake *FakeTeam) DeleteAPITokenArgsForCall(i int) string {
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
+	argsForCall := fake.deleteAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteAPITokenReturns(result1 bool, result2 error) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = nil
+	fake.deleteAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteAPITokenMutex.Lock()
+	defer fake.deleteAPITokenMutex.Unlock()
+	fake.DeleteAPITokenStub = nil
+	if fake.deleteAPITokenReturnsOnCall == nil {
+		fake.deleteAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DeletePipeline(arg1 string) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
@@ -1982,6 +2487,124 @@ func (fake *FakeTeam) EnableResourceVersionReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ExplainAuth(arg1 atc.ExplainTeamAuthRequest) (atc.TeamAuthExplanation, error) {
+	fake.explainAuthMutex.Lock()
+	ret, specificReturn := fake.explainAuthReturnsOnCall[len(fake.explainAuthArgsForCall)]
+	fake.explainAuthArgsForCall = append(fake.explainAuthArgsForCall, struct {
+		arg1 atc.ExplainTeamAuthRequest
+	}{arg1})
+	fake.recordInvocation("ExplainAuth", []interface{}{arg1})
+	fake.explainAuthMutex.Unlock()
+	if fake.ExplainAuthStub != nil {
+		return fake.ExplainAuthStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.explainAuthReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ExplainAuthCallCount() int {
+	fake.explainAuthMutex.RLock()
+	defer fake.explainAuthMutex.RUnlock()
+	return len(fake.explainAuthArgsForCall)
+}
+
+func (fake *FakeTeam) ExplainAuthCalls(stub func(atc.ExplainTeamAuthRequest) (atc.TeamAuthExplanation, error)) {
+	fake.explainAuthMutex.Lock()
+	defer fake.explainAuthMutex.Unlock()
+	fake.ExplainAuthStub = stub
+}
+
+func (fake *FakeTeam) ExplainAuthArgsForCall(i int) atc.ExplainTeamAuthRequest {
+	fake.explainAuthMutex.RLock()
+	defer fake.explainAuthMutex.RUnlock()
+	argsForCall := fake.explainAuthArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ExplainAuthReturns(result1 atc.TeamAuthExplanation, result2 error) {
+	fake.explainAuthMutex.Lock()
+	defer fake.explainAuthMutex.Unlock()
+	fake.ExplainAuthStub = nil
+	fake.explainAuthReturns = struct {
+		result1 atc.TeamAuthExplanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ExplainAuthReturnsOnCall(i int, result1 atc.TeamAuthExplanation, result2 error) {
+	fake.explainAuthMutex.Lock()
+	defer fake.explainAuthMutex.Unlock()
+	fake.ExplainAuthStub = nil
+	if fake.explainAuthReturnsOnCall == nil {
+		fake.explainAuthReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamAuthExplanation
+			result2 error
+		})
+	}
+	fake.explainAuthReturnsOnCall[i] = struct {
+		result1 atc.TeamAuthExplanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) Export() (atc.TeamExport, error) {
+	fake.exportMutex.Lock()
+	ret, specificReturn := fake.exportReturnsOnCall[len(fake.exportArgsForCall)]
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Export", []interface{}{})
+	fake.exportMutex.Unlock()
+	if fake.ExportStub != nil {
+		return fake.ExportStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.exportReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeTeam) ExportCalls(stub func() (atc.TeamExport, error)) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = stub
+}
+
+func (fake *FakeTeam) ExportReturns(result1 atc.TeamExport, result2 error) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = nil
+	fake.exportReturns = struct {
+		result1 atc.TeamExport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ExportReturnsOnCall(i int, result1 atc.TeamExport, result2 error) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.ExportStub = nil
+	if fake.exportReturnsOnCall == nil {
+		fake.exportReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamExport
+			result2 error
+		})
+	}
+	fake.exportReturnsOnCall[i] = struct {
+		result1 atc.TeamExport
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ExposePipeline(arg1 string) (bool, error) {
 	fake.exposePipelineMutex.Lock()
 	ret, specificReturn := fake.exposePipelineReturnsOnCall[len(fake.exposePipelineArgsForCall)]
@@ -2234,6 +2857,70 @@ func (fake *FakeTeam) HidePipelineReturnsOnCall(i int, result1 bool, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Import(arg1 atc.TeamExport, arg2 bool) (atc.TeamImportResponse, error) {
+	fake.importMutex.Lock()
+	ret, specificReturn := fake.importReturnsOnCall[len(fake.importArgsForCall)]
+	fake.importArgsForCall = append(fake.importArgsForCall, struct {
+		arg1 atc.TeamExport
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("Import", []interface{}{arg1, arg2})
+	fake.importMutex.Unlock()
+	if fake.ImportStub != nil {
+		return fake.ImportStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.importReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ImportCallCount() int {
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	return len(fake.importArgsForCall)
+}
+
+func (fake *FakeTeam) ImportCalls(stub func(atc.TeamExport, bool) (atc.TeamImportResponse, error)) {
+	fake.importMutex.Lock()
+	defer fake.importMutex.Unlock()
+	fake.ImportStub = stub
+}
+
+func (fake *FakeTeam) ImportArgsForCall(i int) (atc.TeamExport, bool) {
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	argsForCall := fake.importArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ImportReturns(result1 atc.TeamImportResponse, result2 error) {
+	fake.importMutex.Lock()
+	defer fake.importMutex.Unlock()
+	fake.ImportStub = nil
+	fake.importReturns = struct {
+		result1 atc.TeamImportResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ImportReturnsOnCall(i int, result1 atc.TeamImportResponse, result2 error) {
+	fake.importMutex.Lock()
+	defer fake.importMutex.Unlock()
+	fake.ImportStub = nil
+	if fake.importReturnsOnCall == nil {
+		fake.importReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamImportResponse
+			result2 error
+		})
+	}
+	fake.importReturnsOnCall[i] = struct {
+		result1 atc.TeamImportResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Job(arg1 string, arg2 string) (atc.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -2440,6 +3127,129 @@ func (fake *FakeTeam) JobBuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) JobStats(arg1 string, arg2 time.Time, arg3 time.Time) ([]atc.JobStats, bool, error) {
+	fake.jobStatsMutex.Lock()
+	ret, specificReturn := fake.jobStatsReturnsOnCall[len(fake.jobStatsArgsForCall)]
+	fake.jobStatsArgsForCall = append(fake.jobStatsArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("JobStats", []interface{}{arg1, arg2, arg3})
+	fake.jobStatsMutex.Unlock()
+	if fake.JobStatsStub != nil {
+		return fake.JobStatsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.jobStatsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobStatsCallCount() int {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	return len(fake.jobStatsArgsForCall)
+}
+
+func (fake *FakeTeam) JobStatsCalls(stub func(string, time.Time, time.Time) ([]atc.JobStats, bool, error)) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = stub
+}
+
+func (fake *FakeTeam) JobStatsArgsForCall(i int) (string, time.Time, time.Time) {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	argsForCall := fake.jobStatsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) JobStatsReturns(result1 []atc.JobStats, result2 bool, result3 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	fake.jobStatsReturns = struct {
+		result1 []atc.JobStats
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobStatsReturnsOnCall(i int, result1 []atc.JobStats, result2 bool, result3 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	if fake.jobStatsReturnsOnCall == nil {
+		fake.jobStatsReturnsOnCall = make(map[int]struct {
+			result1 []atc.JobStats
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobStatsReturnsOnCall[i] = struct {
+		result1 []atc.JobStats
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ListAPITokens() ([]atc.APIToken, error) {
+	fake.listAPITokensMutex.Lock()
+	ret, specificReturn := fake.listAPITokensReturnsOnCall[len(fake.listAPITokensArgsForCall)]
+	fake.listAPITokensArgsForCall = append(fake.listAPITokensArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListAPITokens", []interface{}{})
+	fake.listAPITokensMutex.Unlock()
+	if fake.ListAPITokensStub != nil {
+		return fake.ListAPITokensStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listAPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListAPITokensCallCount() int {
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
+	return len(fake.listAPITokensArgsForCall)
+}
+
+func (fake *FakeTeam) ListAPITokensCalls(stub func() ([]atc.APIToken, error)) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = stub
+}
+
+func (fake *FakeTeam) ListAPITokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = nil
+	fake.listAPITokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListAPITokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = nil
+	if fake.listAPITokensReturnsOnCall == nil {
+		fake.listAPITokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.listAPITokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListContainers(arg1 map[string]string) ([]atc.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
@@ -2791,6 +3601,73 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTeam) NotificationDeliveries(arg1 string, arg2 int) ([]atc.NotificationDelivery, bool, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1, arg2})
+	fake.notificationDeliveriesMutex.Unlock()
+	if fake.NotificationDeliveriesStub != nil {
+		return fake.NotificationDeliveriesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.notificationDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCalls(stub func(string, int) ([]atc.NotificationDelivery, bool, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) NotificationDeliveriesArgsForCall(i int) (string, int) {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturns(result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) OrderingPipelines(arg1 []string) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -3253,6 +4130,69 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineEvents(arg1 string) (concourse.PipelineEventStream, error) {
+	fake.pipelineEventsMutex.Lock()
+	ret, specificReturn := fake.pipelineEventsReturnsOnCall[len(fake.pipelineEventsArgsForCall)]
+	fake.pipelineEventsArgsForCall = append(fake.pipelineEventsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PipelineEvents", []interface{}{arg1})
+	fake.pipelineEventsMutex.Unlock()
+	if fake.PipelineEventsStub != nil {
+		return fake.PipelineEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pipelineEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PipelineEventsCallCount() int {
+	fake.pipelineEventsMutex.RLock()
+	defer fake.pipelineEventsMutex.RUnlock()
+	return len(fake.pipelineEventsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineEventsCalls(stub func(string) (concourse.PipelineEventStream, error)) {
+	fake.pipelineEventsMutex.Lock()
+	defer fake.pipelineEventsMutex.Unlock()
+	fake.PipelineEventsStub = stub
+}
+
+func (fake *FakeTeam) PipelineEventsArgsForCall(i int) string {
+	fake.pipelineEventsMutex.RLock()
+	defer fake.pipelineEventsMutex.RUnlock()
+	argsForCall := fake.pipelineEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineEventsReturns(result1 concourse.PipelineEventStream, result2 error) {
+	fake.pipelineEventsMutex.Lock()
+	defer fake.pipelineEventsMutex.Unlock()
+	fake.PipelineEventsStub = nil
+	fake.pipelineEventsReturns = struct {
+		result1 concourse.PipelineEventStream
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PipelineEventsReturnsOnCall(i int, result1 concourse.PipelineEventStream, result2 error) {
+	fake.pipelineEventsMutex.Lock()
+	defer fake.pipelineEventsMutex.Unlock()
+	fake.PipelineEventsStub = nil
+	if fake.pipelineEventsReturnsOnCall == nil {
+		fake.pipelineEventsReturnsOnCall = make(map[int]struct {
+			result1 concourse.PipelineEventStream
+			result2 error
+		})
+	}
+	fake.pipelineEventsReturnsOnCall[i] = struct {
+		result1 concourse.PipelineEventStream
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -3714,6 +4654,134 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) StreamInContainer(arg1 string, arg2 string, arg3 string, arg4 io.Reader) error {
+	fake.streamInContainerMutex.Lock()
+	ret, specificReturn := fake.streamInContainerReturnsOnCall[len(fake.streamInContainerArgsForCall)]
+	fake.streamInContainerArgsForCall = append(fake.streamInContainerArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 io.Reader
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("StreamInContainer", []interface{}{arg1, arg2, arg3, arg4})
+	fake.streamInContainerMutex.Unlock()
+	if fake.StreamInContainerStub != nil {
+		return fake.StreamInContainerStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.streamInContainerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) StreamInContainerCallCount() int {
+	fake.streamInContainerMutex.RLock()
+	defer fake.streamInContainerMutex.RUnlock()
+	return len(fake.streamInContainerArgsForCall)
+}
+
+func (fake *FakeTeam) StreamInContainerCalls(stub func(string, string, string, io.Reader) error) {
+	fake.streamInContainerMutex.Lock()
+	defer fake.streamInContainerMutex.Unlock()
+	fake.StreamInContainerStub = stub
+}
+
+func (fake *FakeTeam) StreamInContainerArgsForCall(i int) (string, string, string, io.Reader) {
+	fake.streamInContainerMutex.RLock()
+	defer fake.streamInContainerMutex.RUnlock()
+	argsForCall := fake.streamInContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) StreamInContainerReturns(result1 error) {
+	fake.streamInContainerMutex.Lock()
+	defer fake.streamInContainerMutex.Unlock()
+	fake.StreamInContainerStub = nil
+	fake.streamInContainerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) StreamInContainerReturnsOnCall(i int, result1 error) {
+	fake.streamInContainerMutex.Lock()
+	defer fake.streamInContainerMutex.Unlock()
+	fake.StreamInContainerStub = nil
+	if fake.streamInContainerReturnsOnCall == nil {
+		fake.streamInContainerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.streamInContainerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) StreamOutContainer(arg1 string, arg2 string, arg3 string) (io.ReadCloser, error) {
+	fake.streamOutContainerMutex.Lock()
+	ret, specificReturn := fake.streamOutContainerReturnsOnCall[len(fake.streamOutContainerArgsForCall)]
+	fake.streamOutContainerArgsForCall = append(fake.streamOutContainerArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("StreamOutContainer", []interface{}{arg1, arg2, arg3})
+	fake.streamOutContainerMutex.Unlock()
+	if fake.StreamOutContainerStub != nil {
+		return fake.StreamOutContainerStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.streamOutContainerReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) StreamOutContainerCallCount() int {
+	fake.streamOutContainerMutex.RLock()
+	defer fake.streamOutContainerMutex.RUnlock()
+	return len(fake.streamOutContainerArgsForCall)
+}
+
+func (fake *FakeTeam) StreamOutContainerCalls(stub func(string, string, string) (io.ReadCloser, error)) {
+	fake.streamOutContainerMutex.Lock()
+	defer fake.streamOutContainerMutex.Unlock()
+	fake.StreamOutContainerStub = stub
+}
+
+func (fake *FakeTeam) StreamOutContainerArgsForCall(i int) (string, string, string) {
+	fake.streamOutContainerMutex.RLock()
+	defer fake.streamOutContainerMutex.RUnlock()
+	argsForCall := fake.streamOutContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) StreamOutContainerReturns(result1 io.ReadCloser, result2 error) {
+	fake.streamOutContainerMutex.Lock()
+	defer fake.streamOutContainerMutex.Unlock()
+	fake.StreamOutContainerStub = nil
+	fake.streamOutContainerReturns = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) StreamOutContainerReturnsOnCall(i int, result1 io.ReadCloser, result2 error) {
+	fake.streamOutContainerMutex.Lock()
+	defer fake.streamOutContainerMutex.Unlock()
+	fake.StreamOutContainerStub = nil
+	if fake.streamOutContainerReturnsOnCall == nil {
+		fake.streamOutContainerReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 error
+		})
+	}
+	fake.streamOutContainerReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 string, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -3974,6 +5042,8 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.allPipelineEventsMutex.RLock()
+	defer fake.allPipelineEventsMutex.RUnlock()
 	fake.archivePipelineMutex.RLock()
 	defer fake.archivePipelineMutex.RUnlock()
 	fake.authMutex.RLock()
@@ -3986,24 +5056,32 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.buildsWithVersionAsInputMutex.RUnlock()
 	fake.buildsWithVersionAsOutputMutex.RLock()
 	defer fake.buildsWithVersionAsOutputMutex.RUnlock()
+	fake.checkPipelineConfigCredsMutex.RLock()
+	defer fake.checkPipelineConfigCredsMutex.RUnlock()
 	fake.checkResourceMutex.RLock()
 	defer fake.checkResourceMutex.RUnlock()
 	fake.checkResourceTypeMutex.RLock()
 	defer fake.checkResourceTypeMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
 	fake.createArtifactMutex.RLock()
 	defer fake.createArtifactMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
 	fake.createJobBuildMutex.RLock()
 	defer fake.createJobBuildMutex.RUnlock()
+	fake.createJobPlanMutex.RLock()
+	defer fake.createJobPlanMutex.RUnlock()
 	fake.createOrUpdateMutex.RLock()
 	defer fake.createOrUpdateMutex.RUnlock()
 	fake.createOrUpdatePipelineConfigMutex.RLock()
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
+	fake.deleteAPITokenMutex.RLock()
+	defer fake.deleteAPITokenMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
@@ -4012,6 +5090,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.disableResourceVersionMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
 	defer fake.enableResourceVersionMutex.RUnlock()
+	fake.explainAuthMutex.RLock()
+	defer fake.explainAuthMutex.RUnlock()
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
 	fake.getArtifactMutex.RLock()
@@ -4020,12 +5102,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.getContainerMutex.RUnlock()
 	fake.hidePipelineMutex.RLock()
 	defer fake.hidePipelineMutex.RUnlock()
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobBuildMutex.RLock()
 	defer fake.jobBuildMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
@@ -4038,6 +5126,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listVolumesMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
 	fake.orderingPipelinesMutex.RLock()
 	defer fake.orderingPipelinesMutex.RUnlock()
 	fake.pauseJobMutex.RLock()
@@ -4052,6 +5142,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineEventsMutex.RLock()
+	defer fake.pipelineEventsMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -4066,6 +5158,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.scheduleJobMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.streamInContainerMutex.RLock()
+	defer fake.streamInContainerMutex.RUnlock()
+	fake.streamOutContainerMutex.RLock()
+	defer fake.streamOutContainerMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
@@ -66,7 +66,10 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 		queryParams.Add(atc.SaveConfigCheckCreds, "")
 	}
 
-	response := internal.Response{}
+	responseHeaders := http.Header{}
+	response := internal.Response{
+		Headers: &responseHeaders,
+	}
 
 	err := team.connection.Send(internal.Request{
 		ReturnResponseBody: true,
@@ -117,5 +120,61 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 		return false, false, []ConfigWarning{}, err
 	}
 
-	return response.Created, !response.Created, configResponse.Warnings, nil
+	warnings := append(configResponse.Warnings, policyCheckWarnings(responseHeaders)...)
+
+	return response.Created, !response.Created, warnings, nil
+}
+
+// policyCheckWarnings returns the reasons of a policy check that only warned
+// about the request.
+func policyCheckWarnings(header http.Header) []ConfigWarning {
+	result, found := internal.PolicyCheckResult(header)
+	if !found {
+		return nil
+	}
+
+	warnings := []ConfigWarning{}
+	for _, reason := range result.Reasons {
+		warnings = append(warnings, ConfigWarning{
+			Type:    "policy",
+			Message: reason,
+		})
+	}
+
+	return warnings
+}
+
+func (team *team) CheckPipelineConfigCreds(pipelineName string, passedConfig []byte) (atc.CheckCredsResponse, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	var checkResponse atc.CheckCredsResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.CheckConfigCreds,
+		Params:      params,
+		Body:        bytes.NewBuffer(passedConfig),
+		Header: http.Header{
+			"Content-Type": {"application/x-yaml"},
+		},
+	}, &internal.Response{
+		Result: &checkResponse,
+	})
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+			var validationErr atc.SaveConfigResponse
+			err = json.Unmarshal([]byte(unexpectedResponseError.Body), &validationErr)
+			if err != nil {
+				return atc.CheckCredsResponse{}, err
+			}
+
+			return atc.CheckCredsResponse{}, InvalidConfigError{
+				Errors: validationErr.Errors,
+			}
+		}
+	}
+
+	return checkResponse, err
 }
@@ -148,8 +148,9 @@ var _ = Describe("ATC Handler Configs", func() {
 			expectedVersion      string
 			expectedConfig       []byte
 
-			returnHeader int
-			returnBody   []byte
+			returnHeader      int
+			returnBody        []byte
+			returnPolicyCheck string
 
 			checkCredentials bool
 		)
@@ -162,6 +163,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			expectedPath := "/api/v1/teams/some-team/pipelines/mypipeline/config"
 
 			checkCredentials = false
+			returnPolicyCheck = ""
 
 			atcServer.RouteToHandler("PUT", expectedPath,
 				ghttp.CombineHandlers(
@@ -178,6 +180,10 @@ var _ = Describe("ATC Handler Configs", func() {
 
 						Expect(receivedConfig).To(Equal(expectedConfig))
 
+						if returnPolicyCheck != "" {
+							w.Header().Set(atc.PolicyCheckHeader, returnPolicyCheck)
+						}
+
 						w.WriteHeader(returnHeader)
 						w.Write(returnBody)
 					},
@@ -293,6 +299,43 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 		})
 
+		Context("when the policy check warns about the config", func() {
+			BeforeEach(func() {
+				returnHeader = http.StatusOK
+				returnBody = []byte(`{"warnings":[{"type": "warning-1-type", "message": "fake-warning1"}]}`)
+				returnPolicyCheck = `{"allowed":false,"reasons":["some-reason"],"severity":"warn"}`
+			})
+
+			It("returns the reasons as warnings", func() {
+				_, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated).To(BeTrue())
+				Expect(warnings).To(ConsistOf([]concourse.ConfigWarning{
+					{
+						Type:    "warning-1-type",
+						Message: "fake-warning1",
+					},
+					{
+						Type:    "policy",
+						Message: "some-reason",
+					},
+				}))
+			})
+		})
+
+		Context("when the policy check rejects the config", func() {
+			BeforeEach(func() {
+				returnHeader = http.StatusForbidden
+				returnBody = []byte(`policy check not pass: some-reason`)
+				returnPolicyCheck = `{"allowed":false,"reasons":["some-reason"],"severity":"block"}`
+			})
+
+			It("returns a policy check error", func() {
+				_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				Expect(err).To(Equal(concourse.PolicyCheckError{Reasons: []string{"some-reason"}}))
+			})
+		})
+
 		Context("when setting config returns bad request", func() {
 			BeforeEach(func() {
 				returnHeader = http.StatusBadRequest
@@ -1,6 +1,8 @@
 package concourse_test
 
 import (
+	"bytes"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/concourse/concourse/atc"
@@ -108,4 +110,59 @@ var _ = Describe("ATC Handler Containers", func() {
 			})
 		})
 	})
+
+	Describe("StreamInContainer", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/containers/myid-1/files", "path=%2Ftmp%2Fsome-dir&user=root"),
+					ghttp.VerifyContentType("application/x-tar"),
+					ghttp.VerifyBody([]byte("some-tar")),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("streams the tar into the container", func() {
+			err := team.StreamInContainer("myid-1", "/tmp/some-dir", "root", bytes.NewBufferString("some-tar"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("StreamOutContainer", func() {
+		Context("when the path can be streamed out", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/containers/myid-1/files", "path=%2Ftmp%2Fcore&user=root"),
+						ghttp.RespondWith(http.StatusOK, "some-tar"),
+					),
+				)
+			})
+
+			It("returns the tar stream", func() {
+				stream, err := team.StreamOutContainer("myid-1", "/tmp/core", "root")
+				Expect(err).NotTo(HaveOccurred())
+
+				defer stream.Close()
+
+				contents, err := ioutil.ReadAll(stream)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-tar"))
+			})
+		})
+
+		Context("when the container is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.StreamOutContainer("myid-1", "/tmp/core", "root")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
@@ -13,6 +13,10 @@ var ErrUnauthorized = internal.ErrUnauthorized
 // ErrForbidden is returned for 403 response codes.
 var ErrForbidden = internal.ErrForbidden
 
+// PolicyCheckError is returned for 403 response codes caused by a request not
+// passing policy check. It lists the reasons given by the policy.
+type PolicyCheckError = internal.PolicyCheckError
+
 // GenericError is used when no more specific error is available, i.e. a
 // generic 500 Internal Server Error response with a message in the body.
 type GenericError struct {
@@ -34,3 +38,13 @@ type InvalidConfigError struct {
 func (c InvalidConfigError) Error() string {
 	return fmt.Sprintf("invalid pipeline config:\n%s", strings.Join(c.Errors, "\n"))
 }
+
+// InvalidExportError is returned when importing a team export is rejected.
+type InvalidExportError struct {
+	Errors []string `json:"errors"`
+}
+
+// Error lists the errors returned for the export.
+func (e InvalidExportError) Error() string {
+	return fmt.Sprintf("invalid team export:\n%s", strings.Join(e.Errors, "\n"))
+}
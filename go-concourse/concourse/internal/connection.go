@@ -203,6 +203,10 @@ func (connection *connection) populateResponse(response *http.Response, returnRe
 	}
 
 	if response.StatusCode == http.StatusForbidden {
+		if result, found := PolicyCheckResult(response.Header); found {
+			return PolicyCheckError{Reasons: result.Reasons}
+		}
+
 		return ErrForbidden
 	}
 
@@ -353,6 +353,36 @@ var _ = Describe("ATC Connection", func() {
 				})
 			})
 
+			Describe("403 response from a policy check", func() {
+				BeforeEach(func() {
+					atcServer = ghttp.NewServer()
+
+					connection = NewConnection(atcServer.URL(), nil, tracing)
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/pipelines/foo"),
+							ghttp.RespondWith(http.StatusForbidden, "policy check not pass", http.Header{
+								atc.PolicyCheckHeader: {`{"allowed":false,"reasons":["some-reason"],"severity":"block"}`},
+							}),
+						),
+					)
+				})
+
+				It("returns back PolicyCheckError", func() {
+					err := connection.Send(Request{
+						RequestName: atc.DeletePipeline,
+						Params: rata.Params{
+							"pipeline_name": "foo",
+							"team_name":     atc.DefaultTeamName,
+						},
+					}, nil)
+
+					Expect(err).To(Equal(PolicyCheckError{Reasons: []string{"some-reason"}}))
+					Expect(err.Error()).To(Equal("policy check failed: some-reason"))
+				})
+			})
+
 			Describe("403 response", func() {
 				BeforeEach(func() {
 					atcServer = ghttp.NewServer()
@@ -1,9 +1,13 @@
 package internal
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/google/jsonapi"
 )
 
@@ -39,3 +43,34 @@ func (e ResourceNotFoundError) Error() string {
 
 var ErrUnauthorized = errors.New("not authorized")
 var ErrForbidden = errors.New("forbidden")
+
+// PolicyCheckError is returned for 403 response codes caused by a request not
+// passing policy check.
+type PolicyCheckError struct {
+	Reasons []string
+}
+
+func (e PolicyCheckError) Error() string {
+	if len(e.Reasons) == 0 {
+		return "policy check failed"
+	}
+
+	return fmt.Sprintf("policy check failed: %s", strings.Join(e.Reasons, "; "))
+}
+
+// PolicyCheckResult returns the policy check result set on a response, if
+// the request did not pass policy check.
+func PolicyCheckResult(header http.Header) (atc.PolicyCheckResult, bool) {
+	value := header.Get(atc.PolicyCheckHeader)
+	if value == "" {
+		return atc.PolicyCheckResult{}, false
+	}
+
+	var result atc.PolicyCheckResult
+	err := json.Unmarshal([]byte(value), &result)
+	if err != nil {
+		return atc.PolicyCheckResult{}, false
+	}
+
+	return result, true
+}
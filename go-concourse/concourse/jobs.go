@@ -3,6 +3,8 @@ package concourse
 import (
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -94,6 +96,39 @@ func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]a
 	}
 }
 
+func (team *team) JobStats(pipelineName string, since time.Time, until time.Time) ([]atc.JobStats, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	queryParams := url.Values{}
+	if !since.IsZero() {
+		queryParams.Add(atc.JobStatsQuerySince, strconv.FormatInt(since.Unix(), 10))
+	}
+
+	if !until.IsZero() {
+		queryParams.Add(atc.JobStatsQueryUntil, strconv.FormatInt(until.Unix(), 10))
+	}
+
+	var stats []atc.JobStats
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListJobStats,
+		Params:      params,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &stats,
+	})
+	switch err.(type) {
+	case nil:
+		return stats, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
 func (team *team) PauseJob(pipelineName string, jobName string) (bool, error) {
 	params := rata.Params{
 		"pipeline_name": pipelineName,
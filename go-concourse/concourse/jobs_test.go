@@ -3,6 +3,7 @@ package concourse_test
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -354,6 +355,69 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 	})
 
+	Describe("JobStats", func() {
+		var expectedStats []atc.JobStats
+
+		BeforeEach(func() {
+			expectedStats = []atc.JobStats{
+				{JobName: "myjob", Builds: 2, Succeeded: 1, Failed: 1, SuccessRate: 0.5},
+			}
+		})
+
+		Context("when a window is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/job-stats", "since=100&until=200"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedStats),
+					),
+				)
+			})
+
+			It("returns the stats of each job within it", func() {
+				stats, found, err := team.JobStats("mypipeline", time.Unix(100, 0), time.Unix(200, 0))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(stats).To(Equal(expectedStats))
+			})
+		})
+
+		Context("when no window is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/job-stats", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedStats),
+					),
+				)
+			})
+
+			It("leaves the window to the server", func() {
+				stats, found, err := team.JobStats("mypipeline", time.Time{}, time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(stats).To(Equal(expectedStats))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/job-stats"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				_, found, err := team.JobStats("mypipeline", time.Time{}, time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("PauseJob", func() {
 		var (
 			expectedStatus int
@@ -0,0 +1,46 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+func (client *client) GetMaintenance() (atc.Maintenance, error) {
+	var mode atc.Maintenance
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetMaintenance,
+	}, &internal.Response{
+		Result: &mode,
+	})
+
+	return mode, err
+}
+
+func (client *client) EnableMaintenance(reason string, pauseChecks bool) error {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(atc.Maintenance{
+		Reason:      reason,
+		PauseChecks: pauseChecks,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.EnableMaintenance,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (client *client) DisableMaintenance() error {
+	return client.connection.Send(internal.Request{
+		RequestName: atc.DisableMaintenance,
+	}, nil)
+}
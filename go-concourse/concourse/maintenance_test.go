@@ -0,0 +1,74 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Maintenance Handler", func() {
+	Describe("GetMaintenance", func() {
+		expectedMode := atc.Maintenance{
+			Enabled:       true,
+			Reason:        "upgrading",
+			EnabledAt:     100,
+			RunningBuilds: 2,
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/maintenance"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMode),
+				),
+			)
+		})
+
+		It("returns the maintenance mode", func() {
+			mode, err := client.GetMaintenance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mode).To(Equal(expectedMode))
+		})
+	})
+
+	Describe("EnableMaintenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/maintenance"),
+					ghttp.VerifyJSONRepresenting(atc.Maintenance{
+						Reason:      "upgrading",
+						PauseChecks: true,
+					}),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("enables maintenance mode", func() {
+			err := client.EnableMaintenance("upgrading", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("DisableMaintenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/maintenance"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("disables maintenance mode", func() {
+			err := client.DisableMaintenance()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Notification Deliveries", func() {
+	Describe("NotificationDeliveries", func() {
+		var expectedDeliveries []atc.NotificationDelivery
+
+		BeforeEach(func() {
+			expectedDeliveries = []atc.NotificationDelivery{
+				{
+					ID:               2,
+					NotificationName: "slack",
+					EventID:          42,
+					EventType:        atc.PipelineEventBuildStatus,
+					Status:           atc.NotificationDeliverySucceeded,
+					Attempts:         1,
+					ResponseCode:     200,
+				},
+			}
+		})
+
+		Context("when a limit is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/notification-deliveries", "limit=10"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+					),
+				)
+			})
+
+			It("returns the pipeline's deliveries", func() {
+				deliveries, found, err := team.NotificationDeliveries("mypipeline", 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(deliveries).To(Equal(expectedDeliveries))
+			})
+		})
+
+		Context("when no limit is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/notification-deliveries", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+					),
+				)
+			})
+
+			It("leaves the limit to the server", func() {
+				deliveries, found, err := team.NotificationDeliveries("mypipeline", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(deliveries).To(Equal(expectedDeliveries))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/notification-deliveries"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns not found", func() {
+				_, found, err := team.NotificationDeliveries("mypipeline", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package concourse
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+	"github.com/vito/go-sse/sse"
+)
+
+// PipelineEventStream is a stream of pipeline events. If the connection
+// drops it is re-established, resuming after the last event received.
+type PipelineEventStream interface {
+	NextEvent() (atc.PipelineEvent, error)
+	Close() error
+}
+
+func (team *team) PipelineEvents(pipelineName string) (PipelineEventStream, error) {
+	return team.connectToPipelineEvents(internal.Request{
+		RequestName: atc.PipelineEvents,
+		Params: rata.Params{
+			"team_name":     team.Name(),
+			"pipeline_name": pipelineName,
+		},
+	})
+}
+
+func (team *team) AllPipelineEvents() (PipelineEventStream, error) {
+	return team.connectToPipelineEvents(internal.Request{
+		RequestName: atc.TeamEvents,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+	})
+}
+
+func (team *team) connectToPipelineEvents(request internal.Request) (PipelineEventStream, error) {
+	sseEvents, err := team.connection.ConnectToEventStream(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelineEventStream{sseReader: sseEvents}, nil
+}
+
+type pipelineEventStream struct {
+	sseReader *sse.EventSource
+}
+
+func (s *pipelineEventStream) NextEvent() (atc.PipelineEvent, error) {
+	se, err := s.sseReader.Next()
+	if err != nil {
+		return atc.PipelineEvent{}, err
+	}
+
+	if se.Name != "event" {
+		return atc.PipelineEvent{}, fmt.Errorf("unknown event name: %s", se.Name)
+	}
+
+	var ev atc.PipelineEvent
+	err = json.Unmarshal(se.Data, &ev)
+	if err != nil {
+		return atc.PipelineEvent{}, err
+	}
+
+	return ev, nil
+}
+
+func (s *pipelineEventStream) Close() error {
+	return s.sseReader.Close()
+}
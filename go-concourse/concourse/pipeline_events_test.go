@@ -0,0 +1,117 @@
+package concourse_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("ATC Handler Pipeline Events", func() {
+	var events []atc.PipelineEvent
+
+	BeforeEach(func() {
+		events = []atc.PipelineEvent{
+			{
+				ID:           1,
+				Type:         atc.PipelineEventPipelinePaused,
+				TeamName:     "some-team",
+				PipelineName: "mypipeline",
+			},
+			{
+				ID:           2,
+				Type:         atc.PipelineEventBuildStatus,
+				TeamName:     "some-team",
+				PipelineName: "mypipeline",
+				JobName:      "myjob",
+				Build:        &atc.PipelineEventBuild{ID: 3, Name: "4", Status: atc.StatusStarted},
+			},
+		}
+	})
+
+	eventsHandler := func(path string) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path),
+			func(w http.ResponseWriter, r *http.Request) {
+				flusher := w.(http.Flusher)
+
+				w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+				w.WriteHeader(http.StatusOK)
+
+				for _, ev := range events {
+					payload, err := json.Marshal(ev)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = sse.Event{
+						ID:   strconv.Itoa(ev.ID),
+						Name: "event",
+						Data: payload,
+					}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+
+					flusher.Flush()
+				}
+			},
+		)
+	}
+
+	expectEvents := func(stream concourse.PipelineEventStream) {
+		for _, expected := range events {
+			ev, err := stream.NextEvent()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ev).To(Equal(expected))
+		}
+	}
+
+	Describe("PipelineEvents", func() {
+		Context("when the server streams events", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(eventsHandler("/api/v1/teams/some-team/pipelines/mypipeline/events"))
+			})
+
+			It("returns each of the pipeline's events", func() {
+				stream, err := team.PipelineEvents("mypipeline")
+				Expect(err).NotTo(HaveOccurred())
+				defer stream.Close()
+
+				expectEvents(stream)
+			})
+		})
+
+		Context("when the server returns 403", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/events"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.PipelineEvents("mypipeline")
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("AllPipelineEvents", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(eventsHandler("/api/v1/teams/some-team/events"))
+		})
+
+		It("returns the events of all of the team's pipelines", func() {
+			stream, err := team.AllPipelineEvents()
+			Expect(err).NotTo(HaveOccurred())
+			defer stream.Close()
+
+			expectEvents(stream)
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// ListSessions lists the active sessions of the current user, or of every
+// user if all is set (which requires admin access).
+func (client *client) ListSessions(all bool) ([]atc.Session, error) {
+	queryParams := url.Values{}
+	if all {
+		queryParams.Add("all", "true")
+	}
+
+	var sessions []atc.Session
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListSessions,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &sessions,
+	})
+
+	return sessions, err
+}
+
+func (client *client) RevokeSession(sessionID int) (bool, error) {
+	params := rata.Params{
+		"session_id": strconv.Itoa(sessionID),
+	}
+
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeSession,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// RevokeCurrentSession revokes the session of the token the client is
+// authenticated with. Servers which predate session management have nothing
+// to revoke.
+func (client *client) RevokeCurrentSession() error {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeCurrentSession,
+	}, nil)
+
+	if _, ok := err.(internal.ResourceNotFoundError); ok {
+		return nil
+	}
+
+	return err
+}
+
+// RevokeUserSessions revokes every active session and personal API token of
+// the user identified by the connector and sub, and returns how many of each
+// were revoked.
+func (client *client) RevokeUserSessions(connector string, sub string) (atc.RevokedSessions, error) {
+	params := rata.Params{
+		"connector": connector,
+		"user_sub":  sub,
+	}
+
+	var revoked atc.RevokedSessions
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeUserSessions,
+		Params:      params,
+	}, &internal.Response{
+		Result: &revoked,
+	})
+
+	return revoked, err
+}
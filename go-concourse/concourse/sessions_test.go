@@ -0,0 +1,138 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Sessions Handler", func() {
+	Describe("ListSessions", func() {
+		expectedSessions := []atc.Session{
+			{ID: 1, Sub: "some-sub", UserName: "some-user", Connector: "github", Current: true},
+		}
+
+		Context("when listing the sessions of the user", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/sessions", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSessions),
+					),
+				)
+			})
+
+			It("returns the sessions", func() {
+				sessions, err := client.ListSessions(false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessions).To(Equal(expectedSessions))
+			})
+		})
+
+		Context("when listing every session", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/sessions", "all=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSessions),
+					),
+				)
+			})
+
+			It("asks for every session", func() {
+				sessions, err := client.ListSessions(true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessions).To(Equal(expectedSessions))
+			})
+		})
+	})
+
+	Describe("RevokeSession", func() {
+		Context("when the session exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/sessions/42"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("revokes the session", func() {
+				revoked, err := client.RevokeSession(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeTrue())
+			})
+		})
+
+		Context("when the session does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/sessions/42"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				revoked, err := client.RevokeSession(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RevokeCurrentSession", func() {
+		Context("when the session is revoked", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/session"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				Expect(client.RevokeCurrentSession()).To(Succeed())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the server does not support sessions", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/session"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				Expect(client.RevokeCurrentSession()).To(Succeed())
+			})
+		})
+	})
+
+	Describe("RevokeUserSessions", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/users/github/some-sub/sessions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.RevokedSessions{Count: 2, APITokens: 1}),
+				),
+			)
+		})
+
+		It("returns how many sessions and api tokens were revoked", func() {
+			revoked, err := client.RevokeUserSessions("github", "some-sub")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(Equal(atc.RevokedSessions{Count: 2, APITokens: 1}))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package concourse
 
 import (
 	"io"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -18,6 +19,9 @@ type Team interface {
 	RenameTeam(teamName, name string) (bool, error)
 	DestroyTeam(teamName string) error
 
+	Export() (atc.TeamExport, error)
+	Import(export atc.TeamExport, checkCredentials bool) (atc.TeamImportResponse, error)
+
 	Pipeline(name string) (atc.Pipeline, bool, error)
 	PipelineBuilds(pipelineName string, page Page) ([]atc.Build, Pagination, bool, error)
 	DeletePipeline(pipelineName string) (bool, error)
@@ -30,6 +34,12 @@ type Team interface {
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineName string) (atc.Config, string, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	CheckPipelineConfigCreds(pipelineName string, passedConfig []byte) (atc.CheckCredsResponse, error)
+
+	PipelineEvents(pipelineName string) (PipelineEventStream, error)
+	AllPipelineEvents() (PipelineEventStream, error)
+
+	NotificationDeliveries(pipelineName string, limit int) ([]atc.NotificationDelivery, bool, error)
 
 	CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error)
 
@@ -41,6 +51,7 @@ type Team interface {
 	CreateJobBuild(pipelineName string, jobName string) (atc.Build, error)
 	RerunJobBuild(pipelineName string, jobName string, buildName string) (atc.Build, error)
 	ListJobs(pipelineName string) ([]atc.Job, error)
+	JobStats(pipelineName string, since time.Time, until time.Time) ([]atc.JobStats, bool, error)
 	ScheduleJob(pipelineName string, jobName string) (bool, error)
 
 	PauseJob(pipelineName string, jobName string) (bool, error)
@@ -66,13 +77,22 @@ type Team interface {
 
 	ListContainers(queryList map[string]string) ([]atc.Container, error)
 	GetContainer(id string) (atc.Container, error)
+	StreamInContainer(handle string, path string, user string, tarStream io.Reader) error
+	StreamOutContainer(handle string, path string, user string) (io.ReadCloser, error)
 	ListVolumes() ([]atc.Volume, error)
 	CreateBuild(plan atc.Plan) (atc.Build, error)
+	CreateJobPlan(request atc.JobPlanRequest) (atc.Plan, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
 	OrderingPipelines(pipelineNames []string) error
 
 	CreateArtifact(io.Reader, string) (atc.WorkerArtifact, error)
 	GetArtifact(int) (io.ReadCloser, error)
+
+	ListAPITokens() ([]atc.APIToken, error)
+	CreateAPIToken(atc.APITokenRequest) (atc.APIToken, error)
+	DeleteAPIToken(tokenName string) (bool, error)
+
+	ExplainAuth(atc.ExplainTeamAuthRequest) (atc.TeamAuthExplanation, error)
 }
 
 type team struct {
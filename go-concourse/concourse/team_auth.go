@@ -0,0 +1,39 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// ExplainAuth returns the rules of the team's auth config that grant roles to
+// the subject of the request, or to the current user if it has none.
+func (team *team) ExplainAuth(request atc.ExplainTeamAuthRequest) (atc.TeamAuthExplanation, error) {
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(request)
+	if err != nil {
+		return atc.TeamAuthExplanation{}, err
+	}
+
+	var explanation atc.TeamAuthExplanation
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.ExplainTeamAuth,
+		Params:      params,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &explanation,
+	})
+
+	return explanation, err
+}
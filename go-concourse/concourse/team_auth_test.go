@@ -0,0 +1,71 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Team Auth", func() {
+	Describe("ExplainAuth", func() {
+		var (
+			request             atc.ExplainTeamAuthRequest
+			expectedExplanation atc.TeamAuthExplanation
+		)
+
+		BeforeEach(func() {
+			request = atc.ExplainTeamAuthRequest{
+				Subject: &atc.AuthSubject{
+					Connector: "github",
+					UserName:  "some-user",
+				},
+			}
+
+			expectedExplanation = atc.TeamAuthExplanation{
+				Team:    "some-team",
+				Subject: *request.Subject,
+				Roles:   []string{"owner"},
+				Rules: []atc.TeamAuthRule{
+					{Role: "owner", Type: atc.TeamAuthRuleUser, Value: "github:some-user"},
+				},
+			}
+		})
+
+		Context("when the explanation is returned", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/auth/explain"),
+						ghttp.VerifyJSONRepresenting(request),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedExplanation),
+					),
+				)
+			})
+
+			It("returns the explanation", func() {
+				explanation, err := team.ExplainAuth(request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation).To(Equal(expectedExplanation))
+			})
+		})
+
+		Context("when the request is forbidden", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/auth/explain"),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.ExplainAuth(request)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
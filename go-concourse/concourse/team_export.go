@@ -0,0 +1,69 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// Export returns the team's auth, pipelines, and the resource state set
+// through the API, for importing into another team.
+func (team *team) Export() (atc.TeamExport, error) {
+	var export atc.TeamExport
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ExportTeam,
+		Params:      rata.Params{"team_name": team.name},
+	}, &internal.Response{
+		Result: &export,
+	})
+
+	return export, err
+}
+
+// Import restores an export into the team, creating it if necessary. The
+// response lists what was applied, and its warnings describe anything which
+// could not be restored. If the import fails part way through, the response
+// lists what was applied before the failure.
+func (team *team) Import(export atc.TeamExport, checkCredentials bool) (atc.TeamImportResponse, error) {
+	payload, err := json.Marshal(export)
+	if err != nil {
+		return atc.TeamImportResponse{}, err
+	}
+
+	queryParams := url.Values{}
+	if checkCredentials {
+		queryParams.Add(atc.SaveConfigCheckCreds, "")
+	}
+
+	var importResponse atc.TeamImportResponse
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.ImportTeam,
+		Params:      rata.Params{"team_name": team.name},
+		Query:       queryParams,
+		Body:        bytes.NewBuffer(payload),
+		Header:      http.Header{"Content-Type": {"application/json"}},
+	}, &internal.Response{
+		Result: &importResponse,
+	})
+	if err != nil {
+		if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+			var rejected atc.TeamImportResponse
+			if json.Unmarshal([]byte(unexpectedResponseError.Body), &rejected) == nil && len(rejected.Errors) > 0 {
+				if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+					return atc.TeamImportResponse{}, InvalidExportError{Errors: rejected.Errors}
+				}
+
+				return rejected, err
+			}
+		}
+
+		return atc.TeamImportResponse{}, err
+	}
+
+	return importResponse, nil
+}
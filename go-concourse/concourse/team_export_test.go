@@ -0,0 +1,178 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Team Export", func() {
+	var export atc.TeamExport
+
+	BeforeEach(func() {
+		export = atc.TeamExport{
+			Version: atc.TeamExportVersion,
+			Team: atc.Team{
+				Name: "some-team",
+				Auth: atc.TeamAuth{"owner": {"users": {"local:username"}}},
+			},
+			Pipelines: []atc.PipelineExport{
+				{Name: "some-pipeline", Paused: true},
+			},
+		}
+	})
+
+	Describe("Export", func() {
+		Context("when the export succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/export"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, export),
+					),
+				)
+			})
+
+			It("returns the export", func() {
+				Expect(team.Export()).To(Equal(export))
+			})
+		})
+
+		Context("when the team is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/export"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				_, err := team.Export()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Import", func() {
+		Context("when the import succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import"),
+						ghttp.VerifyJSONRepresenting(export),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.TeamImportResponse{
+							Team:      "some-team",
+							Pipelines: []string{"some-pipeline"},
+							Warnings:  []string{"some warning"},
+						}),
+					),
+				)
+			})
+
+			It("returns what was applied and the warnings", func() {
+				response, err := team.Import(export, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Pipelines).To(Equal([]string{"some-pipeline"}))
+				Expect(response.Warnings).To(Equal([]string{"some warning"}))
+			})
+		})
+
+		Context("when checking credentials", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import", "check_creds="),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.TeamImportResponse{}),
+					),
+				)
+			})
+
+			It("asks the server to check them", func() {
+				_, err := team.Import(export, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the import fails part way through", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import"),
+						ghttp.RespondWithJSONEncoded(http.StatusInternalServerError, atc.TeamImportResponse{
+							Team:      "some-team",
+							Pipelines: []string{"some-pipeline"},
+							Errors:    []string{"failed to import pipeline other-pipeline: boom"},
+						}),
+					),
+				)
+			})
+
+			It("returns what was applied along with the error", func() {
+				response, err := team.Import(export, false)
+				Expect(err).To(HaveOccurred())
+				Expect(response.Pipelines).To(Equal([]string{"some-pipeline"}))
+			})
+		})
+
+		Context("when the export is blocked by policy", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import"),
+						ghttp.RespondWith(http.StatusForbidden, "", http.Header{
+							atc.PolicyCheckHeader: {`{"allowed":false,"reasons":["pipeline some-pipeline: policy check not pass"]}`},
+						}),
+					),
+				)
+			})
+
+			It("returns a PolicyCheckError", func() {
+				_, err := team.Import(export, false)
+				Expect(err).To(Equal(concourse.PolicyCheckError{Reasons: []string{"pipeline some-pipeline: policy check not pass"}}))
+			})
+		})
+
+		Context("when the export is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import"),
+						ghttp.RespondWithJSONEncoded(http.StatusBadRequest, atc.TeamImportResponse{
+							Errors: []string{"some error"},
+						}),
+					),
+				)
+			})
+
+			It("returns an InvalidExportError", func() {
+				_, err := team.Import(export, false)
+				Expect(err).To(Equal(concourse.InvalidExportError{Errors: []string{"some error"}}))
+			})
+		})
+
+		Context("when the server blows up", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/import"),
+						ghttp.RespondWith(http.StatusInternalServerError, "boom"),
+					),
+				)
+			})
+
+			It("errors", func() {
+				_, err := team.Import(export, false)
+				Expect(err).To(HaveOccurred())
+				Expect(err).NotTo(BeAssignableToTypeOf(concourse.InvalidExportError{}))
+			})
+		})
+	})
+})
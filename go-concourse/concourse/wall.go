@@ -0,0 +1,74 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// ListWallMessages lists the wall messages shown to the current user, or
+// every message that has not ended if all is set (which requires admin
+// access).
+func (client *client) ListWallMessages(all bool) ([]atc.WallMessage, error) {
+	queryParams := url.Values{}
+	if all {
+		queryParams.Add("all", "true")
+	}
+
+	var messages []atc.WallMessage
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListWallMessages,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &messages,
+	})
+
+	return messages, err
+}
+
+func (client *client) CreateWallMessage(message atc.WallMessage) (atc.WallMessage, error) {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(message)
+	if err != nil {
+		return atc.WallMessage{}, err
+	}
+
+	var created atc.WallMessage
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.CreateWallMessage,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	return created, err
+}
+
+func (client *client) DeleteWallMessage(id int) (bool, error) {
+	params := rata.Params{
+		"message_id": strconv.Itoa(id),
+	}
+
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.DeleteWallMessage,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,116 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Wall Messages Handler", func() {
+	Describe("ListWallMessages", func() {
+		expectedMessages := []atc.WallMessage{
+			{ID: 1, Message: "some-message", Severity: atc.WallSeverityWarning, EndsAt: 100},
+		}
+
+		Context("when listing the messages shown to the user", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/wall/messages", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMessages),
+					),
+				)
+			})
+
+			It("returns the messages", func() {
+				messages, err := client.ListWallMessages(false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(messages).To(Equal(expectedMessages))
+			})
+		})
+
+		Context("when listing every message", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/wall/messages", "all=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMessages),
+					),
+				)
+			})
+
+			It("asks for every message", func() {
+				messages, err := client.ListWallMessages(true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(messages).To(Equal(expectedMessages))
+			})
+		})
+	})
+
+	Describe("CreateWallMessage", func() {
+		message := atc.WallMessage{
+			Message:  "some-message",
+			Severity: atc.WallSeverityCritical,
+			Teams:    []string{"some-team"},
+		}
+
+		BeforeEach(func() {
+			created := message
+			created.ID = 42
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/wall/messages"),
+					ghttp.VerifyJSONRepresenting(message),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, created),
+				),
+			)
+		})
+
+		It("returns the created message", func() {
+			created, err := client.CreateWallMessage(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.ID).To(Equal(42))
+			Expect(created.Message).To(Equal("some-message"))
+		})
+	})
+
+	Describe("DeleteWallMessage", func() {
+		Context("when the message exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/wall/messages/42"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("returns true", func() {
+				found, err := client.DeleteWallMessage(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the message does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/wall/messages/42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := client.DeleteWallMessage(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
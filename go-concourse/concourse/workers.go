@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -81,10 +82,21 @@ func (client *client) PruneWorker(workerName string) error {
 }
 
 func (client *client) LandWorker(workerName string) error {
+	return client.LandWorkerBy(workerName, 0)
+}
+
+func (client *client) LandWorkerBy(workerName string, deadline time.Duration) error {
 	params := rata.Params{"worker_name": workerName}
+
+	query := url.Values{}
+	if deadline != 0 {
+		query.Set("deadline", deadline.String())
+	}
+
 	err := client.connection.Send(internal.Request{
 		RequestName: atc.LandWorker,
 		Params:      params,
+		Query:       query,
 		Header: http.Header{
 			"Content-Type": {"application/json"},
 		},
@@ -2,6 +2,7 @@ package concourse_test
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -160,4 +161,20 @@ var _ = Describe("ATC Handler Workers", func() {
 			})
 		})
 	})
+
+	Describe("LandWorkerBy", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land", "deadline=1h30m0s"),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("lands the worker with a deadline", func() {
+			err := client.LandWorkerBy("some-worker", 90*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -141,6 +141,15 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 			"users":  users,
 			"groups": groups,
 		}
+
+		// restrict the role to some of the team's pipelines
+		if pipelines, ok := role["pipelines"].([]interface{}); ok {
+			for _, pipeline := range pipelines {
+				if name, ok := pipeline.(string); ok && name != "" {
+					auth[roleName]["pipelines"] = append(auth[roleName]["pipelines"], name)
+				}
+			}
+		}
 	}
 
 	if err := auth.Validate(); err != nil {
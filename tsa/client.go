@@ -179,8 +179,9 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 // Land invokes the 'land-worker' command, which will initiate the landing
 // process for the worker. The worker will transition to 'landing' and finally
 // to 'landed' when it is fully drained, causing any existing registrations to
-// exit.
-func (client *Client) Land(ctx context.Context) error {
+// exit. If a deadline is given, builds still running on the worker once it has
+// passed are interrupted and rerun elsewhere.
+func (client *Client) Land(ctx context.Context, deadline time.Duration) error {
 	logger := lagerctx.FromContext(ctx)
 
 	sshClient, _, err := client.dial(ctx, 0)
@@ -191,7 +192,12 @@ func (client *Client) Land(ctx context.Context) error {
 
 	defer sshClient.Close()
 
-	return client.run(ctx, sshClient, "land-worker", os.Stdout)
+	command := "land-worker"
+	if deadline != 0 {
+		command += " --deadline " + deadline.String()
+	}
+
+	return client.run(ctx, sshClient, command, os.Stdout)
 }
 
 // Retire invokes the 'retire-worker' command, which will initiate the retiring
@@ -2,6 +2,7 @@ package main_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -10,10 +11,17 @@ import (
 )
 
 var _ = Describe("Land", func() {
-	var landErr error
+	var (
+		deadline time.Duration
+		landErr  error
+	)
+
+	BeforeEach(func() {
+		deadline = 0
+	})
 
 	JustBeforeEach(func() {
-		landErr = tsaClient.Land(context.TODO())
+		landErr = tsaClient.Land(context.TODO(), deadline)
 	})
 
 	Context("when the worker is registered globally", func() {
@@ -40,6 +48,22 @@ var _ = Describe("Land", func() {
 				})
 			})
 
+			Context("when landing with a deadline", func() {
+				BeforeEach(func() {
+					deadline = 30 * time.Minute
+
+					atcServer.AppendHandlers(ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land", "deadline=30m0s"),
+						ghttp.RespondWith(200, nil, nil),
+					))
+				})
+
+				It("passes the deadline along to the ATC", func() {
+					Expect(landErr).ToNot(HaveOccurred())
+					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+				})
+			})
+
 			Context("when the ATC responds with a missing worker (404)", func() {
 				BeforeEach(func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
@@ -3,6 +3,8 @@ package tsa
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"time"
 
 	"net/http/httputil"
 
@@ -17,6 +19,7 @@ import (
 type Lander struct {
 	ATCEndpoint *rata.RequestGenerator
 	HTTPClient  *http.Client
+	Deadline    time.Duration
 }
 
 func (l *Lander) Land(ctx context.Context, worker atc.Worker) error {
@@ -33,6 +36,12 @@ func (l *Lander) Land(ctx context.Context, worker atc.Worker) error {
 		return err
 	}
 
+	if l.Deadline != 0 {
+		request.URL.RawQuery = url.Values{
+			"deadline": {l.Deadline.String()},
+		}.Encode()
+	}
+
 	response, err := l.HTTPClient.Do(request)
 	if err != nil {
 		logger.Error("failed-to-land", err)
@@ -2,6 +2,7 @@ package tsa_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/concourse/concourse/tsa"
 	"golang.org/x/oauth2"
@@ -78,6 +79,24 @@ var _ = Describe("Lander", func() {
 		Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
 	})
 
+	Context("when a deadline is configured", func() {
+		BeforeEach(func() {
+			lander.Deadline = 2 * time.Hour
+		})
+
+		It("tells the ATC to land the worker by the deadline", func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land", "deadline=2h0m0s"),
+				ghttp.RespondWith(200, nil, nil),
+			))
+
+			err := lander.Land(ctx, worker)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
 	Context("when the ATC responds with a 403", func() {
 		BeforeEach(func() {
 			fakeATC.AppendHandlers(ghttp.CombineHandlers(
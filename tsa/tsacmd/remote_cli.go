@@ -140,6 +140,8 @@ func (r forwardWorkerRequest) expectedForwards() int {
 
 type landWorkerRequest struct {
 	server *server
+
+	deadline time.Duration
 }
 
 func checkTeam(state ConnState, worker atc.Worker) error {
@@ -173,6 +175,7 @@ func (req landWorkerRequest) Handle(ctx context.Context, state ConnState, channe
 	return (&tsa.Lander{
 		ATCEndpoint: req.server.atcEndpointPicker.Pick(),
 		HTTPClient:  req.server.httpClient,
+		Deadline:    req.deadline,
 	}).Land(ctx, worker)
 }
 
@@ -507,8 +507,19 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 			baggageclaimAddr: *baggageclaim,
 		}
 	case tsa.LandWorker:
+		var fs = flag.NewFlagSet(command, flag.ContinueOnError)
+
+		var deadline = fs.Duration("deadline", 0, "interrupt builds still running on the worker after this long")
+
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, "", err
+		}
+
 		req = landWorkerRequest{
 			server: server,
+
+			deadline: *deadline,
 		}
 	case tsa.RetireWorker:
 		req = retireWorkerRequest{
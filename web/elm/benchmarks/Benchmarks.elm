@@ -695,6 +695,7 @@ sampleSession =
     , turbulenceImgSrc = ""
     , userState = UserState.UserStateLoggedOut
     , version = ""
+    , wall = []
     }
 
 
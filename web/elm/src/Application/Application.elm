@@ -39,6 +39,7 @@ import Time
 import Tooltip
 import Url
 import UserState exposing (UserState(..))
+import Views.Wall as Wall
 
 
 type alias Flags =
@@ -69,6 +70,7 @@ init flags url =
             , hovered = HoverState.NoHover
             , clusterName = ""
             , version = ""
+            , wall = []
             , turbulenceImgSrc = flags.turbulenceImgSrc
             , notFoundImgSrc = flags.notFoundImgSrc
             , csrfToken = flags.csrfToken
@@ -192,13 +194,17 @@ handleCallback callback model =
             in
             subpageHandleCallback callback ( { model | session = newSession }, [] )
 
-        ClusterInfoFetched (Ok { clusterName, version }) ->
+        ClusterInfoFetched (Ok { clusterName, version, wall }) ->
             let
                 session =
                     model.session
 
                 newSession =
-                    { session | clusterName = clusterName, version = version }
+                    { session
+                        | clusterName = clusterName
+                        , version = version
+                        , wall = wall
+                    }
             in
             subpageHandleCallback callback ( { model | session = newSession }, [] )
 
@@ -447,6 +453,7 @@ view model =
             , SideBar.tooltip model.session
                 |> Maybe.map (Tooltip.view model.session)
                 |> Maybe.withDefault (Html.text "")
+            , Wall.view model.session.wall
             , Html.div
                 (id "page-wrapper"
                     :: style "height" "100%"
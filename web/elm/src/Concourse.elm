@@ -39,6 +39,7 @@ module Concourse exposing
     , Version
     , VersionedResource
     , VersionedResourceIdentifier
+    , WallMessage
     , csrfTokenHeaderName
     , customDecoder
     , decodeAuthToken
@@ -549,6 +550,7 @@ decodeBuildStepLoadVar =
 type alias ClusterInfo =
     { version : String
     , clusterName : String
+    , wall : List WallMessage
     }
 
 
@@ -557,6 +559,20 @@ decodeInfo =
     Json.Decode.succeed ClusterInfo
         |> andMap (Json.Decode.field "version" Json.Decode.string)
         |> andMap (defaultTo "" <| Json.Decode.field "cluster_name" Json.Decode.string)
+        |> andMap (defaultTo [] <| Json.Decode.field "wall" <| Json.Decode.list decodeWallMessage)
+
+
+type alias WallMessage =
+    { message : String
+    , severity : String
+    }
+
+
+decodeWallMessage : Json.Decode.Decoder WallMessage
+decodeWallMessage =
+    Json.Decode.succeed WallMessage
+        |> andMap (Json.Decode.field "message" Json.Decode.string)
+        |> andMap (defaultTo "info" <| Json.Decode.field "severity" Json.Decode.string)
 
 
 
@@ -177,11 +177,39 @@ decodeBuildEvent =
                     "finish-put" ->
                         Json.Decode.field "data" (decodeFinishResource FinishPut)
 
+                    "policy-check" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 Log
+                                (Json.Decode.field "origin" decodeOrigin)
+                                decodePolicyCheckPayload
+                                (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     unknown ->
                         Json.Decode.fail ("unknown event type: " ++ unknown)
             )
 
 
+decodePolicyCheckPayload : Json.Decode.Decoder String
+decodePolicyCheckPayload =
+    Json.Decode.map3
+        (\action severity reasons ->
+            let
+                header =
+                    if severity == "warn" then
+                        "policy check warning (" ++ action ++ "):\n"
+
+                    else
+                        "policy check failed (" ++ action ++ "):\n"
+            in
+            header ++ String.concat (List.map (\r -> "  - " ++ r ++ "\n") reasons)
+        )
+        (Json.Decode.field "action" Json.Decode.string)
+        (Json.Decode.map (Maybe.withDefault "") <| Json.Decode.maybe <| Json.Decode.field "severity" Json.Decode.string)
+        (Json.Decode.map (Maybe.withDefault []) <| Json.Decode.maybe <| Json.Decode.field "reasons" <| Json.Decode.list Json.Decode.string)
+
+
 dateFromSeconds : Int -> Time.Posix
 dateFromSeconds =
     Time.millisToPosix << (*) 1000
@@ -0,0 +1,48 @@
+module Views.Wall exposing (view)
+
+import Colors
+import Concourse
+import Html exposing (Html)
+import Html.Attributes exposing (id, style)
+
+
+view : List Concourse.WallMessage -> Html msg
+view messages =
+    if List.isEmpty messages then
+        Html.text ""
+
+    else
+        Html.div
+            [ id "wall"
+            , style "position" "fixed"
+            , style "bottom" "0"
+            , style "left" "0"
+            , style "right" "0"
+            , style "z-index" "1000"
+            ]
+            (List.map viewMessage messages)
+
+
+viewMessage : Concourse.WallMessage -> Html msg
+viewMessage { message, severity } =
+    Html.div
+        [ style "background-color" (severityColor severity)
+        , style "color" Colors.white
+        , style "padding" "8px 15px"
+        , style "font-size" "13px"
+        , style "line-height" "17px"
+        ]
+        [ Html.text message ]
+
+
+severityColor : String -> String
+severityColor severity =
+    case severity of
+        "critical" ->
+            Colors.failure
+
+        "warning" ->
+            Colors.errorFaded
+
+        _ ->
+            Colors.paused
@@ -4,13 +4,14 @@ import Application.Application as Application
 import Browser
 import Common exposing (queryView)
 import Expect
+import Message.Callback as Callback
 import Message.Effects as Effects
 import Message.Message exposing (DomID(..), Message(..))
 import Message.Subscription as Subscription exposing (Delivery(..))
 import Message.TopLevelMessage as Msgs
 import Test exposing (..)
 import Test.Html.Query as Query
-import Test.Html.Selector exposing (id, style)
+import Test.Html.Selector exposing (id, style, text)
 import Url
 
 
@@ -96,4 +97,31 @@ all =
                     |> Common.queryView
                     |> Query.find [ id "page-wrapper" ]
                     |> Query.has [ style "height" "100%" ]
+        , test "shows the wall messages of the cluster" <|
+            \_ ->
+                Common.init "/"
+                    |> Application.handleCallback
+                        (Callback.ClusterInfoFetched <|
+                            Ok
+                                { version = "1.2.3"
+                                , clusterName = "some-cluster"
+                                , wall =
+                                    [ { message = "upgrading tonight"
+                                      , severity = "warning"
+                                      }
+                                    ]
+                                }
+                        )
+                    |> Tuple.first
+                    |> Common.queryView
+                    |> Query.find [ id "wall" ]
+                    |> Query.has
+                        [ style "position" "fixed"
+                        , text "upgrading tonight"
+                        ]
+        , test "shows no wall when there are no messages" <|
+            \_ ->
+                Common.init "/"
+                    |> Common.queryView
+                    |> Query.hasNot [ id "wall" ]
         ]
@@ -372,6 +372,7 @@ session =
     , userState = UserState.UserStateLoggedOut
     , clusterName = ""
     , version = ""
+    , wall = []
     , turbulenceImgSrc = ""
     , notFoundImgSrc = ""
     , csrfToken = ""
@@ -2073,7 +2073,7 @@ givenClusterInfo :
 givenClusterInfo version clusterName =
     Application.handleCallback
         (Callback.ClusterInfoFetched <|
-            Ok { version = version, clusterName = clusterName }
+            Ok { version = version, clusterName = clusterName, wall = [] }
         )
 
 
@@ -3970,6 +3970,7 @@ session =
     , hovered = HoverState.NoHover
     , clusterName = ""
     , version = ""
+    , wall = []
     , turbulenceImgSrc = flags.turbulenceImgSrc
     , notFoundImgSrc = flags.notFoundImgSrc
     , csrfToken = flags.csrfToken
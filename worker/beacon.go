@@ -21,6 +21,7 @@ type Beacon struct {
 
 	RebalanceInterval      time.Duration
 	ConnectionDrainTimeout time.Duration
+	LandDeadline           time.Duration
 
 	LocalGardenNetwork string
 	LocalGardenAddr    string
@@ -118,7 +119,7 @@ func (beacon *Beacon) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 			if isLand(sig) {
 				logger.Info("landing-worker")
 
-				err := beacon.Client.Land(ctx)
+				err := beacon.Client.Land(ctx, beacon.LandDeadline)
 				if err != nil {
 					logger.Error("failed-to-land-worker", err)
 
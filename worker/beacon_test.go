@@ -103,6 +103,18 @@ var _ = Describe("Beacon", func() {
 				Consistently(process.Wait()).ShouldNot(Receive())
 			})
 
+			Context("when a land deadline is configured", func() {
+				BeforeEach(func() {
+					beacon.LandDeadline = time.Hour
+				})
+
+				It("lands the worker with the deadline", func() {
+					Eventually(fakeClient.LandCallCount).Should(Equal(1))
+					_, deadline := fakeClient.LandArgsForCall(0)
+					Expect(deadline).To(Equal(time.Hour))
+				})
+			})
+
 			Describe("Drained", func() {
 				It("returns true", func() {
 					Eventually(beacon.Drained).Should(BeTrue())
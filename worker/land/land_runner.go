@@ -3,6 +3,7 @@ package land
 import (
 	"context"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -13,7 +14,8 @@ import (
 type LandWorkerCommand struct {
 	TSA worker.TSAConfig `group:"TSA Configuration" namespace:"tsa" required:"true"`
 
-	WorkerName string `long:"name" required:"true" description:"The name of the worker you wish to land."`
+	WorkerName string        `long:"name" required:"true" description:"The name of the worker you wish to land."`
+	Deadline   time.Duration `long:"deadline" description:"Interrupt and rerun elsewhere any builds still running on the worker after this long."`
 }
 
 func (cmd *LandWorkerCommand) Execute(args []string) error {
@@ -24,5 +26,5 @@ func (cmd *LandWorkerCommand) Execute(args []string) error {
 		Name: cmd.WorkerName,
 	})
 
-	return client.Land(lagerctx.NewContext(context.Background(), logger))
+	return client.Land(lagerctx.NewContext(context.Background(), logger), cmd.Deadline)
 }
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/concourse/concourse/tsa"
 )
@@ -11,7 +12,7 @@ import (
 type TSAClient interface {
 	Register(context.Context, tsa.RegisterOptions) error
 
-	Land(context.Context) error
+	Land(context.Context, time.Duration) error
 	Retire(context.Context) error
 	Delete(context.Context) error
 
@@ -50,6 +50,8 @@ type WorkerCommand struct {
 
 	ConnectionDrainTimeout time.Duration `long:"connection-drain-timeout" default:"1h" description:"Duration after which a worker should give up draining forwarded connections on shutdown."`
 
+	LandDeadline time.Duration `long:"land-deadline" description:"When landing, duration after which builds still running on the worker are interrupted and rerun elsewhere. By default landing waits for all builds to finish."`
+
 	Garden GardenBackend `group:"Garden Configuration" namespace:"garden"`
 
 	ExternalGardenURL flag.URL `long:"external-garden-url" description:"API endpoint of an externally managed Garden server to use instead of running the embedded Garden server."`
@@ -103,6 +105,7 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		tsaClient,
 		cmd.RebalanceInterval,
 		cmd.ConnectionDrainTimeout,
+		cmd.LandDeadline,
 		cmd.gardenAddr(),
 		cmd.baggageclaimAddr(),
 	)